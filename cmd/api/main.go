@@ -2,6 +2,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/bazilio91/sferra-cloud/pkg/auth"
 	"github.com/bazilio91/sferra-cloud/pkg/config"
 	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/services/notification"
+	"github.com/bazilio91/sferra-cloud/pkg/services/webhook"
 )
 
 func main() {
@@ -30,6 +33,21 @@ func main() {
 	handlers.SetJWTManager(jwtManager)
 	middleware.SetJWTManager(jwtManager)
 
+	// Start email notifications
+	if cfg.SMTPHost != "" {
+		sender := notification.NewSMTPSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom)
+		dispatcher, err := notification.NewDispatcher(db.DB, sender, cfg.QuotaLowThreshold)
+		if err != nil {
+			log.Fatalf("Failed to initialize notifications: %v", err)
+		}
+		go dispatcher.Run(context.Background())
+	} else {
+		log.Println("SMTP_HOST is not set, email notifications are disabled")
+	}
+
+	// Start webhook deliveries
+	go webhook.NewService(db.DB, nil).Run(context.Background())
+
 	// Start the server
 	r := router.SetupRouter(jwtManager, cfg)
 	if err := r.Run(":" + cfg.APIServerPort); err != nil {
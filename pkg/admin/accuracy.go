@@ -0,0 +1,133 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/accuracy"
+	"github.com/gin-gonic/gin"
+)
+
+var accuracyFieldLabels = map[string]string{
+	accuracy.FieldDesignation:    "Обозначение",
+	accuracy.FieldName:           "Наименование",
+	accuracy.FieldCount:          "Количество",
+	accuracy.FieldMaterial:       "Материал",
+	accuracy.FieldAssortmentSize: "Типоразмер",
+	accuracy.FieldStructure:      "Структура",
+}
+
+// accuracyRows pivots the totals into a row per group with a cell per field
+func accuracyRows(totals []accuracy.Total, label func(group string) string) []gin.H {
+	var (
+		rows   []gin.H
+		groups = map[string]map[string]accuracy.Total{}
+	)
+	for _, total := range totals {
+		if _, ok := groups[total.Group]; !ok {
+			groups[total.Group] = map[string]accuracy.Total{}
+			rows = append(rows, gin.H{"Group": total.Group})
+		}
+		groups[total.Group][total.Field] = total
+	}
+
+	for _, row := range rows {
+		group := row["Group"].(string)
+		cells := make([]gin.H, 0, len(accuracy.Fields))
+		for _, field := range accuracy.Fields {
+			total := groups[group][field]
+			cell := gin.H{"Total": total.Total, "Corrected": total.Corrected}
+			if total.Total > 0 {
+				cell["Percent"] = fmt.Sprintf("%.1f", accuracy.Accuracy(total.Total, total.Corrected)*100)
+			}
+			cells = append(cells, cell)
+		}
+		row["Label"], row["Cells"] = label(group), cells
+	}
+
+	return rows
+}
+
+func AccuracyDashboard(c *gin.Context) {
+	days, err := strconv.Atoi(c.DefaultQuery("days", "90"))
+	if err != nil || days <= 0 {
+		days = 90
+	}
+	filter := accuracy.Filter{
+		Version: c.Query("version"),
+		Since:   time.Now().AddDate(0, 0, -days),
+	}
+	if clientID, err := strconv.ParseUint(c.Query("client_id"), 10, 64); err == nil {
+		filter.ClientID = clientID
+	}
+	filters := gin.H{
+		"ClientID": c.Query("client_id"),
+		"Version":  filter.Version,
+		"Days":     days,
+	}
+
+	var clients []proto.ClientORM
+	if err := db.DB.Find(&clients).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "accuracy/dashboard.html", gin.H{
+			"Error":   "Failed to fetch clients",
+			"Filters": filters,
+		})
+		return
+	}
+	clientNames := make(map[string]string, len(clients))
+	for _, client := range clients {
+		clientNames[strconv.FormatUint(client.Id, 10)] = client.Name
+	}
+
+	service := accuracy.NewService(db.DB)
+	labels := map[string]func(group string) string{
+		accuracy.GroupNone:    func(string) string { return "Все задачи" },
+		accuracy.GroupVersion: func(group string) string { return group },
+		accuracy.GroupClient: func(group string) string {
+			if name, ok := clientNames[group]; ok {
+				return name
+			}
+			return group
+		},
+		accuracy.GroupWeek: func(group string) string { return "с " + group },
+	}
+	sections := []struct{ group, title, column string }{
+		{accuracy.GroupNone, "Итого", "Период"},
+		{accuracy.GroupWeek, "По неделям", "Неделя"},
+		{accuracy.GroupVersion, "По версиям модели", "Версия"},
+		{accuracy.GroupClient, "По клиентам", "Клиент"},
+	}
+	reports := make([]gin.H, 0, len(sections))
+	for _, section := range sections {
+		totals, err := service.Totals(c, filter, section.group)
+		if err != nil {
+			c.HTML(http.StatusInternalServerError, "accuracy/dashboard.html", gin.H{
+				"Error":   "Failed to fetch accuracy metrics",
+				"Clients": clients,
+				"Filters": filters,
+			})
+			return
+		}
+		reports = append(reports, gin.H{
+			"Title":  section.title,
+			"Column": section.column,
+			"Rows":   accuracyRows(totals, labels[section.group]),
+		})
+	}
+
+	fields := make([]string, 0, len(accuracy.Fields))
+	for _, field := range accuracy.Fields {
+		fields = append(fields, accuracyFieldLabels[field])
+	}
+
+	c.HTML(http.StatusOK, "accuracy/dashboard.html", gin.H{
+		"Fields":   fields,
+		"Sections": reports,
+		"Clients":  clients,
+		"Filters":  filters,
+	})
+}
@@ -0,0 +1,258 @@
+package admin
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/assortment"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+	"gorm.io/gorm"
+)
+
+type AssortmentStandardFormInput struct {
+	Designation string `form:"designation" binding:"required,max=100"`
+	Title       string `form:"title" binding:"max=300"`
+	Family      int32  `form:"family" binding:"gte=0"`
+	Sizes       string `form:"sizes"`
+}
+
+// assortmentFamily is a profile family option of the assortment standard forms
+type assortmentFamily struct {
+	Value int32
+	Label string
+}
+
+var assortmentFamilies = []assortmentFamily{
+	{int32(proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED), "Не указан"},
+	{int32(proto.ProfileFamily_PROFILE_FAMILY_SHEET), "Лист"},
+	{int32(proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR), "Круг"},
+	{int32(proto.ProfileFamily_PROFILE_FAMILY_PIPE), "Труба"},
+	{int32(proto.ProfileFamily_PROFILE_FAMILY_ANGLE), "Уголок"},
+	{int32(proto.ProfileFamily_PROFILE_FAMILY_CHANNEL), "Швеллер"},
+	{int32(proto.ProfileFamily_PROFILE_FAMILY_BEAM), "Двутавр"},
+	{int32(proto.ProfileFamily_PROFILE_FAMILY_SQUARE_TUBE), "Труба профильная"},
+}
+
+// assortmentFamilyLabels maps the family values to their labels, the templates cannot look up the options
+func assortmentFamilyLabels() map[int32]string {
+	labels := make(map[int32]string, len(assortmentFamilies))
+	for _, family := range assortmentFamilies {
+		labels[family.Value] = family.Label
+	}
+
+	return labels
+}
+
+func ListAssortmentStandards(c *gin.Context) {
+	query := db.DB.Preload("Sizes").Order("designation")
+	if search := c.Query("q"); search != "" {
+		pattern := "%" + search + "%"
+		query = query.Where("designation ILIKE ? OR title ILIKE ?", pattern, pattern)
+	}
+
+	var standards []proto.AssortmentStandardORM
+	if err := query.Find(&standards).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "assortment_standard/assortment_standards.html", gin.H{
+			"Error": "Failed to fetch assortment standards",
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "assortment_standard/assortment_standards.html", gin.H{
+		"Standards": standards,
+		"Families":  assortmentFamilyLabels(),
+		"Query":     c.Query("q"),
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
+
+func NewAssortmentStandard(c *gin.Context) {
+	renderAssortmentStandardForm(c, http.StatusOK, &proto.AssortmentStandardORM{}, "", "")
+}
+
+func CreateAssortmentStandard(c *gin.Context) {
+	standard := proto.AssortmentStandardORM{}
+	sizes, ok := bindAssortmentStandard(c, &standard)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	standard.CreatedAt = &now
+	if err := saveAssortmentStandard(&standard, sizes); err != nil {
+		renderAssortmentStandardForm(c, http.StatusBadRequest, &standard, c.PostForm("sizes"), "Не удалось сохранить стандарт, возможно, он уже есть в справочнике")
+		return
+	}
+	c.Redirect(http.StatusFound, "/assortment-standards")
+}
+
+func EditAssortmentStandard(c *gin.Context) {
+	var standard proto.AssortmentStandardORM
+	if err := db.DB.Preload("Sizes").First(&standard, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	renderAssortmentStandardForm(c, http.StatusOK, &standard, formatStandardSizes(&standard), "")
+}
+
+func UpdateAssortmentStandard(c *gin.Context) {
+	var standard proto.AssortmentStandardORM
+	if err := db.DB.First(&standard, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	sizes, ok := bindAssortmentStandard(c, &standard)
+	if !ok {
+		return
+	}
+
+	if err := saveAssortmentStandard(&standard, sizes); err != nil {
+		renderAssortmentStandardForm(c, http.StatusBadRequest, &standard, c.PostForm("sizes"), "Не удалось сохранить стандарт, возможно, он уже есть в справочнике")
+		return
+	}
+	c.Redirect(http.StatusFound, "/assortment-standards")
+}
+
+func DeleteAssortmentStandard(c *gin.Context) {
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("assortment_standard_id = ?", c.Param("id")).Delete(&proto.AssortmentStandardSizeORM{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&proto.AssortmentStandardORM{}, c.Param("id")).Error
+	})
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Redirect(http.StatusFound, "/assortment-standards")
+}
+
+// ImportAssortmentStandardsForm renders the CSV import form of the assortment standards
+func ImportAssortmentStandardsForm(c *gin.Context) {
+	renderAssortmentStandardImport(c, http.StatusOK, "", "")
+}
+
+// ImportAssortmentStandards stores the standards of the uploaded CSV file, or of the form text when no file
+// is given, replacing the size series of the standards already in the reference database
+func ImportAssortmentStandards(c *gin.Context) {
+	text := c.PostForm("csv")
+	standards, err := assortmentStandardsCSV(c, text)
+	if err != nil {
+		renderAssortmentStandardImport(c, http.StatusBadRequest, text, "Ошибка импорта: "+err.Error())
+		return
+	}
+	if len(standards) == 0 {
+		renderAssortmentStandardImport(c, http.StatusBadRequest, text, "Файл не содержит стандартов")
+		return
+	}
+
+	if err := assortment.NewService(db.DB).Import(c, standards); err != nil {
+		renderAssortmentStandardImport(c, http.StatusInternalServerError, text, "Не удалось сохранить стандарты")
+		return
+	}
+	c.Redirect(http.StatusFound, "/assortment-standards")
+}
+
+// ExportAssortmentStandards downloads the reference database as CSV in the import format
+func ExportAssortmentStandards(c *gin.Context) {
+	var standards []*proto.AssortmentStandardORM
+	if err := db.DB.Preload("Sizes").Order("designation").Find(&standards).Error; err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=assortment-standards.csv")
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	if err := assortment.WriteCSV(c.Writer, standards); err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
+
+// assortmentStandardsCSV reads the standards from the uploaded CSV file, or from the form text when no file is given
+func assortmentStandardsCSV(c *gin.Context, text string) ([]*proto.AssortmentStandardORM, error) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return assortment.ReadCSV(strings.NewReader(text))
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return assortment.ReadCSV(f)
+}
+
+// bindAssortmentStandard applies the submitted form to standard and reads its size series,
+// rendering the form with an error on failure
+func bindAssortmentStandard(c *gin.Context, standard *proto.AssortmentStandardORM) ([]*proto.AssortmentStandardSizeORM, bool) {
+	var input AssortmentStandardFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderAssortmentStandardForm(c, http.StatusBadRequest, standard, c.PostForm("sizes"), "Ошибка валидации: "+err.Error())
+		return nil, false
+	}
+
+	now := time.Now()
+	standard.Designation = strings.Join(strings.Fields(input.Designation), " ")
+	standard.Title = input.Title
+	standard.Family = input.Family
+	standard.UpdatedAt = &now
+
+	sizes, err := assortment.ParseSizes(input.Sizes, proto.ProfileFamily(input.Family))
+	if err != nil {
+		renderAssortmentStandardForm(c, http.StatusBadRequest, standard, input.Sizes, "Ошибка в размерах: "+err.Error())
+		return nil, false
+	}
+
+	return sizes, true
+}
+
+// saveAssortmentStandard stores the standard with its size series, replacing the previous one
+func saveAssortmentStandard(standard *proto.AssortmentStandardORM, sizes []*proto.AssortmentStandardSizeORM) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if standard.Id != 0 {
+			if err := tx.Where("assortment_standard_id = ?", standard.Id).Delete(&proto.AssortmentStandardSizeORM{}).Error; err != nil {
+				return err
+			}
+		}
+		standard.Sizes = sizes
+
+		return tx.Save(standard).Error
+	})
+}
+
+// formatStandardSizes renders the size series one size per line for the edit form
+func formatStandardSizes(standard *proto.AssortmentStandardORM) string {
+	sizes := make([]string, 0, len(standard.Sizes))
+	for _, size := range standard.Sizes {
+		sizes = append(sizes, size.Size)
+	}
+
+	return strings.Join(sizes, "\n")
+}
+
+func renderAssortmentStandardForm(c *gin.Context, status int, standard *proto.AssortmentStandardORM, sizes, message string) {
+	c.HTML(status, "assortment_standard/assortment_standard_form.html", gin.H{
+		"Error":     message,
+		"Standard":  standard,
+		"Sizes":     sizes,
+		"Families":  assortmentFamilies,
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
+
+func renderAssortmentStandardImport(c *gin.Context, status int, text, message string) {
+	c.HTML(status, "assortment_standard/assortment_standard_import.html", gin.H{
+		"Error":       message,
+		"CSV":         text,
+		"CsvHeader":   strings.Join(assortment.CSVHeader, ","),
+		"FamilyCodes": strings.Join(assortment.FamilyCodes, ", "),
+		"CsrfToken":   csrf.GetToken(c),
+	})
+}
@@ -5,16 +5,26 @@ import (
 	"github.com/bazilio91/sferra-cloud/pkg/proto"
 	"github.com/gin-gonic/gin"
 	csrf "github.com/utrack/gin-csrf"
+	"gorm.io/gorm"
 	"net/http"
+	"time"
 )
 
 type ClientFormInput struct {
-	Name       string `form:"name" binding:"required,min=3,max=100"`
-	Quota      int64  `form:"quota" binding:"required,gte=0"`
-	TotalQuota int64  `form:"total_quota" binding:"required,gte=0"`
-	OwnerFio   string `form:"owner_fio" binding:"required"`
-	Inn        string `form:"inn" binding:"required"`
-	Ogrn       string `form:"ogrn" binding:"required"`
+	Name                string `form:"name" binding:"required,min=3,max=100"`
+	Quota               int64  `form:"quota" binding:"required,gte=0"`
+	TotalQuota          int64  `form:"total_quota" binding:"required,gte=0"`
+	OwnerFio            string `form:"owner_fio" binding:"required"`
+	Inn                 string `form:"inn" binding:"required"`
+	Ogrn                string `form:"ogrn" binding:"required"`
+	Sandbox             bool   `form:"sandbox"`
+	TrainingDataConsent int32  `form:"training_data_consent" binding:"gte=0,lte=2"`
+}
+
+var trainingDataConsentLabels = map[proto.TrainingDataConsent]string{
+	proto.TrainingDataConsent_TRAINING_DATA_CONSENT_UNDECIDED: "Не указано",
+	proto.TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_IN:    "Разрешено",
+	proto.TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_OUT:   "Запрещено",
 }
 
 func ListClients(c *gin.Context) {
@@ -65,12 +75,14 @@ func CreateClient(c *gin.Context) {
 	}
 
 	client := proto.ClientORM{
-		Name:       input.Name,
-		Quota:      input.Quota,
-		TotalQuota: input.TotalQuota,
-		OwnerFio:   input.OwnerFio,
-		Inn:        input.Inn,
-		Ogrn:       input.Ogrn,
+		Name:                input.Name,
+		Quota:               input.Quota,
+		TotalQuota:          input.TotalQuota,
+		OwnerFio:            input.OwnerFio,
+		Inn:                 input.Inn,
+		Ogrn:                input.Ogrn,
+		Sandbox:             input.Sandbox,
+		TrainingDataConsent: input.TrainingDataConsent,
 	}
 	if err := db.DB.Create(&client).Error; err != nil {
 		c.HTML(http.StatusBadRequest, "client/client_new.html", gin.H{
@@ -89,8 +101,20 @@ func ViewClient(c *gin.Context) {
 		c.AbortWithStatus(http.StatusNotFound)
 		return
 	}
+
+	var orders []proto.OrderORM
+	if err := db.DB.Where("client_id = ?", client.Id).Order("created_at DESC").Find(&orders).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "client/client_view.html", gin.H{
+			"Error":  "Failed to fetch orders",
+			"Client": client,
+		})
+		return
+	}
+
 	c.HTML(http.StatusOK, "client/client_view.html", gin.H{
-		"Client": client,
+		"Client":              client,
+		"Orders":              orderRows(orders),
+		"TrainingDataConsent": trainingDataConsentLabels[proto.TrainingDataConsent(client.TrainingDataConsent)],
 	})
 }
 
@@ -125,14 +149,36 @@ func UpdateClient(c *gin.Context) {
 		return
 	}
 
+	quotaDelta := input.Quota - client.Quota
+
 	client.Name = input.Name
 	client.Quota = input.Quota
 	client.TotalQuota = input.TotalQuota
 	client.OwnerFio = input.OwnerFio
 	client.Inn = input.Inn
 	client.Ogrn = input.Ogrn
-
-	if err := db.DB.Save(&client).Error; err != nil {
+	client.Sandbox = input.Sandbox
+	client.TrainingDataConsent = input.TrainingDataConsent
+
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&client).Error; err != nil {
+			return err
+		}
+		if quotaDelta == 0 {
+			return nil
+		}
+
+		// Manual quota changes are recorded as adjustments
+		now := time.Now()
+		return tx.Create(&proto.QuotaTransactionORM{
+			ClientId:  client.Id,
+			Reason:    int32(proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_ADJUSTMENT),
+			Amount:    quotaDelta,
+			Balance:   client.Quota,
+			CreatedAt: &now,
+		}).Error
+	})
+	if err != nil {
 		c.HTML(http.StatusBadRequest, "client/client_edit.html", gin.H{
 			"Error":     "Failed to update client",
 			"Client":    client,
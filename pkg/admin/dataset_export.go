@@ -0,0 +1,210 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/dataset"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// datasets runs the training dataset exports, set by RunAdminServer
+var datasets *dataset.Service
+
+type DatasetExportFormInput struct {
+	Name         string   `form:"name" binding:"required"`
+	Comment      string   `form:"comment"`
+	ClientIDs    []uint64 `form:"client_ids"`
+	ApprovedOnly bool     `form:"approved_only"`
+	Since        string   `form:"since"`
+	Until        string   `form:"until"`
+	Limit        int32    `form:"limit" binding:"gte=0"`
+	TaskIDs      string   `form:"task_ids"`
+}
+
+var datasetExportStatusLabels = map[proto.DatasetExportStatus]string{
+	proto.DatasetExportStatus_DATASET_EXPORT_STATUS_PENDING:   "В очереди",
+	proto.DatasetExportStatus_DATASET_EXPORT_STATUS_RUNNING:   "Выполняется",
+	proto.DatasetExportStatus_DATASET_EXPORT_STATUS_COMPLETED: "Готов",
+	proto.DatasetExportStatus_DATASET_EXPORT_STATUS_FAILED:    "Ошибка",
+}
+
+func datasetExportRow(export *proto.DatasetExportORM) gin.H {
+	row := gin.H{
+		"Id":                export.Id,
+		"Name":              export.Name,
+		"Version":           export.Version,
+		"Status":            datasetExportStatusLabels[proto.DatasetExportStatus(export.Status)],
+		"Completed":         export.Status == int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_COMPLETED),
+		"Error":             export.Error,
+		"Comment":           export.Comment,
+		"CreatedBy":         export.CreatedBy,
+		"TaskCount":         export.TaskCount,
+		"ImageCount":        export.ImageCount,
+		"ExcludedTaskCount": export.ExcludedTaskCount,
+		"ArchiveSize":       fmt.Sprintf("%.1f МБ", float64(export.ArchiveSize)/(1<<20)),
+		"ArchiveSha256":     export.ArchiveSha256,
+	}
+	if export.CreatedAt != nil {
+		row["CreatedAt"] = export.CreatedAt.Format("2006-01-02 15:04:05")
+	}
+	if export.FinishedAt != nil {
+		row["FinishedAt"] = export.FinishedAt.Format("2006-01-02 15:04:05")
+	}
+
+	return row
+}
+
+func ListDatasetExports(c *gin.Context) {
+	var exports []*proto.DatasetExportORM
+	if err := db.DB.Order("id DESC").Find(&exports).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "dataset_export/exports.html", gin.H{
+			"Error": "Failed to fetch dataset exports",
+		})
+		return
+	}
+
+	rows := make([]gin.H, 0, len(exports))
+	for _, export := range exports {
+		rows = append(rows, datasetExportRow(export))
+	}
+
+	c.HTML(http.StatusOK, "dataset_export/exports.html", gin.H{
+		"Exports": rows,
+	})
+}
+
+// renderDatasetExportForm renders the export form with the clients and their training data consent
+func renderDatasetExportForm(c *gin.Context, status int, input *DatasetExportFormInput, message string) {
+	var clients []proto.ClientORM
+	if err := db.DB.Order("name").Find(&clients).Error; err != nil {
+		status, message = http.StatusInternalServerError, "Failed to fetch clients"
+	}
+
+	selected := make(map[uint64]bool, len(input.ClientIDs))
+	for _, id := range input.ClientIDs {
+		selected[id] = true
+	}
+	rows := make([]gin.H, 0, len(clients))
+	for _, client := range clients {
+		rows = append(rows, gin.H{
+			"Id":       client.Id,
+			"Name":     client.Name,
+			"Sandbox":  client.Sandbox,
+			"Consent":  trainingDataConsentLabels[proto.TrainingDataConsent(client.TrainingDataConsent)],
+			"Selected": selected[client.Id],
+		})
+	}
+
+	c.HTML(status, "dataset_export/form.html", gin.H{
+		"Error":     message,
+		"Input":     input,
+		"Clients":   rows,
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
+
+func NewDatasetExport(c *gin.Context) {
+	renderDatasetExportForm(c, http.StatusOK, &DatasetExportFormInput{Name: "sferra", ApprovedOnly: true}, "")
+}
+
+func CreateDatasetExport(c *gin.Context) {
+	var input DatasetExportFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderDatasetExportForm(c, http.StatusBadRequest, &input, "Validation error: "+err.Error())
+		return
+	}
+
+	selection := &proto.DatasetSelection{
+		ClientIds:    input.ClientIDs,
+		ApprovedOnly: input.ApprovedOnly,
+		Limit:        input.Limit,
+		TaskIds: strings.FieldsFunc(input.TaskIDs, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+		}),
+	}
+	since, err := parsePriceListDate(input.Since)
+	if err != nil {
+		renderDatasetExportForm(c, http.StatusBadRequest, &input, "Invalid date: "+input.Since)
+		return
+	}
+	until, err := parsePriceListDate(input.Until)
+	if err != nil {
+		renderDatasetExportForm(c, http.StatusBadRequest, &input, "Invalid date: "+input.Until)
+		return
+	}
+	if since != nil {
+		selection.Since = timestamppb.New(*since)
+	}
+	if until != nil {
+		selection.Until = timestamppb.New(*until)
+	}
+
+	_, err = datasets.Create(c, input.Name, currentAdminEmail(c), input.Comment, selection)
+	if errors.Is(err, dataset.ErrInvalidName) || errors.Is(err, dataset.ErrInvalidTaskID) {
+		renderDatasetExportForm(c, http.StatusBadRequest, &input, err.Error())
+		return
+	}
+	if err != nil {
+		renderDatasetExportForm(c, http.StatusInternalServerError, &input, "Failed to create dataset export")
+		return
+	}
+
+	c.Redirect(http.StatusFound, "/dataset-exports")
+}
+
+func DownloadDatasetArchive(c *gin.Context) {
+	downloadDatasetExport(c, false)
+}
+
+func DownloadDatasetManifest(c *gin.Context) {
+	downloadDatasetExport(c, true)
+}
+
+func downloadDatasetExport(c *gin.Context, manifest bool) {
+	var export proto.DatasetExportORM
+	if err := db.DB.First(&export, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	reader, err := datasets.Open(c, &export, manifest)
+	if errors.Is(err, dataset.ErrExportNotFound) {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	defer reader.Close()
+
+	if manifest {
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s-v%d-manifest.json", export.Name, export.Version))
+		c.Header("Content-Type", "application/json")
+	} else {
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s-v%d.zip", export.Name, export.Version))
+		c.Header("Content-Type", "application/zip")
+	}
+	if _, err := io.Copy(c.Writer, reader); err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
+
+// currentAdminEmail returns the email of the signed in admin
+func currentAdminEmail(c *gin.Context) string {
+	var admin proto.AdminORM
+	if err := db.DB.First(&admin, sessions.Default(c).Get("admin_id")).Error; err != nil {
+		return ""
+	}
+
+	return admin.Email
+}
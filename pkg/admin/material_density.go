@@ -0,0 +1,170 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+)
+
+type MaterialDensityFormInput struct {
+	ClientID string  `form:"client_id"`
+	Material string  `form:"material" binding:"required,max=100"`
+	Density  float64 `form:"density" binding:"gt=0,lte=25000"`
+}
+
+// materialDensitiesURL returns the density list of the scope: a client or the shared densities
+func materialDensitiesURL(clientID *uint64) string {
+	if clientID == nil {
+		return "/material-densities"
+	}
+
+	return fmt.Sprintf("/material-densities?client_id=%d", *clientID)
+}
+
+func ListMaterialDensities(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var client proto.ClientORM
+	query := db.DB.Order("material, id")
+	if clientID != nil {
+		if err := db.DB.First(&client, *clientID).Error; err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		query = query.Where("client_id = ?", *clientID)
+	} else {
+		query = query.Where("client_id IS NULL")
+	}
+
+	var densities []proto.MaterialDensityORM
+	if err := query.Find(&densities).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "material_density/material_densities.html", gin.H{
+			"Error": "Failed to fetch material densities",
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "material_density/material_densities.html", gin.H{
+		"Densities": densities,
+		"Client":    client,
+		"ClientID":  c.Query("client_id"),
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
+
+func NewMaterialDensity(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	renderMaterialDensityForm(c, http.StatusOK, &proto.MaterialDensityORM{ClientId: clientID}, "")
+}
+
+func CreateMaterialDensity(c *gin.Context) {
+	density := proto.MaterialDensityORM{}
+	if !bindMaterialDensity(c, &density) {
+		return
+	}
+
+	now := time.Now()
+	density.CreatedAt = &now
+	if err := db.DB.Create(&density).Error; err != nil {
+		renderMaterialDensityForm(c, http.StatusBadRequest, &density, "Не удалось сохранить плотность")
+		return
+	}
+	c.Redirect(http.StatusFound, materialDensitiesURL(density.ClientId))
+}
+
+func EditMaterialDensity(c *gin.Context) {
+	var density proto.MaterialDensityORM
+	if err := db.DB.First(&density, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	renderMaterialDensityForm(c, http.StatusOK, &density, "")
+}
+
+func UpdateMaterialDensity(c *gin.Context) {
+	var density proto.MaterialDensityORM
+	if err := db.DB.First(&density, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if !bindMaterialDensity(c, &density) {
+		return
+	}
+
+	if err := db.DB.Save(&density).Error; err != nil {
+		renderMaterialDensityForm(c, http.StatusBadRequest, &density, "Не удалось сохранить плотность")
+		return
+	}
+	c.Redirect(http.StatusFound, materialDensitiesURL(density.ClientId))
+}
+
+func DeleteMaterialDensity(c *gin.Context) {
+	var density proto.MaterialDensityORM
+	if err := db.DB.First(&density, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err := db.DB.Delete(&density).Error; err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Redirect(http.StatusFound, materialDensitiesURL(density.ClientId))
+}
+
+// bindMaterialDensity applies the submitted form to density, rendering the form with an error on failure
+func bindMaterialDensity(c *gin.Context, density *proto.MaterialDensityORM) bool {
+	var input MaterialDensityFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderMaterialDensityForm(c, http.StatusBadRequest, density, "Ошибка валидации: "+err.Error())
+		return false
+	}
+	clientID, err := parseClientScope(input.ClientID)
+	if err != nil {
+		renderMaterialDensityForm(c, http.StatusBadRequest, density, "Некорректный клиент")
+		return false
+	}
+
+	now := time.Now()
+	density.ClientId = clientID
+	density.Material = input.Material
+	density.Density = input.Density
+	density.UpdatedAt = &now
+
+	return true
+}
+
+func renderMaterialDensityForm(c *gin.Context, status int, density *proto.MaterialDensityORM, message string) {
+	var clients []proto.ClientORM
+	if err := db.DB.Order("name").Find(&clients).Error; err != nil {
+		message = "Failed to fetch clients"
+	}
+
+	clientID := ""
+	if density.ClientId != nil {
+		clientID = strconv.FormatUint(*density.ClientId, 10)
+	}
+
+	c.HTML(status, "material_density/material_density_form.html", gin.H{
+		"Error":     message,
+		"Density":   density,
+		"ClientID":  clientID,
+		"Clients":   clients,
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
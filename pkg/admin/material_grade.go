@@ -0,0 +1,173 @@
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/material"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+)
+
+type MaterialGradeFormInput struct {
+	Grade       string  `form:"grade" binding:"required,max=100"`
+	Standard    string  `form:"standard" binding:"max=100"`
+	Density     float64 `form:"density" binding:"gte=0,lte=25000"`
+	Equivalents string  `form:"equivalents" binding:"max=500"`
+	PriceGroup  string  `form:"price_group" binding:"max=100"`
+	Description string  `form:"description"`
+}
+
+func ListMaterialGrades(c *gin.Context) {
+	query := db.DB.Order("grade")
+	if search := c.Query("q"); search != "" {
+		pattern := "%" + search + "%"
+		query = query.Where("grade ILIKE ? OR equivalents ILIKE ? OR standard ILIKE ?", pattern, pattern, pattern)
+	}
+
+	var grades []proto.MaterialGradeORM
+	if err := query.Find(&grades).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "material_grade/material_grades.html", gin.H{
+			"Error": "Failed to fetch material grades",
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "material_grade/material_grades.html", gin.H{
+		"Grades":    grades,
+		"Query":     c.Query("q"),
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
+
+// NewMaterialGrade renders an empty form, prefilled with the grade of an unmatched material when given
+func NewMaterialGrade(c *gin.Context) {
+	renderMaterialGradeForm(c, http.StatusOK, &proto.MaterialGradeORM{Grade: c.Query("grade")}, "")
+}
+
+func CreateMaterialGrade(c *gin.Context) {
+	grade := proto.MaterialGradeORM{}
+	if !bindMaterialGrade(c, &grade) {
+		return
+	}
+
+	now := time.Now()
+	grade.CreatedAt = &now
+	if err := db.DB.Create(&grade).Error; err != nil {
+		renderMaterialGradeForm(c, http.StatusBadRequest, &grade, "Не удалось сохранить марку, возможно, она уже есть в справочнике")
+		return
+	}
+	pruneUnmatchedMaterials(c)
+	c.Redirect(http.StatusFound, "/material-grades")
+}
+
+func EditMaterialGrade(c *gin.Context) {
+	var grade proto.MaterialGradeORM
+	if err := db.DB.First(&grade, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	renderMaterialGradeForm(c, http.StatusOK, &grade, "")
+}
+
+func UpdateMaterialGrade(c *gin.Context) {
+	var grade proto.MaterialGradeORM
+	if err := db.DB.First(&grade, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if !bindMaterialGrade(c, &grade) {
+		return
+	}
+
+	if err := db.DB.Save(&grade).Error; err != nil {
+		renderMaterialGradeForm(c, http.StatusBadRequest, &grade, "Не удалось сохранить марку, возможно, она уже есть в справочнике")
+		return
+	}
+	pruneUnmatchedMaterials(c)
+	c.Redirect(http.StatusFound, "/material-grades")
+}
+
+func DeleteMaterialGrade(c *gin.Context) {
+	if err := db.DB.Delete(&proto.MaterialGradeORM{}, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Redirect(http.StatusFound, "/material-grades")
+}
+
+// bindMaterialGrade applies the submitted form to grade, rendering the form with an error on failure
+func bindMaterialGrade(c *gin.Context, grade *proto.MaterialGradeORM) bool {
+	var input MaterialGradeFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderMaterialGradeForm(c, http.StatusBadRequest, grade, "Ошибка валидации: "+err.Error())
+		return false
+	}
+
+	now := time.Now()
+	grade.Grade = input.Grade
+	grade.Standard = input.Standard
+	grade.Density = input.Density
+	grade.Equivalents = input.Equivalents
+	grade.PriceGroup = input.PriceGroup
+	grade.Description = input.Description
+	grade.UpdatedAt = &now
+
+	return true
+}
+
+func renderMaterialGradeForm(c *gin.Context, status int, grade *proto.MaterialGradeORM, message string) {
+	c.HTML(status, "material_grade/material_grade_form.html", gin.H{
+		"Error":     message,
+		"Grade":     grade,
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
+
+func ListUnmatchedMaterials(c *gin.Context) {
+	var materials []proto.UnmatchedMaterialORM
+	if err := db.DB.Order("occurrences DESC, text").Find(&materials).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "material_grade/unmatched_materials.html", gin.H{
+			"Error": "Failed to fetch unmatched materials",
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "material_grade/unmatched_materials.html", gin.H{
+		"Materials": materials,
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
+
+func DeleteUnmatchedMaterial(c *gin.Context) {
+	if err := db.DB.Delete(&proto.UnmatchedMaterialORM{}, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Redirect(http.StatusFound, "/unmatched-materials")
+}
+
+// pruneUnmatchedMaterials drops the reviewed materials the catalog matches now
+func pruneUnmatchedMaterials(c *gin.Context) {
+	catalog, err := material.NewService(db.DB).Catalog(c)
+	if err != nil {
+		return
+	}
+	var materials []proto.UnmatchedMaterialORM
+	if err := db.DB.Find(&materials).Error; err != nil {
+		return
+	}
+
+	var matched []uint64
+	for _, m := range materials {
+		if catalog.Match(m.Text).GradeId != 0 {
+			matched = append(matched, m.Id)
+		}
+	}
+	if len(matched) > 0 {
+		db.DB.Delete(&proto.UnmatchedMaterialORM{}, matched)
+	}
+}
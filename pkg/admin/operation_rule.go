@@ -0,0 +1,231 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/routing"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+)
+
+var operationLabels = map[string]string{
+	routing.OperationCutting:   "Резка",
+	routing.OperationBending:   "Гибка",
+	routing.OperationMachining: "Мехобработка",
+	routing.OperationWelding:   "Сварка",
+	routing.OperationAssembly:  "Сборка",
+	routing.OperationPainting:  "Окраска",
+}
+
+type OperationRuleFormInput struct {
+	ClientID            string  `form:"client_id"`
+	Name                string  `form:"name" binding:"required,max=100"`
+	Operation           string  `form:"operation" binding:"required,max=50"`
+	Priority            int32   `form:"priority"`
+	Enabled             bool    `form:"enabled"`
+	Target              int32   `form:"target" binding:"gte=0,lte=1"`
+	FigureType          string  `form:"figure_type"`
+	SubType             string  `form:"sub_type"`
+	Material            string  `form:"material"`
+	MinMass             float64 `form:"min_mass" binding:"gte=0"`
+	MaxMass             float64 `form:"max_mass" binding:"gte=0"`
+	MinLength           float64 `form:"min_length" binding:"gte=0"`
+	MaxLength           float64 `form:"max_length" binding:"gte=0"`
+	SetupHours          float64 `form:"setup_hours" binding:"gte=0"`
+	LabourHoursPerUnit  float64 `form:"labour_hours_per_unit" binding:"gte=0"`
+	LabourHoursPerKg    float64 `form:"labour_hours_per_kg" binding:"gte=0"`
+	LabourHoursPerMetre float64 `form:"labour_hours_per_metre" binding:"gte=0"`
+	MachineHoursPerUnit float64 `form:"machine_hours_per_unit" binding:"gte=0"`
+	MachineHoursPerKg   float64 `form:"machine_hours_per_kg" binding:"gte=0"`
+}
+
+// operationRulesURL returns the rule list of the scope: a client or the shared rules
+func operationRulesURL(clientID *uint64) string {
+	if clientID == nil {
+		return "/operation-rules"
+	}
+
+	return fmt.Sprintf("/operation-rules?client_id=%d", *clientID)
+}
+
+func parseClientScope(value string) (*uint64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func ListOperationRules(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var client proto.ClientORM
+	query := db.DB.Order("operation, priority, id")
+	if clientID != nil {
+		if err := db.DB.First(&client, *clientID).Error; err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		query = query.Where("client_id = ?", *clientID)
+	} else {
+		query = query.Where("client_id IS NULL")
+	}
+
+	var rules []proto.OperationRuleORM
+	if err := query.Find(&rules).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "operation_rule/operation_rules.html", gin.H{
+			"Error": "Failed to fetch operation rules",
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "operation_rule/operation_rules.html", gin.H{
+		"Rules":           rules,
+		"Client":          client,
+		"ClientID":        c.Query("client_id"),
+		"OperationLabels": operationLabels,
+		"CsrfToken":       csrf.GetToken(c),
+	})
+}
+
+func NewOperationRule(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	renderOperationRuleForm(c, http.StatusOK, &proto.OperationRuleORM{ClientId: clientID, Enabled: true}, "")
+}
+
+func CreateOperationRule(c *gin.Context) {
+	rule := proto.OperationRuleORM{}
+	if !bindOperationRule(c, &rule) {
+		return
+	}
+
+	now := time.Now()
+	rule.CreatedAt = &now
+	if err := db.DB.Create(&rule).Error; err != nil {
+		renderOperationRuleForm(c, http.StatusBadRequest, &rule, "Не удалось сохранить правило")
+		return
+	}
+	c.Redirect(http.StatusFound, operationRulesURL(rule.ClientId))
+}
+
+func EditOperationRule(c *gin.Context) {
+	var rule proto.OperationRuleORM
+	if err := db.DB.First(&rule, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	renderOperationRuleForm(c, http.StatusOK, &rule, "")
+}
+
+func UpdateOperationRule(c *gin.Context) {
+	var rule proto.OperationRuleORM
+	if err := db.DB.First(&rule, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if !bindOperationRule(c, &rule) {
+		return
+	}
+
+	if err := db.DB.Save(&rule).Error; err != nil {
+		renderOperationRuleForm(c, http.StatusBadRequest, &rule, "Не удалось сохранить правило")
+		return
+	}
+	c.Redirect(http.StatusFound, operationRulesURL(rule.ClientId))
+}
+
+func DeleteOperationRule(c *gin.Context) {
+	var rule proto.OperationRuleORM
+	if err := db.DB.First(&rule, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err := db.DB.Delete(&rule).Error; err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Redirect(http.StatusFound, operationRulesURL(rule.ClientId))
+}
+
+// bindOperationRule applies the submitted form to rule, rendering the form with an error on failure
+func bindOperationRule(c *gin.Context, rule *proto.OperationRuleORM) bool {
+	var input OperationRuleFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderOperationRuleForm(c, http.StatusBadRequest, rule, "Ошибка валидации: "+err.Error())
+		return false
+	}
+	clientID, err := parseClientScope(input.ClientID)
+	if err != nil {
+		renderOperationRuleForm(c, http.StatusBadRequest, rule, "Некорректный клиент")
+		return false
+	}
+
+	now := time.Now()
+	rule.ClientId = clientID
+	rule.Name = input.Name
+	rule.Operation = input.Operation
+	rule.Priority = input.Priority
+	rule.Enabled = input.Enabled
+	rule.Target = input.Target
+	rule.FigureType = input.FigureType
+	rule.SubType = input.SubType
+	rule.Material = input.Material
+	rule.MinMass = input.MinMass
+	rule.MaxMass = input.MaxMass
+	rule.MinLength = input.MinLength
+	rule.MaxLength = input.MaxLength
+	rule.SetupHours = input.SetupHours
+	rule.LabourHoursPerUnit = input.LabourHoursPerUnit
+	rule.LabourHoursPerKg = input.LabourHoursPerKg
+	rule.LabourHoursPerMetre = input.LabourHoursPerMetre
+	rule.MachineHoursPerUnit = input.MachineHoursPerUnit
+	rule.MachineHoursPerKg = input.MachineHoursPerKg
+	rule.UpdatedAt = &now
+
+	return true
+}
+
+func renderOperationRuleForm(c *gin.Context, status int, rule *proto.OperationRuleORM, message string) {
+	var clients []proto.ClientORM
+	if err := db.DB.Order("name").Find(&clients).Error; err != nil {
+		message = "Failed to fetch clients"
+	}
+
+	clientID := ""
+	if rule.ClientId != nil {
+		clientID = strconv.FormatUint(*rule.ClientId, 10)
+	}
+
+	operations := make([]gin.H, 0, len(routing.Operations))
+	for _, op := range routing.Operations {
+		operations = append(operations, gin.H{"Value": op, "Label": operationLabels[op]})
+	}
+
+	c.HTML(status, "operation_rule/operation_rule_form.html", gin.H{
+		"Error":      message,
+		"Rule":       rule,
+		"ClientID":   clientID,
+		"Clients":    clients,
+		"Operations": operations,
+		"CsrfToken":  csrf.GetToken(c),
+	})
+}
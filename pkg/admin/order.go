@@ -0,0 +1,98 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/gin-gonic/gin"
+)
+
+var orderStatusLabels = map[proto.OrderStatus]string{
+	proto.OrderStatus_ORDER_STATUS_PENDING:  "Ожидает оплаты",
+	proto.OrderStatus_ORDER_STATUS_PAID:     "Оплачен",
+	proto.OrderStatus_ORDER_STATUS_FAILED:   "Ошибка оплаты",
+	proto.OrderStatus_ORDER_STATUS_CANCELED: "Отменён",
+}
+
+// orderRows prepares orders for the order history tables
+func orderRows(orders []proto.OrderORM) []gin.H {
+	rows := make([]gin.H, 0, len(orders))
+	for _, order := range orders {
+		row := gin.H{
+			"Id":         order.Id,
+			"ClientId":   order.ClientId,
+			"Quota":      order.Quota,
+			"Amount":     fmt.Sprintf("%d.%02d %s", order.Amount/100, order.Amount%100, order.Currency),
+			"Status":     orderStatusLabels[proto.OrderStatus(order.Status)],
+			"Provider":   order.Provider,
+			"ExternalId": order.ExternalId,
+			"Error":      order.Error,
+			"CreatedAt":  order.CreatedAt,
+			"PaidAt":     order.PaidAt,
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func ListOrders(c *gin.Context) {
+	var orders []proto.OrderORM
+	query := db.DB.Order("created_at DESC")
+
+	// Apply filters
+	if clientID := c.Query("client_id"); clientID != "" {
+		query = query.Where("client_id = ?", clientID)
+	}
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	// Get clients for the filter dropdown
+	var clients []proto.ClientORM
+	if err := db.DB.Find(&clients).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "order/orders.html", gin.H{
+			"Error": "Failed to fetch clients",
+		})
+		return
+	}
+
+	if err := query.Find(&orders).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "order/orders.html", gin.H{
+			"Error": "Failed to fetch orders",
+		})
+		return
+	}
+
+	clientNames := make(map[uint64]string, len(clients))
+	for _, client := range clients {
+		clientNames[client.Id] = client.Name
+	}
+	rows := orderRows(orders)
+	for _, row := range rows {
+		row["ClientName"] = clientNames[row["ClientId"].(uint64)]
+	}
+
+	statuses := make([]gin.H, 0, len(orderStatusLabels))
+	for _, status := range []proto.OrderStatus{
+		proto.OrderStatus_ORDER_STATUS_PENDING,
+		proto.OrderStatus_ORDER_STATUS_PAID,
+		proto.OrderStatus_ORDER_STATUS_FAILED,
+		proto.OrderStatus_ORDER_STATUS_CANCELED,
+	} {
+		statuses = append(statuses, gin.H{"Value": fmt.Sprint(int32(status)), "Label": orderStatusLabels[status]})
+	}
+
+	c.HTML(http.StatusOK, "order/orders.html", gin.H{
+		"Orders":     rows,
+		"ShowClient": true,
+		"Clients":    clients,
+		"Statuses":   statuses,
+		"Filters": gin.H{
+			"ClientID": c.Query("client_id"),
+			"Status":   c.Query("status"),
+		},
+	})
+}
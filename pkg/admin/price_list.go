@@ -0,0 +1,301 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/costing"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+	"gorm.io/gorm"
+)
+
+type PriceListFormInput struct {
+	Name                      string  `form:"name" binding:"required,max=100"`
+	Currency                  string  `form:"currency" binding:"required,len=3"`
+	ClientID                  string  `form:"client_id"`
+	ValidFrom                 string  `form:"valid_from"`
+	ValidTo                   string  `form:"valid_to"`
+	IsDefault                 bool    `form:"is_default"`
+	ProcessingCostPerPart     float64 `form:"processing_cost_per_part" binding:"gte=0"`
+	ProcessingCostPerAssembly float64 `form:"processing_cost_per_assembly" binding:"gte=0"`
+	Prices                    string  `form:"prices"`
+}
+
+const priceListDateLayout = "2006-01-02"
+
+// parsePriceListDate reads an optional date of the form
+func parsePriceListDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.ParseInLocation(priceListDateLayout, value, time.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
+
+// priceListPrices reads the prices from the uploaded CSV file, or from the form text when no file is given
+func priceListPrices(c *gin.Context, text string) ([]*proto.MaterialPriceORM, []*proto.OperationRateORM, error) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return costing.ReadCSV(strings.NewReader(text))
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	return costing.ReadCSV(f)
+}
+
+// formatPrices renders prices of the list as CSV for the edit form
+func formatPrices(list *proto.PriceListORM) string {
+	var b strings.Builder
+	if err := costing.WriteCSV(&b, list); err != nil {
+		return ""
+	}
+
+	return b.String()
+}
+
+// savePriceList stores the list with its materials and operation rates, replacing the previous ones
+func savePriceList(list *proto.PriceListORM, materials []*proto.MaterialPriceORM, operations []*proto.OperationRateORM) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		if list.Id != 0 {
+			if err := tx.Where("price_list_id = ?", list.Id).Delete(&proto.MaterialPriceORM{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("price_list_id = ?", list.Id).Delete(&proto.OperationRateORM{}).Error; err != nil {
+				return err
+			}
+		}
+		list.Materials = materials
+		list.Operations = operations
+		list.Version++
+
+		return tx.Save(list).Error
+	})
+}
+
+func ListPriceLists(c *gin.Context) {
+	query := db.DB.Preload("Materials").Preload("Operations").Order("client_id NULLS FIRST, valid_from DESC NULLS LAST, id")
+	if clientID := c.Query("client_id"); clientID != "" {
+		query = query.Where("client_id = ?", clientID)
+	}
+
+	var lists []proto.PriceListORM
+	if err := query.Find(&lists).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "price_list/price_lists.html", gin.H{
+			"Error": "Failed to fetch price lists",
+		})
+		return
+	}
+
+	var clients []proto.ClientORM
+	if err := db.DB.Find(&clients).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "price_list/price_lists.html", gin.H{
+			"Error": "Failed to fetch clients",
+		})
+		return
+	}
+	names := make(map[uint64]string, len(clients))
+	for _, client := range clients {
+		names[client.Id] = client.Name
+	}
+	// client names by price list id, the templates cannot dereference client ids
+	clientNames := make(map[uint64]string, len(lists))
+	for _, list := range lists {
+		if list.ClientId != nil {
+			clientNames[list.Id] = names[*list.ClientId]
+		}
+	}
+
+	c.HTML(http.StatusOK, "price_list/price_lists.html", gin.H{
+		"PriceLists":  lists,
+		"ClientNames": clientNames,
+		"ClientID":    c.Query("client_id"),
+		"CsrfToken":   csrf.GetToken(c),
+	})
+}
+
+func NewPriceList(c *gin.Context) {
+	list := proto.PriceListORM{Currency: "RUB"}
+	if clientID, err := parseClientScope(c.Query("client_id")); err == nil {
+		list.ClientId = clientID
+	}
+
+	renderPriceListForm(c, http.StatusOK, &list, "", "")
+}
+
+func CreatePriceList(c *gin.Context) {
+	list := proto.PriceListORM{}
+	input, materials, operations, ok := bindPriceList(c, &list)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	list.CreatedAt = &now
+	if err := savePriceList(&list, materials, operations); err != nil {
+		renderPriceListForm(c, http.StatusBadRequest, &list, input.Prices, "Не удалось сохранить прайс-лист")
+		return
+	}
+	c.Redirect(http.StatusFound, "/price-lists")
+}
+
+func EditPriceList(c *gin.Context) {
+	var list proto.PriceListORM
+	if err := db.DB.Preload("Materials").Preload("Operations").First(&list, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	renderPriceListForm(c, http.StatusOK, &list, formatPrices(&list), "")
+}
+
+func UpdatePriceList(c *gin.Context) {
+	var list proto.PriceListORM
+	if err := db.DB.First(&list, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	input, materials, operations, ok := bindPriceList(c, &list)
+	if !ok {
+		return
+	}
+	if err := savePriceList(&list, materials, operations); err != nil {
+		renderPriceListForm(c, http.StatusBadRequest, &list, input.Prices, "Не удалось сохранить прайс-лист")
+		return
+	}
+	c.Redirect(http.StatusFound, "/price-lists")
+}
+
+// ExportPriceList downloads the prices of the list as CSV
+func ExportPriceList(c *gin.Context) {
+	var list proto.PriceListORM
+	if err := db.DB.Preload("Materials").Preload("Operations").First(&list, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=price-list-%d-v%d.csv", list.Id, list.Version))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	if err := costing.WriteCSV(c.Writer, &list); err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
+
+func DeletePriceList(c *gin.Context) {
+	var list proto.PriceListORM
+	if err := db.DB.First(&list, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("price_list_id = ?", list.Id).Delete(&proto.MaterialPriceORM{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("price_list_id = ?", list.Id).Delete(&proto.OperationRateORM{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&list).Error
+	})
+	if err != nil {
+		c.HTML(http.StatusBadRequest, "price_list/price_lists.html", gin.H{
+			"Error": "Failed to delete price list",
+		})
+		return
+	}
+	c.Redirect(http.StatusFound, "/price-lists")
+}
+
+// bindPriceList applies the submitted form to list and parses its prices, rendering the form with an error on failure
+func bindPriceList(c *gin.Context, list *proto.PriceListORM) (*PriceListFormInput, []*proto.MaterialPriceORM, []*proto.OperationRateORM, bool) {
+	var input PriceListFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderPriceListForm(c, http.StatusBadRequest, list, input.Prices, "Ошибка валидации: "+err.Error())
+		return nil, nil, nil, false
+	}
+
+	clientID, err := parseClientScope(input.ClientID)
+	if err != nil {
+		renderPriceListForm(c, http.StatusBadRequest, list, input.Prices, "Некорректный клиент")
+		return nil, nil, nil, false
+	}
+	validFrom, err := parsePriceListDate(input.ValidFrom)
+	if err != nil {
+		renderPriceListForm(c, http.StatusBadRequest, list, input.Prices, "Некорректная дата начала действия")
+		return nil, nil, nil, false
+	}
+	validTo, err := parsePriceListDate(input.ValidTo)
+	if err != nil {
+		renderPriceListForm(c, http.StatusBadRequest, list, input.Prices, "Некорректная дата окончания действия")
+		return nil, nil, nil, false
+	}
+	if validFrom != nil && validTo != nil && !validTo.After(*validFrom) {
+		renderPriceListForm(c, http.StatusBadRequest, list, input.Prices, "Дата окончания должна быть позже даты начала")
+		return nil, nil, nil, false
+	}
+
+	materials, operations, err := priceListPrices(c, input.Prices)
+	if err != nil {
+		renderPriceListForm(c, http.StatusBadRequest, list, input.Prices, "Ошибка в ценах: "+err.Error())
+		return nil, nil, nil, false
+	}
+
+	now := time.Now()
+	list.Name = input.Name
+	list.Currency = strings.ToUpper(input.Currency)
+	list.ClientId = clientID
+	list.ValidFrom = validFrom
+	list.ValidTo = validTo
+	// client lists always apply to their client, the flag only selects shared lists
+	list.IsDefault = input.IsDefault && clientID == nil
+	list.ProcessingCostPerPart = input.ProcessingCostPerPart
+	list.ProcessingCostPerAssembly = input.ProcessingCostPerAssembly
+	list.UpdatedAt = &now
+
+	return &input, materials, operations, true
+}
+
+func renderPriceListForm(c *gin.Context, status int, list *proto.PriceListORM, prices, message string) {
+	var clients []proto.ClientORM
+	if err := db.DB.Order("name").Find(&clients).Error; err != nil {
+		message = "Failed to fetch clients"
+	}
+
+	clientID := ""
+	if list.ClientId != nil {
+		clientID = strconv.FormatUint(*list.ClientId, 10)
+	}
+	formatDate := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(priceListDateLayout)
+	}
+
+	c.HTML(status, "price_list/price_list_form.html", gin.H{
+		"Error":     message,
+		"PriceList": list,
+		"ClientID":  clientID,
+		"Clients":   clients,
+		"ValidFrom": formatDate(list.ValidFrom),
+		"ValidTo":   formatDate(list.ValidTo),
+		"Prices":    prices,
+		"CsvHeader": strings.Join(costing.CSVHeader, ","),
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
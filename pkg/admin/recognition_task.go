@@ -3,6 +3,7 @@ package admin
 import (
 	"github.com/bazilio91/sferra-cloud/pkg/db"
 	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/treediff"
 	"github.com/gin-gonic/gin"
 	csrf "github.com/utrack/gin-csrf"
 	"gorm.io/gorm"
@@ -62,8 +63,21 @@ func EditRecognitionTask(c *gin.Context) {
 		return
 	}
 
+	var changes gin.H
+	if task.FrontendResult != nil {
+		diff := treediff.TaskDiff(&task)
+		changes = gin.H{
+			"Added":   diff.Added,
+			"Removed": diff.Removed,
+			"Moved":   diff.Moved,
+			"Updated": diff.Updated,
+			"Changes": treeChangeRows(diff),
+		}
+	}
+
 	c.HTML(http.StatusOK, "recognition_task/edit.html", gin.H{
 		"Task":      task,
+		"Diff":      changes,
 		"CsrfToken": csrf.GetToken(c),
 		"Statuses": []gin.H{
 			{"Value": int32(proto.Status_STATUS_CREATED), "Label": "Created"},
@@ -1,6 +1,8 @@
 package admin
 
 import (
+	"context"
+	"github.com/bazilio91/sferra-cloud/pkg/services/dataset"
 	"github.com/bazilio91/sferra-cloud/pkg/services/storage"
 	"html/template"
 	"path/filepath"
@@ -28,10 +30,15 @@ func RunAdminServer() error {
 	}
 
 	// Initialize default admin user
-	if err := seed(storage.NewS3Client(cfg)); err != nil {
+	s3Client := storage.NewS3Client(cfg)
+	if err := seed(s3Client); err != nil {
 		return err
 	}
 
+	// Start the training dataset exports
+	datasets = dataset.NewService(db.DB, s3Client)
+	go datasets.Run(context.Background())
+
 	// Initialize the router
 	r := gin.Default()
 
@@ -94,6 +101,107 @@ func setupRoutes(router *gin.Engine) {
 		authorized.GET("/recognition-tasks", ListRecognitionTasks)
 		authorized.GET("/recognition-tasks/:id/edit", EditRecognitionTask)
 		authorized.POST("/recognition-tasks/:id", UpdateRecognitionTask)
+		authorized.GET("/recognition-tasks/:id/revisions", ListTreeRevisions)
+		authorized.GET("/recognition-tasks/:id/revisions/:revision", ShowTreeRevision)
+
+		// Order routes
+		authorized.GET("/orders", ListOrders)
+
+		// Price list routes
+		authorized.GET("/price-lists", ListPriceLists)
+		authorized.GET("/price-lists/new", NewPriceList)
+		authorized.POST("/price-lists", CreatePriceList)
+		authorized.GET("/price-lists/:id/edit", EditPriceList)
+		authorized.GET("/price-lists/:id/export", ExportPriceList)
+		authorized.POST("/price-lists/:id", UpdatePriceList)
+		authorized.POST("/price-lists/:id/delete", DeletePriceList)
+
+		// Operation rule routes
+		authorized.GET("/operation-rules", ListOperationRules)
+		authorized.GET("/operation-rules/new", NewOperationRule)
+		authorized.POST("/operation-rules", CreateOperationRule)
+		authorized.GET("/operation-rules/:id/edit", EditOperationRule)
+		authorized.POST("/operation-rules/:id", UpdateOperationRule)
+		authorized.POST("/operation-rules/:id/delete", DeleteOperationRule)
+
+		// Validation rule routes
+		authorized.GET("/validation-rules", ListValidationRules)
+		authorized.GET("/validation-rules/new", NewValidationRule)
+		authorized.POST("/validation-rules", CreateValidationRule)
+		authorized.GET("/validation-rules/:id/edit", EditValidationRule)
+		authorized.POST("/validation-rules/:id", UpdateValidationRule)
+		authorized.POST("/validation-rules/:id/delete", DeleteValidationRule)
+
+		// Waste factor routes
+		authorized.GET("/waste-factors", ListWasteFactors)
+		authorized.GET("/waste-factors/new", NewWasteFactor)
+		authorized.POST("/waste-factors", CreateWasteFactor)
+		authorized.GET("/waste-factors/:id/edit", EditWasteFactor)
+		authorized.POST("/waste-factors/:id", UpdateWasteFactor)
+		authorized.POST("/waste-factors/:id/delete", DeleteWasteFactor)
+
+		// Stock length routes
+		authorized.GET("/stock-lengths", ListStockLengths)
+		authorized.GET("/stock-lengths/new", NewStockLength)
+		authorized.POST("/stock-lengths", CreateStockLength)
+		authorized.GET("/stock-lengths/:id/edit", EditStockLength)
+		authorized.POST("/stock-lengths/:id", UpdateStockLength)
+		authorized.POST("/stock-lengths/:id/delete", DeleteStockLength)
+
+		// Sheet format routes
+		authorized.GET("/sheet-formats", ListSheetFormats)
+		authorized.GET("/sheet-formats/new", NewSheetFormat)
+		authorized.POST("/sheet-formats", CreateSheetFormat)
+		authorized.GET("/sheet-formats/:id/edit", EditSheetFormat)
+		authorized.POST("/sheet-formats/:id", UpdateSheetFormat)
+		authorized.POST("/sheet-formats/:id/delete", DeleteSheetFormat)
+
+		// Material density routes
+		authorized.GET("/material-densities", ListMaterialDensities)
+		authorized.GET("/material-densities/new", NewMaterialDensity)
+		authorized.POST("/material-densities", CreateMaterialDensity)
+		authorized.GET("/material-densities/:id/edit", EditMaterialDensity)
+		authorized.POST("/material-densities/:id", UpdateMaterialDensity)
+		authorized.POST("/material-densities/:id/delete", DeleteMaterialDensity)
+
+		// Material catalog routes
+		authorized.GET("/material-grades", ListMaterialGrades)
+		authorized.GET("/material-grades/new", NewMaterialGrade)
+		authorized.POST("/material-grades", CreateMaterialGrade)
+		authorized.GET("/material-grades/:id/edit", EditMaterialGrade)
+		authorized.POST("/material-grades/:id", UpdateMaterialGrade)
+		authorized.POST("/material-grades/:id/delete", DeleteMaterialGrade)
+		authorized.GET("/unmatched-materials", ListUnmatchedMaterials)
+		authorized.POST("/unmatched-materials/:id/delete", DeleteUnmatchedMaterial)
+
+		// Assortment standard routes
+		authorized.GET("/assortment-standards", ListAssortmentStandards)
+		authorized.GET("/assortment-standards/new", NewAssortmentStandard)
+		authorized.POST("/assortment-standards", CreateAssortmentStandard)
+		authorized.GET("/assortment-standards/import", ImportAssortmentStandardsForm)
+		authorized.POST("/assortment-standards/import", ImportAssortmentStandards)
+		authorized.GET("/assortment-standards/export", ExportAssortmentStandards)
+		authorized.GET("/assortment-standards/:id/edit", EditAssortmentStandard)
+		authorized.POST("/assortment-standards/:id", UpdateAssortmentStandard)
+		authorized.POST("/assortment-standards/:id/delete", DeleteAssortmentStandard)
+
+		// Standards dictionary routes
+		authorized.GET("/standard-parts", ListStandardParts)
+		authorized.GET("/standard-parts/new", NewStandardPart)
+		authorized.POST("/standard-parts", CreateStandardPart)
+		authorized.GET("/standard-parts/:id/edit", EditStandardPart)
+		authorized.POST("/standard-parts/:id", UpdateStandardPart)
+		authorized.POST("/standard-parts/:id/delete", DeleteStandardPart)
+
+		// Recognition accuracy routes
+		authorized.GET("/accuracy", AccuracyDashboard)
+
+		// Training dataset export routes
+		authorized.GET("/dataset-exports", ListDatasetExports)
+		authorized.GET("/dataset-exports/new", NewDatasetExport)
+		authorized.POST("/dataset-exports", CreateDatasetExport)
+		authorized.GET("/dataset-exports/:id/archive", DownloadDatasetArchive)
+		authorized.GET("/dataset-exports/:id/manifest", DownloadDatasetManifest)
 	}
 }
 
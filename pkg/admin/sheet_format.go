@@ -0,0 +1,176 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+)
+
+type SheetFormatFormInput struct {
+	ClientID  string  `form:"client_id"`
+	Material  string  `form:"material" binding:"max=100"`
+	Thickness float64 `form:"thickness" binding:"gte=0,lte=500"`
+	Width     float64 `form:"width" binding:"gt=0,lte=20000"`
+	Length    float64 `form:"length" binding:"gt=0,lte=20000"`
+	Gap       float64 `form:"gap" binding:"gte=0,lte=100"`
+}
+
+// sheetFormatsURL returns the format list of the scope: a client or the shared formats
+func sheetFormatsURL(clientID *uint64) string {
+	if clientID == nil {
+		return "/sheet-formats"
+	}
+
+	return fmt.Sprintf("/sheet-formats?client_id=%d", *clientID)
+}
+
+func ListSheetFormats(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var client proto.ClientORM
+	query := db.DB.Order("material, thickness, width, length, id")
+	if clientID != nil {
+		if err := db.DB.First(&client, *clientID).Error; err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		query = query.Where("client_id = ?", *clientID)
+	} else {
+		query = query.Where("client_id IS NULL")
+	}
+
+	var formats []proto.SheetFormatORM
+	if err := query.Find(&formats).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "sheet_format/sheet_formats.html", gin.H{
+			"Error": "Failed to fetch sheet formats",
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "sheet_format/sheet_formats.html", gin.H{
+		"Formats":   formats,
+		"Client":    client,
+		"ClientID":  c.Query("client_id"),
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
+
+func NewSheetFormat(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	renderSheetFormatForm(c, http.StatusOK, &proto.SheetFormatORM{ClientId: clientID, Gap: 5}, "")
+}
+
+func CreateSheetFormat(c *gin.Context) {
+	format := proto.SheetFormatORM{}
+	if !bindSheetFormat(c, &format) {
+		return
+	}
+
+	now := time.Now()
+	format.CreatedAt = &now
+	if err := db.DB.Create(&format).Error; err != nil {
+		renderSheetFormatForm(c, http.StatusBadRequest, &format, "Не удалось сохранить формат листа")
+		return
+	}
+	c.Redirect(http.StatusFound, sheetFormatsURL(format.ClientId))
+}
+
+func EditSheetFormat(c *gin.Context) {
+	var format proto.SheetFormatORM
+	if err := db.DB.First(&format, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	renderSheetFormatForm(c, http.StatusOK, &format, "")
+}
+
+func UpdateSheetFormat(c *gin.Context) {
+	var format proto.SheetFormatORM
+	if err := db.DB.First(&format, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if !bindSheetFormat(c, &format) {
+		return
+	}
+
+	if err := db.DB.Save(&format).Error; err != nil {
+		renderSheetFormatForm(c, http.StatusBadRequest, &format, "Не удалось сохранить формат листа")
+		return
+	}
+	c.Redirect(http.StatusFound, sheetFormatsURL(format.ClientId))
+}
+
+func DeleteSheetFormat(c *gin.Context) {
+	var format proto.SheetFormatORM
+	if err := db.DB.First(&format, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err := db.DB.Delete(&format).Error; err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Redirect(http.StatusFound, sheetFormatsURL(format.ClientId))
+}
+
+// bindSheetFormat applies the submitted form to format, rendering the form with an error on failure
+func bindSheetFormat(c *gin.Context, format *proto.SheetFormatORM) bool {
+	var input SheetFormatFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderSheetFormatForm(c, http.StatusBadRequest, format, "Ошибка валидации: "+err.Error())
+		return false
+	}
+	clientID, err := parseClientScope(input.ClientID)
+	if err != nil {
+		renderSheetFormatForm(c, http.StatusBadRequest, format, "Некорректный клиент")
+		return false
+	}
+
+	now := time.Now()
+	format.ClientId = clientID
+	format.Material = input.Material
+	format.Thickness = input.Thickness
+	format.Width = input.Width
+	format.Length = input.Length
+	format.Gap = input.Gap
+	format.UpdatedAt = &now
+
+	return true
+}
+
+func renderSheetFormatForm(c *gin.Context, status int, format *proto.SheetFormatORM, message string) {
+	var clients []proto.ClientORM
+	if err := db.DB.Order("name").Find(&clients).Error; err != nil {
+		message = "Failed to fetch clients"
+	}
+
+	clientID := ""
+	if format.ClientId != nil {
+		clientID = strconv.FormatUint(*format.ClientId, 10)
+	}
+
+	c.HTML(status, "sheet_format/sheet_format_form.html", gin.H{
+		"Error":     message,
+		"Format":    format,
+		"ClientID":  clientID,
+		"Clients":   clients,
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
@@ -0,0 +1,133 @@
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+)
+
+var partKindLabels = map[int32]string{
+	int32(proto.PartKind_PART_KIND_STANDARD):  "Стандартное",
+	int32(proto.PartKind_PART_KIND_PURCHASED): "Покупное",
+}
+
+type StandardPartFormInput struct {
+	Designation string `form:"designation" binding:"max=100"`
+	Name        string `form:"name" binding:"max=100"`
+	Kind        int32  `form:"kind" binding:"gte=2,lte=3"`
+	Description string `form:"description"`
+	Enabled     bool   `form:"enabled"`
+}
+
+func ListStandardParts(c *gin.Context) {
+	query := db.DB.Order("kind, name, designation")
+	if search := c.Query("q"); search != "" {
+		pattern := "%" + search + "%"
+		query = query.Where("designation ILIKE ? OR name ILIKE ?", pattern, pattern)
+	}
+
+	var parts []proto.StandardPartORM
+	if err := query.Find(&parts).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "standard_part/standard_parts.html", gin.H{
+			"Error": "Failed to fetch standard parts",
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "standard_part/standard_parts.html", gin.H{
+		"Parts":      parts,
+		"Query":      c.Query("q"),
+		"KindLabels": partKindLabels,
+		"CsrfToken":  csrf.GetToken(c),
+	})
+}
+
+func NewStandardPart(c *gin.Context) {
+	renderStandardPartForm(c, http.StatusOK, &proto.StandardPartORM{Kind: int32(proto.PartKind_PART_KIND_STANDARD), Enabled: true}, "")
+}
+
+func CreateStandardPart(c *gin.Context) {
+	part := proto.StandardPartORM{}
+	if !bindStandardPart(c, &part) {
+		return
+	}
+
+	now := time.Now()
+	part.CreatedAt = &now
+	if err := db.DB.Create(&part).Error; err != nil {
+		renderStandardPartForm(c, http.StatusBadRequest, &part, "Не удалось сохранить запись")
+		return
+	}
+	c.Redirect(http.StatusFound, "/standard-parts")
+}
+
+func EditStandardPart(c *gin.Context) {
+	var part proto.StandardPartORM
+	if err := db.DB.First(&part, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	renderStandardPartForm(c, http.StatusOK, &part, "")
+}
+
+func UpdateStandardPart(c *gin.Context) {
+	var part proto.StandardPartORM
+	if err := db.DB.First(&part, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if !bindStandardPart(c, &part) {
+		return
+	}
+
+	if err := db.DB.Save(&part).Error; err != nil {
+		renderStandardPartForm(c, http.StatusBadRequest, &part, "Не удалось сохранить запись")
+		return
+	}
+	c.Redirect(http.StatusFound, "/standard-parts")
+}
+
+func DeleteStandardPart(c *gin.Context) {
+	if err := db.DB.Delete(&proto.StandardPartORM{}, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Redirect(http.StatusFound, "/standard-parts")
+}
+
+// bindStandardPart applies the submitted form to part, rendering the form with an error on failure
+func bindStandardPart(c *gin.Context, part *proto.StandardPartORM) bool {
+	var input StandardPartFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderStandardPartForm(c, http.StatusBadRequest, part, "Ошибка валидации: "+err.Error())
+		return false
+	}
+	if input.Designation == "" && input.Name == "" {
+		renderStandardPartForm(c, http.StatusBadRequest, part, "Укажите обозначение стандарта или наименование")
+		return false
+	}
+
+	now := time.Now()
+	part.Designation = input.Designation
+	part.Name = input.Name
+	part.Kind = input.Kind
+	part.Description = input.Description
+	part.Enabled = input.Enabled
+	part.UpdatedAt = &now
+
+	return true
+}
+
+func renderStandardPartForm(c *gin.Context, status int, part *proto.StandardPartORM, message string) {
+	c.HTML(status, "standard_part/standard_part_form.html", gin.H{
+		"Error":      message,
+		"Part":       part,
+		"KindLabels": partKindLabels,
+		"CsrfToken":  csrf.GetToken(c),
+	})
+}
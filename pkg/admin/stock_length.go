@@ -0,0 +1,176 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+)
+
+type StockLengthFormInput struct {
+	ClientID       string  `form:"client_id"`
+	AssortmentType string  `form:"assortment_type" binding:"max=100"`
+	Material       string  `form:"material" binding:"max=100"`
+	Size           string  `form:"size" binding:"max=50"`
+	Length         float64 `form:"length" binding:"gt=0,lte=100000"`
+	Kerf           float64 `form:"kerf" binding:"gte=0,lte=100"`
+}
+
+// stockLengthsURL returns the stock length list of the scope: a client or the shared lengths
+func stockLengthsURL(clientID *uint64) string {
+	if clientID == nil {
+		return "/stock-lengths"
+	}
+
+	return fmt.Sprintf("/stock-lengths?client_id=%d", *clientID)
+}
+
+func ListStockLengths(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var client proto.ClientORM
+	query := db.DB.Order("assortment_type, material, size, length, id")
+	if clientID != nil {
+		if err := db.DB.First(&client, *clientID).Error; err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		query = query.Where("client_id = ?", *clientID)
+	} else {
+		query = query.Where("client_id IS NULL")
+	}
+
+	var lengths []proto.StockLengthORM
+	if err := query.Find(&lengths).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "stock_length/stock_lengths.html", gin.H{
+			"Error": "Failed to fetch stock lengths",
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "stock_length/stock_lengths.html", gin.H{
+		"Lengths":   lengths,
+		"Client":    client,
+		"ClientID":  c.Query("client_id"),
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
+
+func NewStockLength(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	renderStockLengthForm(c, http.StatusOK, &proto.StockLengthORM{ClientId: clientID, Kerf: 3}, "")
+}
+
+func CreateStockLength(c *gin.Context) {
+	length := proto.StockLengthORM{}
+	if !bindStockLength(c, &length) {
+		return
+	}
+
+	now := time.Now()
+	length.CreatedAt = &now
+	if err := db.DB.Create(&length).Error; err != nil {
+		renderStockLengthForm(c, http.StatusBadRequest, &length, "Не удалось сохранить длину заготовки")
+		return
+	}
+	c.Redirect(http.StatusFound, stockLengthsURL(length.ClientId))
+}
+
+func EditStockLength(c *gin.Context) {
+	var length proto.StockLengthORM
+	if err := db.DB.First(&length, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	renderStockLengthForm(c, http.StatusOK, &length, "")
+}
+
+func UpdateStockLength(c *gin.Context) {
+	var length proto.StockLengthORM
+	if err := db.DB.First(&length, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if !bindStockLength(c, &length) {
+		return
+	}
+
+	if err := db.DB.Save(&length).Error; err != nil {
+		renderStockLengthForm(c, http.StatusBadRequest, &length, "Не удалось сохранить длину заготовки")
+		return
+	}
+	c.Redirect(http.StatusFound, stockLengthsURL(length.ClientId))
+}
+
+func DeleteStockLength(c *gin.Context) {
+	var length proto.StockLengthORM
+	if err := db.DB.First(&length, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err := db.DB.Delete(&length).Error; err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Redirect(http.StatusFound, stockLengthsURL(length.ClientId))
+}
+
+// bindStockLength applies the submitted form to length, rendering the form with an error on failure
+func bindStockLength(c *gin.Context, length *proto.StockLengthORM) bool {
+	var input StockLengthFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderStockLengthForm(c, http.StatusBadRequest, length, "Ошибка валидации: "+err.Error())
+		return false
+	}
+	clientID, err := parseClientScope(input.ClientID)
+	if err != nil {
+		renderStockLengthForm(c, http.StatusBadRequest, length, "Некорректный клиент")
+		return false
+	}
+
+	now := time.Now()
+	length.ClientId = clientID
+	length.AssortmentType = input.AssortmentType
+	length.Material = input.Material
+	length.Size = input.Size
+	length.Length = input.Length
+	length.Kerf = input.Kerf
+	length.UpdatedAt = &now
+
+	return true
+}
+
+func renderStockLengthForm(c *gin.Context, status int, length *proto.StockLengthORM, message string) {
+	var clients []proto.ClientORM
+	if err := db.DB.Order("name").Find(&clients).Error; err != nil {
+		message = "Failed to fetch clients"
+	}
+
+	clientID := ""
+	if length.ClientId != nil {
+		clientID = strconv.FormatUint(*length.ClientId, 10)
+	}
+
+	c.HTML(status, "stock_length/stock_length_form.html", gin.H{
+		"Error":     message,
+		"Length":    length,
+		"ClientID":  clientID,
+		"Clients":   clients,
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
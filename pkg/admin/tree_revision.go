@@ -0,0 +1,152 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/revision"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+var revisionActionLabels = map[string]string{
+	revision.ActionRecognition: "Распознавание",
+	revision.ActionReplace:     "Сохранение дерева",
+	revision.ActionAddNode:     "Добавление узла",
+	revision.ActionMoveNode:    "Перемещение узла",
+	revision.ActionDeleteNode:  "Удаление узла",
+	revision.ActionUpdateNode:  "Изменение узла",
+	revision.ActionPatch:       "JSON Patch",
+	revision.ActionRevert:      "Откат",
+}
+
+var treeChangeLabels = map[proto.TreeChangeKind]string{
+	proto.TreeChangeKind_TREE_CHANGE_KIND_ADDED:   "Добавлен",
+	proto.TreeChangeKind_TREE_CHANGE_KIND_REMOVED: "Удалён",
+	proto.TreeChangeKind_TREE_CHANGE_KIND_MOVED:   "Перемещён",
+	proto.TreeChangeKind_TREE_CHANGE_KIND_UPDATED: "Изменён",
+}
+
+func revisionAction(action string) string {
+	if label, ok := revisionActionLabels[action]; ok {
+		return label
+	}
+	return action
+}
+
+func treeChangeRows(diff *proto.TreeDiff) []gin.H {
+	var rows []gin.H
+	for _, change := range diff.Changes {
+		rows = append(rows, gin.H{
+			"Kind":   treeChangeLabels[change.Kind],
+			"NodeId": change.NodeId,
+			"Number": change.Number,
+			"Name":   change.Name,
+			"Field":  change.Field,
+			"Before": change.Before,
+			"After":  change.After,
+		})
+	}
+
+	return rows
+}
+
+func revisionTask(c *gin.Context, template string) (*proto.DataRecognitionTaskORM, bool) {
+	var task proto.DataRecognitionTaskORM
+	if err := db.DB.Preload("Client").First(&task, "id = ?", c.Param("id")).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.AbortWithStatus(http.StatusNotFound)
+			return nil, false
+		}
+		c.HTML(http.StatusInternalServerError, template, gin.H{
+			"Error": "Failed to fetch task",
+		})
+		return nil, false
+	}
+
+	return &task, true
+}
+
+func revisionRow(item *proto.TreeRevisionORM) gin.H {
+	row := gin.H{
+		"Revision":   item.Revision,
+		"Author":     item.Author,
+		"Action":     revisionAction(item.Action),
+		"RevertedTo": item.RevertedTo,
+	}
+	if item.CreatedAt != nil {
+		row["CreatedAt"] = item.CreatedAt.Format("2006-01-02 15:04:05")
+	}
+	if item.Diff != nil {
+		diff := item.Diff.Data()
+		row["Added"], row["Removed"], row["Moved"], row["Updated"] = diff.Added, diff.Removed, diff.Moved, diff.Updated
+	}
+
+	return row
+}
+
+func ListTreeRevisions(c *gin.Context) {
+	task, ok := revisionTask(c, "recognition_task/revisions.html")
+	if !ok {
+		return
+	}
+
+	revisions, err := revision.TaskRevisions(c, db.DB, task, c.Query("field"))
+	if err != nil {
+		c.HTML(http.StatusInternalServerError, "recognition_task/revisions.html", gin.H{
+			"Error": "Failed to fetch revisions",
+			"Task":  task,
+		})
+		return
+	}
+
+	rows := make([]gin.H, 0, len(revisions))
+	for _, item := range revisions {
+		rows = append(rows, revisionRow(item))
+	}
+
+	c.HTML(http.StatusOK, "recognition_task/revisions.html", gin.H{
+		"Task":      task,
+		"Revisions": rows,
+		"Field":     c.Query("field"),
+	})
+}
+
+func ShowTreeRevision(c *gin.Context) {
+	task, ok := revisionTask(c, "recognition_task/revision.html")
+	if !ok {
+		return
+	}
+
+	number, err := strconv.ParseInt(c.Param("revision"), 10, 32)
+	if err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	item, err := revision.TaskRevision(c, db.DB, task, int32(number))
+	if err == revision.ErrRevisionNotFound {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		c.HTML(http.StatusInternalServerError, "recognition_task/revision.html", gin.H{
+			"Error": "Failed to fetch revision",
+			"Task":  task,
+		})
+		return
+	}
+
+	var changes []gin.H
+	if item.Diff != nil {
+		diff := item.Diff.Data()
+		changes = treeChangeRows(&diff)
+	}
+
+	c.HTML(http.StatusOK, "recognition_task/revision.html", gin.H{
+		"Task":     task,
+		"Revision": revisionRow(item),
+		"Changes":  changes,
+	})
+}
@@ -0,0 +1,202 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/validation"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+)
+
+var validationCheckLabels = map[string]string{
+	validation.CheckCountPositive:        "Количество больше нуля",
+	validation.CheckMaterialRequired:     "Материал у изготавливаемых деталей",
+	validation.CheckDesignationFormat:    "Формат обозначения",
+	validation.CheckParentResolves:       "Родительский узел существует",
+	validation.CheckAccumulatedCount:     "Общее количество согласовано",
+	validation.CheckDesignationHierarchy: "Обозначение входит в сборку",
+}
+
+type ValidationRuleFormInput struct {
+	ClientID    string `form:"client_id"`
+	Code        string `form:"code" binding:"required,max=50"`
+	Enabled     bool   `form:"enabled"`
+	Pattern     string `form:"pattern" binding:"max=500"`
+	Description string `form:"description"`
+}
+
+// validationRulesURL returns the rule list of the scope: a client or the shared rules
+func validationRulesURL(clientID *uint64) string {
+	if clientID == nil {
+		return "/validation-rules"
+	}
+
+	return fmt.Sprintf("/validation-rules?client_id=%d", *clientID)
+}
+
+func ListValidationRules(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var client proto.ClientORM
+	query := db.DB.Order("code, id")
+	if clientID != nil {
+		if err := db.DB.First(&client, *clientID).Error; err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		query = query.Where("client_id = ?", *clientID)
+	} else {
+		query = query.Where("client_id IS NULL")
+	}
+
+	var rules []proto.ValidationRuleORM
+	if err := query.Find(&rules).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "validation_rule/validation_rules.html", gin.H{
+			"Error": "Failed to fetch validation rules",
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "validation_rule/validation_rules.html", gin.H{
+		"Rules":              rules,
+		"Client":             client,
+		"ClientID":           c.Query("client_id"),
+		"CheckLabels":        validationCheckLabels,
+		"DefaultDesignation": validation.DefaultDesignationPattern,
+		"CsrfToken":          csrf.GetToken(c),
+	})
+}
+
+func NewValidationRule(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	renderValidationRuleForm(c, http.StatusOK, &proto.ValidationRuleORM{ClientId: clientID, Enabled: true}, "")
+}
+
+func CreateValidationRule(c *gin.Context) {
+	rule := proto.ValidationRuleORM{}
+	if !bindValidationRule(c, &rule) {
+		return
+	}
+
+	now := time.Now()
+	rule.CreatedAt = &now
+	if err := db.DB.Create(&rule).Error; err != nil {
+		renderValidationRuleForm(c, http.StatusBadRequest, &rule, "Не удалось сохранить правило")
+		return
+	}
+	c.Redirect(http.StatusFound, validationRulesURL(rule.ClientId))
+}
+
+func EditValidationRule(c *gin.Context) {
+	var rule proto.ValidationRuleORM
+	if err := db.DB.First(&rule, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	renderValidationRuleForm(c, http.StatusOK, &rule, "")
+}
+
+func UpdateValidationRule(c *gin.Context) {
+	var rule proto.ValidationRuleORM
+	if err := db.DB.First(&rule, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if !bindValidationRule(c, &rule) {
+		return
+	}
+
+	if err := db.DB.Save(&rule).Error; err != nil {
+		renderValidationRuleForm(c, http.StatusBadRequest, &rule, "Не удалось сохранить правило")
+		return
+	}
+	c.Redirect(http.StatusFound, validationRulesURL(rule.ClientId))
+}
+
+func DeleteValidationRule(c *gin.Context) {
+	var rule proto.ValidationRuleORM
+	if err := db.DB.First(&rule, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err := db.DB.Delete(&rule).Error; err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Redirect(http.StatusFound, validationRulesURL(rule.ClientId))
+}
+
+// bindValidationRule applies the submitted form to rule, rendering the form with an error on failure
+func bindValidationRule(c *gin.Context, rule *proto.ValidationRuleORM) bool {
+	var input ValidationRuleFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderValidationRuleForm(c, http.StatusBadRequest, rule, "Ошибка валидации: "+err.Error())
+		return false
+	}
+	clientID, err := parseClientScope(input.ClientID)
+	if err != nil {
+		renderValidationRuleForm(c, http.StatusBadRequest, rule, "Некорректный клиент")
+		return false
+	}
+	if _, ok := validationCheckLabels[input.Code]; !ok {
+		renderValidationRuleForm(c, http.StatusBadRequest, rule, "Неизвестная проверка")
+		return false
+	}
+	if _, err := regexp.Compile(input.Pattern); err != nil {
+		renderValidationRuleForm(c, http.StatusBadRequest, rule, "Некорректное регулярное выражение: "+err.Error())
+		return false
+	}
+
+	now := time.Now()
+	rule.ClientId = clientID
+	rule.Code = input.Code
+	rule.Enabled = input.Enabled
+	rule.Pattern = input.Pattern
+	rule.Description = input.Description
+	rule.UpdatedAt = &now
+
+	return true
+}
+
+func renderValidationRuleForm(c *gin.Context, status int, rule *proto.ValidationRuleORM, message string) {
+	var clients []proto.ClientORM
+	if err := db.DB.Order("name").Find(&clients).Error; err != nil {
+		message = "Failed to fetch clients"
+	}
+
+	clientID := ""
+	if rule.ClientId != nil {
+		clientID = strconv.FormatUint(*rule.ClientId, 10)
+	}
+
+	checks := make([]gin.H, 0, len(validation.Checks))
+	for _, check := range validation.Checks {
+		checks = append(checks, gin.H{"Value": check, "Label": validationCheckLabels[check]})
+	}
+
+	c.HTML(status, "validation_rule/validation_rule_form.html", gin.H{
+		"Error":              message,
+		"Rule":               rule,
+		"ClientID":           clientID,
+		"Clients":            clients,
+		"Checks":             checks,
+		"DefaultDesignation": validation.DefaultDesignationPattern,
+		"CsrfToken":          csrf.GetToken(c),
+	})
+}
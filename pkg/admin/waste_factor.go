@@ -0,0 +1,172 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/gin-gonic/gin"
+	csrf "github.com/utrack/gin-csrf"
+)
+
+type WasteFactorFormInput struct {
+	ClientID       string  `form:"client_id"`
+	AssortmentType string  `form:"assortment_type" binding:"max=100"`
+	Material       string  `form:"material" binding:"max=100"`
+	Percent        float64 `form:"percent" binding:"gte=0,lte=100"`
+}
+
+// wasteFactorsURL returns the factor list of the scope: a client or the shared factors
+func wasteFactorsURL(clientID *uint64) string {
+	if clientID == nil {
+		return "/waste-factors"
+	}
+
+	return fmt.Sprintf("/waste-factors?client_id=%d", *clientID)
+}
+
+func ListWasteFactors(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var client proto.ClientORM
+	query := db.DB.Order("assortment_type, material, id")
+	if clientID != nil {
+		if err := db.DB.First(&client, *clientID).Error; err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		query = query.Where("client_id = ?", *clientID)
+	} else {
+		query = query.Where("client_id IS NULL")
+	}
+
+	var factors []proto.WasteFactorORM
+	if err := query.Find(&factors).Error; err != nil {
+		c.HTML(http.StatusInternalServerError, "waste_factor/waste_factors.html", gin.H{
+			"Error": "Failed to fetch waste factors",
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "waste_factor/waste_factors.html", gin.H{
+		"Factors":   factors,
+		"Client":    client,
+		"ClientID":  c.Query("client_id"),
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
+
+func NewWasteFactor(c *gin.Context) {
+	clientID, err := parseClientScope(c.Query("client_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	renderWasteFactorForm(c, http.StatusOK, &proto.WasteFactorORM{ClientId: clientID}, "")
+}
+
+func CreateWasteFactor(c *gin.Context) {
+	factor := proto.WasteFactorORM{}
+	if !bindWasteFactor(c, &factor) {
+		return
+	}
+
+	now := time.Now()
+	factor.CreatedAt = &now
+	if err := db.DB.Create(&factor).Error; err != nil {
+		renderWasteFactorForm(c, http.StatusBadRequest, &factor, "Не удалось сохранить коэффициент")
+		return
+	}
+	c.Redirect(http.StatusFound, wasteFactorsURL(factor.ClientId))
+}
+
+func EditWasteFactor(c *gin.Context) {
+	var factor proto.WasteFactorORM
+	if err := db.DB.First(&factor, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	renderWasteFactorForm(c, http.StatusOK, &factor, "")
+}
+
+func UpdateWasteFactor(c *gin.Context) {
+	var factor proto.WasteFactorORM
+	if err := db.DB.First(&factor, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if !bindWasteFactor(c, &factor) {
+		return
+	}
+
+	if err := db.DB.Save(&factor).Error; err != nil {
+		renderWasteFactorForm(c, http.StatusBadRequest, &factor, "Не удалось сохранить коэффициент")
+		return
+	}
+	c.Redirect(http.StatusFound, wasteFactorsURL(factor.ClientId))
+}
+
+func DeleteWasteFactor(c *gin.Context) {
+	var factor proto.WasteFactorORM
+	if err := db.DB.First(&factor, c.Param("id")).Error; err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err := db.DB.Delete(&factor).Error; err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Redirect(http.StatusFound, wasteFactorsURL(factor.ClientId))
+}
+
+// bindWasteFactor applies the submitted form to factor, rendering the form with an error on failure
+func bindWasteFactor(c *gin.Context, factor *proto.WasteFactorORM) bool {
+	var input WasteFactorFormInput
+	if err := c.ShouldBind(&input); err != nil {
+		renderWasteFactorForm(c, http.StatusBadRequest, factor, "Ошибка валидации: "+err.Error())
+		return false
+	}
+	clientID, err := parseClientScope(input.ClientID)
+	if err != nil {
+		renderWasteFactorForm(c, http.StatusBadRequest, factor, "Некорректный клиент")
+		return false
+	}
+
+	now := time.Now()
+	factor.ClientId = clientID
+	factor.AssortmentType = input.AssortmentType
+	factor.Material = input.Material
+	factor.Percent = input.Percent
+	factor.UpdatedAt = &now
+
+	return true
+}
+
+func renderWasteFactorForm(c *gin.Context, status int, factor *proto.WasteFactorORM, message string) {
+	var clients []proto.ClientORM
+	if err := db.DB.Order("name").Find(&clients).Error; err != nil {
+		message = "Failed to fetch clients"
+	}
+
+	clientID := ""
+	if factor.ClientId != nil {
+		clientID = strconv.FormatUint(*factor.ClientId, 10)
+	}
+
+	c.HTML(status, "waste_factor/waste_factor_form.html", gin.H{
+		"Error":     message,
+		"Factor":    factor,
+		"ClientID":  clientID,
+		"Clients":   clients,
+		"CsrfToken": csrf.GetToken(c),
+	})
+}
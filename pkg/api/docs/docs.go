@@ -52,35 +52,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/login": {
-            "post": {
-                "description": "Authenticate user and return JWT token",
-                "consumes": [
-                    "application/json"
+        "/api/v1/account/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Email notification settings of the current user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
-                ],
-                "summary": "Login",
-                "parameters": [
-                    {
-                        "description": "Login credentials",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pkg_api_handlers.LoginInput"
-                        }
-                    }
+                    "account"
                 ],
+                "summary": "Get Notification Preferences",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pkg_api_handlers.TokenResponse"
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.NotificationPreferences"
                         }
                     },
                     "401": {
@@ -96,11 +87,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/auth/register": {
-            "post": {
-                "description": "Register a new user",
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Choose the language and the events the current user is emailed about. A zero quota threshold uses the server default.",
                 "consumes": [
                     "application/json"
                 ],
@@ -108,17 +102,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "account"
                 ],
-                "summary": "Register",
+                "summary": "Update Notification Preferences",
                 "parameters": [
                     {
-                        "description": "Registration data",
-                        "name": "register",
+                        "description": "Notification preferences",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pkg_api_handlers.RegisterInput"
+                            "$ref": "#/definitions/pkg_api_handlers.NotificationPreferencesInput"
                         }
                     }
                 ],
@@ -126,7 +120,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pkg_api_handlers.SuccessResponse"
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.NotificationPreferences"
                         }
                     },
                     "400": {
@@ -135,6 +129,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -144,72 +144,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/recognition_tasks": {
+        "/api/v1/account/training_data_consent": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "List DataRecognitionTasks for the authenticated client",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Whether the tasks of the current client may be used to train the recognition models. Undecided clients are only included in training datasets when the export asks for them.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "recognition_tasks"
-                ],
-                "summary": "List DataRecognitionTask",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Filter by status",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
-                    }
+                    "account"
                 ],
+                "summary": "Get Training Data Consent",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pkg_api_handlers.DataRecognitionTaskListResponse"
+                            "$ref": "#/definitions/pkg_api_handlers.TrainingDataConsentResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
                         }
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new DataRecognitionTask",
+                "description": "Opt the current client in or out of using its completed tasks, images and corrections to train the recognition models. Opted out clients are never included in training datasets.",
                 "consumes": [
                     "application/json"
                 ],
@@ -217,25 +194,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "recognition_tasks"
+                    "account"
                 ],
-                "summary": "Create DataRecognitionTask",
+                "summary": "Update Training Data Consent",
                 "parameters": [
                     {
-                        "description": "DataRecognitionTask data",
+                        "description": "Consent",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask"
+                            "$ref": "#/definitions/pkg_api_handlers.TrainingDataConsentInput"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask"
+                            "$ref": "#/definitions/pkg_api_handlers.TrainingDataConsentResponse"
                         }
                     },
                     "400": {
@@ -249,42 +226,50 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/recognition_tasks/{id}": {
+        "/api/v1/account/usage": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "GetTaskImage a DataRecognitionTask by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Usage of the current client over a date range: tasks by status, images processed, average time per stage, quota consumed and a breakdown per user who created the tasks. Dates are YYYY-MM-DD (to is inclusive) or RFC3339; the default range is the last 30 days.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "recognition_tasks"
+                    "account"
                 ],
-                "summary": "GetTaskImage DataRecognitionTask",
+                "summary": "Get Account Usage",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "DataRecognitionTask ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Range start",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range end",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask"
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_services_usage.Report"
                         }
                     },
                     "400": {
@@ -299,21 +284,64 @@ const docTemplate = `{
                             "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
+            }
+        },
+        "/api/v1/auth/login": {
+            "post": {
+                "description": "Authenticate user and return JWT token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Login",
+                "parameters": [
                     {
-                        "BearerAuth": []
+                        "description": "Login credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.LoginInput"
+                        }
                     }
                 ],
-                "description": "Update a UpdateDataRecognitionTask by ID",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.TokenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/register": {
+            "post": {
+                "description": "Register a new user",
                 "consumes": [
                     "application/json"
                 ],
@@ -321,24 +349,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "recognition_tasks"
+                    "auth"
                 ],
-                "summary": "Update UpdateDataRecognitionTask",
+                "summary": "Register",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "UpdateDataRecognitionTask ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated DataRecognitionTask",
-                        "name": "data",
+                        "description": "Registration data",
+                        "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask"
+                            "$ref": "#/definitions/pkg_api_handlers.RegisterInput"
                         }
                     }
                 ],
@@ -346,7 +367,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask"
+                            "$ref": "#/definitions/pkg_api_handlers.SuccessResponse"
                         }
                     },
                     "400": {
@@ -355,51 +376,61 @@ const docTemplate = `{
                             "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/material_requirements": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a DataRecognitionTask by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Materials of several completed tasks aggregated by grade, assortment type and size with accumulated quantities and waste allowances",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
                     "recognition_tasks"
                 ],
-                "summary": "Delete DataRecognitionTask",
+                "summary": "Get Material Requirements",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "DataRecognitionTask ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Task IDs",
+                        "name": "task_id",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "Output format",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pkg_api_handlers.SuccessResponse"
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.MaterialRequirements"
                         }
                     },
                     "400": {
@@ -419,33 +450,131 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
                         }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/recognition-tasks/{task_id}/images/upload": {
-            "post": {
-                "description": "UploadTaskImage an image to storage",
-                "consumes": [
-                    "multipart/form-data"
+        "/api/v1/orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "List quota orders of the authenticated client, newest first",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "UploadTaskImage an image",
+                "tags": [
+                    "orders"
+                ],
+                "summary": "List Orders",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Recognition Task ID",
-                        "name": "task_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "type": "file",
-                        "description": "Image file",
-                        "name": "image",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.OrderListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Start a prepaid quota purchase. Quota is credited once the payment provider confirms the payment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Create Order",
+                "parameters": [
+                    {
+                        "description": "Order data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.CreateOrderInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get an order of the authenticated client by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get Order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -453,211 +582,4626 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/payments/{provider}/webhook": {
+            "post": {
+                "description": "Callback endpoint for payment providers. Duplicate callbacks are acknowledged without crediting quota twice.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Payment provider webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payment provider name",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quotes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List quotes of the authenticated client without revisions, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quotes"
+                ],
+                "summary": "List Quotes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only quotes of the task",
+                        "name": "task_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Quote"
                             }
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a commercial quote of a completed task. Lines are the top-level nodes of the BOM priced from the task cost estimate, which is computed when missing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quotes"
+                ],
+                "summary": "Create Quote",
+                "parameters": [
+                    {
+                        "description": "Quote data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.CreateQuoteInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Quote"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/recognition-tasks/{task_id}/images/{image_id}": {
-            "get": {
-                "description": "GetTaskImage an image by ID",
-                "produces": [
-                    "image/jpeg",
-                    "image/png",
-                    "image/gif"
-                ],
-                "summary": "GetTaskImage an image",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Recognition Task ID",
-                        "name": "task_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Image ID",
-                        "name": "image_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "file"
-                        }
+        "/api/v1/quotes/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a quote with all revisions and their lines",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quotes"
+                ],
+                "summary": "Get Quote",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quote ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Quote"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a quote with all revisions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quotes"
+                ],
+                "summary": "Delete Quote",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quote ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quotes/{id}/diff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compare terms, totals and lines of two revisions. By default the latest revision is compared with the previous one.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quotes"
+                ],
+                "summary": "Diff Quote Revisions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quote ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Old revision",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "New revision",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.QuoteDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quotes/{id}/pdf": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Render a revision of the quote to PDF with the requisites of the client",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "quotes"
+                ],
+                "summary": "Get Quote PDF",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quote ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision, the latest by default",
+                        "name": "revision",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quotes/{id}/revisions": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create the next revision of a quote with changed terms. Omitted terms are taken from the latest revision.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quotes"
+                ],
+                "summary": "Create Quote Revision",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quote ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Changed terms",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.CreateQuoteRevisionInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Quote"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List DataRecognitionTasks for the authenticated client",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "List DataRecognitionTask",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.DataRecognitionTaskListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new DataRecognitionTask",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Create DataRecognitionTask",
+                "parameters": [
+                    {
+                        "description": "DataRecognitionTask data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GetTaskImage a DataRecognitionTask by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "GetTaskImage DataRecognitionTask",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "DataRecognitionTask ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a UpdateDataRecognitionTask by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Update UpdateDataRecognitionTask",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UpdateDataRecognitionTask ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated DataRecognitionTask",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a DataRecognitionTask by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Delete DataRecognitionTask",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "DataRecognitionTask ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approve the current tree of a completed task as final. The recognition result is compared with it to record how many recognized designations, names, counts, materials, assortment sizes and nodes the users corrected. Approving again replaces the recorded metrics.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Approve Task Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TaskAccuracy"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/assortment_check": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Recognized assortments of a completed task validated against the reference database of assortment standards. Sizes missing from the series of their standard are invalid and come with the closest sizes of the series; standards missing from the database are listed as unknown.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Assortment Validation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.AssortmentValidation"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/cost_estimate": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the latest cost estimate of a task with the breakdown per node",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Task Cost Estimate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.CostEstimate"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compute the manufacturing cost of a completed task with the client's price list in effect and the hourly rates of its routed operations. The estimate records the price list version and replaces the previous one of the task.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Estimate Task Cost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.CostEstimate"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/cutting_plan": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Plan of cutting the profile parts of a completed task from stock bars: bars needed, cut patterns and waste",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Task Cutting Plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.CuttingPlan"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/diff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes users made to the recognized tree of a completed task: nodes of the frontend result added, removed, moved to another parent or with changed fields compared to the recognition result. Nodes are matched by id, falling back to designation and name. The diff is empty while the task has no frontend result.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Recognition Diff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeDiff"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/frontend_result": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Current tree of a completed task: the frontend result once edited, the recognized tree before",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Frontend Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Apply an RFC 6902 JSON Patch, sent as application/json-patch+json or application/json, to the current tree of a completed task. The patched tree must keep unique node ids and parent references matching the structure. Accumulated counts, field statuses and the flat result are recomputed by the server.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Patch Frontend Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JSON Patch operations",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/frontend_result/nodes": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach a new node with its leaves to a node of the current tree of a completed task. Nodes without an id get a generated one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Add Tree Node",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Node and its position",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.AddNodeInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/frontend_result/nodes/{node_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a node with its subtree from the current tree of a completed task. The root cannot be deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Delete Tree Node",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Node ID",
+                        "name": "node_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set fields of a node of the current tree of a completed task, e.g. {\"count\": 2}. Omitted fields are kept; id, leaves and parent_id change with the structural edits only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Update Tree Node",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Node ID",
+                        "name": "node_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Node fields",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/frontend_result/nodes/{node_id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach a node of the current tree of a completed task with its subtree to another node, or move it among the leaves of its parent. A node cannot be moved into its own subtree.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Move Tree Node",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Node ID",
+                        "name": "node_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New position",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.MoveNodeInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/mass": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Theoretical masses of the parts of a completed task computed from the typed sizes, drawing dimensions and material densities, compared with the recognized masses. Parts deviating by more than the tolerance are mismatches.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Task Mass Report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "default": 10,
+                        "description": "Accepted deviation in percent",
+                        "name": "tolerance",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.MassReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/material_requirements": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Materials of a completed task aggregated by grade, assortment type and size with accumulated quantities and waste allowances",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Task Material Requirements",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "Output format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.MaterialRequirements"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/materials": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Recognized materials of a completed task mapped to the material reference catalog with a confidence from 0 to 1. Materials without a grade are unmatched and are listed for review by administrators.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Task Materials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TaskMaterials"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/nesting_plan": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Plan of nesting the sheet parts of a completed task on sheet formats: sheets needed, utilisation and layouts; format=svg draws the layouts",
+                "produces": [
+                    "application/json",
+                    "image/svg+xml"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Task Nesting Plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "svg"
+                        ],
+                        "type": "string",
+                        "description": "Output format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.NestingPlan"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/part_totals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Quantities of the parts and assemblies of a completed task in the whole product, summed over the specification rows naming the same designation. The accumulated counts are recomputed by the server from the counts on every recognition result and user edit.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Task Part Totals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.PartTotals"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/purchase_list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Standard and purchased items of a completed task with total quantities. These items are excluded from the cost estimate, routing and material requirements.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Task Purchase List",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "description": "Output format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.PurchaseList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revision history of the frontend result of a task, newest first, without the trees. Every saved edit is a revision with its author, time and the changes from the previous one; revision 0 is the recognized tree. With field set, e.g. count, only the revisions changing that field are listed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "List Frontend Result Revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Changed field, e.g. count or spec.material",
+                        "name": "field",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeRevisionList"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/revisions/{revision}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "A revision of the frontend result of a task with its tree and the changes from the previous revision. Revision 0 is the recognized tree.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Frontend Result Revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeRevision"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/revisions/{revision}/revert": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore the tree of a revision as the frontend result of a completed task, revision 0 restores the recognized tree. The restored tree is recorded as a new revision.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Revert Frontend Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/routing": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Manufacturing operations with labour and machine hours per node of a completed task, built from the client's operation rules",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Task Routing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Routing"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recognition_tasks/{id}/validation": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Problems the validation engine found in the current tree of a completed task, counted by check and by node. The engine runs on every recognition result and user edit and sets the matching field statuses of the tree nodes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recognition_tasks"
+                ],
+                "summary": "Get Validation Summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.ValidationSummary"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List webhook endpoints of the authenticated client",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List Webhook Endpoints",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.WebhookEndpoint"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Register a URL receiving task events. The signing secret is returned only in this response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create Webhook Endpoint",
+                "parameters": [
+                    {
+                        "description": "Endpoint data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.CreateWebhookEndpointInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.WebhookEndpoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a webhook endpoint of the authenticated client by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get Webhook Endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Endpoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.WebhookEndpoint"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the URL, events or state of an endpoint. Enabling a disabled endpoint resets its failure counter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Update Webhook Endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Endpoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Endpoint data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.UpdateWebhookEndpointInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.WebhookEndpoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an endpoint together with its delivery log",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete Webhook Endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Endpoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delivery log of an endpoint, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List Webhook Deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Endpoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.WebhookDeliveryListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/{id}/deliveries/{delivery_id}/replay": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queue a new delivery with the payload of a logged one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Replay Webhook Delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Endpoint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.WebhookDelivery"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkg_api_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recognition-tasks/{task_id}/images/upload": {
+            "post": {
+                "description": "UploadTaskImage an image to storage",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "UploadTaskImage an image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recognition Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/recognition-tasks/{task_id}/images/{image_id}": {
+            "get": {
+                "description": "GetTaskImage an image by ID",
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif"
+                ],
+                "summary": "GetTaskImage an image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recognition Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image ID",
+                        "name": "image_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_bazilio91_sferra-cloud_pkg_proto.AngleSize": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "number"
+                },
+                "thickness": {
+                    "type": "number"
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.Assortment": {
+            "type": "object",
+            "properties": {
+                "chemical_composition": {
+                    "type": "string"
+                },
+                "chemical_gost": {
+                    "type": "string"
+                },
+                "field_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.FieldStatus"
+                    }
+                },
+                "figure_type": {
+                    "type": "string"
+                },
+                "form_gost": {
+                    "type": "string"
+                },
+                "material": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.ProfileSize"
+                },
+                "sub_type": {
+                    "type": "string"
+                },
+                "suggested_sizes": {
+                    "description": "closest sizes of the series of form_gost when the size is not in it",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.AssortmentCheck": {
+            "type": "object",
+            "properties": {
+                "family_mismatch": {
+                    "description": "the standard is for profiles of another family than the size",
+                    "type": "boolean"
+                },
+                "form_gost": {
+                    "description": "recognized standard of the assortment",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "node_id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "size": {
+                    "description": "recognized size",
+                    "type": "string"
+                },
+                "size_valid": {
+                    "description": "the size is in the series of the standard",
+                    "type": "boolean"
+                },
+                "standard": {
+                    "description": "designation of the reference standard, empty when the standard is unknown",
+                    "type": "string"
+                },
+                "suggestions": {
+                    "description": "closest sizes of the series for an invalid size",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.AssortmentValidation": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.AssortmentCheck"
+                    }
+                },
+                "invalid": {
+                    "description": "number of checks with an invalid size or a family mismatch",
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "unknown_standards": {
+                    "description": "recognized standards missing from the reference database",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.BeamSize": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "number"
+                },
+                "number": {
+                    "description": "profile number with the series, e.g. 20Б1",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.ChannelSize": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "number"
+                },
+                "number": {
+                    "description": "profile number with the series letter, e.g. 10П",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.Client": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "inn": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ogrn": {
+                    "type": "string"
+                },
+                "owner_fio": {
+                    "type": "string"
+                },
+                "quota": {
+                    "type": "integer"
+                },
+                "sandbox": {
+                    "description": "sandbox clients do not consume quota and are served by the built-in fake worker",
+                    "type": "boolean"
+                },
+                "total_quota": {
+                    "type": "integer"
+                },
+                "training_data_consent": {
+                    "description": "opted out clients are never exported to training datasets",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TrainingDataConsent"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.ClientUser"
+                    }
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.ClientUser": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Client"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.CostEstimate": {
+            "type": "object",
+            "properties": {
+                "breakdown": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.CostNode"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "incomplete_parts": {
+                    "description": "parts without a known price or mass",
+                    "type": "integer"
+                },
+                "material_cost": {
+                    "type": "number"
+                },
+                "price_list": {
+                    "description": "snapshot of the price list the estimate was computed with",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.PriceList"
+                        }
+                    ]
+                },
+                "price_list_id": {
+                    "type": "integer"
+                },
+                "price_list_version": {
+                    "type": "integer"
+                },
+                "processing_cost": {
+                    "type": "number"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "total_cost": {
+                    "type": "number"
+                },
+                "total_mass": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.CostNode": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.CostNode"
+                    }
+                },
+                "material": {
+                    "type": "string"
+                },
+                "material_cost": {
+                    "description": "totals for all units including sub-assemblies",
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "node_id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "operation_cost": {
+                    "description": "part of processing_cost charged for routed operations",
+                    "type": "number"
+                },
+                "price_per_kg": {
+                    "type": "number"
+                },
+                "price_per_metre": {
+                    "type": "number"
+                },
+                "processing_cost": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "description": "number of units in the whole product, taken from accumulated_count",
+                    "type": "integer"
+                },
+                "stock_factor": {
+                    "description": "stock consumed per unit of part length or area according to the cutting or nesting plan, 0 without a plan",
+                    "type": "number"
+                },
+                "total_cost": {
+                    "type": "number"
+                },
+                "total_mass": {
+                    "type": "number"
+                },
+                "unit_cost": {
+                    "type": "number"
+                },
+                "unit_length": {
+                    "description": "length of a single unit in metres",
+                    "type": "number"
+                },
+                "unit_mass": {
+                    "description": "mass of a single unit in kg",
+                    "type": "number"
+                },
+                "unit_material_cost": {
+                    "type": "number"
+                },
+                "unit_processing_cost": {
+                    "type": "number"
+                },
+                "warnings": {
+                    "description": "reasons the cost may be incomplete, e.g. a missing price or mass",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.CutPattern": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "cuts": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "stock_length": {
+                    "description": "lengths in mm",
+                    "type": "number"
+                },
+                "waste": {
+                    "description": "remainder of a bar including the saw cuts",
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.CuttingGroup": {
+            "type": "object",
+            "properties": {
+                "assortment_type": {
+                    "type": "string"
+                },
+                "bars": {
+                    "type": "integer"
+                },
+                "grade": {
+                    "type": "string"
+                },
+                "kerf": {
+                    "description": "saw cut width in mm",
+                    "type": "number"
+                },
+                "oversize": {
+                    "description": "parts longer than the longest bar, in mm; they are not in the plan",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "parts_length": {
+                    "description": "total length of the parts and of the stock bars in metres",
+                    "type": "number"
+                },
+                "patterns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.CutPattern"
+                    }
+                },
+                "pieces": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "string"
+                },
+                "stock_length": {
+                    "type": "number"
+                },
+                "unmeasured": {
+                    "description": "parts without a known length",
+                    "type": "integer"
+                },
+                "unplanned": {
+                    "description": "parts over the piece limit of a group; they are not in the plan",
+                    "type": "integer"
+                },
+                "waste_percent": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.CuttingPlan": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.CuttingGroup"
+                    }
+                },
+                "task_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask": {
+            "type": "object",
+            "properties": {
+                "approved_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "approved_by_id": {
+                    "description": "user who approved the corrected result",
+                    "type": "integer"
+                },
+                "client": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Client"
+                },
+                "created_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "created_by_id": {
+                    "description": "user who created the task",
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "frontend_result": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                },
+                "frontend_result_flat": {
+                    "$ref": "#/definitions/types.JSONValue"
+                },
+                "frontend_result_unrecognized": {
+                    "$ref": "#/definitions/types.JSONValue"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "model_version": {
+                    "description": "version of the recognition model reported by the worker",
+                    "type": "string"
+                },
+                "processed_images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "recognition_result": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                },
+                "sandbox": {
+                    "type": "boolean"
+                },
+                "source_images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Status"
+                },
+                "status_text": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "worker_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.FieldAccuracy": {
+            "type": "object",
+            "properties": {
+                "accuracy": {
+                    "description": "1 - corrected / total, 1 when nothing was compared",
+                    "type": "number"
+                },
+                "corrected": {
+                    "type": "integer"
+                },
+                "field": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.FieldStatus": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "FieldStatus_FIELD_STATUS_UNSPECIFIED",
+                "FieldStatus_OK",
+                "FieldStatus_YELLOW"
+            ]
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.Figure": {
+            "type": "object",
+            "properties": {
+                "assortment": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Assortment"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "image_id": {
+                    "type": "string"
+                },
+                "mainSize": {
+                    "description": "Types that are valid to be assigned to MainSize:\n\t*Figure_MainSizeFloat\n\t*Figure_MainSizeStr"
+                },
+                "mass": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "size_horizontal": {
+                    "type": "number"
+                },
+                "size_vertical": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.MassCheck": {
+            "type": "object",
+            "properties": {
+                "computed_mass": {
+                    "type": "number"
+                },
+                "density": {
+                    "description": "kg/m³",
+                    "type": "number"
+                },
+                "deviation_percent": {
+                    "description": "difference of the recognized mass from the computed one relative to the computed one",
+                    "type": "number"
+                },
+                "material": {
+                    "type": "string"
+                },
+                "mismatch": {
+                    "description": "the deviation exceeds the tolerance",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "node_id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "recognized_mass": {
+                    "description": "masses of one unit in kg, 0 when unknown",
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.MassReport": {
+            "type": "object",
+            "properties": {
+                "mismatches": {
+                    "type": "integer"
+                },
+                "parts": {
+                    "description": "parts with a recognized or computed mass",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.MassCheck"
+                    }
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "tolerance_percent": {
+                    "type": "number"
+                },
+                "total_mass": {
+                    "description": "mass of the product in kg, computed where possible and recognized otherwise",
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.MaterialMatch": {
+            "type": "object",
+            "properties": {
+                "confidence": {
+                    "description": "1 for an exact grade or equivalent, lower for a grade with a deoxidation suffix,\na grade written inside a longer word or a misspelling; 0 when unmatched",
+                    "type": "number"
+                },
+                "density": {
+                    "type": "number"
+                },
+                "grade": {
+                    "type": "string"
+                },
+                "grade_id": {
+                    "description": "matched grade, 0 when unmatched",
+                    "type": "integer"
+                },
+                "node_ids": {
+                    "description": "tree nodes with the material",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "price_group": {
+                    "type": "string"
+                },
+                "standard": {
+                    "type": "string"
+                },
+                "text": {
+                    "description": "recognized material text",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.MaterialPrice": {
+            "type": "object",
+            "properties": {
+                "assortment_type": {
+                    "description": "figure type of the assortment the price applies to, empty for any assortment",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "material": {
+                    "description": "material grade matched against the material of a part, e.g. Ст3сп",
+                    "type": "string"
+                },
+                "price": {
+                    "description": "price per unit, see unit",
+                    "type": "number"
+                },
+                "price_list_id": {
+                    "type": "integer"
+                },
+                "unit": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.PriceUnit"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.MaterialRequirement": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "type": "number"
+                },
+                "assortment_type": {
+                    "type": "string"
+                },
+                "bars": {
+                    "description": "stock bars of the cutting plan and their total length in metres, profiles only.\nWithout a matching waste factor the gross amounts and waste_percent come from the plan.",
+                    "type": "integer"
+                },
+                "grade": {
+                    "type": "string"
+                },
+                "gross_area": {
+                    "type": "number"
+                },
+                "gross_length": {
+                    "description": "net amounts with the waste allowance",
+                    "type": "number"
+                },
+                "gross_mass": {
+                    "type": "number"
+                },
+                "length": {
+                    "description": "net amounts: length in metres for profiles, area in square metres for sheets, mass in kg",
+                    "type": "number"
+                },
+                "mass": {
+                    "type": "number"
+                },
+                "parts": {
+                    "description": "number of distinct parts and their pieces in the whole product",
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "sheet_area": {
+                    "type": "number"
+                },
+                "sheets": {
+                    "description": "sheets of the nesting plan and their total area in square metres, sheet parts only.\nWithout a matching waste factor the gross amounts and waste_percent come from the plan.",
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "profile size as written in the material, e.g. 10 for a sheet or 57×3.5 for a pipe",
+                    "type": "string"
+                },
+                "stock_length": {
+                    "type": "number"
+                },
+                "waste_percent": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.MaterialRequirements": {
+            "type": "object",
+            "properties": {
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.MaterialRequirement"
+                    }
+                },
+                "task_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "total_gross_mass": {
+                    "type": "number"
+                },
+                "total_mass": {
+                    "type": "number"
+                },
+                "unresolved": {
+                    "description": "parts without a recognized material, excluded from the rows",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.NestingGroup": {
+            "type": "object",
+            "properties": {
+                "assortment_type": {
+                    "type": "string"
+                },
+                "gap": {
+                    "description": "distance between the parts in mm",
+                    "type": "number"
+                },
+                "grade": {
+                    "type": "string"
+                },
+                "layouts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.SheetLayout"
+                    }
+                },
+                "oversize": {
+                    "description": "parts that fit on no sheet format; they are not in the plan",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "parts_area": {
+                    "description": "total area of the part bounding boxes and of the sheets in square metres",
+                    "type": "number"
+                },
+                "pieces": {
+                    "type": "integer"
+                },
+                "sheet_area": {
+                    "type": "number"
+                },
+                "sheets": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "string"
+                },
+                "thickness": {
+                    "type": "number"
+                },
+                "unmeasured": {
+                    "description": "parts without known dimensions",
+                    "type": "integer"
+                },
+                "unplanned": {
+                    "description": "parts over the piece limit of a group; they are not in the plan",
+                    "type": "integer"
+                },
+                "utilisation_percent": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.NestingPlan": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.NestingGroup"
+                    }
+                },
+                "task_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.NotificationPreferences": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "language": {
+                    "description": "\"ru\" or \"en\"",
+                    "type": "string"
+                },
+                "quota_low": {
+                    "type": "boolean"
+                },
+                "quota_threshold": {
+                    "description": "quota balance to warn at, 0 means the server default",
+                    "type": "integer"
+                },
+                "task_completed": {
+                    "type": "boolean"
+                },
+                "task_failed": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.OperationRate": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "labour_rate": {
+                    "type": "number"
+                },
+                "machine_rate": {
+                    "type": "number"
+                },
+                "operation": {
+                    "description": "operation code of the routing, e.g. welding",
+                    "type": "string"
+                },
+                "price_list_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.Order": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "price in minor currency units (kopecks, cents)",
+                    "type": "integer"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "confirmation_url": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "paid_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "quota": {
+                    "description": "quota units credited to the client once the order is paid",
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.OrderStatus"
+                },
+                "updated_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.OrderStatus": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3
+            ],
+            "x-enum-varnames": [
+                "OrderStatus_ORDER_STATUS_PENDING",
+                "OrderStatus_ORDER_STATUS_PAID",
+                "OrderStatus_ORDER_STATUS_FAILED",
+                "OrderStatus_ORDER_STATUS_CANCELED"
+            ]
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.PartKind": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3
+            ],
+            "x-enum-varnames": [
+                "PartKind_PART_KIND_UNSPECIFIED",
+                "PartKind_PART_KIND_MANUFACTURED",
+                "PartKind_PART_KIND_STANDARD",
+                "PartKind_PART_KIND_PURCHASED"
+            ]
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.PartTotal": {
+            "type": "object",
+            "properties": {
+                "designation": {
+                    "description": "normalized designation without the document code",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "node_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "number": {
+                    "description": "designation as recognized in the first row",
+                    "type": "string"
+                },
+                "occurrences": {
+                    "description": "specification rows naming the designation",
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.PartTotals": {
+            "type": "object",
+            "properties": {
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.PartTotal"
+                    }
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.PipeSize": {
+            "type": "object",
+            "properties": {
+                "diameter": {
+                    "type": "number"
+                },
+                "wall": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.PriceList": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "description": "owner of the price list, null for lists shared by all clients",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_default": {
+                    "description": "a default shared price list is used for clients without a list of their own",
+                    "type": "boolean"
+                },
+                "materials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.MaterialPrice"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.OperationRate"
+                    }
+                },
+                "processing_cost_per_assembly": {
+                    "description": "assembly cost of a single sub-assembly",
+                    "type": "number"
+                },
+                "processing_cost_per_part": {
+                    "description": "processing cost of a single manufactured part",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "valid_from": {
+                    "description": "the list is in effect from valid_from inclusive to valid_to exclusive, unset bounds are open",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/timestamppb.Timestamp"
+                        }
+                    ]
+                },
+                "valid_to": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "version": {
+                    "description": "incremented on every change of the list, recorded by cost estimates",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.PriceUnit": {
+            "type": "integer",
+            "enum": [
+                0,
+                1
+            ],
+            "x-enum-varnames": [
+                "PriceUnit_PRICE_UNIT_KG",
+                "PriceUnit_PRICE_UNIT_METRE"
+            ]
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.ProfileFamily": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4,
+                5,
+                6,
+                7
+            ],
+            "x-enum-varnames": [
+                "ProfileFamily_PROFILE_FAMILY_UNSPECIFIED",
+                "ProfileFamily_PROFILE_FAMILY_SHEET",
+                "ProfileFamily_PROFILE_FAMILY_ROUND_BAR",
+                "ProfileFamily_PROFILE_FAMILY_PIPE",
+                "ProfileFamily_PROFILE_FAMILY_ANGLE",
+                "ProfileFamily_PROFILE_FAMILY_CHANNEL",
+                "ProfileFamily_PROFILE_FAMILY_BEAM",
+                "ProfileFamily_PROFILE_FAMILY_SQUARE_TUBE"
+            ]
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.ProfileSize": {
+            "type": "object",
+            "properties": {
+                "angle": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.AngleSize"
+                },
+                "beam": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.BeamSize"
+                },
+                "channel": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.ChannelSize"
+                },
+                "family": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.ProfileFamily"
+                },
+                "pipe": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.PipeSize"
+                },
+                "raw": {
+                    "description": "the recognized text the size was read from",
+                    "type": "string"
+                },
+                "round_bar": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.RoundBarSize"
+                },
+                "sheet": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.SheetSize"
+                },
+                "square_tube": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.SquareTubeSize"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.PurchaseItem": {
+            "type": "object",
+            "properties": {
+                "designation": {
+                    "description": "standard designation when known",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "PartKind: standard or purchased",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "node_ids": {
+                    "description": "tree nodes of the item",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.PurchaseList": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.PurchaseItem"
+                    }
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.Quote": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "current_revision": {
+                    "description": "number of the latest revision",
+                    "type": "integer"
+                },
+                "customer_details": {
+                    "type": "string"
+                },
+                "customer_name": {
+                    "description": "recipient of the offer as printed in the quote",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.QuoteRevision"
+                    }
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.QuoteDiff": {
+            "type": "object",
+            "properties": {
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.QuoteFieldChange"
+                    }
+                },
+                "from_revision": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.QuoteItemChange"
+                    }
+                },
+                "quote_id": {
+                    "type": "integer"
+                },
+                "to_revision": {
+                    "type": "integer"
+                },
+                "total_delta": {
+                    "description": "change of the total amount with VAT",
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.QuoteFieldChange": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "number"
+                },
+                "to": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.QuoteItem": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "cost": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mass": {
+                    "type": "number"
+                },
+                "material": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "node_id": {
+                    "type": "string"
+                },
+                "number": {
+                    "description": "designation of the drawing",
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "quote_revision_id": {
+                    "type": "integer"
+                },
+                "unit_cost": {
+                    "type": "number"
+                },
+                "unit_price": {
+                    "description": "unit price with margin and discount, without VAT",
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.QuoteItemChange": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "description": "added, removed or changed",
+                    "type": "string"
+                },
+                "from_amount": {
+                    "type": "number"
+                },
+                "from_quantity": {
+                    "type": "integer"
+                },
+                "from_unit_price": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "node_id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "to_amount": {
+                    "type": "number"
+                },
+                "to_quantity": {
+                    "type": "integer"
+                },
+                "to_unit_price": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.QuoteRevision": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "description": "manufacturing cost of all items",
+                    "type": "number"
+                },
+                "created_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "discount_amount": {
+                    "type": "number"
+                },
+                "discount_percent": {
+                    "type": "number"
+                },
+                "gross_amount": {
+                    "description": "price of all items with margin before the discount",
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.QuoteItem"
+                    }
+                },
+                "margin_percent": {
+                    "description": "percentages applied to the cost: price = cost × (1 + margin) × (1 - discount), VAT is added on top",
+                    "type": "number"
+                },
+                "net_amount": {
+                    "description": "price of all items without VAT",
+                    "type": "number"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "price_list_id": {
+                    "description": "price list of the cost estimate the prices are based on",
+                    "type": "integer"
+                },
+                "price_list_version": {
+                    "type": "integer"
+                },
+                "quote_id": {
+                    "type": "integer"
+                },
+                "revision": {
+                    "description": "1 for the first version, incremented by every revision",
+                    "type": "integer"
+                },
+                "total_amount": {
+                    "type": "number"
+                },
+                "valid_days": {
+                    "description": "the quote is valid for the number of days from the revision date, 0 if not limited",
+                    "type": "integer"
+                },
+                "vat_amount": {
+                    "type": "number"
+                },
+                "vat_percent": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.RoundBarSize": {
+            "type": "object",
+            "properties": {
+                "diameter": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.Routing": {
+            "type": "object",
+            "properties": {
+                "labour_hours": {
+                    "type": "number"
+                },
+                "machine_hours": {
+                    "type": "number"
+                },
+                "operations": {
+                    "description": "hours per operation over the whole product",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.RoutingOperation"
+                    }
+                },
+                "root": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.RoutingNode"
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.RoutingNode": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.RoutingNode"
+                    }
+                },
+                "labour_hours": {
+                    "type": "number"
+                },
+                "machine_hours": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "node_id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.RoutingOperation"
+                    }
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.RoutingOperation": {
+            "type": "object",
+            "properties": {
+                "labour_hours": {
+                    "type": "number"
+                },
+                "machine_hours": {
+                    "type": "number"
+                },
+                "operation": {
+                    "type": "string"
+                },
+                "rule_id": {
+                    "type": "integer"
+                },
+                "rule_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.SheetLayout": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "length": {
+                    "type": "number"
+                },
+                "placements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.SheetPlacement"
+                    }
+                },
+                "utilisation_percent": {
+                    "type": "number"
+                },
+                "width": {
+                    "description": "sheet size in mm",
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.SheetPlacement": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "number"
+                },
+                "node_id": {
+                    "type": "string"
+                },
+                "rotated": {
+                    "description": "the part is turned by 90 degrees",
+                    "type": "boolean"
+                },
+                "width": {
+                    "type": "number"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "y": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.SheetSize": {
+            "type": "object",
+            "properties": {
+                "length": {
+                    "type": "number"
+                },
+                "thickness": {
+                    "type": "number"
+                },
+                "width": {
+                    "description": "0 when not given",
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.SpecificationRow": {
+            "type": "object",
+            "properties": {
+                "assortment": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Assortment"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_id": {
+                    "type": "string"
+                },
+                "material": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "part_kind": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.PartKind"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "sb_number": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "string"
+                },
+                "size_h": {
+                    "type": "string"
+                },
+                "size_v": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.SquareTubeSize": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "number"
+                },
+                "wall": {
+                    "type": "number"
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.Status": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4,
+                5,
+                6,
+                7,
+                8,
+                9,
+                10,
+                11,
+                12,
+                13,
+                15
+            ],
+            "x-enum-varnames": [
+                "Status_STATUS_CREATED",
+                "Status_STATUS_READY_FOR_PROCESSING",
+                "Status_STATUS_IMAGES_PENDING",
+                "Status_STATUS_IMAGES_PROCESSING",
+                "Status_STATUS_IMAGES_COMPLETED",
+                "Status_STATUS_IMAGES_FAILED_QUOTA",
+                "Status_STATUS_IMAGES_FAILED_PROCESSING",
+                "Status_STATUS_IMAGES_FAILED_TIMEOUT",
+                "Status_STATUS_RECOGNITION_PENDING",
+                "Status_STATUS_RECOGNITION_PROCESSING",
+                "Status_STATUS_RECOGNITION_COMPLETED",
+                "Status_STATUS_RECOGNITION_FAILED_QUOTA",
+                "Status_STATUS_RECOGNITION_FAILED_PROCESSING",
+                "Status_STATUS_RECOGNITION_FAILED_TIMEOUT",
+                "Status_STATUS_PROCESSING_COMPLETED"
+            ]
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.TaskAccuracy": {
+            "type": "object",
+            "properties": {
+                "approved_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.FieldAccuracy"
+                    }
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.TaskMaterials": {
+            "type": "object",
+            "properties": {
+                "materials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.MaterialMatch"
+                    }
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "unmatched": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.TrainingDataConsent": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "TrainingDataConsent_TRAINING_DATA_CONSENT_UNDECIDED",
+                "TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_IN",
+                "TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_OUT"
+            ]
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.TreeChange": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "type": "string"
+                },
+                "before": {
+                    "description": "JSON encoded values of the field before and after the change, empty when absent",
+                    "type": "string"
+                },
+                "field": {
+                    "description": "JSON path of the changed field in the node, e.g. count or spec.material; parent_id for moves",
+                    "type": "string"
+                },
+                "kind": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeChangeKind"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "node_id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "previous_node_id": {
+                    "description": "id of the node in the before tree when it differs, for nodes matched by designation or name",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.TreeChangeKind": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4
+            ],
+            "x-enum-varnames": [
+                "TreeChangeKind_TREE_CHANGE_KIND_UNSPECIFIED",
+                "TreeChangeKind_TREE_CHANGE_KIND_ADDED",
+                "TreeChangeKind_TREE_CHANGE_KIND_REMOVED",
+                "TreeChangeKind_TREE_CHANGE_KIND_MOVED",
+                "TreeChangeKind_TREE_CHANGE_KIND_UPDATED"
+            ]
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.TreeDiff": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "integer"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeChange"
                     }
+                },
+                "moved": {
+                    "type": "integer"
+                },
+                "removed": {
+                    "type": "integer"
+                },
+                "updated": {
+                    "type": "integer"
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_bazilio91_sferra-cloud_pkg_proto.Assortment": {
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode": {
             "type": "object",
             "properties": {
-                "chemical_composition": {
-                    "type": "string"
+                "accumulated_count": {
+                    "type": "integer"
                 },
-                "chemical_gost": {
-                    "type": "string"
+                "computed_mass": {
+                    "description": "mass of one unit in kg: computed from the size and material density for parts,\nthe sum of the leaves for assemblies, the recognized mass when it cannot be computed",
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
                 },
                 "field_status": {
+                    "description": "statuses of the node fields set by the validation engine, e.g. count or material",
                     "type": "object",
                     "additionalProperties": {
                         "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.FieldStatus"
                     }
                 },
-                "figure_type": {
-                    "type": "string"
+                "figure": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Figure"
                 },
-                "form_gost": {
+                "id": {
                     "type": "string"
                 },
+                "leaves": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                    }
+                },
+                "mass_status": {
+                    "description": "YELLOW when the recognized mass differs from the computed one by more than the tolerance",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.FieldStatus"
+                        }
+                    ]
+                },
                 "material": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "size": {
-                    "$ref": "#/definitions/types.Any"
+                "number": {
+                    "type": "string"
                 },
-                "sub_type": {
+                "parent_id": {
                     "type": "string"
+                },
+                "spec": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.SpecificationRow"
+                },
+                "total_mass": {
+                    "description": "mass of all units of the node in the product in kg",
+                    "type": "number"
                 }
             }
         },
-        "github_com_bazilio91_sferra-cloud_pkg_proto.Client": {
+        "github_com_bazilio91_sferra-cloud_pkg_proto.TreeRevision": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "inn": {
+                "action": {
+                    "description": "edit that produced the revision, e.g. update_node, patch or revert",
                     "type": "string"
                 },
-                "name": {
+                "author": {
+                    "description": "email of the author at the time of the change",
                     "type": "string"
                 },
-                "ogrn": {
-                    "type": "string"
+                "author_id": {
+                    "type": "integer"
                 },
-                "owner_fio": {
-                    "type": "string"
+                "created_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
                 },
-                "quota": {
+                "diff": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeDiff"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "total_quota": {
+                "reverted_to": {
+                    "description": "revision restored by a revert",
                     "type": "integer"
                 },
-                "updated_at": {
+                "revision": {
+                    "description": "number of the revision within the task, starting from 1; revision 0 is the recognized tree",
                     "type": "integer"
                 },
-                "users": {
+                "task_id": {
+                    "type": "string"
+                },
+                "tree": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.TreeRevisionList": {
+            "type": "object",
+            "properties": {
+                "current_revision": {
+                    "type": "integer"
+                },
+                "revisions": {
+                    "description": "revisions without their trees",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.ClientUser"
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeRevision"
                     }
+                },
+                "task_id": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_bazilio91_sferra-cloud_pkg_proto.ClientUser": {
+        "github_com_bazilio91_sferra-cloud_pkg_proto.ValidationIssue": {
             "type": "object",
             "properties": {
-                "client": {
-                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Client"
-                },
-                "client_id": {
-                    "type": "integer"
+                "code": {
+                    "description": "check code that found the problem",
+                    "type": "string"
                 },
                 "created_at": {
-                    "type": "integer"
+                    "$ref": "#/definitions/timestamppb.Timestamp"
                 },
-                "email": {
+                "field": {
+                    "description": "field status key of the field, e.g. count",
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "password": {
+                "message": {
                     "type": "string"
                 },
-                "role": {
+                "name": {
                     "type": "string"
                 },
-                "updated_at": {
+                "node_id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_proto.ValidationSummary": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "description": "number of problems by check code",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.ValidationIssue"
+                    }
+                },
+                "nodes": {
+                    "description": "number of nodes with at least one problem",
                     "type": "integer"
                 },
-                "username": {
+                "problems": {
+                    "type": "integer"
+                },
+                "task_id": {
                     "type": "string"
                 }
             }
         },
-        "github_com_bazilio91_sferra-cloud_pkg_proto.DataRecognitionTask": {
+        "github_com_bazilio91_sferra-cloud_pkg_proto.WebhookDelivery": {
             "type": "object",
             "properties": {
-                "client": {
-                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Client"
+                "attempts": {
+                    "type": "integer"
+                },
+                "client_id": {
+                    "type": "integer"
                 },
                 "created_at": {
                     "$ref": "#/definitions/timestamppb.Timestamp"
                 },
-                "error": {
+                "delivered_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "endpoint_id": {
                     "type": "string"
                 },
-                "frontend_result": {
-                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                "error": {
+                    "type": "string"
                 },
-                "frontend_result_flat": {
-                    "$ref": "#/definitions/types.JSONValue"
+                "event": {
+                    "type": "string"
                 },
-                "frontend_result_unrecognized": {
-                    "$ref": "#/definitions/types.JSONValue"
+                "event_key": {
+                    "description": "source of the delivery, unique per endpoint",
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "processed_images": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "next_attempt_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
                 },
-                "recognition_result": {
-                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
+                "payload": {
+                    "$ref": "#/definitions/types.JSONValue"
                 },
-                "source_images": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "replay_of": {
+                    "description": "delivery this one replays",
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "integer"
                 },
                 "status": {
-                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Status"
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.WebhookDeliveryStatus"
                 },
-                "status_text": {
+                "task_id": {
                     "type": "string"
                 },
                 "updated_at": {
                     "$ref": "#/definitions/timestamppb.Timestamp"
-                },
-                "worker_id": {
-                    "type": "string"
                 }
             }
         },
-        "github_com_bazilio91_sferra-cloud_pkg_proto.FieldStatus": {
+        "github_com_bazilio91_sferra-cloud_pkg_proto.WebhookDeliveryStatus": {
             "type": "integer",
             "enum": [
                 0,
@@ -665,176 +5209,248 @@ const docTemplate = `{
                 2
             ],
             "x-enum-varnames": [
-                "FieldStatus_FIELD_STATUS_UNSPECIFIED",
-                "FieldStatus_OK",
-                "FieldStatus_YELLOW"
+                "WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING",
+                "WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+                "WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED"
             ]
         },
-        "github_com_bazilio91_sferra-cloud_pkg_proto.Figure": {
+        "github_com_bazilio91_sferra-cloud_pkg_proto.WebhookEndpoint": {
             "type": "object",
             "properties": {
-                "assortment": {
-                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Assortment"
+                "client_id": {
+                    "type": "integer"
                 },
-                "id": {
+                "consecutive_failures": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "disabled_reason": {
                     "type": "string"
                 },
-                "image": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "events": {
+                    "description": "event names to deliver, e.g. task.completed",
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "type": "string"
                     }
                 },
-                "image_id": {
+                "id": {
                     "type": "string"
                 },
-                "mainSize": {
-                    "description": "Types that are valid to be assigned to MainSize:\n\n\t*Figure_MainSizeFloat\n\t*Figure_MainSizeStr"
+                "secret": {
+                    "description": "HMAC-SHA256 key, only returned when the endpoint is created",
+                    "type": "string"
                 },
-                "mass": {
-                    "type": "number"
+                "updated_at": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
                 },
-                "name": {
+                "url": {
                     "type": "string"
-                },
-                "number": {
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_services_usage.Report": {
+            "type": "object",
+            "properties": {
+                "from": {
                     "type": "string"
                 },
-                "parent_id": {
-                    "type": "string"
+                "images_processed": {
+                    "type": "integer"
                 },
-                "size_horizontal": {
-                    "type": "number"
+                "quota_consumed": {
+                    "type": "integer"
                 },
-                "size_vertical": {
-                    "type": "number"
+                "stages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_services_usage.StageStats"
+                    }
+                },
+                "tasks_by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total_tasks": {
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_services_usage.UserUsage"
+                    }
                 }
             }
         },
-        "github_com_bazilio91_sferra-cloud_pkg_proto.SpecificationRow": {
+        "github_com_bazilio91_sferra-cloud_pkg_services_usage.StageStats": {
             "type": "object",
             "properties": {
-                "assortment": {
-                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Assortment"
+                "average_seconds": {
+                    "type": "number"
                 },
                 "count": {
                     "type": "integer"
                 },
-                "id": {
-                    "type": "string"
-                },
-                "image_id": {
+                "status": {
                     "type": "string"
-                },
-                "material": {
+                }
+            }
+        },
+        "github_com_bazilio91_sferra-cloud_pkg_services_usage.UserUsage": {
+            "type": "object",
+            "properties": {
+                "email": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "images_processed": {
+                    "type": "integer"
                 },
-                "number": {
-                    "type": "string"
+                "quota_consumed": {
+                    "type": "integer"
                 },
-                "parent_id": {
-                    "type": "string"
+                "tasks_by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
                 },
-                "position": {
+                "total_tasks": {
                     "type": "integer"
                 },
-                "sb_number": {
-                    "type": "string"
+                "user_id": {
+                    "type": "integer"
                 },
-                "size": {
+                "username": {
                     "type": "string"
+                }
+            }
+        },
+        "pkg_api_handlers.AccountInfoResponse": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.ClientUser"
+                }
+            }
+        },
+        "pkg_api_handlers.AddNodeInput": {
+            "type": "object",
+            "required": [
+                "parent_id"
+            ],
+            "properties": {
+                "index": {
+                    "description": "position among the leaves of the parent, the node is appended when omitted",
+                    "type": "integer"
                 },
-                "size_h": {
-                    "type": "string"
+                "node": {
+                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
                 },
-                "size_v": {
+                "parent_id": {
                     "type": "string"
                 }
             }
         },
-        "github_com_bazilio91_sferra-cloud_pkg_proto.Status": {
-            "type": "integer",
-            "enum": [
-                0,
-                1,
-                2,
-                3,
-                4,
-                5,
-                6,
-                7,
-                8,
-                9,
-                10,
-                11,
-                12,
-                13,
-                15
-            ],
-            "x-enum-varnames": [
-                "Status_STATUS_CREATED",
-                "Status_STATUS_READY_FOR_PROCESSING",
-                "Status_STATUS_IMAGES_PENDING",
-                "Status_STATUS_IMAGES_PROCESSING",
-                "Status_STATUS_IMAGES_COMPLETED",
-                "Status_STATUS_IMAGES_FAILED_QUOTA",
-                "Status_STATUS_IMAGES_FAILED_PROCESSING",
-                "Status_STATUS_IMAGES_FAILED_TIMEOUT",
-                "Status_STATUS_RECOGNITION_PENDING",
-                "Status_STATUS_RECOGNITION_PROCESSING",
-                "Status_STATUS_RECOGNITION_COMPLETED",
-                "Status_STATUS_RECOGNITION_FAILED_QUOTA",
-                "Status_STATUS_RECOGNITION_FAILED_PROCESSING",
-                "Status_STATUS_RECOGNITION_FAILED_TIMEOUT",
-                "Status_STATUS_PROCESSING_COMPLETED"
-            ]
-        },
-        "github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode": {
+        "pkg_api_handlers.CreateOrderInput": {
             "type": "object",
+            "required": [
+                "quota"
+            ],
             "properties": {
-                "accumulated_count": {
-                    "type": "integer"
+                "provider": {
+                    "type": "string"
                 },
-                "count": {
+                "quota": {
                     "type": "integer"
+                }
+            }
+        },
+        "pkg_api_handlers.CreateQuoteInput": {
+            "type": "object",
+            "required": [
+                "task_id"
+            ],
+            "properties": {
+                "customer_details": {
+                    "type": "string"
                 },
-                "figure": {
-                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Figure"
+                "customer_name": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "id": {
-                    "type": "string"
+                "discount_percent": {
+                    "type": "number"
                 },
-                "leaves": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.TreeNode"
-                    }
+                "margin_percent": {
+                    "type": "number"
                 },
-                "material": {
+                "notes": {
                     "type": "string"
                 },
-                "name": {
+                "task_id": {
                     "type": "string"
                 },
-                "number": {
-                    "type": "string"
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "parent_id": {
+                "valid_days": {
+                    "type": "integer"
+                },
+                "vat_percent": {
+                    "type": "number"
+                }
+            }
+        },
+        "pkg_api_handlers.CreateQuoteRevisionInput": {
+            "type": "object",
+            "properties": {
+                "discount_percent": {
+                    "type": "number"
+                },
+                "margin_percent": {
+                    "type": "number"
+                },
+                "notes": {
                     "type": "string"
                 },
-                "spec": {
-                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.SpecificationRow"
+                "refresh": {
+                    "description": "estimate the costs anew instead of using the stored cost estimate",
+                    "type": "boolean"
+                },
+                "valid_days": {
+                    "type": "integer"
+                },
+                "vat_percent": {
+                    "type": "number"
                 }
             }
         },
-        "pkg_api_handlers.AccountInfoResponse": {
+        "pkg_api_handlers.CreateWebhookEndpointInput": {
             "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
             "properties": {
-                "user": {
-                    "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.ClientUser"
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
@@ -881,6 +5497,69 @@ const docTemplate = `{
                 }
             }
         },
+        "pkg_api_handlers.MoveNodeInput": {
+            "type": "object",
+            "required": [
+                "parent_id"
+            ],
+            "properties": {
+                "index": {
+                    "description": "position among the leaves of the parent, the node is appended when omitted",
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pkg_api_handlers.NotificationPreferencesInput": {
+            "type": "object",
+            "required": [
+                "language"
+            ],
+            "properties": {
+                "language": {
+                    "type": "string",
+                    "enum": [
+                        "ru",
+                        "en"
+                    ]
+                },
+                "quota_low": {
+                    "type": "boolean"
+                },
+                "quota_threshold": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "task_completed": {
+                    "type": "boolean"
+                },
+                "task_failed": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pkg_api_handlers.OrderListResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.Order"
+                    }
+                },
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
         "pkg_api_handlers.RegisterInput": {
             "type": "object",
             "required": [
@@ -917,32 +5596,82 @@ const docTemplate = `{
                 }
             }
         },
-        "timestamppb.Timestamp": {
+        "pkg_api_handlers.TrainingDataConsentInput": {
             "type": "object",
+            "required": [
+                "consent"
+            ],
             "properties": {
-                "nanos": {
-                    "description": "Non-negative fractions of a second at nanosecond resolution. Negative\nsecond values with fractions must still have non-negative nanos values\nthat count forward in time. Must be from 0 to 999,999,999\ninclusive.",
-                    "type": "integer"
-                },
-                "seconds": {
-                    "description": "Represents seconds of UTC time since Unix epoch\n1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to\n9999-12-31T23:59:59Z inclusive.",
-                    "type": "integer"
+                "consent": {
+                    "type": "string",
+                    "enum": [
+                        "opt_in",
+                        "opt_out",
+                        "undecided"
+                    ]
                 }
             }
         },
-        "types.Any": {
+        "pkg_api_handlers.TrainingDataConsentResponse": {
             "type": "object",
             "properties": {
-                "type_url": {
-                    "description": "A URL/resource name that uniquely identifies the type of the serialized\nprotocol buffer message. This string must contain at least\none \"/\" character. The last segment of the URL's path must represent\nthe fully qualified name of the type (as in\n` + "`" + `path/google.protobuf.Duration` + "`" + `). The name should be in a canonical form\n(e.g., leading \".\" is not accepted).\n\nIn practice, teams usually precompile into the binary all types that they\nexpect it to use in the context of Any. However, for URLs which use the\nscheme ` + "`" + `http` + "`" + `, ` + "`" + `https` + "`" + `, or no scheme, one can optionally set up a type\nserver that maps type URLs to message definitions as follows:\n\n  - If no scheme is provided, ` + "`" + `https` + "`" + ` is assumed.\n  - An HTTP GET on the URL must yield a [google.protobuf.Type][]\n    value in binary format, or produce an error.\n  - Applications are allowed to cache lookup results based on the\n    URL, or have them precompiled into a binary to avoid any\n    lookup. Therefore, binary compatibility needs to be preserved\n    on changes to types. (Use versioned type names to manage\n    breaking changes.)\n\nNote: this functionality is not currently available in the official\nprotobuf release, and it is not used for type URLs beginning with\ntype.googleapis.com.\n\nSchemes other than ` + "`" + `http` + "`" + `, ` + "`" + `https` + "`" + ` (or the empty scheme) might be\nused with implementation specific semantics.",
+                "consent": {
+                    "description": "opt_in, opt_out or undecided",
                     "type": "string"
+                }
+            }
+        },
+        "pkg_api_handlers.UpdateWebhookEndpointInput": {
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
                 },
-                "value": {
-                    "description": "Must be a valid serialized protocol buffer of the above specified type.",
+                "events": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "pkg_api_handlers.WebhookDeliveryListResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_bazilio91_sferra-cloud_pkg_proto.WebhookDelivery"
                     }
+                },
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "timestamppb.Timestamp": {
+            "type": "object",
+            "properties": {
+                "nanos": {
+                    "description": "Non-negative fractions of a second at nanosecond resolution. Negative\nsecond values with fractions must still have non-negative nanos values\nthat count forward in time. Must be from 0 to 999,999,999\ninclusive.",
+                    "type": "integer"
+                },
+                "seconds": {
+                    "description": "Represents seconds of UTC time since Unix epoch\n1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to\n9999-12-31T23:59:59Z inclusive.",
+                    "type": "integer"
                 }
             }
         },
@@ -1,13 +1,20 @@
 package handlers
 
 import (
+	"errors"
 	"github.com/bazilio91/sferra-cloud/pkg/proto"
 	"net/http"
+	"time"
 
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
 	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/services/usage"
 	"github.com/gin-gonic/gin"
 )
 
+// DefaultUsagePeriod is the report range when no dates are given
+const DefaultUsagePeriod = 30 * 24 * time.Hour
+
 // @Summary GetTaskImage Account Info
 // @Description GetTaskImage information about the current user
 // @Tags account
@@ -44,3 +51,73 @@ func GetAccountInfo(c *gin.Context) {
 type AccountInfoResponse struct {
 	User proto.ClientUser `json:"user"`
 }
+
+// GetAccountUsage godoc
+// @Summary Get Account Usage
+// @Description Usage of the current client over a date range: tasks by status, images processed, average time per stage, quota consumed and a breakdown per user who created the tasks. Dates are YYYY-MM-DD (to is inclusive) or RFC3339; the default range is the last 30 days.
+// @Tags account
+// @Produce json
+// @Param from query string false "Range start"
+// @Param to query string false "Range end"
+// @Success 200 {object} usage.Report
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/account/usage [get]
+func GetAccountUsage(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	from, to, err := parseUsageRange(c.Query("from"), c.Query("to"), time.Now())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	report, err := usage.NewService(db.DB).Report(c, userClaims.ClientID, from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// parseUsageRange returns the half-open range [from, to) requested by the client
+func parseUsageRange(fromParam, toParam string, now time.Time) (time.Time, time.Time, error) {
+	to := now
+	if toParam != "" {
+		t, dateOnly, err := parseUsageDate(toParam)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid to date: use YYYY-MM-DD or RFC3339")
+		}
+		if dateOnly {
+			t = t.AddDate(0, 0, 1)
+		}
+		to = t
+	}
+
+	from := to.Add(-DefaultUsagePeriod)
+	if fromParam != "" {
+		t, _, err := parseUsageDate(fromParam)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid from date: use YYYY-MM-DD or RFC3339")
+		}
+		from = t
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, errors.New("from must be before to")
+	}
+
+	return from, to, nil
+}
+
+func parseUsageDate(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	return t, false, err
+}
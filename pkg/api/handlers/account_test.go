@@ -1,13 +1,17 @@
 package handlers_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"github.com/bazilio91/sferra-cloud/pkg/proto"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"github.com/bazilio91/sferra-cloud/pkg/api/handlers"
+	"github.com/bazilio91/sferra-cloud/pkg/services/usage"
 	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -71,4 +75,161 @@ var _ = Describe("Account Handlers", func() {
 			})
 		})
 	})
+
+	Describe("GetAccountUsage", func() {
+		var token string
+
+		createTask := func(createdBy uint64, status proto.Status, processedImages []string, createdAt time.Time) *proto.DataRecognitionTaskORM {
+			task := &proto.DataRecognitionTaskORM{
+				Id:              uuid.New().String(),
+				ClientId:        &clientModel.Id,
+				CreatedById:     &createdBy,
+				Status:          int32(status),
+				SourceImages:    processedImages,
+				ProcessedImages: processedImages,
+				CreatedAt:       &createdAt,
+				UpdatedAt:       &createdAt,
+			}
+			Expect(DB.Create(task).Error).NotTo(HaveOccurred())
+			return task
+		}
+
+		debit := func(task *proto.DataRecognitionTaskORM, amount int64, reason proto.QuotaTransactionReason) {
+			Expect(DB.Create(&proto.QuotaTransactionORM{
+				ClientId:  clientModel.Id,
+				TaskId:    task.Id,
+				Amount:    -amount,
+				Reason:    int32(reason),
+				CreatedAt: task.CreatedAt,
+			}).Error).NotTo(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			var err error
+			token, err = jwtManager.GenerateToken(userModel.Id, *userModel.ClientId)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should aggregate usage per status, stage and user", func() {
+			second, err := testutils.CreateTestUser(DB, "second@example.com", "password123", clientModel.Id)
+			Expect(err).NotTo(HaveOccurred())
+
+			now := time.Now()
+			completed := createTask(userModel.Id, proto.Status_STATUS_PROCESSING_COMPLETED, []string{"a.jpg", "b.jpg"}, now.Add(-time.Hour))
+			failed := createTask(second.Id, proto.Status_STATUS_RECOGNITION_FAILED_PROCESSING, []string{"c.jpg"}, now.Add(-time.Hour))
+			old := createTask(userModel.Id, proto.Status_STATUS_PROCESSING_COMPLETED, []string{"d.jpg"}, now.AddDate(0, 0, -60))
+
+			debit(completed, 2, proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_IMAGE_PROCESSING)
+			debit(completed, 1, proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_RECOGNITION)
+			debit(failed, 1, proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_IMAGE_PROCESSING)
+			debit(old, 1, proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_IMAGE_PROCESSING)
+
+			// the completed task spent 10 seconds processing images
+			started := now.Add(-2 * time.Hour)
+			finished := started.Add(10 * time.Second)
+			Expect(DB.Create(&[]proto.TaskStatusEventORM{
+				{TaskId: completed.Id, ClientId: clientModel.Id, Status: int32(proto.Status_STATUS_IMAGES_PROCESSING), CreatedAt: &started},
+				{TaskId: completed.Id, ClientId: clientModel.Id, Status: int32(proto.Status_STATUS_IMAGES_COMPLETED), CreatedAt: &finished},
+			}).Error).NotTo(HaveOccurred())
+
+			req, _ := http.NewRequest("GET", "/api/v1/account/usage", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+
+			var report usage.Report
+			Expect(json.Unmarshal(resp.Body.Bytes(), &report)).To(Succeed())
+			Expect(report.TotalTasks).To(Equal(int64(2)))
+			Expect(report.TasksByStatus).To(Equal(map[string]int64{
+				"STATUS_PROCESSING_COMPLETED":          1,
+				"STATUS_RECOGNITION_FAILED_PROCESSING": 1,
+			}))
+			Expect(report.ImagesProcessed).To(Equal(int64(3)))
+			Expect(report.QuotaConsumed).To(Equal(int64(4)))
+			Expect(report.Stages).To(ContainElement(usage.StageStats{
+				Status:         "STATUS_IMAGES_PROCESSING",
+				Count:          1,
+				AverageSeconds: 10,
+			}))
+
+			Expect(report.Users).To(HaveLen(2))
+			Expect(report.Users[0].Email).To(Equal("test@example.com"))
+			Expect(report.Users[0].TotalTasks).To(Equal(int64(1)))
+			Expect(report.Users[0].QuotaConsumed).To(Equal(int64(3)))
+			Expect(report.Users[1].Email).To(Equal("second@example.com"))
+			Expect(report.Users[1].ImagesProcessed).To(Equal(int64(1)))
+			Expect(report.Users[1].QuotaConsumed).To(Equal(int64(1)))
+		})
+
+		It("should reject an empty date range", func() {
+			req, _ := http.NewRequest("GET", "/api/v1/account/usage?from=2024-02-01&to=2024-01-01", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("NotificationPreferences", func() {
+		var token string
+
+		BeforeEach(func() {
+			var err error
+			token, err = jwtManager.GenerateToken(userModel.Id, *userModel.ClientId)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		getPreferences := func() *proto.NotificationPreferences {
+			req, _ := http.NewRequest("GET", "/api/v1/account/notifications", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+
+			prefs := &proto.NotificationPreferences{}
+			Expect(json.Unmarshal(resp.Body.Bytes(), prefs)).To(Succeed())
+			return prefs
+		}
+
+		It("should return defaults for users without preferences", func() {
+			prefs := getPreferences()
+			Expect(prefs.Language).To(Equal("ru"))
+			Expect(prefs.TaskCompleted).To(BeTrue())
+			Expect(prefs.TaskFailed).To(BeTrue())
+			Expect(prefs.QuotaLow).To(BeTrue())
+		})
+
+		It("should update preferences", func() {
+			body, _ := json.Marshal(handlers.NotificationPreferencesInput{
+				Language:       "en",
+				TaskFailed:     true,
+				QuotaThreshold: 5,
+			})
+			req, _ := http.NewRequest("PUT", "/api/v1/account/notifications", bytes.NewBuffer(body))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+
+			prefs := getPreferences()
+			Expect(prefs.Language).To(Equal("en"))
+			Expect(prefs.TaskCompleted).To(BeFalse())
+			Expect(prefs.TaskFailed).To(BeTrue())
+			Expect(prefs.QuotaThreshold).To(Equal(int64(5)))
+		})
+
+		It("should reject unsupported languages", func() {
+			req, _ := http.NewRequest("PUT", "/api/v1/account/notifications", bytes.NewBufferString(`{"language": "de"}`))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
 })
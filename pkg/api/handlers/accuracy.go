@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/accuracy"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+type AccuracyHandler struct {
+	accuracy *accuracy.Service
+}
+
+func NewAccuracyHandler(accuracy *accuracy.Service) *AccuracyHandler {
+	return &AccuracyHandler{accuracy: accuracy}
+}
+
+// ApproveTask godoc
+// @Summary Approve Task Result
+// @Description Approve the current tree of a completed task as final. The recognition result is compared with it to record how many recognized designations, names, counts, materials, assortment sizes and nodes the users corrected. Approving again replaces the recorded metrics.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} proto.TaskAccuracy
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/approve [post]
+func (h *AccuracyHandler) ApproveTask(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var (
+		result *proto.TaskAccuracy
+		err    error
+	)
+	result, err = h.accuracy.Approve(c, userClaims.ClientID, userClaims.UserID, c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrTaskNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		case errors.Is(err, types.ErrTaskNotCompleted), errors.Is(err, types.ErrNoRecognizedTree):
+			c.JSON(http.StatusConflict, ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
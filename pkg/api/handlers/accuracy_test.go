@@ -0,0 +1,90 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Accuracy Handlers", func() {
+	var account testAccount
+
+	approve := func(taskID string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, http.MethodPost, "/recognition_tasks/"+taskID+"/approve", nil)
+	}
+
+	createTask := func(status proto.Status, frontend *proto.TreeNode) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id: "root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Number: "СФ-01.00.001", Name: "Пластина", Count: 4},
+				{Id: "bolt", Number: "СФ-01.00.002", Name: "Болт", Count: 2},
+			},
+		}, withFrontendResult(frontend), func(task *proto.DataRecognitionTaskORM) {
+			task.WorkerId = "worker-1"
+			task.ModelVersion = "v2"
+		})
+	}
+
+	BeforeEach(func() {
+		account = setupTestAccount("approver@example.com")
+	})
+
+	It("should record the corrected fields on approval", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED, &proto.TreeNode{
+			Id: "root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Number: "СФ-01.00.001", Name: "Пластина", Count: 6},
+				{Id: "bolt", Number: "СФ-01.00.002", Name: "Болт", Count: 2},
+			},
+		})
+
+		resp := approve(taskID)
+		Expect(resp.Code).To(Equal(http.StatusOK), resp.Body.String())
+		var result proto.TaskAccuracy
+		Expect(json.Unmarshal(resp.Body.Bytes(), &result)).To(Succeed())
+		Expect(result.TaskId).To(Equal(taskID))
+
+		fields := map[string]*proto.FieldAccuracy{}
+		for _, field := range result.Fields {
+			fields[field.Field] = field
+		}
+		Expect(fields["count"].Total).To(Equal(int64(2)))
+		Expect(fields["count"].Corrected).To(Equal(int64(1)))
+		Expect(fields["count"].Accuracy).To(BeNumerically("~", 0.5))
+		Expect(fields["designation"].Corrected).To(Equal(int64(0)))
+
+		var task proto.DataRecognitionTaskORM
+		Expect(DB.First(&task, "id = ?", taskID).Error).NotTo(HaveOccurred())
+		Expect(task.ApprovedAt).NotTo(BeNil())
+		Expect(task.ApprovedById).NotTo(BeNil())
+
+		var metrics []proto.RecognitionMetricORM
+		Expect(DB.Find(&metrics, "task_id = ?", taskID).Error).NotTo(HaveOccurred())
+		Expect(metrics).To(HaveLen(len(result.Fields)))
+		Expect(metrics[0].ModelVersion).To(Equal("v2"))
+		Expect(metrics[0].ClientId).To(Equal(account.client.Id))
+
+		// approving again replaces the metrics
+		Expect(approve(taskID).Code).To(Equal(http.StatusOK))
+		var count int64
+		Expect(DB.Model(&proto.RecognitionMetricORM{}).Where("task_id = ?", taskID).Count(&count).Error).NotTo(HaveOccurred())
+		Expect(count).To(Equal(int64(len(result.Fields))))
+	})
+
+	It("should not approve a task still being recognized", func() {
+		taskID := createTask(proto.Status_STATUS_RECOGNITION_PROCESSING, nil)
+		Expect(approve(taskID).Code).To(Equal(http.StatusConflict))
+
+		var task proto.DataRecognitionTaskORM
+		Expect(DB.First(&task, "id = ?", taskID).Error).NotTo(HaveOccurred())
+		Expect(task.ApprovedAt).To(BeNil())
+		var count int64
+		Expect(DB.Model(&proto.RecognitionMetricORM{}).Where("task_id = ?", taskID).Count(&count).Error).NotTo(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+})
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/assortment"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+type AssortmentHandler struct {
+	assortments *assortment.Service
+}
+
+func NewAssortmentHandler(assortments *assortment.Service) *AssortmentHandler {
+	return &AssortmentHandler{assortments: assortments}
+}
+
+// GetAssortmentValidation godoc
+// @Summary Get Assortment Validation
+// @Description Recognized assortments of a completed task validated against the reference database of assortment standards. Sizes missing from the series of their standard are invalid and come with the closest sizes of the series; standards missing from the database are listed as unknown.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} proto.AssortmentValidation
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/assortment_check [get]
+func (h *AssortmentHandler) GetAssortmentValidation(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var (
+		validation *proto.AssortmentValidation
+		err        error
+	)
+	validation, err = h.assortments.TaskValidation(c, userClaims.ClientID, c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrTaskNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		case errors.Is(err, types.ErrTaskNotCompleted), errors.Is(err, types.ErrNoRecognizedTree):
+			c.JSON(http.StatusConflict, ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, validation)
+}
@@ -0,0 +1,77 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Assortment Validation Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("assortment@example.com")
+
+		Expect(DB.Create(&proto.AssortmentStandardORM{
+			Designation: "ГОСТ 8732-78",
+			Family:      int32(proto.ProfileFamily_PROFILE_FAMILY_PIPE),
+			Sizes:       []*proto.AssortmentStandardSizeORM{{Size: "57×3"}, {Size: "57×3.5"}, {Size: "57×4"}},
+		}).Error).NotTo(HaveOccurred())
+	})
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{Id: "valid", Material: "Труба 57х3,5 ГОСТ 8732-78/20"},
+				{Id: "invalid", Material: "Труба 57х3,6 ГОСТ 8732-78/20"},
+				{Id: "unknown", Material: "Труба 57х3 ГОСТ 10704-91/20"},
+			},
+		})
+	}
+
+	request := func(path string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, http.MethodGet, path, nil)
+	}
+
+	It("should validate the task assortments against the standards", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		resp := request("/recognition_tasks/" + taskID + "/assortment_check")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		validation := &proto.AssortmentValidation{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), validation)).To(Succeed())
+		Expect(validation.TaskId).To(Equal(taskID))
+		Expect(validation.Checks).To(HaveLen(2))
+		Expect(validation.Checks[0].SizeValid).To(BeTrue())
+		Expect(validation.Checks[1].NodeId).To(Equal("invalid"))
+		Expect(validation.Checks[1].Suggestions).To(Equal([]string{"57×3.5", "57×4", "57×3"}))
+		Expect(validation.Invalid).To(Equal(int32(1)))
+		Expect(validation.UnknownStandards).To(Equal([]string{"ГОСТ 10704-91"}))
+	})
+
+	It("should not check the assortments of bought rows", func() {
+		taskID := createTestTask(account.client.Id, proto.Status_STATUS_PROCESSING_COMPLETED, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{
+					Id: "bought", Material: "Труба 57х3,6 ГОСТ 8732-78/20",
+					Spec: &proto.SpecificationRow{PartKind: proto.PartKind_PART_KIND_PURCHASED},
+				},
+			},
+		})
+
+		resp := request("/recognition_tasks/" + taskID + "/assortment_check")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		validation := &proto.AssortmentValidation{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), validation)).To(Succeed())
+		Expect(validation.Checks).To(BeEmpty())
+		Expect(validation.Invalid).To(BeZero())
+	})
+})
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/costing"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+type CostEstimateHandler struct {
+	costing *costing.Service
+}
+
+func NewCostEstimateHandler(costing *costing.Service) *CostEstimateHandler {
+	return &CostEstimateHandler{costing: costing}
+}
+
+func costingErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, types.ErrTaskNotFound), errors.Is(err, costing.ErrEstimateNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, types.ErrTaskNotCompleted), errors.Is(err, types.ErrNoRecognizedTree),
+		errors.Is(err, costing.ErrNoPriceList):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func renderCostEstimate(c *gin.Context, status int, estimate *proto.CostEstimateORM) {
+	response, err := estimate.ToPB(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(status, &response)
+}
+
+// CreateCostEstimate godoc
+// @Summary Estimate Task Cost
+// @Description Compute the manufacturing cost of a completed task with the client's price list in effect and the hourly rates of its routed operations. The estimate records the price list version and replaces the previous one of the task.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} proto.CostEstimate
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/cost_estimate [post]
+func (h *CostEstimateHandler) CreateCostEstimate(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	estimate, err := h.costing.EstimateTask(c, userClaims.ClientID, c.Param("id"))
+	if err != nil {
+		c.JSON(costingErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	renderCostEstimate(c, http.StatusOK, estimate)
+}
+
+// GetCostEstimate godoc
+// @Summary Get Task Cost Estimate
+// @Description Get the latest cost estimate of a task with the breakdown per node
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} proto.CostEstimate
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/cost_estimate [get]
+func (h *CostEstimateHandler) GetCostEstimate(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	estimate, err := h.costing.GetEstimate(c, userClaims.ClientID, c.Param("id"))
+	if err != nil {
+		c.JSON(costingErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	renderCostEstimate(c, http.StatusOK, estimate)
+}
@@ -0,0 +1,143 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cost Estimate Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("estimator@example.com")
+	})
+
+	createPriceList := func() {
+		Expect(DB.Create(&proto.PriceListORM{
+			Name:                  "Base",
+			Currency:              "RUB",
+			IsDefault:             true,
+			Version:               1,
+			ProcessingCostPerPart: 100,
+			Materials: []*proto.MaterialPriceORM{
+				{Material: "Ст3", Price: 80},
+			},
+		}).Error).NotTo(HaveOccurred())
+	}
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Material: "Лист 10 Ст3", Count: 2, AccumulatedCount: 2, Figure: &proto.Figure{Mass: 1.5}},
+			},
+		})
+	}
+
+	request := func(method, taskID string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, method, "/recognition_tasks/"+taskID+"/cost_estimate", nil)
+	}
+
+	It("should estimate and store the cost of a completed task", func() {
+		createPriceList()
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		Expect(request(http.MethodGet, taskID).Code).To(Equal(http.StatusNotFound))
+
+		resp := request(http.MethodPost, taskID)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		estimate := &proto.CostEstimate{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), estimate)).To(Succeed())
+		Expect(estimate.TaskId).To(Equal(taskID))
+		Expect(estimate.Currency).To(Equal("RUB"))
+		Expect(estimate.MaterialCost).To(Equal(240.0))
+		Expect(estimate.ProcessingCost).To(Equal(200.0))
+		Expect(estimate.TotalCost).To(Equal(440.0))
+		Expect(estimate.Breakdown).NotTo(BeNil())
+		Expect(estimate.Breakdown.Children).To(HaveLen(1))
+		Expect(estimate.PriceListVersion).To(Equal(int32(1)))
+		Expect(estimate.PriceList).NotTo(BeNil())
+		Expect(estimate.PriceList.Materials).To(HaveLen(1))
+
+		// recomputing replaces the stored estimate
+		Expect(request(http.MethodPost, taskID).Code).To(Equal(http.StatusOK))
+		var count int64
+		Expect(DB.Model(&proto.CostEstimateORM{}).Where("task_id = ?", taskID).Count(&count).Error).NotTo(HaveOccurred())
+		Expect(count).To(Equal(int64(1)))
+
+		resp = request(http.MethodGet, taskID)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		stored := &proto.CostEstimate{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), stored)).To(Succeed())
+		Expect(stored.TotalCost).To(Equal(440.0))
+	})
+
+	It("should only estimate completed tasks of the client", func() {
+		createPriceList()
+		other, err := testutils.CreateTestClient(DB, "Other Client", 10)
+		Expect(err).NotTo(HaveOccurred())
+		foreign := createTestTask(other.Id, proto.Status_STATUS_PROCESSING_COMPLETED, proto.TreeNode{Id: "root"})
+
+		Expect(request(http.MethodPost, "not-a-uuid").Code).To(Equal(http.StatusNotFound))
+		Expect(request(http.MethodPost, uuid.New().String()).Code).To(Equal(http.StatusNotFound))
+		Expect(request(http.MethodPost, foreign).Code).To(Equal(http.StatusNotFound))
+		Expect(request(http.MethodPost, createTask(proto.Status_STATUS_RECOGNITION_FAILED_PROCESSING)).Code).To(Equal(http.StatusConflict))
+
+		// nothing is stored for rejected tasks
+		var count int64
+		Expect(DB.Model(&proto.CostEstimateORM{}).Count(&count).Error).NotTo(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+
+	It("should prefer the client's price list in effect", func() {
+		createPriceList()
+		lastMonth := time.Now().AddDate(0, -1, 0)
+		yesterday := time.Now().AddDate(0, 0, -1)
+		for _, list := range []*proto.PriceListORM{
+			{
+				Name: "Expired", Currency: "USD", ClientId: &account.client.Id, Version: 1,
+				ValidFrom: &lastMonth, ValidTo: &yesterday,
+				Materials: []*proto.MaterialPriceORM{{Material: "Ст3", Price: 1}},
+			},
+			{
+				Name: "Current", Currency: "EUR", ClientId: &account.client.Id, Version: 3,
+				ValidFrom:  &yesterday,
+				Materials:  []*proto.MaterialPriceORM{{Material: "Ст3", Price: 2}},
+				Operations: []*proto.OperationRateORM{{Operation: "cutting", LabourRate: 100}},
+			},
+		} {
+			Expect(DB.Create(list).Error).NotTo(HaveOccurred())
+		}
+		Expect(DB.Create(&proto.OperationRuleORM{
+			Name: "Резка", Operation: "cutting", Enabled: true, LabourHoursPerUnit: 0.5,
+		}).Error).NotTo(HaveOccurred())
+
+		resp := request(http.MethodPost, createTask(proto.Status_STATUS_PROCESSING_COMPLETED))
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		estimate := &proto.CostEstimate{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), estimate)).To(Succeed())
+		Expect(estimate.Currency).To(Equal("EUR"))
+		Expect(estimate.PriceListVersion).To(Equal(int32(3)))
+		Expect(estimate.PriceList.Name).To(Equal("Current"))
+		Expect(estimate.MaterialCost).To(Equal(6.0))
+		// 2 parts × 0.5 h of cutting at 100 per hour
+		Expect(estimate.ProcessingCost).To(Equal(100.0))
+	})
+
+	It("should require a default price list", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		Expect(request(http.MethodPost, taskID).Code).To(Equal(http.StatusConflict))
+	})
+})
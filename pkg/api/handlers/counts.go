@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/counts"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+type PartTotalsHandler struct {
+	counts *counts.Service
+}
+
+func NewPartTotalsHandler(counts *counts.Service) *PartTotalsHandler {
+	return &PartTotalsHandler{counts: counts}
+}
+
+// GetPartTotals godoc
+// @Summary Get Task Part Totals
+// @Description Quantities of the parts and assemblies of a completed task in the whole product, summed over the specification rows naming the same designation. The accumulated counts are recomputed by the server from the counts on every recognition result and user edit.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} proto.PartTotals
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/part_totals [get]
+func (h *PartTotalsHandler) GetPartTotals(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var (
+		totals *proto.PartTotals
+		err    error
+	)
+	totals, err = h.counts.TaskPartTotals(c, userClaims.ClientID, c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrTaskNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		case errors.Is(err, types.ErrTaskNotCompleted), errors.Is(err, types.ErrNoRecognizedTree):
+			c.JSON(http.StatusConflict, ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, totals)
+}
@@ -0,0 +1,89 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Part Totals Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("counts@example.com")
+	})
+
+	tree := func(count int32) proto.TreeNode {
+		return proto.TreeNode{
+			Id:    "root",
+			Count: 1,
+			Leaves: []*proto.TreeNode{
+				{
+					Id: "frame", Number: "СФ-01.00.000", Count: count, AccumulatedCount: 1, Spec: &proto.SpecificationRow{Count: count},
+					Leaves: []*proto.TreeNode{
+						{Id: "plate", Number: "СФ-01.00.001", Count: 4, AccumulatedCount: 4, Spec: &proto.SpecificationRow{Count: 4}},
+					},
+				},
+				{Id: "spare", Number: "сф–01.00.001", Count: 1, AccumulatedCount: 1, Spec: &proto.SpecificationRow{Count: 1}},
+			},
+		}
+	}
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, tree(1))
+	}
+
+	request := func(method, path string, body []byte) *httptest.ResponseRecorder {
+		return apiRequest(account.token, method, path, body)
+	}
+
+	It("should recompute accumulated counts of user edits and total them by designation", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		recognized, edited := tree(1), tree(3)
+		body, _ := json.Marshal(proto.DataRecognitionTask{
+			Status:            proto.Status_STATUS_PROCESSING_COMPLETED,
+			RecognitionResult: &recognized,
+			FrontendResult:    &edited,
+		})
+		resp := request(http.MethodPut, "/recognition_tasks/"+taskID, body)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		updated := &proto.DataRecognitionTask{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), updated)).To(Succeed())
+		Expect(updated.FrontendResult.Leaves[0].AccumulatedCount).To(Equal(int32(3)))
+		Expect(updated.FrontendResult.Leaves[0].Leaves[0].AccumulatedCount).To(Equal(int32(12)))
+
+		totals := &proto.PartTotals{}
+		resp = request(http.MethodGet, "/recognition_tasks/"+taskID+"/part_totals", nil)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(json.Unmarshal(resp.Body.Bytes(), totals)).To(Succeed())
+		Expect(totals.TaskId).To(Equal(taskID))
+		Expect(totals.Parts).To(HaveLen(2))
+		Expect(totals.Parts[1].Designation).To(Equal("СФ.01.00.001"))
+		Expect(totals.Parts[1].Quantity).To(Equal(int64(13)))
+		Expect(totals.Parts[1].NodeIds).To(Equal([]string{"plate", "spare"}))
+	})
+
+	It("should reject edits whose accumulated counts overflow and keep the stored result", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		recognized, edited := tree(1), tree(100000)
+		edited.Leaves[0].Leaves[0].Count = 100000
+		body, _ := json.Marshal(proto.DataRecognitionTask{
+			Status:            proto.Status_STATUS_PROCESSING_COMPLETED,
+			RecognitionResult: &recognized,
+			FrontendResult:    &edited,
+		})
+		resp := request(http.MethodPut, "/recognition_tasks/"+taskID, body)
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		Expect(resp.Body.String()).To(ContainSubstring("accumulated count exceeds the limit"))
+
+		var task proto.DataRecognitionTaskORM
+		Expect(DB.First(&task, "id = ?", taskID).Error).NotTo(HaveOccurred())
+		Expect(task.FrontendResult).To(BeNil())
+	})
+})
@@ -0,0 +1,85 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/requirements"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cutting Plan Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("cutting@example.com")
+	})
+
+	request := func(path string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, http.MethodGet, path, nil)
+	}
+
+	It("should plan the cutting of pipes from the client's stock lengths", func() {
+		Expect(DB.Create(&proto.StockLengthORM{ClientId: &account.client.Id, AssortmentType: "Труба", Length: 4000, Kerf: 5}).Error).NotTo(HaveOccurred())
+		Expect(DB.Create(&proto.StockLengthORM{AssortmentType: "Труба", Length: 12000, Kerf: 5}).Error).NotTo(HaveOccurred())
+
+		taskID := createTestTask(account.client.Id, proto.Status_STATUS_PROCESSING_COMPLETED, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{
+					Id: "pipe", Material: "Труба 57х3,5 ГОСТ 8732-78/20 ГОСТ 8731-74", Count: 3, AccumulatedCount: 3,
+					Figure: &proto.Figure{SizeVertical: 57, SizeHorizontal: 1800, Mass: 8.3},
+				},
+			},
+		})
+
+		resp := request("/recognition_tasks/" + taskID + "/cutting_plan")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		plan := &proto.CuttingPlan{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), plan)).To(Succeed())
+		Expect(plan.TaskIds).To(Equal([]string{taskID}))
+		Expect(plan.Groups).To(HaveLen(1))
+		group := plan.Groups[0]
+		Expect(group.Size).To(Equal("57×3.5"))
+		Expect(group.Bars).To(Equal(int32(2)))
+		Expect(group.StockLength).To(Equal(8.0))
+		Expect(group.Patterns).To(HaveLen(2))
+		Expect(group.Patterns[0].Cuts).To(Equal([]float64{1800, 1800}))
+		Expect(group.WastePercent).To(Equal(32.5))
+
+		resp = request("/recognition_tasks/" + taskID + "/material_requirements")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		report := &proto.MaterialRequirements{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), report)).To(Succeed())
+		Expect(report.Rows).To(HaveLen(1))
+		Expect(report.Rows[0].Bars).To(Equal(int32(2)))
+		Expect(report.Rows[0].GrossLength).To(Equal(8.0))
+	})
+
+	It("should report the pieces above the group cap as unplanned", func() {
+		taskID := createTestTask(account.client.Id, proto.Status_STATUS_PROCESSING_COMPLETED, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{
+					Id: "pipe", Material: "Труба 57х3,5 ГОСТ 8732-78/20 ГОСТ 8731-74", Count: 1_000_000, AccumulatedCount: 1_000_000,
+					Figure: &proto.Figure{SizeVertical: 57, SizeHorizontal: 1800, Mass: 8.3},
+				},
+			},
+		})
+
+		resp := request("/recognition_tasks/" + taskID + "/cutting_plan")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		plan := &proto.CuttingPlan{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), plan)).To(Succeed())
+		Expect(plan.Groups).To(HaveLen(1))
+		Expect(plan.Groups[0].Pieces).To(Equal(int64(requirements.MaxGroupPieces)))
+		Expect(plan.Groups[0].Unplanned).To(Equal(int64(1_000_000 - requirements.MaxGroupPieces)))
+	})
+})
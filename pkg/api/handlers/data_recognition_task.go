@@ -11,7 +11,16 @@ import (
 
 	"github.com/bazilio91/sferra-cloud/pkg/auth"
 	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/services/counts"
+	"github.com/bazilio91/sferra-cloud/pkg/services/editor"
+	"github.com/bazilio91/sferra-cloud/pkg/services/quote"
+	"github.com/bazilio91/sferra-cloud/pkg/services/revision"
+	"github.com/bazilio91/sferra-cloud/pkg/services/validation"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
 	"github.com/gin-gonic/gin"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // DataRecognitionTaskListResponse represents a paginated list response
@@ -63,6 +72,8 @@ func CreateDataRecognitionTask(c *gin.Context) {
 
 	// Set client and timestamps
 	ormObj.Client = &clientORM
+	ormObj.Sandbox = clientORM.Sandbox
+	ormObj.CreatedById = ptr.Uint64(userClaims.UserID)
 	ormObj.CreatedAt = ptr.Time(time.Now())
 	ormObj.UpdatedAt = ptr.Time(time.Now())
 
@@ -182,14 +193,77 @@ func UpdateDataRecognitionTask(c *gin.Context) {
 	updateORM.Id = existingORM.Id
 	updateORM.Client = existingORM.Client
 	updateORM.CreatedAt = existingORM.CreatedAt
+	updateORM.Sandbox = existingORM.Sandbox
+	updateORM.CreatedById = existingORM.CreatedById
+	updateORM.ModelVersion = existingORM.ModelVersion
+	updateORM.ApprovedAt = existingORM.ApprovedAt
+	updateORM.ApprovedById = existingORM.ApprovedById
 	updateORM.UpdatedAt = ptr.Time(time.Now())
 
-	// Save updates
-	if err := db.DB.Save(&updateORM).Error; err != nil {
+	// The edited tree must have the structure the tree editor keeps
+	if updateORM.FrontendResult != nil {
+		tree := updateORM.FrontendResult.Data()
+		if err := editor.Check(&tree); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return
+		}
+		editor.LinkParents(&tree)
+		edited := datatypes.NewJSONType(tree)
+		updateORM.FrontendResult = &edited
+	}
+
+	// The server owns the accumulated counts, recompute them from the edited counts
+	if err := counts.AccumulateTask(&updateORM); err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
 	}
 
+	// Validate the edited tree, save the update and record the edited frontend result in the revision
+	// history in one transaction. The task row is locked to number the revision after concurrent edits.
+	var saveErr error
+	err = db.DB.Transaction(func(tx *gorm.DB) error {
+		var locked proto.DataRecognitionTaskORM
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&locked, "id = ?", updateORM.Id).Error; err != nil {
+			return err
+		}
+
+		// Validation sets the field statuses of the edited tree, keep the flat result in sync with it
+		if err := validation.NewService(tx).ApplyTask(c, existingORM.Client.Id, &updateORM); err != nil {
+			return err
+		}
+		if err := editor.SyncFlat(&updateORM); err != nil {
+			return err
+		}
+		if saveErr = tx.Save(&updateORM).Error; saveErr != nil {
+			return saveErr
+		}
+
+		if updateORM.FrontendResult == nil {
+			return nil
+		}
+		var before *proto.TreeNode
+		if tree, err := types.TaskTree(&locked); err == nil {
+			before = tree
+		}
+		after := updateORM.FrontendResult.Data()
+		_, err := revision.Record(c, tx, updateORM.Id, userClaims.UserID, revision.ActionReplace, before, &after, nil)
+		return err
+	})
+	if saveErr != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: saveErr.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	// Saves inside a transaction leave the state machine to the caller, run it on the committed task
+	if err := db.StateMachine.ProcessTask(c, &updateORM); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
 	// Convert back to proto
 	response, err := updateORM.ToPB(c)
 	if err != nil {
@@ -235,7 +309,25 @@ func DeleteDataRecognitionTask(c *gin.Context) {
 		return
 	}
 
-	if err := db.DB.Delete(&ormObj).Error; err != nil {
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("task_id = ?", ormObj.Id).Delete(&proto.CostEstimateORM{}).Error; err != nil {
+			return err
+		}
+		if err := quote.DeleteTaskQuotes(tx, ormObj.Id); err != nil {
+			return err
+		}
+		if err := tx.Where("task_id = ?", ormObj.Id).Delete(&proto.ValidationIssueORM{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("task_id = ?", ormObj.Id).Delete(&proto.TreeRevisionORM{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("task_id = ?", ormObj.Id).Delete(&proto.RecognitionMetricORM{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&ormObj).Error
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
 		return
 	}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/counts"
+	"github.com/bazilio91/sferra-cloud/pkg/services/editor"
+	"github.com/bazilio91/sferra-cloud/pkg/services/revision"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+type FrontendResultHandler struct {
+	editor *editor.Service
+}
+
+func NewFrontendResultHandler(editor *editor.Service) *FrontendResultHandler {
+	return &FrontendResultHandler{editor: editor}
+}
+
+type AddNodeInput struct {
+	ParentId string `json:"parent_id" binding:"required"`
+	// position among the leaves of the parent, the node is appended when omitted
+	Index *int           `json:"index"`
+	Node  proto.TreeNode `json:"node"`
+}
+
+type MoveNodeInput struct {
+	ParentId string `json:"parent_id" binding:"required"`
+	// position among the leaves of the parent, the node is appended when omitted
+	Index *int `json:"index"`
+}
+
+func editorErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, editor.ErrInvalidEdit), errors.Is(err, editor.ErrInvalidTree), errors.Is(err, counts.ErrCountOverflow):
+		return http.StatusBadRequest
+	case errors.Is(err, types.ErrTaskNotFound), errors.Is(err, editor.ErrNodeNotFound), errors.Is(err, revision.ErrRevisionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, types.ErrTaskNotCompleted), errors.Is(err, types.ErrNoRecognizedTree):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func renderTree(c *gin.Context, tree *proto.TreeNode, err error) {
+	if err != nil {
+		c.JSON(editorErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, tree)
+}
+
+// GetFrontendResult godoc
+// @Summary Get Frontend Result
+// @Description Current tree of a completed task: the frontend result once edited, the recognized tree before
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} proto.TreeNode
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/frontend_result [get]
+func (h *FrontendResultHandler) GetFrontendResult(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	tree, err := h.editor.Tree(c, userClaims.ClientID, c.Param("id"))
+	renderTree(c, tree, err)
+}
+
+// PatchFrontendResult godoc
+// @Summary Patch Frontend Result
+// @Description Apply an RFC 6902 JSON Patch, sent as application/json-patch+json or application/json, to the current tree of a completed task. The patched tree must keep unique node ids and parent references matching the structure. Accumulated counts, field statuses and the flat result are recomputed by the server.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param patch body []object true "JSON Patch operations"
+// @Success 200 {object} proto.TreeNode
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/frontend_result [patch]
+func (h *FrontendResultHandler) PatchFrontendResult(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	patch, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	tree, err := h.editor.Patch(c, userClaims.ClientID, userClaims.UserID, c.Param("id"), patch)
+	renderTree(c, tree, err)
+}
+
+// AddNode godoc
+// @Summary Add Tree Node
+// @Description Attach a new node with its leaves to a node of the current tree of a completed task. Nodes without an id get a generated one.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param data body AddNodeInput true "Node and its position"
+// @Success 200 {object} proto.TreeNode
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/frontend_result/nodes [post]
+func (h *FrontendResultHandler) AddNode(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var input AddNodeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	tree, err := h.editor.AddNode(c, userClaims.ClientID, userClaims.UserID, c.Param("id"), input.ParentId, input.Index, &input.Node)
+	renderTree(c, tree, err)
+}
+
+// UpdateNode godoc
+// @Summary Update Tree Node
+// @Description Set fields of a node of the current tree of a completed task, e.g. {"count": 2}. Omitted fields are kept; id, leaves and parent_id change with the structural edits only.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param node_id path string true "Node ID"
+// @Param data body object true "Node fields"
+// @Success 200 {object} proto.TreeNode
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/frontend_result/nodes/{node_id} [patch]
+func (h *FrontendResultHandler) UpdateNode(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var fields map[string]json.RawMessage
+	if err := c.ShouldBindJSON(&fields); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	tree, err := h.editor.UpdateNode(c, userClaims.ClientID, userClaims.UserID, c.Param("id"), c.Param("node_id"), fields)
+	renderTree(c, tree, err)
+}
+
+// MoveNode godoc
+// @Summary Move Tree Node
+// @Description Attach a node of the current tree of a completed task with its subtree to another node, or move it among the leaves of its parent. A node cannot be moved into its own subtree.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param node_id path string true "Node ID"
+// @Param data body MoveNodeInput true "New position"
+// @Success 200 {object} proto.TreeNode
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/frontend_result/nodes/{node_id}/move [post]
+func (h *FrontendResultHandler) MoveNode(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var input MoveNodeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	tree, err := h.editor.MoveNode(c, userClaims.ClientID, userClaims.UserID, c.Param("id"), c.Param("node_id"), input.ParentId, input.Index)
+	renderTree(c, tree, err)
+}
+
+// DeleteNode godoc
+// @Summary Delete Tree Node
+// @Description Remove a node with its subtree from the current tree of a completed task. The root cannot be deleted.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param node_id path string true "Node ID"
+// @Success 200 {object} proto.TreeNode
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/frontend_result/nodes/{node_id} [delete]
+func (h *FrontendResultHandler) DeleteNode(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	tree, err := h.editor.DeleteNode(c, userClaims.ClientID, userClaims.UserID, c.Param("id"), c.Param("node_id"))
+	renderTree(c, tree, err)
+}
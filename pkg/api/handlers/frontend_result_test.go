@@ -0,0 +1,113 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Frontend Result Handlers", func() {
+	var (
+		account testAccount
+		taskID  string
+	)
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id: "root",
+			Leaves: []*proto.TreeNode{
+				{
+					Id: "frame", Number: "СФ-01.00.000", Count: 2, Spec: &proto.SpecificationRow{Count: 2},
+					Leaves: []*proto.TreeNode{
+						{Id: "plate", Number: "СФ-01.00.001", Count: 4, Material: "Лист 10/Ст3", Spec: &proto.SpecificationRow{Count: 4}},
+					},
+				},
+				{Id: "bracket", Number: "СФ-02.00.000", Count: 1, Spec: &proto.SpecificationRow{Count: 1}},
+			},
+		})
+	}
+
+	request := func(method, path string, body string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, method, path, body)
+	}
+
+	decode := func(resp *httptest.ResponseRecorder) *proto.TreeNode {
+		Expect(resp.Code).To(Equal(http.StatusOK), resp.Body.String())
+		tree := &proto.TreeNode{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), tree)).To(Succeed())
+		return tree
+	}
+
+	BeforeEach(func() {
+		account = setupTestAccount("editor@example.com")
+
+		taskID = createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+	})
+
+	It("should edit nodes and keep the stored results in sync", func() {
+		path := "/recognition_tasks/" + taskID + "/frontend_result"
+
+		tree := decode(request(http.MethodPatch, path+"/nodes/plate", `{"count": 3, "material": ""}`))
+		plate := tree.Leaves[0].Leaves[0]
+		Expect(plate.Count).To(Equal(int32(3)))
+		Expect(plate.AccumulatedCount).To(Equal(int32(6)))
+		Expect(plate.ParentId).To(Equal("frame"))
+		Expect(plate.FieldStatus).To(HaveKeyWithValue("material", proto.FieldStatus_YELLOW))
+
+		tree = decode(request(http.MethodPost, path+"/nodes", `{"parent_id": "bracket", "node": {"name": "косынка", "count": 2}}`))
+		added := tree.Leaves[1].Leaves[0]
+		Expect(added.Id).NotTo(BeEmpty())
+		Expect(added.ParentId).To(Equal("bracket"))
+
+		tree = decode(request(http.MethodPost, path+"/nodes/plate/move", `{"parent_id": "bracket", "index": 0}`))
+		Expect(tree.Leaves[0].Leaves).To(BeEmpty())
+		Expect(tree.Leaves[1].Leaves[0].Id).To(Equal("plate"))
+		Expect(tree.Leaves[1].Leaves[0].AccumulatedCount).To(Equal(int32(3)))
+
+		tree = decode(request(http.MethodDelete, path+"/nodes/"+added.Id, ""))
+		Expect(tree.Leaves[1].Leaves).To(HaveLen(1))
+
+		tree = decode(request(http.MethodPatch, path, `[{"op": "replace", "path": "/leaves/0/count", "value": 5}]`))
+		Expect(tree.Leaves[0].AccumulatedCount).To(Equal(int32(5)))
+
+		var task proto.DataRecognitionTaskORM
+		Expect(DB.First(&task, "id = ?", taskID).Error).NotTo(HaveOccurred())
+		Expect(task.FrontendResult.Data().Leaves[0].Count).To(Equal(int32(5)))
+		Expect(task.RecognitionResult.Data().Leaves[0].Count).To(Equal(int32(2)))
+		var flat []proto.TreeNode
+		Expect(json.Unmarshal(task.FrontendResultFlat.RawMessage, &flat)).To(Succeed())
+		Expect(flat).To(HaveLen(4))
+
+		Expect(decode(request(http.MethodGet, path, "")).Leaves[0].Count).To(Equal(int32(5)))
+	})
+
+	It("should reject edits breaking the tree", func() {
+		path := "/recognition_tasks/" + taskID + "/frontend_result"
+
+		Expect(request(http.MethodPost, path+"/nodes/frame/move", `{"parent_id": "plate"}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(request(http.MethodPatch, path+"/nodes/plate", `{"id": "frame"}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(request(http.MethodPost, path+"/nodes", `{"parent_id": "root", "node": {"id": "plate"}}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(request(http.MethodPatch, path, `[{"op": "add", "path": "/leaves/0/leaves/0/parent_id", "value": "bracket"}]`).Code).To(Equal(http.StatusBadRequest))
+		Expect(request(http.MethodDelete, path+"/nodes/root", "").Code).To(Equal(http.StatusBadRequest))
+		Expect(request(http.MethodDelete, path+"/nodes/lost", "").Code).To(Equal(http.StatusNotFound))
+
+		var task proto.DataRecognitionTaskORM
+		Expect(DB.First(&task, "id = ?", taskID).Error).NotTo(HaveOccurred())
+		Expect(task.FrontendResult).To(BeNil())
+	})
+
+	It("should read the recognized tree until the first edit", func() {
+		tree := decode(request(http.MethodGet, "/recognition_tasks/"+taskID+"/frontend_result", ""))
+		Expect(tree.Leaves).To(HaveLen(2))
+		Expect(tree.Leaves[0].Leaves[0].Id).To(Equal("plate"))
+
+		// reading does not store a frontend result
+		var task proto.DataRecognitionTaskORM
+		Expect(DB.First(&task, "id = ?", taskID).Error).NotTo(HaveOccurred())
+		Expect(task.FrontendResult).To(BeNil())
+	})
+})
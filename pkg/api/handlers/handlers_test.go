@@ -1,18 +1,25 @@
 package handlers_test
 
 import (
+	"bytes"
 	"context"
-	"gorm.io/gorm"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/bazilio91/sferra-cloud/pkg/api/handlers"
 	"github.com/bazilio91/sferra-cloud/pkg/api/router"
 	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
 	"github.com/bazilio91/sferra-cloud/pkg/testutils"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 var (
@@ -47,3 +54,82 @@ var _ = AfterSuite(func() {
 	err := testutils.StopTestDBContainer(ctx, testDB)
 	Expect(err).NotTo(HaveOccurred())
 })
+
+// testAccount is a client with a user signed in to the API
+type testAccount struct {
+	client *proto.Client
+	token  string
+}
+
+// setupTestAccount clears the database and creates a client with a signed in user
+func setupTestAccount(email string) testAccount {
+	testutils.ClearDatabase(DB)
+
+	client, err := testutils.CreateTestClient(DB, "Test Client", 10)
+	Expect(err).NotTo(HaveOccurred())
+
+	user, err := testutils.CreateTestUser(DB, email, "password123", client.Id)
+	Expect(err).NotTo(HaveOccurred())
+
+	token, err := jwtManager.GenerateToken(user.Id, *user.ClientId)
+	Expect(err).NotTo(HaveOccurred())
+
+	return testAccount{client: client, token: token}
+}
+
+// createTestTask stores a task of the client with the recognition result and returns its id.
+// The options adjust the task before it is stored.
+func createTestTask(clientID uint64, status proto.Status, result proto.TreeNode, options ...func(*proto.DataRecognitionTaskORM)) string {
+	recognized := datatypes.NewJSONType(result)
+	now := time.Now()
+	task := &proto.DataRecognitionTaskORM{
+		Id:                uuid.New().String(),
+		ClientId:          &clientID,
+		Status:            int32(status),
+		RecognitionResult: &recognized,
+		CreatedAt:         &now,
+		UpdatedAt:         &now,
+	}
+	for _, option := range options {
+		option(task)
+	}
+	Expect(DB.Create(task).Error).NotTo(HaveOccurred())
+
+	return task.Id
+}
+
+// withFrontendResult stores the tree as the result edited by the user; a nil tree leaves the task unedited
+func withFrontendResult(tree *proto.TreeNode) func(*proto.DataRecognitionTaskORM) {
+	return func(task *proto.DataRecognitionTaskORM) {
+		if tree != nil {
+			edited := datatypes.NewJSONType(*tree)
+			task.FrontendResult = &edited
+		}
+	}
+}
+
+// apiRequest sends an authorized request to path under /api/v1. A string or []byte body
+// is sent as is, any other non-nil body as JSON.
+func apiRequest(token, method, path string, body interface{}) *httptest.ResponseRecorder {
+	var payload []byte
+	switch body := body.(type) {
+	case nil:
+	case string:
+		payload = []byte(body)
+	case []byte:
+		payload = body
+	default:
+		var err error
+		payload, err = json.Marshal(body)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	req, err := http.NewRequest(method, "/api/v1"+path, bytes.NewReader(payload))
+	Expect(err).NotTo(HaveOccurred())
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	return resp
+}
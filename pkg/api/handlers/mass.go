@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/mass"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+type MassHandler struct {
+	mass *mass.Service
+}
+
+func NewMassHandler(mass *mass.Service) *MassHandler {
+	return &MassHandler{mass: mass}
+}
+
+// GetMassReport godoc
+// @Summary Get Task Mass Report
+// @Description Theoretical masses of the parts of a completed task computed from the typed sizes, drawing dimensions and material densities, compared with the recognized masses. Parts deviating by more than the tolerance are mismatches.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param tolerance query number false "Accepted deviation in percent" default(10)
+// @Success 200 {object} proto.MassReport
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/mass [get]
+func (h *MassHandler) GetMassReport(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	tolerance := float64(mass.DefaultTolerancePercent)
+	if value := c.Query("tolerance"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || parsed < 0 || parsed > 100 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "tolerance must be a number from 0 to 100"})
+			return
+		}
+		tolerance = parsed
+	}
+
+	var (
+		report *proto.MassReport
+		err    error
+	)
+	report, err = h.mass.TaskMassReport(c, userClaims.ClientID, c.Param("id"), tolerance)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrTaskNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		case errors.Is(err, types.ErrTaskNotCompleted), errors.Is(err, types.ErrNoRecognizedTree):
+			c.JSON(http.StatusConflict, ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
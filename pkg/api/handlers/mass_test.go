@@ -0,0 +1,69 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Mass Report Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("mass@example.com")
+	})
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Material: "Лист 10 09Г2С", Count: 2, AccumulatedCount: 2,
+					Figure: &proto.Figure{SizeVertical: 1000, SizeHorizontal: 1400, Mass: 110}},
+				{Id: "cover", Material: "Лист 5 АМг6", Count: 1, AccumulatedCount: 1,
+					Figure: &proto.Figure{SizeVertical: 500, SizeHorizontal: 500, Mass: 4.9}},
+			},
+		})
+	}
+
+	request := func(path string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, http.MethodGet, path, nil)
+	}
+
+	It("should reconcile the recognized masses with the client densities", func() {
+		Expect(DB.Create(&proto.MaterialDensityORM{ClientId: &account.client.Id, Material: "АМг6", Density: 2640}).Error).NotTo(HaveOccurred())
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		resp := request("/recognition_tasks/" + taskID + "/mass")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		report := &proto.MassReport{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), report)).To(Succeed())
+		Expect(report.TaskId).To(Equal(taskID))
+		Expect(report.TotalMass).To(Equal(223.1))
+		Expect(report.Parts).To(HaveLen(2))
+		Expect(report.Parts[1].ComputedMass).To(Equal(3.3))
+		Expect(report.Parts[1].Mismatch).To(BeTrue())
+		Expect(report.Mismatches).To(Equal(int32(1)))
+
+		resp = request("/recognition_tasks/" + taskID + "/mass?tolerance=50")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		tolerant := &proto.MassReport{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), tolerant)).To(Succeed())
+		Expect(tolerant.TolerancePercent).To(Equal(50.0))
+		Expect(tolerant.Mismatches).To(BeZero())
+	})
+
+	It("should reject tolerances that are not a percentage", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		for _, tolerance := range []string{"abc", "-1", "101"} {
+			resp := request("/recognition_tasks/" + taskID + "/mass?tolerance=" + tolerance)
+			Expect(resp.Code).To(Equal(http.StatusBadRequest), tolerance)
+			Expect(resp.Body.String()).To(ContainSubstring("tolerance must be a number from 0 to 100"))
+		}
+	})
+})
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/material"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+type MaterialHandler struct {
+	materials *material.Service
+}
+
+func NewMaterialHandler(materials *material.Service) *MaterialHandler {
+	return &MaterialHandler{materials: materials}
+}
+
+// GetTaskMaterials godoc
+// @Summary Get Task Materials
+// @Description Recognized materials of a completed task mapped to the material reference catalog with a confidence from 0 to 1. Materials without a grade are unmatched and are listed for review by administrators.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} proto.TaskMaterials
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/materials [get]
+func (h *MaterialHandler) GetTaskMaterials(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var (
+		materials *proto.TaskMaterials
+		err       error
+	)
+	materials, err = h.materials.TaskMaterials(c, userClaims.ClientID, c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrTaskNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		case errors.Is(err, types.ErrTaskNotCompleted), errors.Is(err, types.ErrNoRecognizedTree):
+			c.JSON(http.StatusConflict, ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, materials)
+}
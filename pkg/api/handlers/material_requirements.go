@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/requirements"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+const xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+type MaterialRequirementsHandler struct {
+	requirements *requirements.Service
+}
+
+func NewMaterialRequirementsHandler(requirements *requirements.Service) *MaterialRequirementsHandler {
+	return &MaterialRequirementsHandler{requirements: requirements}
+}
+
+// GetTaskMaterialRequirements godoc
+// @Summary Get Task Material Requirements
+// @Description Materials of a completed task aggregated by grade, assortment type and size with accumulated quantities and waste allowances
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param id path string true "Task ID"
+// @Param format query string false "Output format" Enums(json, csv, xlsx)
+// @Success 200 {object} proto.MaterialRequirements
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/material_requirements [get]
+func (h *MaterialRequirementsHandler) GetTaskMaterialRequirements(c *gin.Context) {
+	h.render(c, []string{c.Param("id")})
+}
+
+// GetMaterialRequirements godoc
+// @Summary Get Material Requirements
+// @Description Materials of several completed tasks aggregated by grade, assortment type and size with accumulated quantities and waste allowances
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param task_id query []string true "Task IDs" collectionFormat(multi)
+// @Param format query string false "Output format" Enums(json, csv, xlsx)
+// @Success 200 {object} proto.MaterialRequirements
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/material_requirements [get]
+func (h *MaterialRequirementsHandler) GetMaterialRequirements(c *gin.Context) {
+	h.render(c, c.QueryArray("task_id"))
+}
+
+func (h *MaterialRequirementsHandler) render(c *gin.Context, taskIDs []string) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" && format != "xlsx" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "format must be json, csv or xlsx"})
+		return
+	}
+
+	var (
+		report *proto.MaterialRequirements
+		err    error
+	)
+	report, err = h.requirements.Report(c, userClaims.ClientID, taskIDs)
+	if err != nil {
+		renderRequirementsError(c, err)
+		return
+	}
+
+	var document bytes.Buffer
+	switch format {
+	case "csv":
+		err = requirements.WriteCSV(&document, report)
+	case "xlsx":
+		err = requirements.WriteXLSX(&document, report)
+	default:
+		c.JSON(http.StatusOK, report)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if format == "xlsx" {
+		contentType = xlsxContentType
+	}
+	c.Header("Content-Disposition", "attachment; filename=material-requirements."+format)
+	c.Data(http.StatusOK, contentType, document.Bytes())
+}
+
+// GetCuttingPlan godoc
+// @Summary Get Task Cutting Plan
+// @Description Plan of cutting the profile parts of a completed task from stock bars: bars needed, cut patterns and waste
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} proto.CuttingPlan
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/cutting_plan [get]
+func (h *MaterialRequirementsHandler) GetCuttingPlan(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var (
+		plan *proto.CuttingPlan
+		err  error
+	)
+	plan, err = h.requirements.CuttingPlan(c, userClaims.ClientID, []string{c.Param("id")})
+	if err != nil {
+		renderRequirementsError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
+// GetNestingPlan godoc
+// @Summary Get Task Nesting Plan
+// @Description Plan of nesting the sheet parts of a completed task on sheet formats: sheets needed, utilisation and layouts; format=svg draws the layouts
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Produce image/svg+xml
+// @Param id path string true "Task ID"
+// @Param format query string false "Output format" Enums(json, svg)
+// @Success 200 {object} proto.NestingPlan
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/nesting_plan [get]
+func (h *MaterialRequirementsHandler) GetNestingPlan(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "svg" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "format must be json or svg"})
+		return
+	}
+
+	var (
+		plan *proto.NestingPlan
+		err  error
+	)
+	plan, err = h.requirements.NestingPlan(c, userClaims.ClientID, []string{c.Param("id")})
+	if err != nil {
+		renderRequirementsError(c, err)
+		return
+	}
+	if format == "json" {
+		c.JSON(http.StatusOK, plan)
+		return
+	}
+
+	var document bytes.Buffer
+	if err := requirements.WriteNestingSVG(&document, plan); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.Data(http.StatusOK, "image/svg+xml", document.Bytes())
+}
+
+func renderRequirementsError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, requirements.ErrNoTasks), errors.Is(err, requirements.ErrTooManyTasks):
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+	case errors.Is(err, types.ErrTaskNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+	case errors.Is(err, types.ErrTaskNotCompleted), errors.Is(err, types.ErrNoRecognizedTree):
+		c.JSON(http.StatusConflict, ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+	}
+}
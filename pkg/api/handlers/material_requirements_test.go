@@ -0,0 +1,91 @@
+package handlers_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Material Requirements Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("procurement@example.com")
+	})
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{
+					Id: "plate", Material: "Лист 10 09Г2С", Count: 2, AccumulatedCount: 2,
+					Figure: &proto.Figure{SizeVertical: 500, SizeHorizontal: 1000, Mass: 39.25},
+				},
+			},
+		})
+	}
+
+	request := func(path string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, http.MethodGet, path, nil)
+	}
+
+	It("should aggregate the materials of several tasks with waste factors", func() {
+		Expect(DB.Create(&proto.WasteFactorORM{AssortmentType: "Лист", Percent: 10}).Error).NotTo(HaveOccurred())
+		first := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+		second := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		resp := request("/material_requirements?task_id=" + first + "&task_id=" + second)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		report := &proto.MaterialRequirements{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), report)).To(Succeed())
+		Expect(report.TaskIds).To(Equal([]string{first, second}))
+		Expect(report.Rows).To(HaveLen(1))
+		Expect(report.Rows[0].Grade).To(Equal("09Г2С"))
+		Expect(report.Rows[0].Size).To(Equal("10"))
+		Expect(report.Rows[0].Quantity).To(Equal(int64(4)))
+		Expect(report.Rows[0].Area).To(Equal(2.0))
+		Expect(report.Rows[0].WastePercent).To(Equal(10.0))
+		Expect(report.Rows[0].GrossMass).To(Equal(172.7))
+	})
+
+	It("should export a task report as CSV and XLSX", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		resp := request("/recognition_tasks/" + taskID + "/material_requirements?format=csv")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Header().Get("Content-Type")).To(HavePrefix("text/csv"))
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(records).To(HaveLen(2))
+
+		resp = request("/recognition_tasks/" + taskID + "/material_requirements?format=xlsx")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Header().Get("Content-Type")).To(Equal("application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"))
+
+		Expect(request("/recognition_tasks/" + taskID + "/material_requirements?format=pdf").Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should name the task that keeps a report from being built", func() {
+		completed := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+		incomplete := createTask(proto.Status_STATUS_RECOGNITION_FAILED_PROCESSING)
+		unknown := uuid.New().String()
+
+		Expect(request("/material_requirements").Code).To(Equal(http.StatusBadRequest))
+
+		resp := request("/material_requirements?task_id=" + completed + "&task_id=" + unknown)
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+		Expect(resp.Body.String()).To(ContainSubstring(unknown))
+
+		resp = request("/material_requirements?task_id=" + completed + "&task_id=" + incomplete)
+		Expect(resp.Code).To(Equal(http.StatusConflict))
+		Expect(resp.Body.String()).To(ContainSubstring(incomplete))
+	})
+})
@@ -0,0 +1,74 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task Materials Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("materials@example.com")
+
+		Expect(DB.Create(&proto.MaterialGradeORM{Grade: "09Г2С", Standard: "ГОСТ 19281-2014", Density: 7850}).Error).NotTo(HaveOccurred())
+	})
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Material: "Сталь 09Г2С ГОСТ 19281-2014", Count: 2},
+				{Id: "rib", Material: "Сталь 09Г2С ГОСТ 19281-2014", Count: 4},
+				{Id: "gasket", Material: "Паронит ПОН-Б"},
+			},
+		})
+	}
+
+	request := func(path string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, http.MethodGet, path, nil)
+	}
+
+	It("should map the task materials to the catalog", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		resp := request("/recognition_tasks/" + taskID + "/materials")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		materials := &proto.TaskMaterials{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), materials)).To(Succeed())
+		Expect(materials.TaskId).To(Equal(taskID))
+		Expect(materials.Materials).To(HaveLen(2))
+		Expect(materials.Materials[0].Grade).To(Equal("09Г2С"))
+		Expect(materials.Materials[0].Confidence).To(Equal(1.0))
+		Expect(materials.Materials[0].NodeIds).To(Equal([]string{"plate", "rib"}))
+		Expect(materials.Materials[1].GradeId).To(BeZero())
+		Expect(materials.Unmatched).To(Equal(int32(1)))
+	})
+
+	It("should map the materials of the tree as edited by the user", func() {
+		taskID := createTestTask(account.client.Id, proto.Status_STATUS_PROCESSING_COMPLETED, proto.TreeNode{
+			Id:     "root",
+			Name:   "Root",
+			Leaves: []*proto.TreeNode{{Id: "gasket", Material: "Паронит ПОН-Б"}},
+		}, withFrontendResult(&proto.TreeNode{
+			Id:     "root",
+			Name:   "Root",
+			Leaves: []*proto.TreeNode{{Id: "gasket", Material: "Сталь 09Г2С ГОСТ 19281-2014"}},
+		}))
+
+		resp := request("/recognition_tasks/" + taskID + "/materials")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		materials := &proto.TaskMaterials{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), materials)).To(Succeed())
+		Expect(materials.Materials).To(HaveLen(1))
+		Expect(materials.Materials[0].Grade).To(Equal("09Г2С"))
+		Expect(materials.Materials[0].NodeIds).To(Equal([]string{"gasket"}))
+		Expect(materials.Unmatched).To(BeZero())
+	})
+})
@@ -0,0 +1,77 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Nesting Plan Handlers", func() {
+	var (
+		account testAccount
+		taskID  string
+	)
+
+	BeforeEach(func() {
+		account = setupTestAccount("nesting@example.com")
+
+		taskID = createTestTask(account.client.Id, proto.Status_STATUS_PROCESSING_COMPLETED, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{
+					Id: "plate", Material: "Лист 10 09Г2С", Count: 3, AccumulatedCount: 3,
+					Figure: &proto.Figure{SizeVertical: 1000, SizeHorizontal: 1400, Mass: 110},
+				},
+			},
+		})
+	})
+
+	request := func(path string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, http.MethodGet, path, nil)
+	}
+
+	It("should nest sheet parts on the client's sheet formats", func() {
+		Expect(DB.Create(&proto.SheetFormatORM{ClientId: &account.client.Id, Thickness: 10, Width: 1500, Length: 3000, Gap: 10}).Error).NotTo(HaveOccurred())
+
+		resp := request("/recognition_tasks/" + taskID + "/nesting_plan")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		plan := &proto.NestingPlan{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), plan)).To(Succeed())
+		Expect(plan.TaskIds).To(Equal([]string{taskID}))
+		Expect(plan.Groups).To(HaveLen(1))
+		group := plan.Groups[0]
+		Expect(group.Thickness).To(Equal(10.0))
+		Expect(group.Sheets).To(Equal(int32(2)))
+		Expect(group.SheetArea).To(Equal(9.0))
+		Expect(group.UtilisationPercent).To(Equal(46.67))
+		Expect(group.Layouts).To(HaveLen(2))
+		Expect(group.Layouts[0].Placements).To(HaveLen(2))
+
+		resp = request("/recognition_tasks/" + taskID + "/nesting_plan?format=svg")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Header().Get("Content-Type")).To(Equal("image/svg+xml"))
+		Expect(strings.Count(resp.Body.String(), "<title>")).To(Equal(3))
+
+		Expect(request("/recognition_tasks/" + taskID + "/nesting_plan?format=dxf").Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should report sheets in the material requirements", func() {
+		resp := request("/recognition_tasks/" + taskID + "/material_requirements")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		report := &proto.MaterialRequirements{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), report)).To(Succeed())
+		Expect(report.Rows).To(HaveLen(1))
+		// three plates fit one default 1500×6000 sheet
+		Expect(report.Rows[0].Sheets).To(Equal(int32(1)))
+		Expect(report.Rows[0].SheetArea).To(Equal(9.0))
+		Expect(report.Rows[0].GrossArea).To(Equal(9.0))
+	})
+})
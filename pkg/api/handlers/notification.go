@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/notification"
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationPreferencesInput struct {
+	Language       string `json:"language" binding:"required,oneof=ru en"`
+	TaskCompleted  bool   `json:"task_completed"`
+	TaskFailed     bool   `json:"task_failed"`
+	QuotaLow       bool   `json:"quota_low"`
+	QuotaThreshold int64  `json:"quota_threshold" binding:"gte=0"`
+}
+
+// GetNotificationPreferences godoc
+// @Summary Get Notification Preferences
+// @Description Email notification settings of the current user
+// @Tags account
+// @Produce json
+// @Success 200 {object} proto.NotificationPreferences
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/account/notifications [get]
+func GetNotificationPreferences(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	prefs, err := notification.LoadPreferences(db.DB, userClaims.UserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	renderNotificationPreferences(c, prefs)
+}
+
+// UpdateNotificationPreferences godoc
+// @Summary Update Notification Preferences
+// @Description Choose the language and the events the current user is emailed about. A zero quota threshold uses the server default.
+// @Tags account
+// @Accept json
+// @Produce json
+// @Param data body NotificationPreferencesInput true "Notification preferences"
+// @Success 200 {object} proto.NotificationPreferences
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/account/notifications [put]
+func UpdateNotificationPreferences(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var input NotificationPreferencesInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	prefs, err := notification.LoadPreferences(db.DB, userClaims.UserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	now := time.Now()
+	if prefs.CreatedAt == nil {
+		prefs.CreatedAt = &now
+	}
+	prefs.UpdatedAt = &now
+	prefs.Language = input.Language
+	prefs.TaskCompleted = input.TaskCompleted
+	prefs.TaskFailed = input.TaskFailed
+	prefs.QuotaLow = input.QuotaLow
+	prefs.QuotaThreshold = input.QuotaThreshold
+
+	if err := db.DB.Save(prefs).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	renderNotificationPreferences(c, prefs)
+}
+
+func renderNotificationPreferences(c *gin.Context, prefs *proto.NotificationPreferencesORM) {
+	response, err := prefs.ToPB(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, &response)
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/payment"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OrderHandler struct {
+	payments        *payment.Service
+	defaultProvider string
+}
+
+func NewOrderHandler(payments *payment.Service, defaultProvider string) *OrderHandler {
+	return &OrderHandler{
+		payments:        payments,
+		defaultProvider: defaultProvider,
+	}
+}
+
+type CreateOrderInput struct {
+	Quota    int64  `json:"quota" binding:"required,gt=0"`
+	Provider string `json:"provider"`
+}
+
+// OrderListResponse represents a paginated list of orders
+type OrderListResponse struct {
+	TotalCount int64          `json:"total_count"`
+	Page       int            `json:"page"`
+	PageSize   int            `json:"page_size"`
+	Results    []*proto.Order `json:"results"`
+}
+
+// CreateOrder godoc
+// @Summary Create Order
+// @Description Start a prepaid quota purchase. Quota is credited once the payment provider confirms the payment.
+// @Tags orders
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param data body CreateOrderInput true "Order data"
+// @Success 201 {object} proto.Order
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/orders [post]
+func (h *OrderHandler) CreateOrder(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var input CreateOrderInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	provider := input.Provider
+	if provider == "" {
+		provider = h.defaultProvider
+	}
+
+	order, err := h.payments.CreateOrder(c, userClaims.ClientID, userClaims.UserID, input.Quota, provider)
+	if err != nil {
+		if errors.Is(err, payment.ErrUnknownProvider) || errors.Is(err, payment.ErrInvalidQuota) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	response, err := order.ToPB(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, &response)
+}
+
+// GetOrder godoc
+// @Summary Get Order
+// @Description Get an order of the authenticated client by ID
+// @Tags orders
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} proto.Order
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/orders/{id} [get]
+func (h *OrderHandler) GetOrder(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+	id := c.Param("id")
+
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid order ID format: must be a valid UUID"})
+		return
+	}
+
+	var ormObj proto.OrderORM
+	if err := db.DB.First(&ormObj, "id = ? AND client_id = ?", id, userClaims.ClientID).Error; err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "order not found"})
+		return
+	}
+
+	response, err := ormObj.ToPB(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, &response)
+}
+
+// ListOrders godoc
+// @Summary List Orders
+// @Description List quota orders of the authenticated client, newest first
+// @Tags orders
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} OrderListResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/orders [get]
+func (h *OrderHandler) ListOrders(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	query := db.DB.Model(&proto.OrderORM{}).Where("client_id = ?", userClaims.ClientID)
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var ormResults []proto.OrderORM
+	offset := (page - 1) * pageSize
+	if err := query.Order("created_at DESC").Offset(offset).Limit(pageSize).Find(&ormResults).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	results := make([]*proto.Order, 0, len(ormResults))
+	for i := range ormResults {
+		order, err := ormResults[i].ToPB(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+			return
+		}
+		results = append(results, &order)
+	}
+
+	c.JSON(http.StatusOK, &OrderListResponse{
+		TotalCount: totalCount,
+		Page:       page,
+		PageSize:   pageSize,
+		Results:    results,
+	})
+}
+
+// PaymentWebhook godoc
+// @Summary Payment provider webhook
+// @Description Callback endpoint for payment providers. Duplicate callbacks are acknowledged without crediting quota twice.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param provider path string true "Payment provider name"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/payments/{provider}/webhook [post]
+func (h *OrderHandler) PaymentWebhook(c *gin.Context) {
+	_, err := h.payments.HandleWebhook(c, c.Param("provider"), c.Request)
+	if err != nil {
+		switch {
+		case errors.Is(err, payment.ErrInvalidSignature):
+			c.JSON(http.StatusUnauthorized, ErrorResponse{Error: err.Error()})
+		case errors.Is(err, payment.ErrUnknownProvider), errors.Is(err, payment.ErrOrderNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		case errors.Is(err, payment.ErrInvalidWebhook):
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{Message: "ok"})
+}
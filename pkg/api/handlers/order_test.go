@@ -0,0 +1,149 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/api/handlers"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/payment"
+	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Order Handlers", func() {
+	var (
+		clientModel *proto.Client
+		token       string
+	)
+
+	BeforeEach(func() {
+		testutils.ClearDatabase(DB)
+
+		var err error
+		clientModel, err = testutils.CreateTestClient(DB, "Test Client", 10)
+		Expect(err).NotTo(HaveOccurred())
+
+		userModel, err := testutils.CreateTestUser(DB, "buyer@example.com", "password123", clientModel.Id)
+		Expect(err).NotTo(HaveOccurred())
+
+		token, err = jwtManager.GenerateToken(userModel.Id, *userModel.ClientId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	createOrder := func(quota int64) *proto.Order {
+		body, _ := json.Marshal(handlers.CreateOrderInput{Quota: quota})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/orders", bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp := httptest.NewRecorder()
+		r.ServeHTTP(resp, req)
+		Expect(resp.Code).To(Equal(http.StatusCreated))
+
+		order := &proto.Order{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), order)).To(Succeed())
+		return order
+	}
+
+	sendWebhook := func(externalID string, status payment.EventStatus) int {
+		body, _ := json.Marshal(payment.FakeWebhookPayload{ExternalID: externalID, Status: status})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/payments/fake/webhook", bytes.NewBuffer(body))
+		req.Header.Set(payment.FakeSignatureHeader, payment.NewFakeProvider(testDB.Config.PaymentWebhookSecret).Sign(body))
+
+		resp := httptest.NewRecorder()
+		r.ServeHTTP(resp, req)
+		return resp.Code
+	}
+
+	clientQuota := func() int64 {
+		var client proto.ClientORM
+		Expect(DB.First(&client, clientModel.Id).Error).NotTo(HaveOccurred())
+		return client.Quota
+	}
+
+	Describe("CreateOrder", func() {
+		It("should create a pending order priced by quota", func() {
+			order := createOrder(5)
+
+			Expect(order.Status).To(Equal(proto.OrderStatus_ORDER_STATUS_PENDING))
+			Expect(order.ClientId).To(Equal(clientModel.Id))
+			Expect(order.Amount).To(Equal(5 * testDB.Config.QuotaUnitPrice))
+			Expect(order.ExternalId).NotTo(BeEmpty())
+			Expect(clientQuota()).To(Equal(int64(10)))
+		})
+
+		It("should reject non-positive quota", func() {
+			body := []byte(`{"quota": 0}`)
+			req, _ := http.NewRequest(http.MethodPost, "/api/v1/orders", bytes.NewBuffer(body))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("PaymentWebhook", func() {
+		It("should credit quota once for duplicate callbacks", func() {
+			order := createOrder(5)
+
+			Expect(sendWebhook(order.ExternalId, payment.EventSucceeded)).To(Equal(http.StatusOK))
+			Expect(sendWebhook(order.ExternalId, payment.EventSucceeded)).To(Equal(http.StatusOK))
+
+			Expect(clientQuota()).To(Equal(int64(15)))
+
+			var stored proto.OrderORM
+			Expect(DB.First(&stored, "id = ?", order.Id).Error).NotTo(HaveOccurred())
+			Expect(stored.Status).To(Equal(int32(proto.OrderStatus_ORDER_STATUS_PAID)))
+			Expect(stored.PaidAt).NotTo(BeNil())
+		})
+
+		It("should not credit quota for failed payments", func() {
+			order := createOrder(5)
+
+			Expect(sendWebhook(order.ExternalId, payment.EventFailed)).To(Equal(http.StatusOK))
+			Expect(sendWebhook(order.ExternalId, payment.EventSucceeded)).To(Equal(http.StatusOK))
+
+			Expect(clientQuota()).To(Equal(int64(10)))
+		})
+
+		It("should reject callbacks with a bad signature", func() {
+			order := createOrder(5)
+
+			body, _ := json.Marshal(payment.FakeWebhookPayload{ExternalID: order.ExternalId, Status: payment.EventSucceeded})
+			req, _ := http.NewRequest(http.MethodPost, "/api/v1/payments/fake/webhook", bytes.NewBuffer(body))
+			req.Header.Set(payment.FakeSignatureHeader, "forged")
+
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+			Expect(clientQuota()).To(Equal(int64(10)))
+		})
+
+		It("should return 404 for unknown orders", func() {
+			Expect(sendWebhook("fake_missing", payment.EventSucceeded)).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("ListOrders", func() {
+		It("should list only the client's orders", func() {
+			createOrder(1)
+			createOrder(2)
+
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/orders", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+
+			var response handlers.OrderListResponse
+			Expect(json.Unmarshal(resp.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.TotalCount).To(Equal(int64(2)))
+			Expect(response.Results).To(HaveLen(2))
+		})
+	})
+})
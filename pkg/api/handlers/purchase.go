@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/purchase"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+type PurchaseHandler struct {
+	purchase *purchase.Service
+}
+
+func NewPurchaseHandler(purchase *purchase.Service) *PurchaseHandler {
+	return &PurchaseHandler{purchase: purchase}
+}
+
+// GetPurchaseList godoc
+// @Summary Get Task Purchase List
+// @Description Standard and purchased items of a completed task with total quantities. These items are excluded from the cost estimate, routing and material requirements.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Produce text/csv
+// @Param id path string true "Task ID"
+// @Param format query string false "Output format" Enums(json, csv)
+// @Success 200 {object} proto.PurchaseList
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/purchase_list [get]
+func (h *PurchaseHandler) GetPurchaseList(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "format must be json or csv"})
+		return
+	}
+
+	var (
+		list *proto.PurchaseList
+		err  error
+	)
+	list, err = h.purchase.TaskPurchaseList(c, userClaims.ClientID, c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrTaskNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		case errors.Is(err, types.ErrTaskNotCompleted), errors.Is(err, types.ErrNoRecognizedTree):
+			c.JSON(http.StatusConflict, ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	if format == "json" {
+		c.JSON(http.StatusOK, list)
+		return
+	}
+
+	var document bytes.Buffer
+	if err := purchase.WriteCSV(&document, list); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.Header("Content-Disposition", "attachment; filename=purchase-list.csv")
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", document.Bytes())
+}
@@ -0,0 +1,77 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Purchase List Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("buyer@example.com")
+	})
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Name: "Plate", Material: "Лист 10 Ст3", Count: 2, AccumulatedCount: 2, Figure: &proto.Figure{Mass: 1.5}},
+				{Id: "bolt", Name: "Болт М16х40 ГОСТ 7798-70", Count: 8, AccumulatedCount: 8, Figure: &proto.Figure{Mass: 0.1}},
+				{Id: "bearing", Name: "Подшипник 180205", Count: 2, AccumulatedCount: 2},
+			},
+		})
+	}
+
+	request := func(method, path string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, method, path, nil)
+	}
+
+	It("should list bought items and exclude them from the cost estimate", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		resp := request(http.MethodGet, "/recognition_tasks/"+taskID+"/purchase_list")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		list := &proto.PurchaseList{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), list)).To(Succeed())
+		Expect(list.TaskId).To(Equal(taskID))
+		Expect(list.Items).To(HaveLen(2))
+		Expect(list.Items[0].Name).To(Equal("Болт М16х40"))
+		Expect(list.Items[0].Designation).To(Equal("ГОСТ 7798-70"))
+		Expect(list.Items[0].Quantity).To(Equal(int64(8)))
+		Expect(list.Items[1].Kind).To(Equal(int32(proto.PartKind_PART_KIND_PURCHASED)))
+
+		resp = request(http.MethodGet, "/recognition_tasks/"+taskID+"/purchase_list?format=csv")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body.String()).To(ContainSubstring("standard,Болт М16х40,ГОСТ 7798-70,8"))
+
+		Expect(DB.Create(&proto.PriceListORM{
+			Name:                  "Base",
+			Currency:              "RUB",
+			IsDefault:             true,
+			Version:               1,
+			ProcessingCostPerPart: 100,
+			Materials:             []*proto.MaterialPriceORM{{Material: "Ст3", Price: 80}},
+		}).Error).NotTo(HaveOccurred())
+		resp = request(http.MethodPost, "/recognition_tasks/"+taskID+"/cost_estimate")
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		estimate := &proto.CostEstimate{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), estimate)).To(Succeed())
+		Expect(estimate.Breakdown.Children).To(HaveLen(1))
+		Expect(estimate.TotalCost).To(Equal(440.0))
+	})
+
+	It("should reject unknown export formats", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		resp := request(http.MethodGet, "/recognition_tasks/"+taskID+"/purchase_list?format=xlsx")
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		Expect(resp.Body.String()).To(ContainSubstring("format must be json or csv"))
+	})
+})
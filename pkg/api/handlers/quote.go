@@ -0,0 +1,373 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/costing"
+	"github.com/bazilio91/sferra-cloud/pkg/services/quote"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+type QuoteHandler struct {
+	quotes *quote.Service
+}
+
+func NewQuoteHandler(quotes *quote.Service) *QuoteHandler {
+	return &QuoteHandler{quotes: quotes}
+}
+
+type CreateQuoteInput struct {
+	TaskId          string   `json:"task_id" binding:"required"`
+	Title           string   `json:"title" binding:"max=255"`
+	CustomerName    string   `json:"customer_name" binding:"max=255"`
+	CustomerDetails string   `json:"customer_details"`
+	MarginPercent   float64  `json:"margin_percent"`
+	DiscountPercent float64  `json:"discount_percent"`
+	VatPercent      *float64 `json:"vat_percent"`
+	ValidDays       int32    `json:"valid_days"`
+	Notes           string   `json:"notes"`
+}
+
+// CreateQuoteRevisionInput changes the terms of the latest revision, omitted fields are kept
+type CreateQuoteRevisionInput struct {
+	MarginPercent   *float64 `json:"margin_percent"`
+	DiscountPercent *float64 `json:"discount_percent"`
+	VatPercent      *float64 `json:"vat_percent"`
+	ValidDays       *int32   `json:"valid_days"`
+	Notes           *string  `json:"notes"`
+	// estimate the costs anew instead of using the stored cost estimate
+	Refresh bool `json:"refresh"`
+}
+
+func quoteErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, quote.ErrInvalidTerms):
+		return http.StatusBadRequest
+	case errors.Is(err, quote.ErrQuoteNotFound), errors.Is(err, quote.ErrRevisionNotFound),
+		errors.Is(err, types.ErrTaskNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, types.ErrTaskNotCompleted), errors.Is(err, costing.ErrNoPriceList),
+		errors.Is(err, types.ErrNoRecognizedTree):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func renderQuote(c *gin.Context, status int, model *proto.QuoteORM) {
+	response, err := model.ToPB(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(status, &response)
+}
+
+// quoteID parses the id path parameter, responding with 404 when it is not a number
+func quoteID(c *gin.Context) (uint64, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: quote.ErrQuoteNotFound.Error()})
+		return 0, false
+	}
+
+	return id, true
+}
+
+// revisionNumber reads a revision number query parameter, defaulting to fallback when omitted
+func revisionNumber(c *gin.Context, name string, fallback int32) (int32, error) {
+	value := c.Query(name)
+	if value == "" {
+		return fallback, nil
+	}
+	number, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || number < 1 {
+		return 0, fmt.Errorf("invalid %s", name)
+	}
+
+	return int32(number), nil
+}
+
+// CreateQuote godoc
+// @Summary Create Quote
+// @Description Create a commercial quote of a completed task. Lines are the top-level nodes of the BOM priced from the task cost estimate, which is computed when missing.
+// @Tags quotes
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param data body CreateQuoteInput true "Quote data"
+// @Success 201 {object} proto.Quote
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/quotes [post]
+func (h *QuoteHandler) CreateQuote(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var input CreateQuoteInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	terms := quote.Terms{
+		MarginPercent:   input.MarginPercent,
+		DiscountPercent: input.DiscountPercent,
+		VatPercent:      quote.DefaultVatPercent,
+		ValidDays:       input.ValidDays,
+		Notes:           input.Notes,
+	}
+	if input.VatPercent != nil {
+		terms.VatPercent = *input.VatPercent
+	}
+	details := quote.Details{
+		Title:           input.Title,
+		CustomerName:    input.CustomerName,
+		CustomerDetails: input.CustomerDetails,
+	}
+
+	created, err := h.quotes.Create(c, userClaims.ClientID, userClaims.UserID, input.TaskId, &details, &terms)
+	if err != nil {
+		c.JSON(quoteErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	renderQuote(c, http.StatusCreated, created)
+}
+
+// ListQuotes godoc
+// @Summary List Quotes
+// @Description List quotes of the authenticated client without revisions, newest first
+// @Tags quotes
+// @Security BearerAuth
+// @Produce json
+// @Param task_id query string false "Only quotes of the task"
+// @Success 200 {array} proto.Quote
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/quotes [get]
+func (h *QuoteHandler) ListQuotes(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	quotes, err := h.quotes.List(c, userClaims.ClientID, c.Query("task_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	response := make([]*proto.Quote, 0, len(quotes))
+	for _, model := range quotes {
+		pb, err := model.ToPB(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+			return
+		}
+		response = append(response, &pb)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetQuote godoc
+// @Summary Get Quote
+// @Description Get a quote with all revisions and their lines
+// @Tags quotes
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Quote ID"
+// @Success 200 {object} proto.Quote
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/quotes/{id} [get]
+func (h *QuoteHandler) GetQuote(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+	id, ok := quoteID(c)
+	if !ok {
+		return
+	}
+
+	model, err := h.quotes.Get(c, userClaims.ClientID, id)
+	if err != nil {
+		c.JSON(quoteErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	renderQuote(c, http.StatusOK, model)
+}
+
+// DeleteQuote godoc
+// @Summary Delete Quote
+// @Description Delete a quote with all revisions
+// @Tags quotes
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Quote ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/quotes/{id} [delete]
+func (h *QuoteHandler) DeleteQuote(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+	id, ok := quoteID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.quotes.Delete(c, userClaims.ClientID, id); err != nil {
+		c.JSON(quoteErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{Message: "Quote deleted successfully"})
+}
+
+// CreateQuoteRevision godoc
+// @Summary Create Quote Revision
+// @Description Create the next revision of a quote with changed terms. Omitted terms are taken from the latest revision.
+// @Tags quotes
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Quote ID"
+// @Param data body CreateQuoteRevisionInput true "Changed terms"
+// @Success 201 {object} proto.Quote
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/quotes/{id}/revisions [post]
+func (h *QuoteHandler) CreateQuoteRevision(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+	id, ok := quoteID(c)
+	if !ok {
+		return
+	}
+
+	var input CreateQuoteRevisionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	update := quote.TermsUpdate{
+		MarginPercent:   input.MarginPercent,
+		DiscountPercent: input.DiscountPercent,
+		VatPercent:      input.VatPercent,
+		ValidDays:       input.ValidDays,
+		Notes:           input.Notes,
+	}
+	model, err := h.quotes.AddRevision(c, userClaims.ClientID, userClaims.UserID, id, &update, input.Refresh)
+	if err != nil {
+		c.JSON(quoteErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	renderQuote(c, http.StatusCreated, model)
+}
+
+// DiffQuoteRevisions godoc
+// @Summary Diff Quote Revisions
+// @Description Compare terms, totals and lines of two revisions. By default the latest revision is compared with the previous one.
+// @Tags quotes
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Quote ID"
+// @Param from query int false "Old revision"
+// @Param to query int false "New revision"
+// @Success 200 {object} proto.QuoteDiff
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/quotes/{id}/diff [get]
+func (h *QuoteHandler) DiffQuoteRevisions(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+	id, ok := quoteID(c)
+	if !ok {
+		return
+	}
+
+	model, err := h.quotes.Get(c, userClaims.ClientID, id)
+	if err != nil {
+		c.JSON(quoteErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	to, err := revisionNumber(c, "to", model.CurrentRevision)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	from, err := revisionNumber(c, "from", max(to-1, 1))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	fromRevision, err := quote.Revision(model, from)
+	if err != nil {
+		c.JSON(quoteErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+	toRevision, err := quote.Revision(model, to)
+	if err != nil {
+		c.JSON(quoteErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, quote.Diff(model.Id, fromRevision, toRevision))
+}
+
+// GetQuotePDF godoc
+// @Summary Get Quote PDF
+// @Description Render a revision of the quote to PDF with the requisites of the client
+// @Tags quotes
+// @Security BearerAuth
+// @Produce application/pdf
+// @Param id path int true "Quote ID"
+// @Param revision query int false "Revision, the latest by default"
+// @Success 200 {file} binary
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/quotes/{id}/pdf [get]
+func (h *QuoteHandler) GetQuotePDF(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+	id, ok := quoteID(c)
+	if !ok {
+		return
+	}
+
+	model, err := h.quotes.Get(c, userClaims.ClientID, id)
+	if err != nil {
+		c.JSON(quoteErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+	number, err := revisionNumber(c, "revision", model.CurrentRevision)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	revision, err := quote.Revision(model, number)
+	if err != nil {
+		c.JSON(quoteErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var document bytes.Buffer
+	if err := h.quotes.WritePDF(c, &document, model, revision); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	filename := fmt.Sprintf("quote-%d-v%d.pdf", model.Id, revision.Revision)
+	c.Header("Content-Disposition", "attachment; filename="+filename)
+	c.Data(http.StatusOK, "application/pdf", document.Bytes())
+}
@@ -0,0 +1,130 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quote Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("sales@example.com")
+
+		Expect(DB.Create(&proto.PriceListORM{
+			Name:                  "Base",
+			Currency:              "RUB",
+			IsDefault:             true,
+			Version:               1,
+			ProcessingCostPerPart: 100,
+			Materials: []*proto.MaterialPriceORM{
+				{Material: "Ст3", Price: 80},
+			},
+		}).Error).NotTo(HaveOccurred())
+	})
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Name: "Plate", Material: "Лист 10 Ст3", Count: 2, AccumulatedCount: 2, Figure: &proto.Figure{Mass: 1.5}},
+			},
+		})
+	}
+
+	request := func(method, path string, body interface{}) *httptest.ResponseRecorder {
+		return apiRequest(account.token, method, path, body)
+	}
+
+	It("should create a quote with revisions, diff and PDF", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		resp := request(http.MethodPost, "/quotes", map[string]interface{}{
+			"task_id":        taskID,
+			"title":          "Plates",
+			"customer_name":  "ACME",
+			"margin_percent": 25,
+		})
+		Expect(resp.Code).To(Equal(http.StatusCreated))
+
+		created := &proto.Quote{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), created)).To(Succeed())
+		Expect(created.TaskId).To(Equal(taskID))
+		Expect(created.CurrentRevision).To(Equal(int32(1)))
+		Expect(created.Revisions).To(HaveLen(1))
+		first := created.Revisions[0]
+		Expect(first.Currency).To(Equal("RUB"))
+		Expect(first.VatPercent).To(Equal(20.0))
+		Expect(first.Items).To(HaveLen(1))
+		// 2 plates × (1.5 kg × 80 + 100) with 25% margin
+		Expect(first.NetAmount).To(Equal(550.0))
+		Expect(first.TotalAmount).To(Equal(660.0))
+
+		path := fmt.Sprintf("/quotes/%d", created.Id)
+		resp = request(http.MethodPost, path+"/revisions", map[string]interface{}{"discount_percent": 10})
+		Expect(resp.Code).To(Equal(http.StatusCreated))
+
+		updated := &proto.Quote{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), updated)).To(Succeed())
+		Expect(updated.CurrentRevision).To(Equal(int32(2)))
+		Expect(updated.Revisions).To(HaveLen(2))
+		Expect(updated.Revisions[1].MarginPercent).To(Equal(25.0))
+		Expect(updated.Revisions[1].NetAmount).To(Equal(495.0))
+
+		resp = request(http.MethodGet, path+"/diff", nil)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		diff := &proto.QuoteDiff{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), diff)).To(Succeed())
+		Expect(diff.FromRevision).To(Equal(int32(1)))
+		Expect(diff.ToRevision).To(Equal(int32(2)))
+		Expect(diff.TotalDelta).To(Equal(-66.0))
+		Expect(diff.Items).To(HaveLen(1))
+
+		resp = request(http.MethodGet, path+"/pdf?revision=1", nil)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Header().Get("Content-Type")).To(Equal("application/pdf"))
+		Expect(resp.Body.String()).To(HavePrefix("%PDF"))
+
+		resp = request(http.MethodGet, "/quotes?task_id="+taskID, nil)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		var quotes []*proto.Quote
+		Expect(json.Unmarshal(resp.Body.Bytes(), &quotes)).To(Succeed())
+		Expect(quotes).To(HaveLen(1))
+
+		Expect(request(http.MethodDelete, path, nil).Code).To(Equal(http.StatusOK))
+		Expect(request(http.MethodGet, path, nil).Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should reject invalid terms and unknown revisions", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		resp := request(http.MethodPost, "/quotes", map[string]interface{}{"task_id": taskID, "discount_percent": 150})
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+
+		resp = request(http.MethodPost, "/quotes", map[string]interface{}{"task_id": taskID})
+		Expect(resp.Code).To(Equal(http.StatusCreated))
+		created := &proto.Quote{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), created)).To(Succeed())
+
+		resp = request(http.MethodGet, fmt.Sprintf("/quotes/%d/pdf?revision=5", created.Id), nil)
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should not leave a quote behind for tasks that cannot be estimated", func() {
+		taskID := createTask(proto.Status_STATUS_RECOGNITION_FAILED_PROCESSING)
+
+		resp := request(http.MethodPost, "/quotes", map[string]interface{}{"task_id": taskID})
+		Expect(resp.Code).To(Equal(http.StatusConflict))
+
+		var count int64
+		Expect(DB.Model(&proto.QuoteORM{}).Count(&count).Error).NotTo(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+})
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/editor"
+	"github.com/bazilio91/sferra-cloud/pkg/services/revision"
+	"github.com/gin-gonic/gin"
+)
+
+type RevisionHandler struct {
+	revisions *revision.Service
+	editor    *editor.Service
+}
+
+func NewRevisionHandler(revisions *revision.Service, editor *editor.Service) *RevisionHandler {
+	return &RevisionHandler{revisions: revisions, editor: editor}
+}
+
+// treeRevision parses the revision path parameter, responding with 404 when it is not a revision number
+func treeRevision(c *gin.Context) (int32, bool) {
+	number, err := strconv.ParseInt(c.Param("revision"), 10, 32)
+	if err != nil || number < 0 {
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: revision.ErrRevisionNotFound.Error()})
+		return 0, false
+	}
+
+	return int32(number), true
+}
+
+// ListRevisions godoc
+// @Summary List Frontend Result Revisions
+// @Description Revision history of the frontend result of a task, newest first, without the trees. Every saved edit is a revision with its author, time and the changes from the previous one; revision 0 is the recognized tree. With field set, e.g. count, only the revisions changing that field are listed.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param field query string false "Changed field, e.g. count or spec.material"
+// @Success 200 {object} proto.TreeRevisionList
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/revisions [get]
+func (h *RevisionHandler) ListRevisions(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	list, err := h.revisions.List(c, userClaims.ClientID, c.Param("id"), c.Query("field"))
+	if err != nil {
+		c.JSON(editorErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, list)
+}
+
+// GetRevision godoc
+// @Summary Get Frontend Result Revision
+// @Description A revision of the frontend result of a task with its tree and the changes from the previous revision. Revision 0 is the recognized tree.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param revision path int true "Revision number"
+// @Success 200 {object} proto.TreeRevision
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/revisions/{revision} [get]
+func (h *RevisionHandler) GetRevision(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+	number, ok := treeRevision(c)
+	if !ok {
+		return
+	}
+
+	var (
+		response *proto.TreeRevision
+		err      error
+	)
+	response, err = h.revisions.Get(c, userClaims.ClientID, c.Param("id"), number)
+	if err != nil {
+		c.JSON(editorErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RevertRevision godoc
+// @Summary Revert Frontend Result
+// @Description Restore the tree of a revision as the frontend result of a completed task, revision 0 restores the recognized tree. The restored tree is recorded as a new revision.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param revision path int true "Revision number"
+// @Success 200 {object} proto.TreeNode
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/revisions/{revision}/revert [post]
+func (h *RevisionHandler) RevertRevision(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+	number, ok := treeRevision(c)
+	if !ok {
+		return
+	}
+
+	tree, err := h.editor.Revert(c, userClaims.ClientID, userClaims.UserID, c.Param("id"), number)
+	renderTree(c, tree, err)
+}
@@ -0,0 +1,95 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Revision Handlers", func() {
+	var (
+		account testAccount
+		taskID  string
+	)
+
+	request := func(method, path string, body string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, method, path, body)
+	}
+
+	history := func(query string) *proto.TreeRevisionList {
+		resp := request(http.MethodGet, "/recognition_tasks/"+taskID+"/revisions"+query, "")
+		Expect(resp.Code).To(Equal(http.StatusOK), resp.Body.String())
+		list := &proto.TreeRevisionList{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), list)).To(Succeed())
+		return list
+	}
+
+	BeforeEach(func() {
+		account = setupTestAccount("editor@example.com")
+
+		taskID = createTestTask(account.client.Id, proto.Status_STATUS_PROCESSING_COMPLETED, proto.TreeNode{
+			Id: "root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Number: "СФ-01.00.001", Count: 4, Spec: &proto.SpecificationRow{Count: 4}},
+			},
+		})
+	})
+
+	It("should record edits and revert to a revision", func() {
+		path := "/recognition_tasks/" + taskID
+
+		Expect(request(http.MethodPatch, path+"/frontend_result/nodes/plate", `{"count": 6}`).Code).To(Equal(http.StatusOK))
+		Expect(request(http.MethodPatch, path+"/frontend_result/nodes/plate", `{"name": "пластина"}`).Code).To(Equal(http.StatusOK))
+		// an edit without changes is not recorded
+		Expect(request(http.MethodPatch, path+"/frontend_result/nodes/plate", `{"name": "пластина"}`).Code).To(Equal(http.StatusOK))
+
+		list := history("")
+		Expect(list.CurrentRevision).To(Equal(int32(2)))
+		Expect(list.Revisions).To(HaveLen(3))
+		Expect(list.Revisions[0].Revision).To(Equal(int32(2)))
+		Expect(list.Revisions[0].Author).To(Equal("editor@example.com"))
+		Expect(list.Revisions[0].Action).To(Equal("update_node"))
+		Expect(list.Revisions[0].Tree).To(BeNil())
+		Expect(list.Revisions[2].Revision).To(Equal(int32(0)))
+
+		counts := history("?field=count")
+		Expect(counts.Revisions).To(HaveLen(1))
+		Expect(counts.Revisions[0].Revision).To(Equal(int32(1)))
+
+		resp := request(http.MethodGet, path+"/revisions/1", "")
+		Expect(resp.Code).To(Equal(http.StatusOK), resp.Body.String())
+		var revision proto.TreeRevision
+		Expect(json.Unmarshal(resp.Body.Bytes(), &revision)).To(Succeed())
+		Expect(revision.Diff.Changes).To(HaveLen(1))
+		Expect(revision.Diff.Changes[0].Field).To(Equal("count"))
+		Expect(revision.Diff.Changes[0].Before).To(Equal("4"))
+		Expect(revision.Diff.Changes[0].After).To(Equal("6"))
+
+		resp = request(http.MethodPost, path+"/revisions/0/revert", "")
+		Expect(resp.Code).To(Equal(http.StatusOK), resp.Body.String())
+		tree := &proto.TreeNode{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), tree)).To(Succeed())
+		Expect(tree.Leaves[0].Count).To(Equal(int32(4)))
+		Expect(tree.Leaves[0].Name).To(BeEmpty())
+
+		list = history("")
+		Expect(list.CurrentRevision).To(Equal(int32(3)))
+		Expect(list.Revisions[0].Action).To(Equal("revert"))
+		Expect(list.Revisions[0].RevertedTo).NotTo(BeNil())
+		Expect(*list.Revisions[0].RevertedTo).To(Equal(int32(0)))
+	})
+
+	It("should reject unknown revisions", func() {
+		path := "/recognition_tasks/" + taskID + "/revisions/"
+
+		Expect(request(http.MethodGet, path+"5", "").Code).To(Equal(http.StatusNotFound))
+		Expect(request(http.MethodGet, path+"last", "").Code).To(Equal(http.StatusNotFound))
+		Expect(request(http.MethodPost, path+"5/revert", "").Code).To(Equal(http.StatusNotFound))
+		Expect(request(http.MethodGet, "/recognition_tasks/"+uuid.New().String()+"/revisions", "").Code).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,90 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Routing Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("technologist@example.com")
+	})
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Material: "Лист 10 Ст3", AccumulatedCount: 2, Figure: &proto.Figure{Mass: 1.5}},
+			},
+		})
+	}
+
+	request := func(taskID string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, http.MethodGet, "/recognition_tasks/"+taskID+"/routing", nil)
+	}
+
+	It("should route a completed task with the client's rules first", func() {
+		Expect(DB.Create(&proto.OperationRuleORM{
+			Name: "Общая резка", Operation: "cutting", Enabled: true, LabourHoursPerUnit: 1,
+		}).Error).NotTo(HaveOccurred())
+		Expect(DB.Create(&proto.OperationRuleORM{
+			ClientId: &account.client.Id, Name: "Резка клиента", Operation: "cutting", Enabled: true, LabourHoursPerUnit: 0.25,
+		}).Error).NotTo(HaveOccurred())
+
+		resp := request(createTask(proto.Status_STATUS_PROCESSING_COMPLETED))
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		result := &proto.Routing{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), result)).To(Succeed())
+		Expect(result.LabourHours).To(Equal(0.5))
+		Expect(result.Operations).To(HaveLen(1))
+		Expect(result.Root.Children).To(HaveLen(1))
+		Expect(result.Root.Children[0].Operations[0].RuleName).To(Equal("Резка клиента"))
+	})
+
+	It("should ignore rules of other clients", func() {
+		other, err := testutils.CreateTestClient(DB, "Other Client", 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(DB.Create(&proto.OperationRuleORM{
+			ClientId: &other.Id, Name: "Чужая резка", Operation: "cutting", Enabled: true, LabourHoursPerUnit: 1,
+		}).Error).NotTo(HaveOccurred())
+
+		resp := request(createTask(proto.Status_STATUS_PROCESSING_COMPLETED))
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		result := &proto.Routing{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), result)).To(Succeed())
+		Expect(result.LabourHours).To(BeZero())
+	})
+
+	It("should route the tree as edited by the user", func() {
+		Expect(DB.Create(&proto.OperationRuleORM{
+			ClientId: &account.client.Id, Name: "Резка клиента", Operation: "cutting", Enabled: true, LabourHoursPerUnit: 0.25,
+		}).Error).NotTo(HaveOccurred())
+		taskID := createTestTask(account.client.Id, proto.Status_STATUS_PROCESSING_COMPLETED, proto.TreeNode{
+			Id:     "root",
+			Name:   "Root",
+			Leaves: []*proto.TreeNode{{Id: "plate", Material: "Лист 10 Ст3", AccumulatedCount: 2}},
+		}, withFrontendResult(&proto.TreeNode{
+			Id:     "root",
+			Name:   "Root",
+			Leaves: []*proto.TreeNode{{Id: "plate", Material: "Лист 10 Ст3", AccumulatedCount: 6}},
+		}))
+
+		resp := request(taskID)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		result := &proto.Routing{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), result)).To(Succeed())
+		Expect(result.LabourHours).To(Equal(1.5))
+	})
+})
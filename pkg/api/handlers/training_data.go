@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/gin-gonic/gin"
+)
+
+type TrainingDataConsentInput struct {
+	Consent string `json:"consent" binding:"required,oneof=opt_in opt_out undecided"`
+}
+
+type TrainingDataConsentResponse struct {
+	// opt_in, opt_out or undecided
+	Consent string `json:"consent"`
+}
+
+const trainingDataConsentPrefix = "TRAINING_DATA_CONSENT_"
+
+// GetTrainingDataConsent godoc
+// @Summary Get Training Data Consent
+// @Description Whether the tasks of the current client may be used to train the recognition models. Undecided clients are only included in training datasets when the export asks for them.
+// @Tags account
+// @Produce json
+// @Success 200 {object} TrainingDataConsentResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/account/training_data_consent [get]
+func GetTrainingDataConsent(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var client proto.ClientORM
+	if err := db.DB.First(&client, userClaims.ClientID).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	renderTrainingDataConsent(c, proto.TrainingDataConsent(client.TrainingDataConsent))
+}
+
+// UpdateTrainingDataConsent godoc
+// @Summary Update Training Data Consent
+// @Description Opt the current client in or out of using its completed tasks, images and corrections to train the recognition models. Opted out clients are never included in training datasets.
+// @Tags account
+// @Accept json
+// @Produce json
+// @Param data body TrainingDataConsentInput true "Consent"
+// @Success 200 {object} TrainingDataConsentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/account/training_data_consent [put]
+func UpdateTrainingDataConsent(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var input TrainingDataConsentInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	consent := proto.TrainingDataConsent(proto.TrainingDataConsent_value[trainingDataConsentPrefix+strings.ToUpper(input.Consent)])
+	err := db.DB.Model(&proto.ClientORM{}).Where("id = ?", userClaims.ClientID).
+		Update("training_data_consent", int32(consent)).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	renderTrainingDataConsent(c, consent)
+}
+
+func renderTrainingDataConsent(c *gin.Context, consent proto.TrainingDataConsent) {
+	c.JSON(http.StatusOK, TrainingDataConsentResponse{
+		Consent: strings.ToLower(strings.TrimPrefix(consent.String(), trainingDataConsentPrefix)),
+	})
+}
@@ -0,0 +1,47 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/api/handlers"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Training Data Consent Handlers", func() {
+	var account testAccount
+
+	request := func(method, body string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, method, "/account/training_data_consent", body)
+	}
+
+	consent := func(resp *httptest.ResponseRecorder) string {
+		Expect(resp.Code).To(Equal(http.StatusOK), resp.Body.String())
+		var response handlers.TrainingDataConsentResponse
+		Expect(json.Unmarshal(resp.Body.Bytes(), &response)).To(Succeed())
+		return response.Consent
+	}
+
+	BeforeEach(func() {
+		account = setupTestAccount("owner@example.com")
+	})
+
+	It("should opt the client in and out", func() {
+		Expect(consent(request(http.MethodGet, ""))).To(Equal("undecided"))
+
+		Expect(consent(request(http.MethodPut, `{"consent": "opt_out"}`))).To(Equal("opt_out"))
+		var client proto.ClientORM
+		Expect(DB.First(&client, account.client.Id).Error).NotTo(HaveOccurred())
+		Expect(client.TrainingDataConsent).To(Equal(int32(proto.TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_OUT)))
+
+		Expect(consent(request(http.MethodPut, `{"consent": "opt_in"}`))).To(Equal("opt_in"))
+		Expect(consent(request(http.MethodGet, ""))).To(Equal("opt_in"))
+	})
+
+	It("should reject unknown values", func() {
+		Expect(request(http.MethodPut, `{"consent": "maybe"}`).Code).To(Equal(http.StatusBadRequest))
+	})
+})
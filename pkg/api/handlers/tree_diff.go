@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/treediff"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/gin-gonic/gin"
+)
+
+type TreeDiffHandler struct {
+	diffs *treediff.Service
+}
+
+func NewTreeDiffHandler(diffs *treediff.Service) *TreeDiffHandler {
+	return &TreeDiffHandler{diffs: diffs}
+}
+
+// GetRecognitionDiff godoc
+// @Summary Get Recognition Diff
+// @Description Changes users made to the recognized tree of a completed task: nodes of the frontend result added, removed, moved to another parent or with changed fields compared to the recognition result. Nodes are matched by id, falling back to designation and name. The diff is empty while the task has no frontend result.
+// @Tags recognition_tasks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} proto.TreeDiff
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/recognition_tasks/{id}/diff [get]
+func (h *TreeDiffHandler) GetRecognitionDiff(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var (
+		diff *proto.TreeDiff
+		err  error
+	)
+	diff, err = h.diffs.RecognitionDiff(c, userClaims.ClientID, c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrTaskNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		case errors.Is(err, types.ErrTaskNotCompleted):
+			c.JSON(http.StatusConflict, ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, diff)
+}
@@ -0,0 +1,75 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tree Diff Handlers", func() {
+	var account testAccount
+
+	createTask := func(status proto.Status, edited *proto.TreeNode) string {
+		return createTestTask(account.client.Id, status, proto.TreeNode{
+			Id: "root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Number: "СФ-01.00.001", Name: "Пластина", Count: 4},
+				{Id: "bolt", Name: "Болт М12", Count: 8},
+			},
+		}, withFrontendResult(edited))
+	}
+
+	request := func(taskID string) *httptest.ResponseRecorder {
+		return apiRequest(account.token, http.MethodGet, "/recognition_tasks/"+taskID+"/diff", nil)
+	}
+
+	BeforeEach(func() {
+		account = setupTestAccount("diff@example.com")
+	})
+
+	It("should report the user corrections of the recognized tree", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED, &proto.TreeNode{
+			Id: "root",
+			Leaves: []*proto.TreeNode{
+				// a node re-created by the frontend is matched by its designation
+				{Id: "new-plate", Number: "СФ-01.00.001", Name: "Пластина", Count: 6},
+				{Id: "washer", Name: "Шайба 12", Count: 8},
+			},
+		})
+
+		resp := request(taskID)
+		Expect(resp.Code).To(Equal(http.StatusOK), resp.Body.String())
+		diff := &proto.TreeDiff{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), diff)).To(Succeed())
+		Expect(diff.Added).To(Equal(int32(1)))
+		Expect(diff.Removed).To(Equal(int32(1)))
+		Expect(diff.Updated).To(Equal(int32(1)))
+		Expect(diff.Changes[0].NodeId).To(Equal("new-plate"))
+		Expect(diff.Changes[0].PreviousNodeId).To(Equal("plate"))
+		Expect(diff.Changes[0].Field).To(Equal("count"))
+	})
+
+	It("should return an empty diff before the first edit", func() {
+		resp := request(createTask(proto.Status_STATUS_PROCESSING_COMPLETED, nil))
+		Expect(resp.Code).To(Equal(http.StatusOK), resp.Body.String())
+		diff := &proto.TreeDiff{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), diff)).To(Succeed())
+		Expect(diff.Changes).To(BeEmpty())
+	})
+
+	It("should not reveal the corrections of tasks of other clients", func() {
+		other, err := testutils.CreateTestClient(DB, "Other Client", 10)
+		Expect(err).NotTo(HaveOccurred())
+		foreign := createTestTask(other.Id, proto.Status_STATUS_PROCESSING_COMPLETED, proto.TreeNode{Id: "root"},
+			withFrontendResult(&proto.TreeNode{Id: "root", Leaves: []*proto.TreeNode{{Id: "washer", Name: "Шайба 12"}}}))
+
+		resp := request(foreign)
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+		Expect(resp.Body.String()).NotTo(ContainSubstring("washer"))
+	})
+})
@@ -0,0 +1,95 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validation Handlers", func() {
+	var account testAccount
+
+	BeforeEach(func() {
+		account = setupTestAccount("validation@example.com")
+	})
+
+	tree := func(material string) proto.TreeNode {
+		return proto.TreeNode{
+			Id:   "root",
+			Name: "Root",
+			Leaves: []*proto.TreeNode{
+				{Id: "plate", Number: "СФ-01.001", Count: 2, Material: material, Spec: &proto.SpecificationRow{Count: 2}},
+			},
+		}
+	}
+
+	createTask := func(status proto.Status) string {
+		return createTestTask(account.client.Id, status, tree("Лист 10/Ст3"))
+	}
+
+	request := func(method, path string, body []byte) *httptest.ResponseRecorder {
+		return apiRequest(account.token, method, path, body)
+	}
+
+	It("should validate user edits and summarise the problems", func() {
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		summary := &proto.ValidationSummary{}
+		resp := request(http.MethodGet, "/recognition_tasks/"+taskID+"/validation", nil)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(json.Unmarshal(resp.Body.Bytes(), summary)).To(Succeed())
+		Expect(summary.Problems).To(BeZero())
+
+		edited := tree("")
+		body, _ := json.Marshal(proto.DataRecognitionTask{
+			Status:            proto.Status_STATUS_PROCESSING_COMPLETED,
+			RecognitionResult: &proto.TreeNode{Id: "root"},
+			FrontendResult:    &edited,
+		})
+		resp = request(http.MethodPut, "/recognition_tasks/"+taskID, body)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		updated := &proto.DataRecognitionTask{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), updated)).To(Succeed())
+		Expect(updated.FrontendResult.Leaves[0].FieldStatus[validation.FieldMaterial]).To(Equal(proto.FieldStatus_YELLOW))
+		Expect(updated.FrontendResult.Leaves[0].FieldStatus[validation.FieldCount]).To(Equal(proto.FieldStatus_OK))
+
+		summary = &proto.ValidationSummary{}
+		resp = request(http.MethodGet, "/recognition_tasks/"+taskID+"/validation", nil)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(json.Unmarshal(resp.Body.Bytes(), summary)).To(Succeed())
+		Expect(summary.TaskId).To(Equal(taskID))
+		Expect(summary.Problems).To(Equal(int32(1)))
+		Expect(summary.Checks).To(Equal(map[string]int32{validation.CheckMaterialRequired: 1}))
+		Expect(summary.Issues[0].NodeId).To(Equal("plate"))
+	})
+
+	It("should skip the checks the client disabled", func() {
+		Expect(DB.Create(&proto.ValidationRuleORM{
+			ClientId: &account.client.Id, Code: validation.CheckMaterialRequired, Enabled: false,
+		}).Error).NotTo(HaveOccurred())
+		taskID := createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		edited := tree("")
+		body, _ := json.Marshal(proto.DataRecognitionTask{
+			Status:            proto.Status_STATUS_PROCESSING_COMPLETED,
+			RecognitionResult: &proto.TreeNode{Id: "root"},
+			FrontendResult:    &edited,
+		})
+		resp := request(http.MethodPut, "/recognition_tasks/"+taskID, body)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		updated := &proto.DataRecognitionTask{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), updated)).To(Succeed())
+		Expect(updated.FrontendResult.Leaves[0].FieldStatus).NotTo(HaveKey(validation.FieldMaterial))
+
+		summary := &proto.ValidationSummary{}
+		resp = request(http.MethodGet, "/recognition_tasks/"+taskID+"/validation", nil)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(json.Unmarshal(resp.Body.Bytes(), summary)).To(Succeed())
+		Expect(summary.Problems).To(BeZero())
+	})
+})
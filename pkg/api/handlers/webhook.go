@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/bazilio91/sferra-cloud/pkg/auth"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	webhooks *webhook.Service
+}
+
+func NewWebhookHandler(webhooks *webhook.Service) *WebhookHandler {
+	return &WebhookHandler{webhooks: webhooks}
+}
+
+type CreateWebhookEndpointInput struct {
+	Url    string   `json:"url" binding:"required"`
+	Events []string `json:"events" binding:"required"`
+}
+
+type UpdateWebhookEndpointInput struct {
+	Url     string   `json:"url" binding:"required"`
+	Events  []string `json:"events" binding:"required"`
+	Enabled bool     `json:"enabled"`
+}
+
+// WebhookDeliveryListResponse represents a paginated delivery log
+type WebhookDeliveryListResponse struct {
+	TotalCount int64                    `json:"total_count"`
+	Page       int                      `json:"page"`
+	PageSize   int                      `json:"page_size"`
+	Results    []*proto.WebhookDelivery `json:"results"`
+}
+
+func webhookErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, webhook.ErrInvalidURL), errors.Is(err, webhook.ErrInvalidEvent),
+		errors.Is(err, webhook.ErrNoEventsSpecified):
+		return http.StatusBadRequest
+	case errors.Is(err, webhook.ErrEndpointNotFound), errors.Is(err, webhook.ErrDeliveryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, webhook.ErrEndpointDisabled):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// renderWebhookEndpoint converts an endpoint, keeping the secret only when withSecret is set
+func renderWebhookEndpoint(c *gin.Context, endpoint *proto.WebhookEndpointORM, withSecret bool) (*proto.WebhookEndpoint, error) {
+	response, err := endpoint.ToPB(c)
+	if err != nil {
+		return nil, err
+	}
+	if !withSecret {
+		response.Secret = ""
+	}
+
+	return &response, nil
+}
+
+// CreateWebhookEndpoint godoc
+// @Summary Create Webhook Endpoint
+// @Description Register a URL receiving task events. The signing secret is returned only in this response.
+// @Tags webhooks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param data body CreateWebhookEndpointInput true "Endpoint data"
+// @Success 201 {object} proto.WebhookEndpoint
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/webhooks [post]
+func (h *WebhookHandler) CreateWebhookEndpoint(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var input CreateWebhookEndpointInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	endpoint, err := h.webhooks.CreateEndpoint(c, userClaims.ClientID, input.Url, input.Events)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	response, err := renderWebhookEndpoint(c, endpoint, true)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListWebhookEndpoints godoc
+// @Summary List Webhook Endpoints
+// @Description List webhook endpoints of the authenticated client
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} proto.WebhookEndpoint
+// @Failure 401 {object} ErrorResponse
+// @Router /api/v1/webhooks [get]
+func (h *WebhookHandler) ListWebhookEndpoints(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	endpoints, err := h.webhooks.ListEndpoints(c, userClaims.ClientID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	results := make([]*proto.WebhookEndpoint, 0, len(endpoints))
+	for i := range endpoints {
+		endpoint, err := renderWebhookEndpoint(c, &endpoints[i], false)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+			return
+		}
+		results = append(results, endpoint)
+	}
+
+	c.JSON(http.StatusOK, results)
+}
+
+// GetWebhookEndpoint godoc
+// @Summary Get Webhook Endpoint
+// @Description Get a webhook endpoint of the authenticated client by ID
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Endpoint ID"
+// @Success 200 {object} proto.WebhookEndpoint
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhookEndpoint(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	endpoint, err := h.webhooks.GetEndpoint(c, userClaims.ClientID, c.Param("id"))
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	response, err := renderWebhookEndpoint(c, endpoint, false)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateWebhookEndpoint godoc
+// @Summary Update Webhook Endpoint
+// @Description Change the URL, events or state of an endpoint. Enabling a disabled endpoint resets its failure counter.
+// @Tags webhooks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Endpoint ID"
+// @Param data body UpdateWebhookEndpointInput true "Endpoint data"
+// @Success 200 {object} proto.WebhookEndpoint
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/webhooks/{id} [put]
+func (h *WebhookHandler) UpdateWebhookEndpoint(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var input UpdateWebhookEndpointInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	endpoint, err := h.webhooks.UpdateEndpoint(c, userClaims.ClientID, c.Param("id"), input.Url, input.Events, input.Enabled)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	response, err := renderWebhookEndpoint(c, endpoint, false)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteWebhookEndpoint godoc
+// @Summary Delete Webhook Endpoint
+// @Description Delete an endpoint together with its delivery log
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Endpoint ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhookEndpoint(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	if err := h.webhooks.DeleteEndpoint(c, userClaims.ClientID, c.Param("id")); err != nil {
+		c.JSON(webhookErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{Message: "Webhook endpoint deleted"})
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List Webhook Deliveries
+// @Description Delivery log of an endpoint, newest first
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Endpoint ID"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} WebhookDeliveryListResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListWebhookDeliveries(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	deliveries, totalCount, err := h.webhooks.ListDeliveries(c, userClaims.ClientID, c.Param("id"), page, pageSize)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	results := make([]*proto.WebhookDelivery, 0, len(deliveries))
+	for i := range deliveries {
+		delivery, err := deliveries[i].ToPB(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+			return
+		}
+		results = append(results, &delivery)
+	}
+
+	c.JSON(http.StatusOK, &WebhookDeliveryListResponse{
+		TotalCount: totalCount,
+		Page:       page,
+		PageSize:   pageSize,
+		Results:    results,
+	})
+}
+
+// ReplayWebhookDelivery godoc
+// @Summary Replay Webhook Delivery
+// @Description Queue a new delivery with the payload of a logged one
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Endpoint ID"
+// @Param delivery_id path string true "Delivery ID"
+// @Success 202 {object} proto.WebhookDelivery
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /api/v1/webhooks/{id}/deliveries/{delivery_id}/replay [post]
+func (h *WebhookHandler) ReplayWebhookDelivery(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	delivery, err := h.webhooks.Replay(c, userClaims.ClientID, c.Param("id"), c.Param("delivery_id"))
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	response, err := delivery.ToPB(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, &response)
+}
@@ -0,0 +1,121 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bazilio91/sferra-cloud/pkg/api/handlers"
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/webhook"
+	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhook Handlers", func() {
+	var token string
+
+	BeforeEach(func() {
+		testutils.ClearDatabase(DB)
+
+		clientModel, err := testutils.CreateTestClient(DB, "Test Client", 10)
+		Expect(err).NotTo(HaveOccurred())
+
+		userModel, err := testutils.CreateTestUser(DB, "hooks@example.com", "password123", clientModel.Id)
+		Expect(err).NotTo(HaveOccurred())
+
+		token, err = jwtManager.GenerateToken(userModel.Id, *userModel.ClientId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	request := func(method, path string, input interface{}) *httptest.ResponseRecorder {
+		var body []byte
+		if input != nil {
+			body, _ = json.Marshal(input)
+		}
+		req, _ := http.NewRequest(method, path, bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp := httptest.NewRecorder()
+		r.ServeHTTP(resp, req)
+		return resp
+	}
+
+	createEndpoint := func() *proto.WebhookEndpoint {
+		resp := request(http.MethodPost, "/api/v1/webhooks", handlers.CreateWebhookEndpointInput{
+			Url:    "https://example.com/hooks",
+			Events: []string{webhook.EventTaskCompleted},
+		})
+		Expect(resp.Code).To(Equal(http.StatusCreated))
+
+		endpoint := &proto.WebhookEndpoint{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), endpoint)).To(Succeed())
+		return endpoint
+	}
+
+	It("should return the secret only on creation", func() {
+		endpoint := createEndpoint()
+		Expect(endpoint.Secret).To(HavePrefix("whsec_"))
+		Expect(endpoint.Enabled).To(BeTrue())
+
+		resp := request(http.MethodGet, "/api/v1/webhooks/"+endpoint.Id, nil)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		fetched := &proto.WebhookEndpoint{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), fetched)).To(Succeed())
+		Expect(fetched.Secret).To(BeEmpty())
+		Expect(fetched.Url).To(Equal("https://example.com/hooks"))
+
+		resp = request(http.MethodGet, "/api/v1/webhooks", nil)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		var endpoints []*proto.WebhookEndpoint
+		Expect(json.Unmarshal(resp.Body.Bytes(), &endpoints)).To(Succeed())
+		Expect(endpoints).To(HaveLen(1))
+		Expect(endpoints[0].Secret).To(BeEmpty())
+	})
+
+	It("should reject unknown events", func() {
+		resp := request(http.MethodPost, "/api/v1/webhooks", handlers.CreateWebhookEndpointInput{
+			Url:    "https://example.com/hooks",
+			Events: []string{"task.deleted"},
+		})
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should update and delete endpoints", func() {
+		endpoint := createEndpoint()
+
+		resp := request(http.MethodPut, "/api/v1/webhooks/"+endpoint.Id, handlers.UpdateWebhookEndpointInput{
+			Url:     "https://example.com/other",
+			Events:  webhook.Events,
+			Enabled: false,
+		})
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		updated := &proto.WebhookEndpoint{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), updated)).To(Succeed())
+		Expect(updated.Url).To(Equal("https://example.com/other"))
+		Expect(updated.Events).To(Equal(webhook.Events))
+		Expect(updated.Enabled).To(BeFalse())
+
+		resp = request(http.MethodDelete, "/api/v1/webhooks/"+endpoint.Id, nil)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		resp = request(http.MethodGet, "/api/v1/webhooks/"+endpoint.Id+"/deliveries", nil)
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should hide endpoints of other clients", func() {
+		endpoint := createEndpoint()
+
+		otherClient, err := testutils.CreateTestClient(DB, "Other Client", 10)
+		Expect(err).NotTo(HaveOccurred())
+		otherUser, err := testutils.CreateTestUser(DB, "other@example.com", "password123", otherClient.Id)
+		Expect(err).NotTo(HaveOccurred())
+		token, err = jwtManager.GenerateToken(otherUser.Id, otherClient.Id)
+		Expect(err).NotTo(HaveOccurred())
+
+		resp := request(http.MethodGet, "/api/v1/webhooks/"+endpoint.Id, nil)
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+	})
+})
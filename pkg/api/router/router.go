@@ -7,7 +7,24 @@ import (
 	"github.com/bazilio91/sferra-cloud/pkg/api/middleware"
 	"github.com/bazilio91/sferra-cloud/pkg/auth"
 	"github.com/bazilio91/sferra-cloud/pkg/config"
+	"github.com/bazilio91/sferra-cloud/pkg/db"
+	"github.com/bazilio91/sferra-cloud/pkg/services/accuracy"
+	"github.com/bazilio91/sferra-cloud/pkg/services/assortment"
+	"github.com/bazilio91/sferra-cloud/pkg/services/costing"
+	"github.com/bazilio91/sferra-cloud/pkg/services/counts"
+	"github.com/bazilio91/sferra-cloud/pkg/services/editor"
+	"github.com/bazilio91/sferra-cloud/pkg/services/mass"
+	"github.com/bazilio91/sferra-cloud/pkg/services/material"
+	"github.com/bazilio91/sferra-cloud/pkg/services/payment"
+	"github.com/bazilio91/sferra-cloud/pkg/services/purchase"
+	"github.com/bazilio91/sferra-cloud/pkg/services/quote"
+	"github.com/bazilio91/sferra-cloud/pkg/services/requirements"
+	"github.com/bazilio91/sferra-cloud/pkg/services/revision"
+	"github.com/bazilio91/sferra-cloud/pkg/services/routing"
 	"github.com/bazilio91/sferra-cloud/pkg/services/storage"
+	"github.com/bazilio91/sferra-cloud/pkg/services/treediff"
+	"github.com/bazilio91/sferra-cloud/pkg/services/validation"
+	"github.com/bazilio91/sferra-cloud/pkg/services/webhook"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -23,6 +40,29 @@ func SetupRouter(jwtManager *auth.JWTManager, cfg *config.Config) *gin.Engine {
 	s3Client := storage.NewS3Client(cfg)
 	imageHandler := handlers.NewImageHandler(s3Client)
 
+	// Initialize payments with the configured providers
+	var paymentProviders []payment.Provider
+	if cfg.PaymentFakeEnabled {
+		paymentProviders = append(paymentProviders, payment.NewFakeProvider(cfg.PaymentWebhookSecret))
+	}
+	payments := payment.NewService(db.DB, cfg.QuotaUnitPrice, cfg.QuotaCurrency, paymentProviders...)
+	orderHandler := handlers.NewOrderHandler(payments, cfg.PaymentProvider)
+	webhookHandler := handlers.NewWebhookHandler(webhook.NewService(db.DB, nil))
+	costEstimateHandler := handlers.NewCostEstimateHandler(costing.NewService(db.DB))
+	routingHandler := handlers.NewRoutingHandler(routing.NewService(db.DB))
+	quoteHandler := handlers.NewQuoteHandler(quote.NewService(db.DB))
+	requirementsHandler := handlers.NewMaterialRequirementsHandler(requirements.NewService(db.DB))
+	purchaseHandler := handlers.NewPurchaseHandler(purchase.NewService(db.DB))
+	massHandler := handlers.NewMassHandler(mass.NewService(db.DB))
+	materialHandler := handlers.NewMaterialHandler(material.NewService(db.DB))
+	assortmentHandler := handlers.NewAssortmentHandler(assortment.NewService(db.DB))
+	validationHandler := handlers.NewValidationHandler(validation.NewService(db.DB))
+	partTotalsHandler := handlers.NewPartTotalsHandler(counts.NewService(db.DB))
+	frontendResultHandler := handlers.NewFrontendResultHandler(editor.NewService(db.DB))
+	revisionHandler := handlers.NewRevisionHandler(revision.NewService(db.DB), editor.NewService(db.DB))
+	treeDiffHandler := handlers.NewTreeDiffHandler(treediff.NewService(db.DB))
+	accuracyHandler := handlers.NewAccuracyHandler(accuracy.NewService(db.DB))
+
 	// Swagger endpoint
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -31,12 +71,18 @@ func SetupRouter(jwtManager *auth.JWTManager, cfg *config.Config) *gin.Engine {
 		// Public routes
 		api.POST("/auth/login", handlers.Login)
 		api.POST("/auth/register", handlers.Register)
+		api.POST("/payments/:provider/webhook", orderHandler.PaymentWebhook)
 
 		// Protected routes
 		apiAuth := api.Group("/")
 		apiAuth.Use(middleware.JWTAuthMiddleware())
 		{
 			apiAuth.GET("/account", handlers.GetAccountInfo)
+			apiAuth.GET("/account/usage", handlers.GetAccountUsage)
+			apiAuth.GET("/account/notifications", handlers.GetNotificationPreferences)
+			apiAuth.PUT("/account/notifications", handlers.UpdateNotificationPreferences)
+			apiAuth.GET("/account/training_data_consent", handlers.GetTrainingDataConsent)
+			apiAuth.PUT("/account/training_data_consent", handlers.UpdateTrainingDataConsent)
 
 			// Data Recognition Task routes
 			apiAuth.POST("/recognition_tasks", handlers.CreateDataRecognitionTask)
@@ -44,10 +90,59 @@ func SetupRouter(jwtManager *auth.JWTManager, cfg *config.Config) *gin.Engine {
 			apiAuth.GET("/recognition_tasks/:id", handlers.GetDataRecognitionTask)
 			apiAuth.PUT("/recognition_tasks/:id", handlers.UpdateDataRecognitionTask)
 			apiAuth.DELETE("/recognition_tasks/:id", handlers.DeleteDataRecognitionTask)
+			apiAuth.POST("/recognition_tasks/:id/cost_estimate", costEstimateHandler.CreateCostEstimate)
+			apiAuth.GET("/recognition_tasks/:id/cost_estimate", costEstimateHandler.GetCostEstimate)
+			apiAuth.GET("/recognition_tasks/:id/routing", routingHandler.GetRouting)
+			apiAuth.GET("/recognition_tasks/:id/material_requirements", requirementsHandler.GetTaskMaterialRequirements)
+			apiAuth.GET("/material_requirements", requirementsHandler.GetMaterialRequirements)
+			apiAuth.GET("/recognition_tasks/:id/cutting_plan", requirementsHandler.GetCuttingPlan)
+			apiAuth.GET("/recognition_tasks/:id/nesting_plan", requirementsHandler.GetNestingPlan)
+			apiAuth.GET("/recognition_tasks/:id/purchase_list", purchaseHandler.GetPurchaseList)
+			apiAuth.GET("/recognition_tasks/:id/mass", massHandler.GetMassReport)
+			apiAuth.GET("/recognition_tasks/:id/materials", materialHandler.GetTaskMaterials)
+			apiAuth.GET("/recognition_tasks/:id/assortment_check", assortmentHandler.GetAssortmentValidation)
+			apiAuth.GET("/recognition_tasks/:id/validation", validationHandler.GetValidationSummary)
+			apiAuth.GET("/recognition_tasks/:id/part_totals", partTotalsHandler.GetPartTotals)
+
+			// Frontend result editing routes
+			apiAuth.GET("/recognition_tasks/:id/frontend_result", frontendResultHandler.GetFrontendResult)
+			apiAuth.PATCH("/recognition_tasks/:id/frontend_result", frontendResultHandler.PatchFrontendResult)
+			apiAuth.POST("/recognition_tasks/:id/frontend_result/nodes", frontendResultHandler.AddNode)
+			apiAuth.PATCH("/recognition_tasks/:id/frontend_result/nodes/:node_id", frontendResultHandler.UpdateNode)
+			apiAuth.DELETE("/recognition_tasks/:id/frontend_result/nodes/:node_id", frontendResultHandler.DeleteNode)
+			apiAuth.POST("/recognition_tasks/:id/frontend_result/nodes/:node_id/move", frontendResultHandler.MoveNode)
+			apiAuth.GET("/recognition_tasks/:id/revisions", revisionHandler.ListRevisions)
+			apiAuth.GET("/recognition_tasks/:id/revisions/:revision", revisionHandler.GetRevision)
+			apiAuth.POST("/recognition_tasks/:id/revisions/:revision/revert", revisionHandler.RevertRevision)
+			apiAuth.GET("/recognition_tasks/:id/diff", treeDiffHandler.GetRecognitionDiff)
+			apiAuth.POST("/recognition_tasks/:id/approve", accuracyHandler.ApproveTask)
+
+			// Quote routes
+			apiAuth.POST("/quotes", quoteHandler.CreateQuote)
+			apiAuth.GET("/quotes", quoteHandler.ListQuotes)
+			apiAuth.GET("/quotes/:id", quoteHandler.GetQuote)
+			apiAuth.DELETE("/quotes/:id", quoteHandler.DeleteQuote)
+			apiAuth.POST("/quotes/:id/revisions", quoteHandler.CreateQuoteRevision)
+			apiAuth.GET("/quotes/:id/diff", quoteHandler.DiffQuoteRevisions)
+			apiAuth.GET("/quotes/:id/pdf", quoteHandler.GetQuotePDF)
 
 			// Image routes
 			apiAuth.POST("/images/upload", imageHandler.UploadImage)
 			apiAuth.GET("/images/:id", imageHandler.GetImage)
+
+			// Order routes
+			apiAuth.POST("/orders", orderHandler.CreateOrder)
+			apiAuth.GET("/orders", orderHandler.ListOrders)
+			apiAuth.GET("/orders/:id", orderHandler.GetOrder)
+
+			// Webhook routes
+			apiAuth.POST("/webhooks", webhookHandler.CreateWebhookEndpoint)
+			apiAuth.GET("/webhooks", webhookHandler.ListWebhookEndpoints)
+			apiAuth.GET("/webhooks/:id", webhookHandler.GetWebhookEndpoint)
+			apiAuth.PUT("/webhooks/:id", webhookHandler.UpdateWebhookEndpoint)
+			apiAuth.DELETE("/webhooks/:id", webhookHandler.DeleteWebhookEndpoint)
+			apiAuth.GET("/webhooks/:id/deliveries", webhookHandler.ListWebhookDeliveries)
+			apiAuth.POST("/webhooks/:id/deliveries/:delivery_id/replay", webhookHandler.ReplayWebhookDelivery)
 		}
 	}
 
@@ -30,6 +30,21 @@ type Config struct {
 	// Sentry Configuration
 	SentryDSN string
 	SentryEnv string
+
+	// Payments Configuration
+	PaymentProvider      string
+	PaymentWebhookSecret string
+	PaymentFakeEnabled   bool
+	QuotaUnitPrice       int64
+	QuotaCurrency        string
+
+	// Notifications Configuration
+	SMTPHost          string
+	SMTPPort          string
+	SMTPUsername      string
+	SMTPPassword      string
+	SMTPFrom          string
+	QuotaLowThreshold int64
 }
 
 func LoadConfig() (*Config, error) {
@@ -59,6 +74,35 @@ func LoadConfig() (*Config, error) {
 		// Sentry Configuration
 		SentryDSN: os.Getenv("SENTRY_DSN"),
 		SentryEnv: os.Getenv("SENTRY_ENV"),
+
+		// Payments Configuration
+		PaymentProvider:      os.Getenv("PAYMENT_PROVIDER"),
+		PaymentWebhookSecret: os.Getenv("PAYMENT_WEBHOOK_SECRET"),
+		PaymentFakeEnabled:   os.Getenv("PAYMENT_FAKE_ENABLED") == "true",
+		QuotaCurrency:        os.Getenv("QUOTA_CURRENCY"),
+
+		// Notifications Configuration
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPPort:     os.Getenv("SMTP_PORT"),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+		SMTPFrom:     os.Getenv("SMTP_FROM"),
+	}
+
+	if price := os.Getenv("QUOTA_UNIT_PRICE"); price != "" {
+		unitPrice, err := strconv.ParseInt(price, 10, 64)
+		if err != nil {
+			return nil, errors.New("QUOTA_UNIT_PRICE must be a number")
+		}
+		cfg.QuotaUnitPrice = unitPrice
+	}
+
+	if threshold := os.Getenv("QUOTA_LOW_THRESHOLD"); threshold != "" {
+		quotaLowThreshold, err := strconv.ParseInt(threshold, 10, 64)
+		if err != nil {
+			return nil, errors.New("QUOTA_LOW_THRESHOLD must be a number")
+		}
+		cfg.QuotaLowThreshold = quotaLowThreshold
 	}
 
 	// Validate configuration
@@ -127,5 +171,38 @@ func (cfg *Config) validate() error {
 	if cfg.SentryEnv == "" {
 		cfg.SentryEnv = "production"
 	}
+	if cfg.PaymentProvider == "fake" && !cfg.PaymentFakeEnabled {
+		// The fake provider confirms payments without charging, dev and test only
+		return errors.New("PAYMENT_PROVIDER=fake requires PAYMENT_FAKE_ENABLED=true")
+	}
+	if (cfg.PaymentProvider != "" || cfg.PaymentFakeEnabled) && cfg.PaymentWebhookSecret == "" {
+		return errors.New("PAYMENT_WEBHOOK_SECRET is not set")
+	}
+	if cfg.QuotaUnitPrice < 0 {
+		return errors.New("QUOTA_UNIT_PRICE must not be negative")
+	}
+	if cfg.QuotaUnitPrice == 0 {
+		cfg.QuotaUnitPrice = 10000 // 100.00 per recognition unit
+	}
+	if cfg.QuotaCurrency == "" {
+		cfg.QuotaCurrency = "RUB"
+	}
+	if cfg.SMTPHost != "" {
+		if cfg.SMTPPort == "" {
+			cfg.SMTPPort = "587"
+		}
+		if _, err := strconv.Atoi(cfg.SMTPPort); err != nil {
+			return errors.New("SMTP_PORT must be a number")
+		}
+		if cfg.SMTPFrom == "" {
+			return errors.New("SMTP_FROM is not set")
+		}
+	}
+	if cfg.QuotaLowThreshold < 0 {
+		return errors.New("QUOTA_LOW_THRESHOLD must not be negative")
+	}
+	if cfg.QuotaLowThreshold == 0 {
+		cfg.QuotaLowThreshold = 10
+	}
 	return nil
 }
@@ -72,6 +72,36 @@ func migrateDB(db *gorm.DB) error {
 		&proto.ClientORM{},
 		&proto.DataRecognitionTaskORM{},
 		&proto.Admin{},
+		&proto.OrderORM{},
+		&proto.TaskStatusEventORM{},
+		&proto.QuotaTransactionORM{},
+		&proto.NotificationPreferencesORM{},
+		&proto.NotificationORM{},
+		&proto.EventCursorORM{},
+		&proto.WebhookEndpointORM{},
+		&proto.WebhookDeliveryORM{},
+		&proto.PriceListORM{},
+		&proto.MaterialPriceORM{},
+		&proto.OperationRateORM{},
+		&proto.CostEstimateORM{},
+		&proto.OperationRuleORM{},
+		&proto.QuoteORM{},
+		&proto.QuoteRevisionORM{},
+		&proto.QuoteItemORM{},
+		&proto.WasteFactorORM{},
+		&proto.StockLengthORM{},
+		&proto.SheetFormatORM{},
+		&proto.MaterialDensityORM{},
+		&proto.MaterialGradeORM{},
+		&proto.UnmatchedMaterialORM{},
+		&proto.AssortmentStandardORM{},
+		&proto.AssortmentStandardSizeORM{},
+		&proto.ValidationRuleORM{},
+		&proto.ValidationIssueORM{},
+		&proto.StandardPartORM{},
+		&proto.TreeRevisionORM{},
+		&proto.RecognitionMetricORM{},
+		&proto.DatasetExportORM{},
 	}
 
 	for _, model := range models {
@@ -2,6 +2,8 @@ package db_hooks
 
 import (
 	"context"
+	"time"
+
 	"gorm.io/gorm"
 
 	"github.com/bazilio91/sferra-cloud/pkg/proto"
@@ -19,15 +21,68 @@ func (sm *StateMachine) processTaskState(tx *gorm.DB) {
 		return
 	}
 
+	// Inside a transaction of the caller the task is not committed yet, and the state machine writing on
+	// its own connection would wait on the row lock of that transaction. The caller runs ProcessTask once
+	// the transaction is committed.
+	if _, ok := tx.Statement.ConnPool.(gorm.TxCommitter); ok {
+		return
+	}
+
 	if task, ok := tx.Statement.Dest.(*proto.DataRecognitionTaskORM); ok {
-		// Skip state machine processing if we're already in a terminal state
-		if types.IsTerminalState(proto.Status(task.Status)) {
-			return
-		}
-		if err := sm.Process(context.Background(), task); err != nil {
+		if err := sm.ProcessTask(context.Background(), task); err != nil {
 			panic(err)
 		}
+	}
+}
 
-		return
+// ProcessTask records the status change of a saved task and moves it through the state machine.
+// Saves run it after their commit, saves inside a transaction leave it to the caller.
+func (sm *StateMachine) ProcessTask(ctx context.Context, task *proto.DataRecognitionTaskORM) error {
+	if err := sm.recordStatusEvent(task); err != nil {
+		return err
+	}
+
+	// Skip state machine processing if we're already in a terminal state
+	if types.IsTerminalState(proto.Status(task.Status)) {
+		return nil
 	}
+
+	return sm.Process(ctx, task)
+}
+
+// recordStatusEvent stores a TaskStatusEvent when the task status differs from the last recorded one.
+// The initial event of a task has FromStatus equal to Status.
+func (sm *StateMachine) recordStatusEvent(task *proto.DataRecognitionTaskORM) error {
+	var last proto.TaskStatusEventORM
+	if err := sm.db.Where("task_id = ?", task.Id).Order("id DESC").Limit(1).Find(&last).Error; err != nil {
+		return err
+	}
+
+	fromStatus := task.Status
+	if last.Id != 0 {
+		if last.Status == task.Status {
+			return nil
+		}
+		fromStatus = last.Status
+	}
+
+	now := time.Now()
+	return sm.db.Create(&proto.TaskStatusEventORM{
+		TaskId:     task.Id,
+		ClientId:   taskClientID(task),
+		FromStatus: fromStatus,
+		Status:     task.Status,
+		CreatedAt:  &now,
+	}).Error
+}
+
+func taskClientID(task *proto.DataRecognitionTaskORM) uint64 {
+	if task.ClientId != nil {
+		return *task.ClientId
+	}
+	if task.Client != nil {
+		return task.Client.Id
+	}
+
+	return 0
 }
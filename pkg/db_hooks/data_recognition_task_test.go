@@ -3,6 +3,7 @@ package db_hooks
 import (
 	"github.com/aws/smithy-go/ptr"
 	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/sandbox"
 	"github.com/bazilio91/sferra-cloud/pkg/testutils"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -12,6 +13,18 @@ import (
 	. "github.com/onsi/gomega"
 )
 
+// typedSizes counts the drawings of the tree with a typed assortment size
+func typedSizes(node *proto.TreeNode) int {
+	count := 0
+	if node.Figure != nil && node.Figure.Assortment != nil && node.Figure.Assortment.Size_ != nil {
+		count++
+	}
+	for _, leaf := range node.Leaves {
+		count += typedSizes(leaf)
+	}
+	return count
+}
+
 func createTestTask(db *gorm.DB, status proto.Status, quota int64, sourceImages []string, processedImages []string) (*proto.DataRecognitionTaskORM, error) {
 	client, err := testutils.CreateTestClient(DB, "Test Client", quota)
 	if err != nil {
@@ -129,4 +142,30 @@ var _ = Describe("DataRecognitionTask", func() {
 			Expect(task.Error).To(BeEmpty())
 		})
 	})
+
+	Describe("sandbox mode", func() {
+		It("should complete the task with the fixture result without consuming quota", func() {
+			task, err := createTestTask(DB, proto.Status_STATUS_READY_FOR_PROCESSING, 0, []string{"image1.jpg", "image2.jpg"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			task.Sandbox = true
+
+			err = DB.Save(task).Error
+			Expect(err).NotTo(HaveOccurred())
+
+			// Reload task to get updated state
+			err = DB.Model(&proto.DataRecognitionTaskORM{}).Preload("Client").First(task, "id = ?", task.Id).Error
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(task.Status).To(Equal(int32(proto.Status_STATUS_PROCESSING_COMPLETED)))
+			Expect(task.WorkerId).To(Equal(sandbox.WorkerID))
+			Expect([]string(task.ProcessedImages)).To(Equal([]string{"image1.jpg", "image2.jpg"}))
+			Expect(task.FrontendResultFlat).NotTo(BeNil())
+			Expect(task.Client.Quota).To(Equal(int64(0)))
+
+			// the fixture goes through the same pipeline as worker results
+			Expect(task.RecognitionResult).NotTo(BeNil())
+			tree := task.RecognitionResult.Data()
+			Expect(typedSizes(&tree)).To(BeNumerically(">", 0))
+		})
+	})
 })
@@ -12,6 +12,8 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/sandbox"
+	"github.com/bazilio91/sferra-cloud/pkg/services/recognition"
 	"github.com/bazilio91/sferra-cloud/pkg/types"
 )
 
@@ -35,6 +37,7 @@ type TaskSubscriber struct {
 // StateMachine handles the state transitions for DataRecognitionTask
 type StateMachine struct {
 	db          *gorm.DB
+	pipeline    *recognition.Pipeline
 	subscribers map[string]*TaskSubscriber
 	mu          sync.RWMutex
 }
@@ -43,6 +46,7 @@ type StateMachine struct {
 func NewStateMachine(db *gorm.DB) *StateMachine {
 	sm := &StateMachine{
 		db:          db,
+		pipeline:    recognition.NewPipeline(db),
 		subscribers: make(map[string]*TaskSubscriber),
 	}
 
@@ -78,6 +82,11 @@ func (sm *StateMachine) Unsubscribe(subscriberId string) {
 
 // notifySubscribers sends task updates to all relevant subscribers
 func (sm *StateMachine) notifySubscribers(task *proto.DataRecognitionTaskORM) {
+	// Sandbox tasks are never handed out to real workers
+	if task.Sandbox {
+		return
+	}
+
 	sm.mu.RLock()
 	defer sm.mu.RUnlock()
 
@@ -160,6 +169,12 @@ func (sm *StateMachine) handleReadyForProcessing(ctx context.Context, task *prot
 		return sm.db.Save(task).Error
 	}
 
+	// Sandbox tasks don't consume quota
+	if task.Sandbox {
+		task.Status = int32(proto.Status_STATUS_IMAGES_PENDING)
+		return sm.db.Save(task).Error
+	}
+
 	// Check quota
 	if task.Client.Quota <= 0 {
 		task.Error = "insufficient quota"
@@ -172,6 +187,9 @@ func (sm *StateMachine) handleReadyForProcessing(ctx context.Context, task *prot
 	if err := sm.db.Save(&task.Client).Error; err != nil {
 		return fmt.Errorf("failed to update client quota: %w", err)
 	}
+	if err := sm.recordQuotaTransaction(task.Client, task, -int64(len(task.SourceImages)), proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_IMAGE_PROCESSING); err != nil {
+		return err
+	}
 
 	// Move to images pending state
 	task.Status = int32(proto.Status_STATUS_IMAGES_PENDING)
@@ -179,6 +197,11 @@ func (sm *StateMachine) handleReadyForProcessing(ctx context.Context, task *prot
 }
 
 func (sm *StateMachine) handleImagesPending(ctx context.Context, task *proto.DataRecognitionTaskORM) error {
+	if task.Sandbox {
+		sandbox.ProcessImages(task)
+		return sm.db.Save(task).Error
+	}
+
 	sm.notifySubscribers(task)
 	return nil
 }
@@ -201,6 +224,12 @@ func (sm *StateMachine) handleImagesProcessing(ctx context.Context, task *proto.
 }
 
 func (sm *StateMachine) handleImagesCompleted(ctx context.Context, task *proto.DataRecognitionTaskORM) error {
+	// Sandbox tasks don't consume quota
+	if task.Sandbox {
+		task.Status = int32(proto.Status_STATUS_RECOGNITION_PENDING)
+		return sm.db.Save(task).Error
+	}
+
 	// Check if client has enough quota for recognition
 	var client proto.ClientORM
 	if err := sm.db.First(&client, task.ClientId).Error; err != nil {
@@ -219,6 +248,9 @@ func (sm *StateMachine) handleImagesCompleted(ctx context.Context, task *proto.D
 	if err := sm.db.Save(&client).Error; err != nil {
 		return fmt.Errorf("failed to update client quota: %w", err)
 	}
+	if err := sm.recordQuotaTransaction(&client, task, -1, proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_RECOGNITION); err != nil {
+		return err
+	}
 
 	// Start recognition
 	task.Status = int32(proto.Status_STATUS_RECOGNITION_PENDING)
@@ -227,6 +259,17 @@ func (sm *StateMachine) handleImagesCompleted(ctx context.Context, task *proto.D
 }
 
 func (sm *StateMachine) handleRecognitionPending(ctx context.Context, task *proto.DataRecognitionTaskORM) error {
+	if task.Sandbox {
+		result, err := sandbox.Recognize(task)
+		if err != nil {
+			return err
+		}
+		if err := sm.pipeline.Apply(ctx, task, result); err != nil {
+			return err
+		}
+		return sm.db.Save(task).Error
+	}
+
 	sm.notifySubscribers(task)
 	return nil
 }
@@ -255,19 +298,28 @@ func (sm *StateMachine) handleImagesFailedQuota(ctx context.Context, task *proto
 	return nil
 }
 
-func nodeFlatten(node proto.TreeNode, nodes []interface{}) []interface{} {
-	for _, child := range node.Leaves {
-		child.ParentId = node.Id
-		nodes = nodeFlatten(*child, nodes)
+// recordQuotaTransaction adds a ledger entry for a quota change caused by the task
+func (sm *StateMachine) recordQuotaTransaction(client *proto.ClientORM, task *proto.DataRecognitionTaskORM, amount int64, reason proto.QuotaTransactionReason) error {
+	now := time.Now()
+	err := sm.db.Create(&proto.QuotaTransactionORM{
+		ClientId:  client.Id,
+		Reason:    int32(reason),
+		Amount:    amount,
+		Balance:   client.Quota,
+		TaskId:    task.Id,
+		CreatedAt: &now,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to record quota transaction: %w", err)
 	}
 
-	return append(nodes, node)
+	return nil
 }
 
 func (sm *StateMachine) handleRecognitionCompleted(ctx context.Context, task *proto.DataRecognitionTaskORM) error {
 	// flatten the recognition result
 	node := task.RecognitionResult.Data()
-	nodes := nodeFlatten(node, []interface{}{})
+	nodes := types.FlattenTree(node)
 
 	bytes, err := json.Marshal(nodes)
 	if err != nil {
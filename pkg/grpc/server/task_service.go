@@ -2,26 +2,31 @@ package server
 
 import (
 	"context"
+	"errors"
 	"github.com/bazilio91/sferra-cloud/pkg/db_hooks"
 	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/counts"
+	"github.com/bazilio91/sferra-cloud/pkg/services/recognition"
 	"github.com/bazilio91/sferra-cloud/pkg/types"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"log"
 )
 
 type TaskService struct {
 	proto.UnimplementedTaskServiceServer
 	db           *gorm.DB
 	stateMachine *db_hooks.StateMachine
+	pipeline     *recognition.Pipeline
 }
 
 func NewTaskService(db *gorm.DB, machine *db_hooks.StateMachine) *TaskService {
 	return &TaskService{
 		db:           db,
 		stateMachine: machine,
+		pipeline:     recognition.NewPipeline(db),
 	}
 }
 
@@ -37,7 +42,7 @@ func (s *TaskService) Subscribe(req *proto.SubscribeRequest, stream proto.TaskSe
 		subscribeToStatus = proto.Status_STATUS_RECOGNITION_PENDING
 	}
 
-	if err := s.db.Where("status = ?", int32(subscribeToStatus)).Find(&existingTasks).Error; err != nil {
+	if err := s.db.Where("status = ? AND sandbox = ?", int32(subscribeToStatus), false).Find(&existingTasks).Error; err != nil {
 		return status.Errorf(codes.Internal, "failed to query existing tasks")
 	}
 
@@ -81,6 +86,11 @@ func (s *TaskService) ReserveTask(ctx context.Context, req *proto.ReserveTaskReq
 		return &proto.ReserveTaskResponse{Success: false}, status.Errorf(codes.FailedPrecondition, "task is in terminal state")
 	}
 
+	// Sandbox tasks are processed by the built-in fake worker
+	if task.Sandbox {
+		return &proto.ReserveTaskResponse{Success: false}, status.Errorf(codes.FailedPrecondition, "task is a sandbox task")
+	}
+
 	task.WorkerId = req.WorkerId
 	if err := s.db.Save(&task).Error; err != nil {
 		return &proto.ReserveTaskResponse{Success: false}, status.Errorf(codes.Internal, "failed to update task")
@@ -126,8 +136,14 @@ func (s *TaskService) FinishTask(ctx context.Context, req *proto.FinishTaskReque
 		taskOrm.ProcessedImages = req.ProcessedImages
 	case proto.Status_STATUS_RECOGNITION_PROCESSING:
 		taskOrm.Status = int32(proto.Status_STATUS_RECOGNITION_COMPLETED)
-		result := datatypes.NewJSONType[proto.TreeNode](*req.RecognitionResult)
-		taskOrm.RecognitionResult = &result
+		taskOrm.ModelVersion = req.ModelVersion
+		if err := s.pipeline.Apply(ctx, &taskOrm, req.RecognitionResult); err != nil {
+			log.Printf("failed to process recognition result of task %s: %v", taskOrm.Id, err)
+			if errors.Is(err, counts.ErrCountOverflow) {
+				return &proto.Ack{Success: false}, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return &proto.Ack{Success: false}, status.Errorf(codes.Internal, "failed to process recognition result")
+		}
 	}
 
 	if err := s.db.Save(&taskOrm).Error; err != nil {
@@ -0,0 +1,497 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type RecognitionMetricORM struct {
+	ApprovedAt   *time.Time `gorm:"index:idx_recognition_metrics_approved_at"`
+	ClientId     uint64     `gorm:"index:idx_recognition_metrics_client_id"`
+	Corrected    int32
+	Field        string
+	Id           uint64
+	ModelVersion string
+	TaskId       string `gorm:"type:uuid;index:idx_recognition_metrics_task_id"`
+	Total        int32
+	WorkerId     string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (RecognitionMetricORM) TableName() string {
+	return "recognition_metrics"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *RecognitionMetric) ToORM(ctx context.Context) (RecognitionMetricORM, error) {
+	to := RecognitionMetricORM{}
+	var err error
+	if prehook, ok := interface{}(m).(RecognitionMetricWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.ClientId = m.ClientId
+	to.WorkerId = m.WorkerId
+	to.ModelVersion = m.ModelVersion
+	to.Field = m.Field
+	to.Total = m.Total
+	to.Corrected = m.Corrected
+	if m.ApprovedAt != nil {
+		t := m.ApprovedAt.AsTime()
+		to.ApprovedAt = &t
+	}
+	if posthook, ok := interface{}(m).(RecognitionMetricWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *RecognitionMetricORM) ToPB(ctx context.Context) (RecognitionMetric, error) {
+	to := RecognitionMetric{}
+	var err error
+	if prehook, ok := interface{}(m).(RecognitionMetricWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.ClientId = m.ClientId
+	to.WorkerId = m.WorkerId
+	to.ModelVersion = m.ModelVersion
+	to.Field = m.Field
+	to.Total = m.Total
+	to.Corrected = m.Corrected
+	if m.ApprovedAt != nil {
+		to.ApprovedAt = timestamppb.New(*m.ApprovedAt)
+	}
+	if posthook, ok := interface{}(m).(RecognitionMetricWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type RecognitionMetric the arg will be the target, the caller the one being converted from
+
+// RecognitionMetricBeforeToORM called before default ToORM code
+type RecognitionMetricWithBeforeToORM interface {
+	BeforeToORM(context.Context, *RecognitionMetricORM) error
+}
+
+// RecognitionMetricAfterToORM called after default ToORM code
+type RecognitionMetricWithAfterToORM interface {
+	AfterToORM(context.Context, *RecognitionMetricORM) error
+}
+
+// RecognitionMetricBeforeToPB called before default ToPB code
+type RecognitionMetricWithBeforeToPB interface {
+	BeforeToPB(context.Context, *RecognitionMetric) error
+}
+
+// RecognitionMetricAfterToPB called after default ToPB code
+type RecognitionMetricWithAfterToPB interface {
+	AfterToPB(context.Context, *RecognitionMetric) error
+}
+
+// DefaultCreateRecognitionMetric executes a basic gorm create call
+func DefaultCreateRecognitionMetric(ctx context.Context, in *RecognitionMetric, db *gorm.DB) (*RecognitionMetric, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RecognitionMetricORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadRecognitionMetric(ctx context.Context, in *RecognitionMetric, db *gorm.DB) (*RecognitionMetric, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := RecognitionMetricORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(RecognitionMetricORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RecognitionMetricORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRecognitionMetric(ctx context.Context, in *RecognitionMetric, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&RecognitionMetricORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type RecognitionMetricORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRecognitionMetricSet(ctx context.Context, in []*RecognitionMetric, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&RecognitionMetricORM{})).(RecognitionMetricORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&RecognitionMetricORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&RecognitionMetricORM{})).(RecognitionMetricORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type RecognitionMetricORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*RecognitionMetric, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*RecognitionMetric, *gorm.DB) error
+}
+
+// DefaultStrictUpdateRecognitionMetric clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateRecognitionMetric(ctx context.Context, in *RecognitionMetric, db *gorm.DB) (*RecognitionMetric, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateRecognitionMetric")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &RecognitionMetricORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type RecognitionMetricORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchRecognitionMetric executes a basic gorm update call with patch behavior
+func DefaultPatchRecognitionMetric(ctx context.Context, in *RecognitionMetric, updateMask *field_mask.FieldMask, db *gorm.DB) (*RecognitionMetric, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj RecognitionMetric
+	var err error
+	if hook, ok := interface{}(&pbObj).(RecognitionMetricWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadRecognitionMetric(ctx, &RecognitionMetric{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(RecognitionMetricWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskRecognitionMetric(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(RecognitionMetricWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateRecognitionMetric(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(RecognitionMetricWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type RecognitionMetricWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *RecognitionMetric, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *RecognitionMetric, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *RecognitionMetric, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *RecognitionMetric, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetRecognitionMetric executes a bulk gorm update call with patch behavior
+func DefaultPatchSetRecognitionMetric(ctx context.Context, objects []*RecognitionMetric, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*RecognitionMetric, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*RecognitionMetric, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchRecognitionMetric(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskRecognitionMetric patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskRecognitionMetric(ctx context.Context, patchee *RecognitionMetric, patcher *RecognitionMetric, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*RecognitionMetric, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedApprovedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TaskId" {
+			patchee.TaskId = patcher.TaskId
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"WorkerId" {
+			patchee.WorkerId = patcher.WorkerId
+			continue
+		}
+		if f == prefix+"ModelVersion" {
+			patchee.ModelVersion = patcher.ModelVersion
+			continue
+		}
+		if f == prefix+"Field" {
+			patchee.Field = patcher.Field
+			continue
+		}
+		if f == prefix+"Total" {
+			patchee.Total = patcher.Total
+			continue
+		}
+		if f == prefix+"Corrected" {
+			patchee.Corrected = patcher.Corrected
+			continue
+		}
+		if !updatedApprovedAt && strings.HasPrefix(f, prefix+"ApprovedAt.") {
+			if patcher.ApprovedAt == nil {
+				patchee.ApprovedAt = nil
+				continue
+			}
+			if patchee.ApprovedAt == nil {
+				patchee.ApprovedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ApprovedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ApprovedAt, patchee.ApprovedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ApprovedAt" {
+			updatedApprovedAt = true
+			patchee.ApprovedAt = patcher.ApprovedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListRecognitionMetric executes a gorm list call
+func DefaultListRecognitionMetric(ctx context.Context, db *gorm.DB) ([]*RecognitionMetric, error) {
+	in := RecognitionMetric{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []RecognitionMetricORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecognitionMetricORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*RecognitionMetric{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type RecognitionMetricORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecognitionMetricORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]RecognitionMetricORM) error
+}
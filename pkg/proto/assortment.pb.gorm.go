@@ -0,0 +1,954 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type AssortmentStandardORM struct {
+	CreatedAt   *time.Time
+	Designation string `gorm:"uniqueIndex:idx_assortment_standards_designation"`
+	Family      int32
+	Id          uint64
+	Sizes       []*AssortmentStandardSizeORM `gorm:"foreignKey:AssortmentStandardId;references:Id"`
+	Title       string
+	UpdatedAt   *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (AssortmentStandardORM) TableName() string {
+	return "assortment_standards"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *AssortmentStandard) ToORM(ctx context.Context) (AssortmentStandardORM, error) {
+	to := AssortmentStandardORM{}
+	var err error
+	if prehook, ok := interface{}(m).(AssortmentStandardWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Designation = m.Designation
+	to.Title = m.Title
+	to.Family = m.Family
+	for _, v := range m.Sizes {
+		if v != nil {
+			if tempSizes, cErr := v.ToORM(ctx); cErr == nil {
+				to.Sizes = append(to.Sizes, &tempSizes)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Sizes = append(to.Sizes, nil)
+		}
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(AssortmentStandardWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *AssortmentStandardORM) ToPB(ctx context.Context) (AssortmentStandard, error) {
+	to := AssortmentStandard{}
+	var err error
+	if prehook, ok := interface{}(m).(AssortmentStandardWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Designation = m.Designation
+	to.Title = m.Title
+	to.Family = m.Family
+	for _, v := range m.Sizes {
+		if v != nil {
+			if tempSizes, cErr := v.ToPB(ctx); cErr == nil {
+				to.Sizes = append(to.Sizes, &tempSizes)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Sizes = append(to.Sizes, nil)
+		}
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(AssortmentStandardWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type AssortmentStandard the arg will be the target, the caller the one being converted from
+
+// AssortmentStandardBeforeToORM called before default ToORM code
+type AssortmentStandardWithBeforeToORM interface {
+	BeforeToORM(context.Context, *AssortmentStandardORM) error
+}
+
+// AssortmentStandardAfterToORM called after default ToORM code
+type AssortmentStandardWithAfterToORM interface {
+	AfterToORM(context.Context, *AssortmentStandardORM) error
+}
+
+// AssortmentStandardBeforeToPB called before default ToPB code
+type AssortmentStandardWithBeforeToPB interface {
+	BeforeToPB(context.Context, *AssortmentStandard) error
+}
+
+// AssortmentStandardAfterToPB called after default ToPB code
+type AssortmentStandardWithAfterToPB interface {
+	AfterToPB(context.Context, *AssortmentStandard) error
+}
+
+type AssortmentStandardSizeORM struct {
+	AssortmentStandardId *uint64 `gorm:"index:idx_assortment_standard_sizes_assortment_standard_id"`
+	Id                   uint64
+	Size                 string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (AssortmentStandardSizeORM) TableName() string {
+	return "assortment_standard_sizes"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *AssortmentStandardSize) ToORM(ctx context.Context) (AssortmentStandardSizeORM, error) {
+	to := AssortmentStandardSizeORM{}
+	var err error
+	if prehook, ok := interface{}(m).(AssortmentStandardSizeWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.AssortmentStandardId = m.AssortmentStandardId
+	to.Size = m.Size
+	if posthook, ok := interface{}(m).(AssortmentStandardSizeWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *AssortmentStandardSizeORM) ToPB(ctx context.Context) (AssortmentStandardSize, error) {
+	to := AssortmentStandardSize{}
+	var err error
+	if prehook, ok := interface{}(m).(AssortmentStandardSizeWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.AssortmentStandardId = m.AssortmentStandardId
+	to.Size = m.Size
+	if posthook, ok := interface{}(m).(AssortmentStandardSizeWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type AssortmentStandardSize the arg will be the target, the caller the one being converted from
+
+// AssortmentStandardSizeBeforeToORM called before default ToORM code
+type AssortmentStandardSizeWithBeforeToORM interface {
+	BeforeToORM(context.Context, *AssortmentStandardSizeORM) error
+}
+
+// AssortmentStandardSizeAfterToORM called after default ToORM code
+type AssortmentStandardSizeWithAfterToORM interface {
+	AfterToORM(context.Context, *AssortmentStandardSizeORM) error
+}
+
+// AssortmentStandardSizeBeforeToPB called before default ToPB code
+type AssortmentStandardSizeWithBeforeToPB interface {
+	BeforeToPB(context.Context, *AssortmentStandardSize) error
+}
+
+// AssortmentStandardSizeAfterToPB called after default ToPB code
+type AssortmentStandardSizeWithAfterToPB interface {
+	AfterToPB(context.Context, *AssortmentStandardSize) error
+}
+
+// DefaultCreateAssortmentStandard executes a basic gorm create call
+func DefaultCreateAssortmentStandard(ctx context.Context, in *AssortmentStandard, db *gorm.DB) (*AssortmentStandard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Preload("Sizes").Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AssortmentStandardORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadAssortmentStandard(ctx context.Context, in *AssortmentStandard, db *gorm.DB) (*AssortmentStandard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := AssortmentStandardORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(AssortmentStandardORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AssortmentStandardORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAssortmentStandard(ctx context.Context, in *AssortmentStandard, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&AssortmentStandardORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type AssortmentStandardORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAssortmentStandardSet(ctx context.Context, in []*AssortmentStandard, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&AssortmentStandardORM{})).(AssortmentStandardORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&AssortmentStandardORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&AssortmentStandardORM{})).(AssortmentStandardORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type AssortmentStandardORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*AssortmentStandard, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*AssortmentStandard, *gorm.DB) error
+}
+
+// DefaultStrictUpdateAssortmentStandard clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateAssortmentStandard(ctx context.Context, in *AssortmentStandard, db *gorm.DB) (*AssortmentStandard, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAssortmentStandard")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &AssortmentStandardORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterSizes := AssortmentStandardSizeORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterSizes.AssortmentStandardId = new(uint64)
+	*filterSizes.AssortmentStandardId = ormObj.Id
+	if err = db.Where(filterSizes).Delete(AssortmentStandardSizeORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Preload("Sizes").Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type AssortmentStandardORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchAssortmentStandard executes a basic gorm update call with patch behavior
+func DefaultPatchAssortmentStandard(ctx context.Context, in *AssortmentStandard, updateMask *field_mask.FieldMask, db *gorm.DB) (*AssortmentStandard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj AssortmentStandard
+	var err error
+	if hook, ok := interface{}(&pbObj).(AssortmentStandardWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadAssortmentStandard(ctx, &AssortmentStandard{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(AssortmentStandardWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskAssortmentStandard(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(AssortmentStandardWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateAssortmentStandard(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(AssortmentStandardWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type AssortmentStandardWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *AssortmentStandard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *AssortmentStandard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *AssortmentStandard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *AssortmentStandard, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetAssortmentStandard executes a bulk gorm update call with patch behavior
+func DefaultPatchSetAssortmentStandard(ctx context.Context, objects []*AssortmentStandard, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*AssortmentStandard, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*AssortmentStandard, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchAssortmentStandard(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskAssortmentStandard patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskAssortmentStandard(ctx context.Context, patchee *AssortmentStandard, patcher *AssortmentStandard, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*AssortmentStandard, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Designation" {
+			patchee.Designation = patcher.Designation
+			continue
+		}
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if f == prefix+"Family" {
+			patchee.Family = patcher.Family
+			continue
+		}
+		if f == prefix+"Sizes" {
+			patchee.Sizes = patcher.Sizes
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListAssortmentStandard executes a gorm list call
+func DefaultListAssortmentStandard(ctx context.Context, db *gorm.DB) ([]*AssortmentStandard, error) {
+	in := AssortmentStandard{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []AssortmentStandardORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*AssortmentStandard{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type AssortmentStandardORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]AssortmentStandardORM) error
+}
+
+// DefaultCreateAssortmentStandardSize executes a basic gorm create call
+func DefaultCreateAssortmentStandardSize(ctx context.Context, in *AssortmentStandardSize, db *gorm.DB) (*AssortmentStandardSize, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AssortmentStandardSizeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadAssortmentStandardSize(ctx context.Context, in *AssortmentStandardSize, db *gorm.DB) (*AssortmentStandardSize, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := AssortmentStandardSizeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(AssortmentStandardSizeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AssortmentStandardSizeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAssortmentStandardSize(ctx context.Context, in *AssortmentStandardSize, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&AssortmentStandardSizeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type AssortmentStandardSizeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAssortmentStandardSizeSet(ctx context.Context, in []*AssortmentStandardSize, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&AssortmentStandardSizeORM{})).(AssortmentStandardSizeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&AssortmentStandardSizeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&AssortmentStandardSizeORM{})).(AssortmentStandardSizeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type AssortmentStandardSizeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*AssortmentStandardSize, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*AssortmentStandardSize, *gorm.DB) error
+}
+
+// DefaultStrictUpdateAssortmentStandardSize clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateAssortmentStandardSize(ctx context.Context, in *AssortmentStandardSize, db *gorm.DB) (*AssortmentStandardSize, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAssortmentStandardSize")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &AssortmentStandardSizeORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type AssortmentStandardSizeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchAssortmentStandardSize executes a basic gorm update call with patch behavior
+func DefaultPatchAssortmentStandardSize(ctx context.Context, in *AssortmentStandardSize, updateMask *field_mask.FieldMask, db *gorm.DB) (*AssortmentStandardSize, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj AssortmentStandardSize
+	var err error
+	if hook, ok := interface{}(&pbObj).(AssortmentStandardSizeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadAssortmentStandardSize(ctx, &AssortmentStandardSize{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(AssortmentStandardSizeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskAssortmentStandardSize(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(AssortmentStandardSizeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateAssortmentStandardSize(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(AssortmentStandardSizeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type AssortmentStandardSizeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *AssortmentStandardSize, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *AssortmentStandardSize, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *AssortmentStandardSize, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *AssortmentStandardSize, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetAssortmentStandardSize executes a bulk gorm update call with patch behavior
+func DefaultPatchSetAssortmentStandardSize(ctx context.Context, objects []*AssortmentStandardSize, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*AssortmentStandardSize, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*AssortmentStandardSize, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchAssortmentStandardSize(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskAssortmentStandardSize patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskAssortmentStandardSize(ctx context.Context, patchee *AssortmentStandardSize, patcher *AssortmentStandardSize, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*AssortmentStandardSize, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"AssortmentStandardId" {
+			patchee.AssortmentStandardId = patcher.AssortmentStandardId
+			continue
+		}
+		if f == prefix+"Size" {
+			patchee.Size = patcher.Size
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListAssortmentStandardSize executes a gorm list call
+func DefaultListAssortmentStandardSize(ctx context.Context, db *gorm.DB) ([]*AssortmentStandardSize, error) {
+	in := AssortmentStandardSize{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []AssortmentStandardSizeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AssortmentStandardSizeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*AssortmentStandardSize{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type AssortmentStandardSizeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AssortmentStandardSizeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]AssortmentStandardSizeORM) error
+}
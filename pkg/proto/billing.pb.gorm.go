@@ -0,0 +1,1059 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type OrderORM struct {
+	Amount          int64
+	ClientId        uint64 `gorm:"index:idx_orders_client_id"`
+	ConfirmationUrl string
+	CreatedAt       *time.Time
+	Currency        string
+	Error           string
+	ExternalId      string `gorm:"uniqueIndex:idx_orders_provider_external_id"`
+	Id              string `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	PaidAt          *time.Time
+	Provider        string `gorm:"uniqueIndex:idx_orders_provider_external_id"`
+	Quota           int64
+	Status          int32
+	UpdatedAt       *time.Time
+	UserId          uint64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (OrderORM) TableName() string {
+	return "orders"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Order) ToORM(ctx context.Context) (OrderORM, error) {
+	to := OrderORM{}
+	var err error
+	if prehook, ok := interface{}(m).(OrderWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.UserId = m.UserId
+	to.Status = int32(m.Status)
+	to.Quota = m.Quota
+	to.Amount = m.Amount
+	to.Currency = m.Currency
+	to.Provider = m.Provider
+	to.ExternalId = m.ExternalId
+	to.ConfirmationUrl = m.ConfirmationUrl
+	to.Error = m.Error
+	if m.PaidAt != nil {
+		t := m.PaidAt.AsTime()
+		to.PaidAt = &t
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(OrderWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *OrderORM) ToPB(ctx context.Context) (Order, error) {
+	to := Order{}
+	var err error
+	if prehook, ok := interface{}(m).(OrderWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.UserId = m.UserId
+	to.Status = OrderStatus(m.Status)
+	to.Quota = m.Quota
+	to.Amount = m.Amount
+	to.Currency = m.Currency
+	to.Provider = m.Provider
+	to.ExternalId = m.ExternalId
+	to.ConfirmationUrl = m.ConfirmationUrl
+	to.Error = m.Error
+	if m.PaidAt != nil {
+		to.PaidAt = timestamppb.New(*m.PaidAt)
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(OrderWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Order the arg will be the target, the caller the one being converted from
+
+// OrderBeforeToORM called before default ToORM code
+type OrderWithBeforeToORM interface {
+	BeforeToORM(context.Context, *OrderORM) error
+}
+
+// OrderAfterToORM called after default ToORM code
+type OrderWithAfterToORM interface {
+	AfterToORM(context.Context, *OrderORM) error
+}
+
+// OrderBeforeToPB called before default ToPB code
+type OrderWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Order) error
+}
+
+// OrderAfterToPB called after default ToPB code
+type OrderWithAfterToPB interface {
+	AfterToPB(context.Context, *Order) error
+}
+
+type QuotaTransactionORM struct {
+	Amount    int64
+	Balance   int64
+	ClientId  uint64 `gorm:"index:idx_quota_transactions_client_id"`
+	CreatedAt *time.Time
+	Id        uint64
+	OrderId   string
+	Reason    int32
+	TaskId    string `gorm:"index:idx_quota_transactions_task_id"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (QuotaTransactionORM) TableName() string {
+	return "quota_transactions"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *QuotaTransaction) ToORM(ctx context.Context) (QuotaTransactionORM, error) {
+	to := QuotaTransactionORM{}
+	var err error
+	if prehook, ok := interface{}(m).(QuotaTransactionWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Reason = int32(m.Reason)
+	to.Amount = m.Amount
+	to.Balance = m.Balance
+	to.TaskId = m.TaskId
+	to.OrderId = m.OrderId
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(QuotaTransactionWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *QuotaTransactionORM) ToPB(ctx context.Context) (QuotaTransaction, error) {
+	to := QuotaTransaction{}
+	var err error
+	if prehook, ok := interface{}(m).(QuotaTransactionWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Reason = QuotaTransactionReason(m.Reason)
+	to.Amount = m.Amount
+	to.Balance = m.Balance
+	to.TaskId = m.TaskId
+	to.OrderId = m.OrderId
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(QuotaTransactionWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type QuotaTransaction the arg will be the target, the caller the one being converted from
+
+// QuotaTransactionBeforeToORM called before default ToORM code
+type QuotaTransactionWithBeforeToORM interface {
+	BeforeToORM(context.Context, *QuotaTransactionORM) error
+}
+
+// QuotaTransactionAfterToORM called after default ToORM code
+type QuotaTransactionWithAfterToORM interface {
+	AfterToORM(context.Context, *QuotaTransactionORM) error
+}
+
+// QuotaTransactionBeforeToPB called before default ToPB code
+type QuotaTransactionWithBeforeToPB interface {
+	BeforeToPB(context.Context, *QuotaTransaction) error
+}
+
+// QuotaTransactionAfterToPB called after default ToPB code
+type QuotaTransactionWithAfterToPB interface {
+	AfterToPB(context.Context, *QuotaTransaction) error
+}
+
+// DefaultCreateOrder executes a basic gorm create call
+func DefaultCreateOrder(ctx context.Context, in *Order, db *gorm.DB) (*Order, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type OrderORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OrderORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadOrder(ctx context.Context, in *Order, db *gorm.DB) (*Order, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := OrderORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(OrderORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type OrderORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OrderORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OrderORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteOrder(ctx context.Context, in *Order, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&OrderORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type OrderORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OrderORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteOrderSet(ctx context.Context, in []*Order, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&OrderORM{})).(OrderORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&OrderORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&OrderORM{})).(OrderORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type OrderORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Order, *gorm.DB) (*gorm.DB, error)
+}
+type OrderORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Order, *gorm.DB) error
+}
+
+// DefaultStrictUpdateOrder clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateOrder(ctx context.Context, in *Order, db *gorm.DB) (*Order, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateOrder")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &OrderORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(OrderORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type OrderORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OrderORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OrderORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchOrder executes a basic gorm update call with patch behavior
+func DefaultPatchOrder(ctx context.Context, in *Order, updateMask *field_mask.FieldMask, db *gorm.DB) (*Order, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Order
+	var err error
+	if hook, ok := interface{}(&pbObj).(OrderWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadOrder(ctx, &Order{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(OrderWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskOrder(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(OrderWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateOrder(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(OrderWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type OrderWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Order, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OrderWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Order, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OrderWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Order, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OrderWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Order, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetOrder executes a bulk gorm update call with patch behavior
+func DefaultPatchSetOrder(ctx context.Context, objects []*Order, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Order, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Order, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchOrder(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskOrder patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskOrder(ctx context.Context, patchee *Order, patcher *Order, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Order, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedPaidAt bool
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"Quota" {
+			patchee.Quota = patcher.Quota
+			continue
+		}
+		if f == prefix+"Amount" {
+			patchee.Amount = patcher.Amount
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"Provider" {
+			patchee.Provider = patcher.Provider
+			continue
+		}
+		if f == prefix+"ExternalId" {
+			patchee.ExternalId = patcher.ExternalId
+			continue
+		}
+		if f == prefix+"ConfirmationUrl" {
+			patchee.ConfirmationUrl = patcher.ConfirmationUrl
+			continue
+		}
+		if f == prefix+"Error" {
+			patchee.Error = patcher.Error
+			continue
+		}
+		if !updatedPaidAt && strings.HasPrefix(f, prefix+"PaidAt.") {
+			if patcher.PaidAt == nil {
+				patchee.PaidAt = nil
+				continue
+			}
+			if patchee.PaidAt == nil {
+				patchee.PaidAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"PaidAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.PaidAt, patchee.PaidAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"PaidAt" {
+			updatedPaidAt = true
+			patchee.PaidAt = patcher.PaidAt
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListOrder executes a gorm list call
+func DefaultListOrder(ctx context.Context, db *gorm.DB) ([]*Order, error) {
+	in := Order{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []OrderORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OrderORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Order{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type OrderORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OrderORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OrderORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]OrderORM) error
+}
+
+// DefaultCreateQuotaTransaction executes a basic gorm create call
+func DefaultCreateQuotaTransaction(ctx context.Context, in *QuotaTransaction, db *gorm.DB) (*QuotaTransaction, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type QuotaTransactionORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadQuotaTransaction(ctx context.Context, in *QuotaTransaction, db *gorm.DB) (*QuotaTransaction, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := QuotaTransactionORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(QuotaTransactionORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type QuotaTransactionORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteQuotaTransaction(ctx context.Context, in *QuotaTransaction, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&QuotaTransactionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type QuotaTransactionORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteQuotaTransactionSet(ctx context.Context, in []*QuotaTransaction, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&QuotaTransactionORM{})).(QuotaTransactionORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&QuotaTransactionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&QuotaTransactionORM{})).(QuotaTransactionORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type QuotaTransactionORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*QuotaTransaction, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*QuotaTransaction, *gorm.DB) error
+}
+
+// DefaultStrictUpdateQuotaTransaction clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateQuotaTransaction(ctx context.Context, in *QuotaTransaction, db *gorm.DB) (*QuotaTransaction, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateQuotaTransaction")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &QuotaTransactionORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type QuotaTransactionORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchQuotaTransaction executes a basic gorm update call with patch behavior
+func DefaultPatchQuotaTransaction(ctx context.Context, in *QuotaTransaction, updateMask *field_mask.FieldMask, db *gorm.DB) (*QuotaTransaction, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj QuotaTransaction
+	var err error
+	if hook, ok := interface{}(&pbObj).(QuotaTransactionWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadQuotaTransaction(ctx, &QuotaTransaction{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(QuotaTransactionWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskQuotaTransaction(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(QuotaTransactionWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateQuotaTransaction(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(QuotaTransactionWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type QuotaTransactionWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *QuotaTransaction, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *QuotaTransaction, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *QuotaTransaction, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *QuotaTransaction, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetQuotaTransaction executes a bulk gorm update call with patch behavior
+func DefaultPatchSetQuotaTransaction(ctx context.Context, objects []*QuotaTransaction, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*QuotaTransaction, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*QuotaTransaction, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchQuotaTransaction(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskQuotaTransaction patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskQuotaTransaction(ctx context.Context, patchee *QuotaTransaction, patcher *QuotaTransaction, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*QuotaTransaction, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"Reason" {
+			patchee.Reason = patcher.Reason
+			continue
+		}
+		if f == prefix+"Amount" {
+			patchee.Amount = patcher.Amount
+			continue
+		}
+		if f == prefix+"Balance" {
+			patchee.Balance = patcher.Balance
+			continue
+		}
+		if f == prefix+"TaskId" {
+			patchee.TaskId = patcher.TaskId
+			continue
+		}
+		if f == prefix+"OrderId" {
+			patchee.OrderId = patcher.OrderId
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListQuotaTransaction executes a gorm list call
+func DefaultListQuotaTransaction(ctx context.Context, db *gorm.DB) ([]*QuotaTransaction, error) {
+	in := QuotaTransaction{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []QuotaTransactionORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuotaTransactionORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*QuotaTransaction{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type QuotaTransactionORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuotaTransactionORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]QuotaTransactionORM) error
+}
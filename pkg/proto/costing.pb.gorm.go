@@ -0,0 +1,2120 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	datatypes "gorm.io/datatypes"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type PriceListORM struct {
+	ClientId                  *uint64 `gorm:"index:idx_price_lists_client_id"`
+	CreatedAt                 *time.Time
+	Currency                  string
+	Id                        uint64
+	IsDefault                 bool
+	Materials                 []*MaterialPriceORM `gorm:"foreignKey:PriceListId;references:Id"`
+	Name                      string
+	Operations                []*OperationRateORM `gorm:"foreignKey:PriceListId;references:Id"`
+	ProcessingCostPerAssembly float64
+	ProcessingCostPerPart     float64
+	UpdatedAt                 *time.Time
+	ValidFrom                 *time.Time
+	ValidTo                   *time.Time
+	Version                   int32
+}
+
+// TableName overrides the default tablename generated by GORM
+func (PriceListORM) TableName() string {
+	return "price_lists"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *PriceList) ToORM(ctx context.Context) (PriceListORM, error) {
+	to := PriceListORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PriceListWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Currency = m.Currency
+	to.IsDefault = m.IsDefault
+	to.ProcessingCostPerPart = m.ProcessingCostPerPart
+	to.ProcessingCostPerAssembly = m.ProcessingCostPerAssembly
+	for _, v := range m.Materials {
+		if v != nil {
+			if tempMaterials, cErr := v.ToORM(ctx); cErr == nil {
+				to.Materials = append(to.Materials, &tempMaterials)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Materials = append(to.Materials, nil)
+		}
+	}
+	to.ClientId = m.ClientId
+	if m.ValidFrom != nil {
+		t := m.ValidFrom.AsTime()
+		to.ValidFrom = &t
+	}
+	if m.ValidTo != nil {
+		t := m.ValidTo.AsTime()
+		to.ValidTo = &t
+	}
+	to.Version = m.Version
+	for _, v := range m.Operations {
+		if v != nil {
+			if tempOperations, cErr := v.ToORM(ctx); cErr == nil {
+				to.Operations = append(to.Operations, &tempOperations)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Operations = append(to.Operations, nil)
+		}
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(PriceListWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PriceListORM) ToPB(ctx context.Context) (PriceList, error) {
+	to := PriceList{}
+	var err error
+	if prehook, ok := interface{}(m).(PriceListWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Currency = m.Currency
+	to.IsDefault = m.IsDefault
+	to.ProcessingCostPerPart = m.ProcessingCostPerPart
+	to.ProcessingCostPerAssembly = m.ProcessingCostPerAssembly
+	for _, v := range m.Materials {
+		if v != nil {
+			if tempMaterials, cErr := v.ToPB(ctx); cErr == nil {
+				to.Materials = append(to.Materials, &tempMaterials)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Materials = append(to.Materials, nil)
+		}
+	}
+	to.ClientId = m.ClientId
+	if m.ValidFrom != nil {
+		to.ValidFrom = timestamppb.New(*m.ValidFrom)
+	}
+	if m.ValidTo != nil {
+		to.ValidTo = timestamppb.New(*m.ValidTo)
+	}
+	to.Version = m.Version
+	for _, v := range m.Operations {
+		if v != nil {
+			if tempOperations, cErr := v.ToPB(ctx); cErr == nil {
+				to.Operations = append(to.Operations, &tempOperations)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Operations = append(to.Operations, nil)
+		}
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(PriceListWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type PriceList the arg will be the target, the caller the one being converted from
+
+// PriceListBeforeToORM called before default ToORM code
+type PriceListWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PriceListORM) error
+}
+
+// PriceListAfterToORM called after default ToORM code
+type PriceListWithAfterToORM interface {
+	AfterToORM(context.Context, *PriceListORM) error
+}
+
+// PriceListBeforeToPB called before default ToPB code
+type PriceListWithBeforeToPB interface {
+	BeforeToPB(context.Context, *PriceList) error
+}
+
+// PriceListAfterToPB called after default ToPB code
+type PriceListWithAfterToPB interface {
+	AfterToPB(context.Context, *PriceList) error
+}
+
+type MaterialPriceORM struct {
+	AssortmentType string
+	Id             uint64
+	Material       string
+	Price          float64
+	PriceListId    *uint64 `gorm:"index:idx_material_prices_price_list_id"`
+	Unit           int32
+}
+
+// TableName overrides the default tablename generated by GORM
+func (MaterialPriceORM) TableName() string {
+	return "material_prices"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *MaterialPrice) ToORM(ctx context.Context) (MaterialPriceORM, error) {
+	to := MaterialPriceORM{}
+	var err error
+	if prehook, ok := interface{}(m).(MaterialPriceWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.PriceListId = m.PriceListId
+	to.Material = m.Material
+	to.Price = m.Price
+	to.Unit = int32(m.Unit)
+	to.AssortmentType = m.AssortmentType
+	if posthook, ok := interface{}(m).(MaterialPriceWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *MaterialPriceORM) ToPB(ctx context.Context) (MaterialPrice, error) {
+	to := MaterialPrice{}
+	var err error
+	if prehook, ok := interface{}(m).(MaterialPriceWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.PriceListId = m.PriceListId
+	to.Material = m.Material
+	to.Price = m.Price
+	to.Unit = PriceUnit(m.Unit)
+	to.AssortmentType = m.AssortmentType
+	if posthook, ok := interface{}(m).(MaterialPriceWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type MaterialPrice the arg will be the target, the caller the one being converted from
+
+// MaterialPriceBeforeToORM called before default ToORM code
+type MaterialPriceWithBeforeToORM interface {
+	BeforeToORM(context.Context, *MaterialPriceORM) error
+}
+
+// MaterialPriceAfterToORM called after default ToORM code
+type MaterialPriceWithAfterToORM interface {
+	AfterToORM(context.Context, *MaterialPriceORM) error
+}
+
+// MaterialPriceBeforeToPB called before default ToPB code
+type MaterialPriceWithBeforeToPB interface {
+	BeforeToPB(context.Context, *MaterialPrice) error
+}
+
+// MaterialPriceAfterToPB called after default ToPB code
+type MaterialPriceWithAfterToPB interface {
+	AfterToPB(context.Context, *MaterialPrice) error
+}
+
+type OperationRateORM struct {
+	Id          uint64
+	LabourRate  float64
+	MachineRate float64
+	Operation   string
+	PriceListId *uint64 `gorm:"index:idx_operation_rates_price_list_id"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (OperationRateORM) TableName() string {
+	return "operation_rates"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *OperationRate) ToORM(ctx context.Context) (OperationRateORM, error) {
+	to := OperationRateORM{}
+	var err error
+	if prehook, ok := interface{}(m).(OperationRateWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.PriceListId = m.PriceListId
+	to.Operation = m.Operation
+	to.LabourRate = m.LabourRate
+	to.MachineRate = m.MachineRate
+	if posthook, ok := interface{}(m).(OperationRateWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *OperationRateORM) ToPB(ctx context.Context) (OperationRate, error) {
+	to := OperationRate{}
+	var err error
+	if prehook, ok := interface{}(m).(OperationRateWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.PriceListId = m.PriceListId
+	to.Operation = m.Operation
+	to.LabourRate = m.LabourRate
+	to.MachineRate = m.MachineRate
+	if posthook, ok := interface{}(m).(OperationRateWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type OperationRate the arg will be the target, the caller the one being converted from
+
+// OperationRateBeforeToORM called before default ToORM code
+type OperationRateWithBeforeToORM interface {
+	BeforeToORM(context.Context, *OperationRateORM) error
+}
+
+// OperationRateAfterToORM called after default ToORM code
+type OperationRateWithAfterToORM interface {
+	AfterToORM(context.Context, *OperationRateORM) error
+}
+
+// OperationRateBeforeToPB called before default ToPB code
+type OperationRateWithBeforeToPB interface {
+	BeforeToPB(context.Context, *OperationRate) error
+}
+
+// OperationRateAfterToPB called after default ToPB code
+type OperationRateWithAfterToPB interface {
+	AfterToPB(context.Context, *OperationRate) error
+}
+
+type CostEstimateORM struct {
+	Breakdown        *datatypes.JSONType[CostNode]
+	ClientId         uint64 `gorm:"index:idx_cost_estimates_client_id"`
+	CreatedAt        *time.Time
+	Currency         string
+	Id               string `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	IncompleteParts  int32
+	MaterialCost     float64
+	PriceList        *datatypes.JSONType[PriceList]
+	PriceListId      uint64
+	PriceListVersion int32
+	ProcessingCost   float64
+	TaskId           string `gorm:"type:uuid;uniqueIndex:idx_cost_estimates_task_id"`
+	TotalCost        float64
+	TotalMass        float64
+	UpdatedAt        *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (CostEstimateORM) TableName() string {
+	return "cost_estimates"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *CostEstimate) ToORM(ctx context.Context) (CostEstimateORM, error) {
+	to := CostEstimateORM{}
+	var err error
+	if prehook, ok := interface{}(m).(CostEstimateWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.ClientId = m.ClientId
+	to.PriceListId = m.PriceListId
+	to.Currency = m.Currency
+	to.MaterialCost = m.MaterialCost
+	to.ProcessingCost = m.ProcessingCost
+	to.TotalCost = m.TotalCost
+	to.TotalMass = m.TotalMass
+	to.IncompleteParts = m.IncompleteParts
+	to.PriceListVersion = m.PriceListVersion
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(CostEstimateWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *CostEstimateORM) ToPB(ctx context.Context) (CostEstimate, error) {
+	to := CostEstimate{}
+	var err error
+	if prehook, ok := interface{}(m).(CostEstimateWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.ClientId = m.ClientId
+	to.PriceListId = m.PriceListId
+	to.Currency = m.Currency
+	to.MaterialCost = m.MaterialCost
+	to.ProcessingCost = m.ProcessingCost
+	to.TotalCost = m.TotalCost
+	to.TotalMass = m.TotalMass
+	to.IncompleteParts = m.IncompleteParts
+	to.PriceListVersion = m.PriceListVersion
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(CostEstimateWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type CostEstimate the arg will be the target, the caller the one being converted from
+
+// CostEstimateBeforeToORM called before default ToORM code
+type CostEstimateWithBeforeToORM interface {
+	BeforeToORM(context.Context, *CostEstimateORM) error
+}
+
+// CostEstimateAfterToORM called after default ToORM code
+type CostEstimateWithAfterToORM interface {
+	AfterToORM(context.Context, *CostEstimateORM) error
+}
+
+// CostEstimateBeforeToPB called before default ToPB code
+type CostEstimateWithBeforeToPB interface {
+	BeforeToPB(context.Context, *CostEstimate) error
+}
+
+// CostEstimateAfterToPB called after default ToPB code
+type CostEstimateWithAfterToPB interface {
+	AfterToPB(context.Context, *CostEstimate) error
+}
+
+// DefaultCreatePriceList executes a basic gorm create call
+func DefaultCreatePriceList(ctx context.Context, in *PriceList, db *gorm.DB) (*PriceList, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Preload("Materials").Preload("Operations").Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PriceListORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadPriceList(ctx context.Context, in *PriceList, db *gorm.DB) (*PriceList, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PriceListORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PriceListORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PriceListORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePriceList(ctx context.Context, in *PriceList, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PriceListORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PriceListORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePriceListSet(ctx context.Context, in []*PriceList, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PriceListORM{})).(PriceListORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PriceListORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PriceListORM{})).(PriceListORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PriceListORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PriceList, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PriceList, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePriceList clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePriceList(ctx context.Context, in *PriceList, db *gorm.DB) (*PriceList, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePriceList")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &PriceListORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterMaterials := MaterialPriceORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterMaterials.PriceListId = new(uint64)
+	*filterMaterials.PriceListId = ormObj.Id
+	if err = db.Where(filterMaterials).Delete(MaterialPriceORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterOperations := OperationRateORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterOperations.PriceListId = new(uint64)
+	*filterOperations.PriceListId = ormObj.Id
+	if err = db.Where(filterOperations).Delete(OperationRateORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Preload("Materials").Preload("Operations").Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type PriceListORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPriceList executes a basic gorm update call with patch behavior
+func DefaultPatchPriceList(ctx context.Context, in *PriceList, updateMask *field_mask.FieldMask, db *gorm.DB) (*PriceList, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj PriceList
+	var err error
+	if hook, ok := interface{}(&pbObj).(PriceListWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadPriceList(ctx, &PriceList{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(PriceListWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPriceList(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PriceListWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePriceList(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PriceListWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PriceListWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PriceList, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PriceList, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PriceList, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PriceList, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPriceList executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPriceList(ctx context.Context, objects []*PriceList, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PriceList, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*PriceList, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPriceList(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPriceList patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPriceList(ctx context.Context, patchee *PriceList, patcher *PriceList, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PriceList, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedValidFrom bool
+	var updatedValidTo bool
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"IsDefault" {
+			patchee.IsDefault = patcher.IsDefault
+			continue
+		}
+		if f == prefix+"ProcessingCostPerPart" {
+			patchee.ProcessingCostPerPart = patcher.ProcessingCostPerPart
+			continue
+		}
+		if f == prefix+"ProcessingCostPerAssembly" {
+			patchee.ProcessingCostPerAssembly = patcher.ProcessingCostPerAssembly
+			continue
+		}
+		if f == prefix+"Materials" {
+			patchee.Materials = patcher.Materials
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if !updatedValidFrom && strings.HasPrefix(f, prefix+"ValidFrom.") {
+			if patcher.ValidFrom == nil {
+				patchee.ValidFrom = nil
+				continue
+			}
+			if patchee.ValidFrom == nil {
+				patchee.ValidFrom = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ValidFrom."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ValidFrom, patchee.ValidFrom, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ValidFrom" {
+			updatedValidFrom = true
+			patchee.ValidFrom = patcher.ValidFrom
+			continue
+		}
+		if !updatedValidTo && strings.HasPrefix(f, prefix+"ValidTo.") {
+			if patcher.ValidTo == nil {
+				patchee.ValidTo = nil
+				continue
+			}
+			if patchee.ValidTo == nil {
+				patchee.ValidTo = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ValidTo."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ValidTo, patchee.ValidTo, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ValidTo" {
+			updatedValidTo = true
+			patchee.ValidTo = patcher.ValidTo
+			continue
+		}
+		if f == prefix+"Version" {
+			patchee.Version = patcher.Version
+			continue
+		}
+		if f == prefix+"Operations" {
+			patchee.Operations = patcher.Operations
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPriceList executes a gorm list call
+func DefaultListPriceList(ctx context.Context, db *gorm.DB) ([]*PriceList, error) {
+	in := PriceList{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PriceListORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceListORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PriceList{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PriceListORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceListORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PriceListORM) error
+}
+
+// DefaultCreateMaterialPrice executes a basic gorm create call
+func DefaultCreateMaterialPrice(ctx context.Context, in *MaterialPrice, db *gorm.DB) (*MaterialPrice, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MaterialPriceORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadMaterialPrice(ctx context.Context, in *MaterialPrice, db *gorm.DB) (*MaterialPrice, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := MaterialPriceORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(MaterialPriceORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MaterialPriceORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMaterialPrice(ctx context.Context, in *MaterialPrice, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&MaterialPriceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type MaterialPriceORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMaterialPriceSet(ctx context.Context, in []*MaterialPrice, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&MaterialPriceORM{})).(MaterialPriceORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&MaterialPriceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&MaterialPriceORM{})).(MaterialPriceORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type MaterialPriceORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*MaterialPrice, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*MaterialPrice, *gorm.DB) error
+}
+
+// DefaultStrictUpdateMaterialPrice clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateMaterialPrice(ctx context.Context, in *MaterialPrice, db *gorm.DB) (*MaterialPrice, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMaterialPrice")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &MaterialPriceORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type MaterialPriceORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchMaterialPrice executes a basic gorm update call with patch behavior
+func DefaultPatchMaterialPrice(ctx context.Context, in *MaterialPrice, updateMask *field_mask.FieldMask, db *gorm.DB) (*MaterialPrice, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj MaterialPrice
+	var err error
+	if hook, ok := interface{}(&pbObj).(MaterialPriceWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadMaterialPrice(ctx, &MaterialPrice{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(MaterialPriceWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskMaterialPrice(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(MaterialPriceWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateMaterialPrice(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(MaterialPriceWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type MaterialPriceWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *MaterialPrice, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *MaterialPrice, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *MaterialPrice, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *MaterialPrice, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetMaterialPrice executes a bulk gorm update call with patch behavior
+func DefaultPatchSetMaterialPrice(ctx context.Context, objects []*MaterialPrice, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MaterialPrice, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*MaterialPrice, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchMaterialPrice(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskMaterialPrice patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMaterialPrice(ctx context.Context, patchee *MaterialPrice, patcher *MaterialPrice, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MaterialPrice, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"PriceListId" {
+			patchee.PriceListId = patcher.PriceListId
+			continue
+		}
+		if f == prefix+"Material" {
+			patchee.Material = patcher.Material
+			continue
+		}
+		if f == prefix+"Price" {
+			patchee.Price = patcher.Price
+			continue
+		}
+		if f == prefix+"Unit" {
+			patchee.Unit = patcher.Unit
+			continue
+		}
+		if f == prefix+"AssortmentType" {
+			patchee.AssortmentType = patcher.AssortmentType
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListMaterialPrice executes a gorm list call
+func DefaultListMaterialPrice(ctx context.Context, db *gorm.DB) ([]*MaterialPrice, error) {
+	in := MaterialPrice{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []MaterialPriceORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialPriceORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*MaterialPrice{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MaterialPriceORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialPriceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MaterialPriceORM) error
+}
+
+// DefaultCreateOperationRate executes a basic gorm create call
+func DefaultCreateOperationRate(ctx context.Context, in *OperationRate, db *gorm.DB) (*OperationRate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type OperationRateORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadOperationRate(ctx context.Context, in *OperationRate, db *gorm.DB) (*OperationRate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := OperationRateORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(OperationRateORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type OperationRateORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteOperationRate(ctx context.Context, in *OperationRate, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&OperationRateORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type OperationRateORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteOperationRateSet(ctx context.Context, in []*OperationRate, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&OperationRateORM{})).(OperationRateORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&OperationRateORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&OperationRateORM{})).(OperationRateORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type OperationRateORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*OperationRate, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*OperationRate, *gorm.DB) error
+}
+
+// DefaultStrictUpdateOperationRate clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateOperationRate(ctx context.Context, in *OperationRate, db *gorm.DB) (*OperationRate, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateOperationRate")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &OperationRateORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type OperationRateORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchOperationRate executes a basic gorm update call with patch behavior
+func DefaultPatchOperationRate(ctx context.Context, in *OperationRate, updateMask *field_mask.FieldMask, db *gorm.DB) (*OperationRate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj OperationRate
+	var err error
+	if hook, ok := interface{}(&pbObj).(OperationRateWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadOperationRate(ctx, &OperationRate{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(OperationRateWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskOperationRate(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(OperationRateWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateOperationRate(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(OperationRateWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type OperationRateWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *OperationRate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *OperationRate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *OperationRate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *OperationRate, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetOperationRate executes a bulk gorm update call with patch behavior
+func DefaultPatchSetOperationRate(ctx context.Context, objects []*OperationRate, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*OperationRate, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*OperationRate, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchOperationRate(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskOperationRate patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskOperationRate(ctx context.Context, patchee *OperationRate, patcher *OperationRate, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*OperationRate, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"PriceListId" {
+			patchee.PriceListId = patcher.PriceListId
+			continue
+		}
+		if f == prefix+"Operation" {
+			patchee.Operation = patcher.Operation
+			continue
+		}
+		if f == prefix+"LabourRate" {
+			patchee.LabourRate = patcher.LabourRate
+			continue
+		}
+		if f == prefix+"MachineRate" {
+			patchee.MachineRate = patcher.MachineRate
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListOperationRate executes a gorm list call
+func DefaultListOperationRate(ctx context.Context, db *gorm.DB) ([]*OperationRate, error) {
+	in := OperationRate{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []OperationRateORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRateORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*OperationRate{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type OperationRateORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRateORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]OperationRateORM) error
+}
+
+// DefaultCreateCostEstimate executes a basic gorm create call
+func DefaultCreateCostEstimate(ctx context.Context, in *CostEstimate, db *gorm.DB) (*CostEstimate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CostEstimateORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadCostEstimate(ctx context.Context, in *CostEstimate, db *gorm.DB) (*CostEstimate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := CostEstimateORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(CostEstimateORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CostEstimateORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCostEstimate(ctx context.Context, in *CostEstimate, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&CostEstimateORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type CostEstimateORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCostEstimateSet(ctx context.Context, in []*CostEstimate, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&CostEstimateORM{})).(CostEstimateORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&CostEstimateORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&CostEstimateORM{})).(CostEstimateORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type CostEstimateORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*CostEstimate, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*CostEstimate, *gorm.DB) error
+}
+
+// DefaultStrictUpdateCostEstimate clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateCostEstimate(ctx context.Context, in *CostEstimate, db *gorm.DB) (*CostEstimate, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateCostEstimate")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &CostEstimateORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type CostEstimateORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchCostEstimate executes a basic gorm update call with patch behavior
+func DefaultPatchCostEstimate(ctx context.Context, in *CostEstimate, updateMask *field_mask.FieldMask, db *gorm.DB) (*CostEstimate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj CostEstimate
+	var err error
+	if hook, ok := interface{}(&pbObj).(CostEstimateWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadCostEstimate(ctx, &CostEstimate{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(CostEstimateWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskCostEstimate(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(CostEstimateWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateCostEstimate(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(CostEstimateWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type CostEstimateWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *CostEstimate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *CostEstimate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *CostEstimate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *CostEstimate, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetCostEstimate executes a bulk gorm update call with patch behavior
+func DefaultPatchSetCostEstimate(ctx context.Context, objects []*CostEstimate, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*CostEstimate, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*CostEstimate, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchCostEstimate(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskCostEstimate patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskCostEstimate(ctx context.Context, patchee *CostEstimate, patcher *CostEstimate, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*CostEstimate, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedBreakdown bool
+	var updatedPriceList bool
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TaskId" {
+			patchee.TaskId = patcher.TaskId
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"PriceListId" {
+			patchee.PriceListId = patcher.PriceListId
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"MaterialCost" {
+			patchee.MaterialCost = patcher.MaterialCost
+			continue
+		}
+		if f == prefix+"ProcessingCost" {
+			patchee.ProcessingCost = patcher.ProcessingCost
+			continue
+		}
+		if f == prefix+"TotalCost" {
+			patchee.TotalCost = patcher.TotalCost
+			continue
+		}
+		if f == prefix+"TotalMass" {
+			patchee.TotalMass = patcher.TotalMass
+			continue
+		}
+		if f == prefix+"IncompleteParts" {
+			patchee.IncompleteParts = patcher.IncompleteParts
+			continue
+		}
+		if !updatedBreakdown && strings.HasPrefix(f, prefix+"Breakdown.") {
+			if patcher.Breakdown == nil {
+				patchee.Breakdown = nil
+				continue
+			}
+			if patchee.Breakdown == nil {
+				patchee.Breakdown = &CostNode{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Breakdown."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Breakdown, patchee.Breakdown, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Breakdown" {
+			updatedBreakdown = true
+			patchee.Breakdown = patcher.Breakdown
+			continue
+		}
+		if f == prefix+"PriceListVersion" {
+			patchee.PriceListVersion = patcher.PriceListVersion
+			continue
+		}
+		if !updatedPriceList && strings.HasPrefix(f, prefix+"PriceList.") {
+			updatedPriceList = true
+			if patcher.PriceList == nil {
+				patchee.PriceList = nil
+				continue
+			}
+			if patchee.PriceList == nil {
+				patchee.PriceList = &PriceList{}
+			}
+			if o, err := DefaultApplyFieldMaskPriceList(ctx, patchee.PriceList, patcher.PriceList, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"PriceList.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.PriceList = o
+			}
+			continue
+		}
+		if f == prefix+"PriceList" {
+			updatedPriceList = true
+			patchee.PriceList = patcher.PriceList
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListCostEstimate executes a gorm list call
+func DefaultListCostEstimate(ctx context.Context, db *gorm.DB) ([]*CostEstimate, error) {
+	in := CostEstimate{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []CostEstimateORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CostEstimateORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*CostEstimate{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type CostEstimateORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CostEstimateORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]CostEstimateORM) error
+}
@@ -7,7 +7,6 @@ import (
 	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/cosmos/gogoproto/proto"
-	types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -86,6 +85,85 @@ func (FieldStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ac8e6d38f431921d, []int{1}
 }
 
+// PartKind tells manufactured parts from bought items. Unspecified rows are classified
+// automatically, other values are kept as set.
+type PartKind int32
+
+const (
+	PartKind_PART_KIND_UNSPECIFIED  PartKind = 0
+	PartKind_PART_KIND_MANUFACTURED PartKind = 1
+	// fasteners and other items made to a standard: bolts, nuts, washers
+	PartKind_PART_KIND_STANDARD PartKind = 2
+	// other bought items: bearings, motors, gearboxes
+	PartKind_PART_KIND_PURCHASED PartKind = 3
+)
+
+var PartKind_name = map[int32]string{
+	0: "PART_KIND_UNSPECIFIED",
+	1: "PART_KIND_MANUFACTURED",
+	2: "PART_KIND_STANDARD",
+	3: "PART_KIND_PURCHASED",
+}
+
+var PartKind_value = map[string]int32{
+	"PART_KIND_UNSPECIFIED":  0,
+	"PART_KIND_MANUFACTURED": 1,
+	"PART_KIND_STANDARD":     2,
+	"PART_KIND_PURCHASED":    3,
+}
+
+func (x PartKind) String() string {
+	return proto.EnumName(PartKind_name, int32(x))
+}
+
+func (PartKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{2}
+}
+
+// ProfileFamily is the kind of rolled profile a size describes
+type ProfileFamily int32
+
+const (
+	ProfileFamily_PROFILE_FAMILY_UNSPECIFIED ProfileFamily = 0
+	ProfileFamily_PROFILE_FAMILY_SHEET       ProfileFamily = 1
+	ProfileFamily_PROFILE_FAMILY_ROUND_BAR   ProfileFamily = 2
+	ProfileFamily_PROFILE_FAMILY_PIPE        ProfileFamily = 3
+	ProfileFamily_PROFILE_FAMILY_ANGLE       ProfileFamily = 4
+	ProfileFamily_PROFILE_FAMILY_CHANNEL     ProfileFamily = 5
+	ProfileFamily_PROFILE_FAMILY_BEAM        ProfileFamily = 6
+	ProfileFamily_PROFILE_FAMILY_SQUARE_TUBE ProfileFamily = 7
+)
+
+var ProfileFamily_name = map[int32]string{
+	0: "PROFILE_FAMILY_UNSPECIFIED",
+	1: "PROFILE_FAMILY_SHEET",
+	2: "PROFILE_FAMILY_ROUND_BAR",
+	3: "PROFILE_FAMILY_PIPE",
+	4: "PROFILE_FAMILY_ANGLE",
+	5: "PROFILE_FAMILY_CHANNEL",
+	6: "PROFILE_FAMILY_BEAM",
+	7: "PROFILE_FAMILY_SQUARE_TUBE",
+}
+
+var ProfileFamily_value = map[string]int32{
+	"PROFILE_FAMILY_UNSPECIFIED": 0,
+	"PROFILE_FAMILY_SHEET":       1,
+	"PROFILE_FAMILY_ROUND_BAR":   2,
+	"PROFILE_FAMILY_PIPE":        3,
+	"PROFILE_FAMILY_ANGLE":       4,
+	"PROFILE_FAMILY_CHANNEL":     5,
+	"PROFILE_FAMILY_BEAM":        6,
+	"PROFILE_FAMILY_SQUARE_TUBE": 7,
+}
+
+func (x ProfileFamily) String() string {
+	return proto.EnumName(ProfileFamily_name, int32(x))
+}
+
+func (ProfileFamily) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{3}
+}
+
 type RecognitionStatus int32
 
 const (
@@ -117,33 +195,28 @@ func (x RecognitionStatus) String() string {
 }
 
 func (RecognitionStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ac8e6d38f431921d, []int{2}
+	return fileDescriptor_ac8e6d38f431921d, []int{4}
 }
 
-type Assortment struct {
-	Material            string                 `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"`
-	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Size_               *types.Any             `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
-	ChemicalComposition string                 `protobuf:"bytes,4,opt,name=chemical_composition,json=chemicalComposition,proto3" json:"chemical_composition,omitempty"`
-	FormGost            string                 `protobuf:"bytes,5,opt,name=form_gost,json=formGost,proto3" json:"form_gost,omitempty"`
-	ChemicalGost        string                 `protobuf:"bytes,6,opt,name=chemical_gost,json=chemicalGost,proto3" json:"chemical_gost,omitempty"`
-	FigureType          string                 `protobuf:"bytes,7,opt,name=figure_type,json=figureType,proto3" json:"figure_type,omitempty"`
-	SubType             string                 `protobuf:"bytes,8,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
-	FieldStatus         map[string]FieldStatus `protobuf:"bytes,9,rep,name=field_status,json=fieldStatus,proto3" json:"field_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=proto.FieldStatus"`
+type SheetSize struct {
+	Thickness float64 `protobuf:"fixed64,1,opt,name=thickness,proto3" json:"thickness,omitempty"`
+	// 0 when not given
+	Width  float64 `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
+	Length float64 `protobuf:"fixed64,3,opt,name=length,proto3" json:"length,omitempty"`
 }
 
-func (m *Assortment) Reset()         { *m = Assortment{} }
-func (m *Assortment) String() string { return proto.CompactTextString(m) }
-func (*Assortment) ProtoMessage()    {}
-func (*Assortment) Descriptor() ([]byte, []int) {
+func (m *SheetSize) Reset()         { *m = SheetSize{} }
+func (m *SheetSize) String() string { return proto.CompactTextString(m) }
+func (*SheetSize) ProtoMessage()    {}
+func (*SheetSize) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ac8e6d38f431921d, []int{0}
 }
-func (m *Assortment) XXX_Unmarshal(b []byte) error {
+func (m *SheetSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Assortment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SheetSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Assortment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SheetSize.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -153,111 +226,153 @@ func (m *Assortment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Assortment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Assortment.Merge(m, src)
+func (m *SheetSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SheetSize.Merge(m, src)
 }
-func (m *Assortment) XXX_Size() int {
+func (m *SheetSize) XXX_Size() int {
 	return m.Size()
 }
-func (m *Assortment) XXX_DiscardUnknown() {
-	xxx_messageInfo_Assortment.DiscardUnknown(m)
+func (m *SheetSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_SheetSize.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Assortment proto.InternalMessageInfo
+var xxx_messageInfo_SheetSize proto.InternalMessageInfo
 
-func (m *Assortment) GetMaterial() string {
+func (m *SheetSize) GetThickness() float64 {
 	if m != nil {
-		return m.Material
+		return m.Thickness
 	}
-	return ""
+	return 0
 }
 
-func (m *Assortment) GetName() string {
+func (m *SheetSize) GetWidth() float64 {
 	if m != nil {
-		return m.Name
+		return m.Width
 	}
-	return ""
+	return 0
 }
 
-func (m *Assortment) GetSize_() *types.Any {
+func (m *SheetSize) GetLength() float64 {
 	if m != nil {
-		return m.Size_
+		return m.Length
 	}
-	return nil
+	return 0
 }
 
-func (m *Assortment) GetChemicalComposition() string {
-	if m != nil {
-		return m.ChemicalComposition
-	}
-	return ""
+type RoundBarSize struct {
+	Diameter float64 `protobuf:"fixed64,1,opt,name=diameter,proto3" json:"diameter,omitempty"`
 }
 
-func (m *Assortment) GetFormGost() string {
-	if m != nil {
-		return m.FormGost
+func (m *RoundBarSize) Reset()         { *m = RoundBarSize{} }
+func (m *RoundBarSize) String() string { return proto.CompactTextString(m) }
+func (*RoundBarSize) ProtoMessage()    {}
+func (*RoundBarSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{1}
+}
+func (m *RoundBarSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundBarSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoundBarSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *RoundBarSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundBarSize.Merge(m, src)
+}
+func (m *RoundBarSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundBarSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundBarSize.DiscardUnknown(m)
 }
 
-func (m *Assortment) GetChemicalGost() string {
+var xxx_messageInfo_RoundBarSize proto.InternalMessageInfo
+
+func (m *RoundBarSize) GetDiameter() float64 {
 	if m != nil {
-		return m.ChemicalGost
+		return m.Diameter
 	}
-	return ""
+	return 0
 }
 
-func (m *Assortment) GetFigureType() string {
-	if m != nil {
-		return m.FigureType
+type PipeSize struct {
+	Diameter float64 `protobuf:"fixed64,1,opt,name=diameter,proto3" json:"diameter,omitempty"`
+	Wall     float64 `protobuf:"fixed64,2,opt,name=wall,proto3" json:"wall,omitempty"`
+}
+
+func (m *PipeSize) Reset()         { *m = PipeSize{} }
+func (m *PipeSize) String() string { return proto.CompactTextString(m) }
+func (*PipeSize) ProtoMessage()    {}
+func (*PipeSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{2}
+}
+func (m *PipeSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipeSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PipeSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *PipeSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipeSize.Merge(m, src)
+}
+func (m *PipeSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipeSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipeSize.DiscardUnknown(m)
 }
 
-func (m *Assortment) GetSubType() string {
+var xxx_messageInfo_PipeSize proto.InternalMessageInfo
+
+func (m *PipeSize) GetDiameter() float64 {
 	if m != nil {
-		return m.SubType
+		return m.Diameter
 	}
-	return ""
+	return 0
 }
 
-func (m *Assortment) GetFieldStatus() map[string]FieldStatus {
+func (m *PipeSize) GetWall() float64 {
 	if m != nil {
-		return m.FieldStatus
+		return m.Wall
 	}
-	return nil
+	return 0
 }
 
-type Figure struct {
-	Id             string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ParentId       string  `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	Image          []byte  `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	ImageId        string  `protobuf:"bytes,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	Number         string  `protobuf:"bytes,5,opt,name=number,proto3" json:"number,omitempty"`
-	Name           string  `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	SizeVertical   float32 `protobuf:"fixed32,7,opt,name=size_vertical,json=sizeVertical,proto3" json:"size_vertical,omitempty"`
-	SizeHorizontal float32 `protobuf:"fixed32,8,opt,name=size_horizontal,json=sizeHorizontal,proto3" json:"size_horizontal,omitempty"`
-	// Types that are valid to be assigned to MainSize:
-	//
-	//	*Figure_MainSizeFloat
-	//	*Figure_MainSizeStr
-	MainSize   isFigure_MainSize `protobuf_oneof:"main_size"`
-	Assortment *Assortment       `protobuf:"bytes,11,opt,name=assortment,proto3" json:"assortment,omitempty"`
-	Mass       float32           `protobuf:"fixed32,12,opt,name=mass,proto3" json:"mass,omitempty"`
+type AngleSize struct {
+	Width     float64 `protobuf:"fixed64,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height    float64 `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
+	Thickness float64 `protobuf:"fixed64,3,opt,name=thickness,proto3" json:"thickness,omitempty"`
 }
 
-func (m *Figure) Reset()         { *m = Figure{} }
-func (m *Figure) String() string { return proto.CompactTextString(m) }
-func (*Figure) ProtoMessage()    {}
-func (*Figure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac8e6d38f431921d, []int{1}
+func (m *AngleSize) Reset()         { *m = AngleSize{} }
+func (m *AngleSize) String() string { return proto.CompactTextString(m) }
+func (*AngleSize) ProtoMessage()    {}
+func (*AngleSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{3}
 }
-func (m *Figure) XXX_Unmarshal(b []byte) error {
+func (m *AngleSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Figure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AngleSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Figure.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AngleSize.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -267,161 +382,163 @@ func (m *Figure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Figure) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Figure.Merge(m, src)
+func (m *AngleSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AngleSize.Merge(m, src)
 }
-func (m *Figure) XXX_Size() int {
+func (m *AngleSize) XXX_Size() int {
 	return m.Size()
 }
-func (m *Figure) XXX_DiscardUnknown() {
-	xxx_messageInfo_Figure.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Figure proto.InternalMessageInfo
-
-type isFigure_MainSize interface {
-	isFigure_MainSize()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type Figure_MainSizeFloat struct {
-	MainSizeFloat float32 `protobuf:"fixed32,9,opt,name=main_size_float,json=mainSizeFloat,proto3,oneof" json:"main_size_float,omitempty"`
-}
-type Figure_MainSizeStr struct {
-	MainSizeStr string `protobuf:"bytes,10,opt,name=main_size_str,json=mainSizeStr,proto3,oneof" json:"main_size_str,omitempty"`
+func (m *AngleSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_AngleSize.DiscardUnknown(m)
 }
 
-func (*Figure_MainSizeFloat) isFigure_MainSize() {}
-func (*Figure_MainSizeStr) isFigure_MainSize()   {}
+var xxx_messageInfo_AngleSize proto.InternalMessageInfo
 
-func (m *Figure) GetMainSize() isFigure_MainSize {
+func (m *AngleSize) GetWidth() float64 {
 	if m != nil {
-		return m.MainSize
+		return m.Width
 	}
-	return nil
+	return 0
 }
 
-func (m *Figure) GetId() string {
+func (m *AngleSize) GetHeight() float64 {
 	if m != nil {
-		return m.Id
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *Figure) GetParentId() string {
+func (m *AngleSize) GetThickness() float64 {
 	if m != nil {
-		return m.ParentId
+		return m.Thickness
 	}
-	return ""
+	return 0
 }
 
-func (m *Figure) GetImage() []byte {
-	if m != nil {
-		return m.Image
-	}
-	return nil
+type ChannelSize struct {
+	// profile number with the series letter, e.g. 10П
+	Number string  `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Height float64 `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *Figure) GetImageId() string {
-	if m != nil {
-		return m.ImageId
-	}
-	return ""
+func (m *ChannelSize) Reset()         { *m = ChannelSize{} }
+func (m *ChannelSize) String() string { return proto.CompactTextString(m) }
+func (*ChannelSize) ProtoMessage()    {}
+func (*ChannelSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{4}
 }
-
-func (m *Figure) GetNumber() string {
-	if m != nil {
-		return m.Number
+func (m *ChannelSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *ChannelSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelSize.Merge(m, src)
+}
+func (m *ChannelSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelSize.DiscardUnknown(m)
 }
 
-func (m *Figure) GetName() string {
+var xxx_messageInfo_ChannelSize proto.InternalMessageInfo
+
+func (m *ChannelSize) GetNumber() string {
 	if m != nil {
-		return m.Name
+		return m.Number
 	}
 	return ""
 }
 
-func (m *Figure) GetSizeVertical() float32 {
+func (m *ChannelSize) GetHeight() float64 {
 	if m != nil {
-		return m.SizeVertical
+		return m.Height
 	}
 	return 0
 }
 
-func (m *Figure) GetSizeHorizontal() float32 {
-	if m != nil {
-		return m.SizeHorizontal
-	}
-	return 0
+type BeamSize struct {
+	// profile number with the series, e.g. 20Б1
+	Number string  `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Height float64 `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *Figure) GetMainSizeFloat() float32 {
-	if x, ok := m.GetMainSize().(*Figure_MainSizeFloat); ok {
-		return x.MainSizeFloat
-	}
-	return 0
+func (m *BeamSize) Reset()         { *m = BeamSize{} }
+func (m *BeamSize) String() string { return proto.CompactTextString(m) }
+func (*BeamSize) ProtoMessage()    {}
+func (*BeamSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{5}
 }
-
-func (m *Figure) GetMainSizeStr() string {
-	if x, ok := m.GetMainSize().(*Figure_MainSizeStr); ok {
-		return x.MainSizeStr
+func (m *BeamSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeamSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeamSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *BeamSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeamSize.Merge(m, src)
+}
+func (m *BeamSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeamSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeamSize.DiscardUnknown(m)
 }
 
-func (m *Figure) GetAssortment() *Assortment {
+var xxx_messageInfo_BeamSize proto.InternalMessageInfo
+
+func (m *BeamSize) GetNumber() string {
 	if m != nil {
-		return m.Assortment
+		return m.Number
 	}
-	return nil
+	return ""
 }
 
-func (m *Figure) GetMass() float32 {
+func (m *BeamSize) GetHeight() float64 {
 	if m != nil {
-		return m.Mass
+		return m.Height
 	}
 	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Figure) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Figure_MainSizeFloat)(nil),
-		(*Figure_MainSizeStr)(nil),
-	}
-}
-
-type SpecificationRow struct {
-	Id         string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ParentId   string      `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	Position   int32       `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
-	Number     string      `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
-	Name       string      `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Material   string      `protobuf:"bytes,6,opt,name=material,proto3" json:"material,omitempty"`
-	Count      int32       `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
-	Size_      string      `protobuf:"bytes,8,opt,name=size,proto3" json:"size,omitempty"`
-	SizeV      string      `protobuf:"bytes,9,opt,name=size_v,json=sizeV,proto3" json:"size_v,omitempty"`
-	SizeH      string      `protobuf:"bytes,10,opt,name=size_h,json=sizeH,proto3" json:"size_h,omitempty"`
-	Assortment *Assortment `protobuf:"bytes,11,opt,name=assortment,proto3" json:"assortment,omitempty"`
-	ImageId    string      `protobuf:"bytes,12,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	SbNumber   string      `protobuf:"bytes,13,opt,name=sb_number,json=sbNumber,proto3" json:"sb_number,omitempty"`
+type SquareTubeSize struct {
+	Width  float64 `protobuf:"fixed64,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height float64 `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
+	Wall   float64 `protobuf:"fixed64,3,opt,name=wall,proto3" json:"wall,omitempty"`
 }
 
-func (m *SpecificationRow) Reset()         { *m = SpecificationRow{} }
-func (m *SpecificationRow) String() string { return proto.CompactTextString(m) }
-func (*SpecificationRow) ProtoMessage()    {}
-func (*SpecificationRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac8e6d38f431921d, []int{2}
+func (m *SquareTubeSize) Reset()         { *m = SquareTubeSize{} }
+func (m *SquareTubeSize) String() string { return proto.CompactTextString(m) }
+func (*SquareTubeSize) ProtoMessage()    {}
+func (*SquareTubeSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{6}
 }
-func (m *SpecificationRow) XXX_Unmarshal(b []byte) error {
+func (m *SquareTubeSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SpecificationRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SquareTubeSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SpecificationRow.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SquareTubeSize.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -431,134 +548,175 @@ func (m *SpecificationRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *SpecificationRow) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpecificationRow.Merge(m, src)
+func (m *SquareTubeSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SquareTubeSize.Merge(m, src)
 }
-func (m *SpecificationRow) XXX_Size() int {
+func (m *SquareTubeSize) XXX_Size() int {
 	return m.Size()
 }
-func (m *SpecificationRow) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpecificationRow.DiscardUnknown(m)
+func (m *SquareTubeSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_SquareTubeSize.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpecificationRow proto.InternalMessageInfo
+var xxx_messageInfo_SquareTubeSize proto.InternalMessageInfo
 
-func (m *SpecificationRow) GetId() string {
+func (m *SquareTubeSize) GetWidth() float64 {
 	if m != nil {
-		return m.Id
+		return m.Width
 	}
-	return ""
+	return 0
 }
 
-func (m *SpecificationRow) GetParentId() string {
+func (m *SquareTubeSize) GetHeight() float64 {
 	if m != nil {
-		return m.ParentId
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *SpecificationRow) GetPosition() int32 {
+func (m *SquareTubeSize) GetWall() float64 {
 	if m != nil {
-		return m.Position
+		return m.Wall
 	}
 	return 0
 }
 
-func (m *SpecificationRow) GetNumber() string {
-	if m != nil {
-		return m.Number
+// ProfileSize is a typed profile size; only the message of the family is set
+type ProfileSize struct {
+	Family ProfileFamily `protobuf:"varint,1,opt,name=family,proto3,enum=proto.ProfileFamily" json:"family,omitempty"`
+	// the recognized text the size was read from
+	Raw        string          `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`
+	Sheet      *SheetSize      `protobuf:"bytes,3,opt,name=sheet,proto3" json:"sheet,omitempty"`
+	RoundBar   *RoundBarSize   `protobuf:"bytes,4,opt,name=round_bar,json=roundBar,proto3" json:"round_bar,omitempty"`
+	Pipe       *PipeSize       `protobuf:"bytes,5,opt,name=pipe,proto3" json:"pipe,omitempty"`
+	Angle      *AngleSize      `protobuf:"bytes,6,opt,name=angle,proto3" json:"angle,omitempty"`
+	Channel    *ChannelSize    `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	Beam       *BeamSize       `protobuf:"bytes,8,opt,name=beam,proto3" json:"beam,omitempty"`
+	SquareTube *SquareTubeSize `protobuf:"bytes,9,opt,name=square_tube,json=squareTube,proto3" json:"square_tube,omitempty"`
+}
+
+func (m *ProfileSize) Reset()         { *m = ProfileSize{} }
+func (m *ProfileSize) String() string { return proto.CompactTextString(m) }
+func (*ProfileSize) ProtoMessage()    {}
+func (*ProfileSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{7}
+}
+func (m *ProfileSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *ProfileSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileSize.Merge(m, src)
+}
+func (m *ProfileSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileSize.DiscardUnknown(m)
 }
 
-func (m *SpecificationRow) GetName() string {
+var xxx_messageInfo_ProfileSize proto.InternalMessageInfo
+
+func (m *ProfileSize) GetFamily() ProfileFamily {
 	if m != nil {
-		return m.Name
+		return m.Family
 	}
-	return ""
+	return ProfileFamily_PROFILE_FAMILY_UNSPECIFIED
 }
 
-func (m *SpecificationRow) GetMaterial() string {
+func (m *ProfileSize) GetRaw() string {
 	if m != nil {
-		return m.Material
+		return m.Raw
 	}
 	return ""
 }
 
-func (m *SpecificationRow) GetCount() int32 {
+func (m *ProfileSize) GetSheet() *SheetSize {
 	if m != nil {
-		return m.Count
+		return m.Sheet
 	}
-	return 0
+	return nil
 }
 
-func (m *SpecificationRow) GetSize_() string {
+func (m *ProfileSize) GetRoundBar() *RoundBarSize {
 	if m != nil {
-		return m.Size_
+		return m.RoundBar
 	}
-	return ""
+	return nil
 }
 
-func (m *SpecificationRow) GetSizeV() string {
+func (m *ProfileSize) GetPipe() *PipeSize {
 	if m != nil {
-		return m.SizeV
+		return m.Pipe
 	}
-	return ""
+	return nil
 }
 
-func (m *SpecificationRow) GetSizeH() string {
+func (m *ProfileSize) GetAngle() *AngleSize {
 	if m != nil {
-		return m.SizeH
+		return m.Angle
 	}
-	return ""
+	return nil
 }
 
-func (m *SpecificationRow) GetAssortment() *Assortment {
+func (m *ProfileSize) GetChannel() *ChannelSize {
 	if m != nil {
-		return m.Assortment
+		return m.Channel
 	}
 	return nil
 }
 
-func (m *SpecificationRow) GetImageId() string {
+func (m *ProfileSize) GetBeam() *BeamSize {
 	if m != nil {
-		return m.ImageId
+		return m.Beam
 	}
-	return ""
+	return nil
 }
 
-func (m *SpecificationRow) GetSbNumber() string {
+func (m *ProfileSize) GetSquareTube() *SquareTubeSize {
 	if m != nil {
-		return m.SbNumber
+		return m.SquareTube
 	}
-	return ""
+	return nil
 }
 
-type TreeNode struct {
-	Id               string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Number           string            `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
-	Name             string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Count            int32             `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	Material         string            `protobuf:"bytes,5,opt,name=material,proto3" json:"material,omitempty"`
-	Spec             *SpecificationRow `protobuf:"bytes,6,opt,name=spec,proto3" json:"spec,omitempty"`
-	Figure           *Figure           `protobuf:"bytes,7,opt,name=figure,proto3" json:"figure,omitempty"`
-	AccumulatedCount int32             `protobuf:"varint,8,opt,name=accumulated_count,json=accumulatedCount,proto3" json:"accumulated_count,omitempty"`
-	Leaves           []*TreeNode       `protobuf:"bytes,9,rep,name=leaves,proto3" json:"leaves,omitempty"`
-	ParentId         string            `protobuf:"bytes,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+type Assortment struct {
+	Material            string                 `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size_               *ProfileSize           `protobuf:"bytes,10,opt,name=size,proto3" json:"size,omitempty"`
+	ChemicalComposition string                 `protobuf:"bytes,4,opt,name=chemical_composition,json=chemicalComposition,proto3" json:"chemical_composition,omitempty"`
+	FormGost            string                 `protobuf:"bytes,5,opt,name=form_gost,json=formGost,proto3" json:"form_gost,omitempty"`
+	ChemicalGost        string                 `protobuf:"bytes,6,opt,name=chemical_gost,json=chemicalGost,proto3" json:"chemical_gost,omitempty"`
+	FigureType          string                 `protobuf:"bytes,7,opt,name=figure_type,json=figureType,proto3" json:"figure_type,omitempty"`
+	SubType             string                 `protobuf:"bytes,8,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
+	FieldStatus         map[string]FieldStatus `protobuf:"bytes,9,rep,name=field_status,json=fieldStatus,proto3" json:"field_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=proto.FieldStatus"`
+	// closest sizes of the series of form_gost when the size is not in it
+	SuggestedSizes []string `protobuf:"bytes,11,rep,name=suggested_sizes,json=suggestedSizes,proto3" json:"suggested_sizes,omitempty"`
 }
 
-func (m *TreeNode) Reset()         { *m = TreeNode{} }
-func (m *TreeNode) String() string { return proto.CompactTextString(m) }
-func (*TreeNode) ProtoMessage()    {}
-func (*TreeNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac8e6d38f431921d, []int{3}
+func (m *Assortment) Reset()         { *m = Assortment{} }
+func (m *Assortment) String() string { return proto.CompactTextString(m) }
+func (*Assortment) ProtoMessage()    {}
+func (*Assortment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{8}
 }
-func (m *TreeNode) XXX_Unmarshal(b []byte) error {
+func (m *Assortment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Assortment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TreeNode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Assortment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -568,862 +726,3669 @@ func (m *TreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TreeNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TreeNode.Merge(m, src)
+func (m *Assortment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Assortment.Merge(m, src)
 }
-func (m *TreeNode) XXX_Size() int {
+func (m *Assortment) XXX_Size() int {
 	return m.Size()
 }
-func (m *TreeNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_TreeNode.DiscardUnknown(m)
+func (m *Assortment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Assortment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TreeNode proto.InternalMessageInfo
+var xxx_messageInfo_Assortment proto.InternalMessageInfo
 
-func (m *TreeNode) GetId() string {
+func (m *Assortment) GetMaterial() string {
 	if m != nil {
-		return m.Id
+		return m.Material
 	}
 	return ""
 }
 
-func (m *TreeNode) GetNumber() string {
+func (m *Assortment) GetName() string {
 	if m != nil {
-		return m.Number
+		return m.Name
 	}
 	return ""
 }
 
-func (m *TreeNode) GetName() string {
+func (m *Assortment) GetSize_() *ProfileSize {
 	if m != nil {
-		return m.Name
+		return m.Size_
 	}
-	return ""
+	return nil
 }
 
-func (m *TreeNode) GetCount() int32 {
+func (m *Assortment) GetChemicalComposition() string {
 	if m != nil {
-		return m.Count
+		return m.ChemicalComposition
 	}
-	return 0
+	return ""
 }
 
-func (m *TreeNode) GetMaterial() string {
+func (m *Assortment) GetFormGost() string {
 	if m != nil {
-		return m.Material
+		return m.FormGost
 	}
 	return ""
 }
 
-func (m *TreeNode) GetSpec() *SpecificationRow {
+func (m *Assortment) GetChemicalGost() string {
 	if m != nil {
-		return m.Spec
+		return m.ChemicalGost
 	}
-	return nil
+	return ""
 }
 
-func (m *TreeNode) GetFigure() *Figure {
+func (m *Assortment) GetFigureType() string {
 	if m != nil {
-		return m.Figure
+		return m.FigureType
 	}
-	return nil
+	return ""
 }
 
-func (m *TreeNode) GetAccumulatedCount() int32 {
+func (m *Assortment) GetSubType() string {
 	if m != nil {
-		return m.AccumulatedCount
+		return m.SubType
 	}
-	return 0
+	return ""
 }
 
-func (m *TreeNode) GetLeaves() []*TreeNode {
+func (m *Assortment) GetFieldStatus() map[string]FieldStatus {
 	if m != nil {
-		return m.Leaves
+		return m.FieldStatus
 	}
 	return nil
 }
 
-func (m *TreeNode) GetParentId() string {
+func (m *Assortment) GetSuggestedSizes() []string {
 	if m != nil {
-		return m.ParentId
+		return m.SuggestedSizes
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("proto.FieldDescription", FieldDescription_name, FieldDescription_value)
-	proto.RegisterEnum("proto.FieldStatus", FieldStatus_name, FieldStatus_value)
-	proto.RegisterEnum("proto.RecognitionStatus", RecognitionStatus_name, RecognitionStatus_value)
-	proto.RegisterType((*Assortment)(nil), "proto.Assortment")
-	proto.RegisterMapType((map[string]FieldStatus)(nil), "proto.Assortment.FieldStatusEntry")
-	proto.RegisterType((*Figure)(nil), "proto.Figure")
-	proto.RegisterType((*SpecificationRow)(nil), "proto.SpecificationRow")
-	proto.RegisterType((*TreeNode)(nil), "proto.TreeNode")
+type Figure struct {
+	Id             string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId       string  `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Image          []byte  `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	ImageId        string  `protobuf:"bytes,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Number         string  `protobuf:"bytes,5,opt,name=number,proto3" json:"number,omitempty"`
+	Name           string  `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	SizeVertical   float32 `protobuf:"fixed32,7,opt,name=size_vertical,json=sizeVertical,proto3" json:"size_vertical,omitempty"`
+	SizeHorizontal float32 `protobuf:"fixed32,8,opt,name=size_horizontal,json=sizeHorizontal,proto3" json:"size_horizontal,omitempty"`
+	// Types that are valid to be assigned to MainSize:
+	//	*Figure_MainSizeFloat
+	//	*Figure_MainSizeStr
+	MainSize   isFigure_MainSize `protobuf_oneof:"main_size"`
+	Assortment *Assortment       `protobuf:"bytes,11,opt,name=assortment,proto3" json:"assortment,omitempty"`
+	Mass       float32           `protobuf:"fixed32,12,opt,name=mass,proto3" json:"mass,omitempty"`
 }
 
-func init() { proto.RegisterFile("proto/data.proto", fileDescriptor_ac8e6d38f431921d) }
-
-var fileDescriptor_ac8e6d38f431921d = []byte{
-	// 971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0xe2, 0xc6,
-	0x17, 0x07, 0x03, 0x0e, 0x3c, 0x43, 0xe2, 0xcc, 0x3f, 0xff, 0xd6, 0x9b, 0x6d, 0x69, 0xca, 0xb6,
-	0x5a, 0x94, 0x48, 0x44, 0x9b, 0x5e, 0xaa, 0xf6, 0x50, 0xb1, 0x60, 0x12, 0xab, 0x04, 0xa2, 0x31,
-	0xdb, 0xaa, 0x7b, 0xb1, 0x06, 0x33, 0x10, 0x6b, 0xb1, 0x8d, 0xec, 0x71, 0x2a, 0x72, 0xee, 0x07,
-	0xe8, 0x67, 0xe9, 0x17, 0xe8, 0xb5, 0xc7, 0x3d, 0xf6, 0x58, 0x25, 0x52, 0x3f, 0x47, 0x35, 0x33,
-	0xc6, 0x71, 0xd8, 0x5c, 0xda, 0x13, 0xf3, 0x7e, 0xef, 0xc7, 0x9b, 0x37, 0xbf, 0xdf, 0x9b, 0x31,
-	0xe8, 0xab, 0x28, 0x64, 0xe1, 0xe9, 0x8c, 0x30, 0xd2, 0x11, 0x4b, 0x54, 0x11, 0x3f, 0x87, 0xcf,
-	0x16, 0x61, 0xb8, 0x58, 0xd2, 0x53, 0x11, 0x4d, 0x93, 0xf9, 0x29, 0x09, 0xd6, 0x92, 0xd1, 0xfa,
-	0xad, 0x04, 0xd0, 0x8d, 0xe3, 0x30, 0x62, 0x3e, 0x0d, 0x18, 0x3a, 0x84, 0xaa, 0x4f, 0x18, 0x8d,
-	0x3c, 0xb2, 0x34, 0x8a, 0x47, 0xc5, 0x76, 0x0d, 0x67, 0x31, 0x42, 0x50, 0x0e, 0x88, 0x4f, 0x0d,
-	0x45, 0xe0, 0x62, 0x8d, 0xda, 0x50, 0x8e, 0xbd, 0x5b, 0x6a, 0x94, 0x8e, 0x8a, 0x6d, 0xed, 0xec,
-	0xa0, 0x23, 0x37, 0xea, 0x6c, 0x36, 0xea, 0x74, 0x83, 0x35, 0x16, 0x0c, 0xf4, 0x0a, 0x0e, 0xdc,
-	0x6b, 0xea, 0x7b, 0x2e, 0x59, 0x3a, 0x6e, 0xe8, 0xaf, 0xc2, 0xd8, 0x63, 0x5e, 0x18, 0x18, 0x65,
-	0x51, 0xed, 0x7f, 0x9b, 0x5c, 0xef, 0x21, 0x85, 0x9e, 0x43, 0x6d, 0x1e, 0x46, 0xbe, 0xb3, 0x08,
-	0x63, 0x66, 0x54, 0x64, 0x37, 0x1c, 0x38, 0x0f, 0x63, 0x86, 0x5e, 0x40, 0x23, 0xab, 0x27, 0x08,
-	0xaa, 0x20, 0xd4, 0x37, 0xa0, 0x20, 0x7d, 0x06, 0xda, 0xdc, 0x5b, 0x24, 0x11, 0x75, 0xd8, 0x7a,
-	0x45, 0x8d, 0x1d, 0x41, 0x01, 0x09, 0x4d, 0xd6, 0x2b, 0x8a, 0x9e, 0x41, 0x35, 0x4e, 0xa6, 0x32,
-	0x5b, 0x15, 0xd9, 0x9d, 0x38, 0x99, 0x8a, 0x94, 0x09, 0xf5, 0xb9, 0x47, 0x97, 0x33, 0x27, 0x66,
-	0x84, 0x25, 0xb1, 0x51, 0x3b, 0x2a, 0xb5, 0xb5, 0xb3, 0x96, 0x3c, 0x5b, 0xe7, 0x41, 0xb3, 0xce,
-	0x80, 0xb3, 0x6c, 0x41, 0x32, 0x03, 0x16, 0xad, 0xb1, 0x36, 0x7f, 0x40, 0x0e, 0x31, 0xe8, 0xdb,
-	0x04, 0xa4, 0x43, 0xe9, 0x1d, 0x5d, 0xa7, 0x02, 0xf3, 0x25, 0x6a, 0x43, 0xe5, 0x86, 0x2c, 0x13,
-	0x29, 0xee, 0xee, 0x19, 0x4a, 0x77, 0xc9, 0xfd, 0x13, 0x4b, 0xc2, 0x37, 0xca, 0xd7, 0xc5, 0xd6,
-	0x2f, 0x25, 0x50, 0x07, 0xe2, 0x10, 0x68, 0x17, 0x14, 0x6f, 0x96, 0x56, 0x52, 0xbc, 0x19, 0xd7,
-	0x6c, 0x45, 0x22, 0x1a, 0x30, 0xc7, 0x9b, 0xa5, 0x4e, 0x55, 0x25, 0x60, 0xcd, 0xd0, 0x01, 0x54,
-	0x3c, 0x9f, 0x2c, 0xa4, 0x5d, 0x75, 0x2c, 0x03, 0xae, 0x81, 0x58, 0xf0, 0x7f, 0x48, 0x37, 0x76,
-	0x44, 0x6c, 0xcd, 0xd0, 0x47, 0xa0, 0x06, 0x89, 0x3f, 0xa5, 0x51, 0x2a, 0x7f, 0x1a, 0x65, 0xa3,
-	0xa0, 0xe6, 0x46, 0xe1, 0x05, 0x34, 0xb8, 0xd1, 0xce, 0x0d, 0x8d, 0x18, 0x37, 0x40, 0xa8, 0xad,
-	0xe0, 0x3a, 0x07, 0x7f, 0x48, 0x31, 0xf4, 0x12, 0xf6, 0x04, 0xe9, 0x3a, 0x8c, 0xbc, 0xdb, 0x30,
-	0x60, 0x64, 0x29, 0x64, 0x57, 0xf0, 0x2e, 0x87, 0x2f, 0x32, 0x14, 0xb5, 0x61, 0xcf, 0x27, 0x5e,
-	0xe0, 0x08, 0xf6, 0x7c, 0x19, 0x12, 0x66, 0xd4, 0x38, 0xf1, 0xa2, 0x80, 0x1b, 0x3c, 0x61, 0x7b,
-	0xb7, 0x74, 0xc0, 0x61, 0xf4, 0x05, 0x34, 0x1e, 0x98, 0x31, 0x8b, 0x0c, 0xe0, 0x4d, 0x5d, 0x14,
-	0xb0, 0xb6, 0xe1, 0xd9, 0x2c, 0x42, 0xaf, 0x00, 0x48, 0x66, 0x99, 0xa1, 0x89, 0x71, 0xdd, 0xff,
-	0xc0, 0x4b, 0x9c, 0x23, 0xf1, 0x43, 0xfa, 0x24, 0x8e, 0x8d, 0xba, 0x68, 0x50, 0xac, 0x5f, 0x6b,
-	0x50, 0xcb, 0x36, 0x6b, 0xfd, 0xad, 0x80, 0x6e, 0xaf, 0xa8, 0xeb, 0xcd, 0x3d, 0x97, 0xf0, 0x89,
-	0xc5, 0xe1, 0xcf, 0xff, 0xce, 0x90, 0x43, 0xa8, 0x66, 0x17, 0x81, 0x7b, 0x52, 0xc1, 0x59, 0x9c,
-	0xd3, 0xbe, 0xfc, 0xa4, 0xf6, 0x95, 0x9c, 0xf6, 0xf9, 0x6b, 0xab, 0x6e, 0x5d, 0xdb, 0x03, 0xa8,
-	0xb8, 0x61, 0x12, 0x30, 0xe1, 0x47, 0x05, 0xcb, 0x80, 0x57, 0x11, 0x17, 0x57, 0x0e, 0xbd, 0xbc,
-	0xa2, 0xff, 0x07, 0x55, 0x3a, 0x28, 0xa4, 0xae, 0xe1, 0x8a, 0xb0, 0x2e, 0x83, 0xaf, 0xa5, 0xb2,
-	0x12, 0xbe, 0xf8, 0x2f, 0x8a, 0xe6, 0x27, 0xad, 0xfe, 0x78, 0xd2, 0x9e, 0x43, 0x2d, 0x9e, 0x3a,
-	0xe9, 0x81, 0x1b, 0xf2, 0x08, 0xf1, 0x74, 0x24, 0xe2, 0xd6, 0xef, 0x0a, 0x54, 0x27, 0x11, 0xa5,
-	0xa3, 0x70, 0xf6, 0xe1, 0xc4, 0x3f, 0xe8, 0xa4, 0x3c, 0xa9, 0x53, 0x29, 0xa7, 0x53, 0xa6, 0x45,
-	0x39, 0xaf, 0x45, 0x5e, 0xbd, 0xca, 0x96, 0x7a, 0x27, 0x50, 0x8e, 0x57, 0xd4, 0x15, 0xaa, 0x6a,
-	0x67, 0x1f, 0xa7, 0xe7, 0xdb, 0x76, 0x1d, 0x0b, 0x12, 0xfa, 0x12, 0x54, 0xf9, 0xb6, 0x08, 0xad,
-	0xb5, 0xb3, 0x46, 0x76, 0x8d, 0x39, 0x88, 0xd3, 0x24, 0x3a, 0x81, 0x7d, 0xe2, 0xba, 0x89, 0x9f,
-	0x2c, 0x09, 0xa3, 0x33, 0x47, 0x76, 0x54, 0x15, 0x1d, 0xe9, 0xb9, 0x44, 0x4f, 0x34, 0xf7, 0x12,
-	0xd4, 0x25, 0x25, 0x37, 0x74, 0xf3, 0x00, 0xed, 0xa5, 0x35, 0x37, 0x7a, 0xe0, 0x34, 0xfd, 0x78,
-	0xd0, 0xe0, 0xf1, 0xa0, 0x1d, 0x5f, 0xa7, 0xaf, 0x50, 0x9f, 0xc6, 0x6e, 0xe4, 0xad, 0xc4, 0x80,
-	0x7d, 0x0e, 0x9f, 0x0e, 0x2c, 0x73, 0xd8, 0x77, 0xfa, 0xa6, 0xdd, 0xc3, 0xd6, 0xd5, 0xc4, 0x1a,
-	0x8f, 0x9c, 0x37, 0x23, 0xfb, 0xca, 0xec, 0x59, 0x03, 0xcb, 0xec, 0xeb, 0x05, 0x54, 0x85, 0xb2,
-	0x6d, 0xbd, 0x35, 0xf5, 0x22, 0xda, 0x05, 0xe8, 0xda, 0xf6, 0x18, 0x4f, 0x2e, 0xcd, 0xd1, 0x44,
-	0x57, 0x78, 0x7c, 0x39, 0xee, 0x9b, 0x43, 0x47, 0xe4, 0x4b, 0x68, 0x07, 0x4a, 0xdd, 0xe1, 0x50,
-	0x2f, 0x1f, 0x7f, 0x07, 0x5a, 0xee, 0xd5, 0x42, 0x9f, 0x80, 0x21, 0x37, 0xb1, 0x27, 0xdd, 0xc9,
-	0x1b, 0x7b, 0xab, 0xbe, 0x0a, 0xca, 0xf8, 0x7b, 0xbd, 0x88, 0x00, 0xd4, 0x9f, 0xcc, 0xe1, 0x70,
-	0xfc, 0xa3, 0xae, 0x1c, 0x87, 0xb0, 0x8f, 0xa9, 0x1b, 0x2e, 0x02, 0x71, 0x0d, 0xd2, 0x32, 0x2d,
-	0x68, 0x62, 0xb3, 0x37, 0x3e, 0x1f, 0x59, 0xa2, 0xcb, 0x27, 0x8b, 0x69, 0xb0, 0x73, 0x65, 0x8e,
-	0xfa, 0xd6, 0xe8, 0x5c, 0xf6, 0x7b, 0x85, 0xc7, 0x3d, 0xd3, 0xb6, 0x79, 0xac, 0xa0, 0x06, 0xd4,
-	0x7a, 0xe3, 0xcb, 0xab, 0xa1, 0x39, 0x31, 0xfb, 0x7a, 0x89, 0x6f, 0x38, 0xe8, 0x5a, 0x43, 0xb3,
-	0xaf, 0x97, 0x5f, 0x9f, 0xfc, 0x71, 0xd7, 0x2c, 0xbe, 0xbf, 0x6b, 0x16, 0xff, 0xba, 0x6b, 0x16,
-	0x7f, 0xbd, 0x6f, 0x16, 0xde, 0xdf, 0x37, 0x0b, 0x7f, 0xde, 0x37, 0x0b, 0x6f, 0xf7, 0x3b, 0xa7,
-	0xab, 0x77, 0x0b, 0xf9, 0xd9, 0xfc, 0x56, 0x7e, 0xd3, 0x54, 0xf1, 0xf3, 0xd5, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xec, 0x6c, 0xad, 0xc5, 0x6c, 0x07, 0x00, 0x00,
+func (m *Figure) Reset()         { *m = Figure{} }
+func (m *Figure) String() string { return proto.CompactTextString(m) }
+func (*Figure) ProtoMessage()    {}
+func (*Figure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{9}
 }
-
-func (m *Assortment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Figure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Figure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Figure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Assortment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Figure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Figure.Merge(m, src)
+}
+func (m *Figure) XXX_Size() int {
+	return m.Size()
+}
+func (m *Figure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Figure.DiscardUnknown(m)
 }
 
-func (m *Assortment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FieldStatus) > 0 {
-		for k := range m.FieldStatus {
-			v := m.FieldStatus[k]
-			baseI := i
-			i = encodeVarintData(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintData(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintData(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.SubType) > 0 {
-		i -= len(m.SubType)
-		copy(dAtA[i:], m.SubType)
-		i = encodeVarintData(dAtA, i, uint64(len(m.SubType)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.FigureType) > 0 {
-		i -= len(m.FigureType)
-		copy(dAtA[i:], m.FigureType)
-		i = encodeVarintData(dAtA, i, uint64(len(m.FigureType)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ChemicalGost) > 0 {
-		i -= len(m.ChemicalGost)
-		copy(dAtA[i:], m.ChemicalGost)
-		i = encodeVarintData(dAtA, i, uint64(len(m.ChemicalGost)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.FormGost) > 0 {
-		i -= len(m.FormGost)
-		copy(dAtA[i:], m.FormGost)
-		i = encodeVarintData(dAtA, i, uint64(len(m.FormGost)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ChemicalComposition) > 0 {
-		i -= len(m.ChemicalComposition)
-		copy(dAtA[i:], m.ChemicalComposition)
-		i = encodeVarintData(dAtA, i, uint64(len(m.ChemicalComposition)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Size_ != nil {
-		{
-			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintData(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Material) > 0 {
-		i -= len(m.Material)
-		copy(dAtA[i:], m.Material)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Material)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_Figure proto.InternalMessageInfo
 
-func (m *Figure) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type isFigure_MainSize interface {
+	isFigure_MainSize()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *Figure) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Figure_MainSizeFloat struct {
+	MainSizeFloat float32 `protobuf:"fixed32,9,opt,name=main_size_float,json=mainSizeFloat,proto3,oneof" json:"main_size_float,omitempty"`
+}
+type Figure_MainSizeStr struct {
+	MainSizeStr string `protobuf:"bytes,10,opt,name=main_size_str,json=mainSizeStr,proto3,oneof" json:"main_size_str,omitempty"`
 }
 
-func (m *Figure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Mass != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Mass))))
-		i--
-		dAtA[i] = 0x65
+func (*Figure_MainSizeFloat) isFigure_MainSize() {}
+func (*Figure_MainSizeStr) isFigure_MainSize()   {}
+
+func (m *Figure) GetMainSize() isFigure_MainSize {
+	if m != nil {
+		return m.MainSize
 	}
-	if m.Assortment != nil {
-		{
-			size, err := m.Assortment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintData(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	return nil
+}
+
+func (m *Figure) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.MainSize != nil {
-		{
-			size := m.MainSize.Size()
-			i -= size
-			if _, err := m.MainSize.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	return ""
+}
+
+func (m *Figure) GetParentId() string {
+	if m != nil {
+		return m.ParentId
 	}
-	if m.SizeHorizontal != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.SizeHorizontal))))
-		i--
-		dAtA[i] = 0x45
+	return ""
+}
+
+func (m *Figure) GetImage() []byte {
+	if m != nil {
+		return m.Image
 	}
-	if m.SizeVertical != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.SizeVertical))))
-		i--
-		dAtA[i] = 0x3d
+	return nil
+}
+
+func (m *Figure) GetImageId() string {
+	if m != nil {
+		return m.ImageId
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *Figure) GetNumber() string {
+	if m != nil {
+		return m.Number
 	}
-	if len(m.Number) > 0 {
-		i -= len(m.Number)
-		copy(dAtA[i:], m.Number)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Number)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *Figure) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.ImageId) > 0 {
-		i -= len(m.ImageId)
-		copy(dAtA[i:], m.ImageId)
-		i = encodeVarintData(dAtA, i, uint64(len(m.ImageId)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *Figure) GetSizeVertical() float32 {
+	if m != nil {
+		return m.SizeVertical
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *Figure) GetSizeHorizontal() float32 {
+	if m != nil {
+		return m.SizeHorizontal
 	}
-	if len(m.ParentId) > 0 {
-		i -= len(m.ParentId)
-		copy(dAtA[i:], m.ParentId)
-		i = encodeVarintData(dAtA, i, uint64(len(m.ParentId)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Figure) GetMainSizeFloat() float32 {
+	if x, ok := m.GetMainSize().(*Figure_MainSizeFloat); ok {
+		return x.MainSizeFloat
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Figure) GetMainSizeStr() string {
+	if x, ok := m.GetMainSize().(*Figure_MainSizeStr); ok {
+		return x.MainSizeStr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Figure_MainSizeFloat) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Figure) GetAssortment() *Assortment {
+	if m != nil {
+		return m.Assortment
+	}
+	return nil
 }
 
-func (m *Figure_MainSizeFloat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 4
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MainSizeFloat))))
-	i--
-	dAtA[i] = 0x4d
-	return len(dAtA) - i, nil
-}
-func (m *Figure_MainSizeStr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Figure) GetMass() float32 {
+	if m != nil {
+		return m.Mass
+	}
+	return 0
 }
 
-func (m *Figure_MainSizeStr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.MainSizeStr)
-	copy(dAtA[i:], m.MainSizeStr)
-	i = encodeVarintData(dAtA, i, uint64(len(m.MainSizeStr)))
-	i--
-	dAtA[i] = 0x52
-	return len(dAtA) - i, nil
-}
-func (m *SpecificationRow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Figure) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Figure_MainSizeFloat)(nil),
+		(*Figure_MainSizeStr)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *SpecificationRow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SpecificationRow struct {
+	Id         string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId   string      `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Position   int32       `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Number     string      `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
+	Name       string      `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Material   string      `protobuf:"bytes,6,opt,name=material,proto3" json:"material,omitempty"`
+	Count      int32       `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	Size_      string      `protobuf:"bytes,8,opt,name=size,proto3" json:"size,omitempty"`
+	SizeV      string      `protobuf:"bytes,9,opt,name=size_v,json=sizeV,proto3" json:"size_v,omitempty"`
+	SizeH      string      `protobuf:"bytes,10,opt,name=size_h,json=sizeH,proto3" json:"size_h,omitempty"`
+	Assortment *Assortment `protobuf:"bytes,11,opt,name=assortment,proto3" json:"assortment,omitempty"`
+	ImageId    string      `protobuf:"bytes,12,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	SbNumber   string      `protobuf:"bytes,13,opt,name=sb_number,json=sbNumber,proto3" json:"sb_number,omitempty"`
+	PartKind   PartKind    `protobuf:"varint,14,opt,name=part_kind,json=partKind,proto3,enum=proto.PartKind" json:"part_kind,omitempty"`
 }
 
-func (m *SpecificationRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SbNumber) > 0 {
-		i -= len(m.SbNumber)
-		copy(dAtA[i:], m.SbNumber)
-		i = encodeVarintData(dAtA, i, uint64(len(m.SbNumber)))
-		i--
-		dAtA[i] = 0x6a
+func (m *SpecificationRow) Reset()         { *m = SpecificationRow{} }
+func (m *SpecificationRow) String() string { return proto.CompactTextString(m) }
+func (*SpecificationRow) ProtoMessage()    {}
+func (*SpecificationRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{10}
+}
+func (m *SpecificationRow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecificationRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecificationRow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ImageId) > 0 {
-		i -= len(m.ImageId)
-		copy(dAtA[i:], m.ImageId)
-		i = encodeVarintData(dAtA, i, uint64(len(m.ImageId)))
-		i--
-		dAtA[i] = 0x62
+}
+func (m *SpecificationRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecificationRow.Merge(m, src)
+}
+func (m *SpecificationRow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecificationRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecificationRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecificationRow proto.InternalMessageInfo
+
+func (m *SpecificationRow) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.Assortment != nil {
-		{
-			size, err := m.Assortment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintData(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	return ""
+}
+
+func (m *SpecificationRow) GetParentId() string {
+	if m != nil {
+		return m.ParentId
 	}
-	if len(m.SizeH) > 0 {
-		i -= len(m.SizeH)
-		copy(dAtA[i:], m.SizeH)
-		i = encodeVarintData(dAtA, i, uint64(len(m.SizeH)))
-		i--
-		dAtA[i] = 0x52
+	return ""
+}
+
+func (m *SpecificationRow) GetPosition() int32 {
+	if m != nil {
+		return m.Position
 	}
-	if len(m.SizeV) > 0 {
-		i -= len(m.SizeV)
-		copy(dAtA[i:], m.SizeV)
-		i = encodeVarintData(dAtA, i, uint64(len(m.SizeV)))
-		i--
-		dAtA[i] = 0x4a
+	return 0
+}
+
+func (m *SpecificationRow) GetNumber() string {
+	if m != nil {
+		return m.Number
 	}
-	if len(m.Size_) > 0 {
-		i -= len(m.Size_)
-		copy(dAtA[i:], m.Size_)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Size_)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *SpecificationRow) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.Count != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+func (m *SpecificationRow) GetMaterial() string {
+	if m != nil {
+		return m.Material
 	}
-	if len(m.Material) > 0 {
-		i -= len(m.Material)
-		copy(dAtA[i:], m.Material)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Material)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *SpecificationRow) GetCount() int32 {
+	if m != nil {
+		return m.Count
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *SpecificationRow) GetSize_() string {
+	if m != nil {
+		return m.Size_
 	}
-	if len(m.Number) > 0 {
-		i -= len(m.Number)
-		copy(dAtA[i:], m.Number)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Number)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *SpecificationRow) GetSizeV() string {
+	if m != nil {
+		return m.SizeV
 	}
-	if m.Position != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.Position))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *SpecificationRow) GetSizeH() string {
+	if m != nil {
+		return m.SizeH
 	}
-	if len(m.ParentId) > 0 {
-		i -= len(m.ParentId)
-		copy(dAtA[i:], m.ParentId)
-		i = encodeVarintData(dAtA, i, uint64(len(m.ParentId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SpecificationRow) GetAssortment() *Assortment {
+	if m != nil {
+		return m.Assortment
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *SpecificationRow) GetImageId() string {
+	if m != nil {
+		return m.ImageId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TreeNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpecificationRow) GetSbNumber() string {
+	if m != nil {
+		return m.SbNumber
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TreeNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpecificationRow) GetPartKind() PartKind {
+	if m != nil {
+		return m.PartKind
+	}
+	return PartKind_PART_KIND_UNSPECIFIED
 }
 
-func (m *TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ParentId) > 0 {
-		i -= len(m.ParentId)
-		copy(dAtA[i:], m.ParentId)
-		i = encodeVarintData(dAtA, i, uint64(len(m.ParentId)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Leaves) > 0 {
-		for iNdEx := len(m.Leaves) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Leaves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintData(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.AccumulatedCount != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.AccumulatedCount))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Figure != nil {
-		{
-			size, err := m.Figure.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintData(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Spec != nil {
-		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintData(dAtA, i, uint64(size))
+type TreeNode struct {
+	Id               string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Number           string            `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Name             string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Count            int32             `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Material         string            `protobuf:"bytes,5,opt,name=material,proto3" json:"material,omitempty"`
+	Spec             *SpecificationRow `protobuf:"bytes,6,opt,name=spec,proto3" json:"spec,omitempty"`
+	Figure           *Figure           `protobuf:"bytes,7,opt,name=figure,proto3" json:"figure,omitempty"`
+	AccumulatedCount int32             `protobuf:"varint,8,opt,name=accumulated_count,json=accumulatedCount,proto3" json:"accumulated_count,omitempty"`
+	Leaves           []*TreeNode       `protobuf:"bytes,9,rep,name=leaves,proto3" json:"leaves,omitempty"`
+	ParentId         string            `protobuf:"bytes,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// mass of one unit in kg: computed from the size and material density for parts,
+	// the sum of the leaves for assemblies, the recognized mass when it cannot be computed
+	ComputedMass float64 `protobuf:"fixed64,11,opt,name=computed_mass,json=computedMass,proto3" json:"computed_mass,omitempty"`
+	// mass of all units of the node in the product in kg
+	TotalMass float64 `protobuf:"fixed64,12,opt,name=total_mass,json=totalMass,proto3" json:"total_mass,omitempty"`
+	// YELLOW when the recognized mass differs from the computed one by more than the tolerance
+	MassStatus FieldStatus `protobuf:"varint,13,opt,name=mass_status,json=massStatus,proto3,enum=proto.FieldStatus" json:"mass_status,omitempty"`
+	// statuses of the node fields set by the validation engine, e.g. count or material
+	FieldStatus map[string]FieldStatus `protobuf:"bytes,14,rep,name=field_status,json=fieldStatus,proto3" json:"field_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=proto.FieldStatus"`
+}
+
+func (m *TreeNode) Reset()         { *m = TreeNode{} }
+func (m *TreeNode) String() string { return proto.CompactTextString(m) }
+func (*TreeNode) ProtoMessage()    {}
+func (*TreeNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{11}
+}
+func (m *TreeNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreeNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Material) > 0 {
-		i -= len(m.Material)
-		copy(dAtA[i:], m.Material)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Material)))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if m.Count != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *TreeNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeNode.Merge(m, src)
+}
+func (m *TreeNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreeNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreeNode proto.InternalMessageInfo
+
+func (m *TreeNode) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *TreeNode) GetNumber() string {
+	if m != nil {
+		return m.Number
 	}
-	if len(m.Number) > 0 {
-		i -= len(m.Number)
-		copy(dAtA[i:], m.Number)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Number)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *TreeNode) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *TreeNode) GetCount() int32 {
+	if m != nil {
+		return m.Count
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintData(dAtA []byte, offset int, v uint64) int {
-	offset -= sovData(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TreeNode) GetMaterial() string {
+	if m != nil {
+		return m.Material
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Assortment) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *TreeNode) GetSpec() *SpecificationRow {
+	if m != nil {
+		return m.Spec
 	}
-	var l int
-	_ = l
-	l = len(m.Material)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return nil
+}
+
+func (m *TreeNode) GetFigure() *Figure {
+	if m != nil {
+		return m.Figure
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return nil
+}
+
+func (m *TreeNode) GetAccumulatedCount() int32 {
+	if m != nil {
+		return m.AccumulatedCount
 	}
-	if m.Size_ != nil {
-		l = m.Size_.Size()
-		n += 1 + l + sovData(uint64(l))
+	return 0
+}
+
+func (m *TreeNode) GetLeaves() []*TreeNode {
+	if m != nil {
+		return m.Leaves
 	}
-	l = len(m.ChemicalComposition)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return nil
+}
+
+func (m *TreeNode) GetParentId() string {
+	if m != nil {
+		return m.ParentId
 	}
-	l = len(m.FormGost)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return ""
+}
+
+func (m *TreeNode) GetComputedMass() float64 {
+	if m != nil {
+		return m.ComputedMass
 	}
-	l = len(m.ChemicalGost)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return 0
+}
+
+func (m *TreeNode) GetTotalMass() float64 {
+	if m != nil {
+		return m.TotalMass
 	}
-	l = len(m.FigureType)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return 0
+}
+
+func (m *TreeNode) GetMassStatus() FieldStatus {
+	if m != nil {
+		return m.MassStatus
 	}
-	l = len(m.SubType)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return FieldStatus_FIELD_STATUS_UNSPECIFIED
+}
+
+func (m *TreeNode) GetFieldStatus() map[string]FieldStatus {
+	if m != nil {
+		return m.FieldStatus
 	}
-	if len(m.FieldStatus) > 0 {
-		for k, v := range m.FieldStatus {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovData(uint64(len(k))) + 1 + sovData(uint64(v))
-			n += mapEntrySize + 1 + sovData(uint64(mapEntrySize))
+	return nil
+}
+
+// PartTotal is the quantity of a part or assembly in the whole product, summed over the specification rows
+// naming its designation
+type PartTotal struct {
+	// normalized designation without the document code
+	Designation string `protobuf:"bytes,1,opt,name=designation,proto3" json:"designation,omitempty"`
+	// designation as recognized in the first row
+	Number   string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity int64  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// specification rows naming the designation
+	Occurrences int32    `protobuf:"varint,5,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	NodeIds     []string `protobuf:"bytes,6,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+}
+
+func (m *PartTotal) Reset()         { *m = PartTotal{} }
+func (m *PartTotal) String() string { return proto.CompactTextString(m) }
+func (*PartTotal) ProtoMessage()    {}
+func (*PartTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{12}
+}
+func (m *PartTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *Figure) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PartTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartTotal.Merge(m, src)
+}
+func (m *PartTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartTotal proto.InternalMessageInfo
+
+func (m *PartTotal) GetDesignation() string {
+	if m != nil {
+		return m.Designation
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return ""
+}
+
+func (m *PartTotal) GetNumber() string {
+	if m != nil {
+		return m.Number
 	}
-	l = len(m.ParentId)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return ""
+}
+
+func (m *PartTotal) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return ""
+}
+
+func (m *PartTotal) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
 	}
-	l = len(m.ImageId)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return 0
+}
+
+func (m *PartTotal) GetOccurrences() int32 {
+	if m != nil {
+		return m.Occurrences
 	}
-	l = len(m.Number)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return 0
+}
+
+func (m *PartTotal) GetNodeIds() []string {
+	if m != nil {
+		return m.NodeIds
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return nil
+}
+
+// PartTotals lists the quantities of the designated parts of a task by designation
+type PartTotals struct {
+	TaskId string       `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Parts  []*PartTotal `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
+}
+
+func (m *PartTotals) Reset()         { *m = PartTotals{} }
+func (m *PartTotals) String() string { return proto.CompactTextString(m) }
+func (*PartTotals) ProtoMessage()    {}
+func (*PartTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{13}
+}
+func (m *PartTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SizeVertical != 0 {
-		n += 5
+}
+func (m *PartTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartTotals.Merge(m, src)
+}
+func (m *PartTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartTotals proto.InternalMessageInfo
+
+func (m *PartTotals) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	if m.SizeHorizontal != 0 {
-		n += 5
+	return ""
+}
+
+func (m *PartTotals) GetParts() []*PartTotal {
+	if m != nil {
+		return m.Parts
 	}
-	if m.MainSize != nil {
-		n += m.MainSize.Size()
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("proto.FieldDescription", FieldDescription_name, FieldDescription_value)
+	proto.RegisterEnum("proto.FieldStatus", FieldStatus_name, FieldStatus_value)
+	proto.RegisterEnum("proto.PartKind", PartKind_name, PartKind_value)
+	proto.RegisterEnum("proto.ProfileFamily", ProfileFamily_name, ProfileFamily_value)
+	proto.RegisterEnum("proto.RecognitionStatus", RecognitionStatus_name, RecognitionStatus_value)
+	proto.RegisterType((*SheetSize)(nil), "proto.SheetSize")
+	proto.RegisterType((*RoundBarSize)(nil), "proto.RoundBarSize")
+	proto.RegisterType((*PipeSize)(nil), "proto.PipeSize")
+	proto.RegisterType((*AngleSize)(nil), "proto.AngleSize")
+	proto.RegisterType((*ChannelSize)(nil), "proto.ChannelSize")
+	proto.RegisterType((*BeamSize)(nil), "proto.BeamSize")
+	proto.RegisterType((*SquareTubeSize)(nil), "proto.SquareTubeSize")
+	proto.RegisterType((*ProfileSize)(nil), "proto.ProfileSize")
+	proto.RegisterType((*Assortment)(nil), "proto.Assortment")
+	proto.RegisterMapType((map[string]FieldStatus)(nil), "proto.Assortment.FieldStatusEntry")
+	proto.RegisterType((*Figure)(nil), "proto.Figure")
+	proto.RegisterType((*SpecificationRow)(nil), "proto.SpecificationRow")
+	proto.RegisterType((*TreeNode)(nil), "proto.TreeNode")
+	proto.RegisterMapType((map[string]FieldStatus)(nil), "proto.TreeNode.FieldStatusEntry")
+	proto.RegisterType((*PartTotal)(nil), "proto.PartTotal")
+	proto.RegisterType((*PartTotals)(nil), "proto.PartTotals")
+}
+
+func init() { proto.RegisterFile("proto/data.proto", fileDescriptor_ac8e6d38f431921d) }
+
+var fileDescriptor_ac8e6d38f431921d = []byte{
+	// 1636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0x16, 0xff, 0xb9, 0x87, 0x22, 0xbd, 0x1e, 0xcb, 0xf6, 0x46, 0x49, 0x54, 0x95, 0x6e, 0x13,
+	0x41, 0x36, 0xec, 0xc6, 0x01, 0x8a, 0x22, 0x45, 0x51, 0xac, 0xc8, 0xa5, 0xc5, 0x9a, 0x22, 0xd9,
+	0x59, 0xaa, 0x41, 0x72, 0xb3, 0x18, 0x72, 0x47, 0xe4, 0x42, 0xe4, 0x2e, 0xb3, 0x33, 0x6b, 0x43,
+	0xbe, 0xee, 0x03, 0xf4, 0xa2, 0x4f, 0xd2, 0xa7, 0xe8, 0x65, 0x7a, 0xd7, 0xbb, 0x16, 0xf6, 0x03,
+	0xf4, 0x11, 0x5a, 0xcc, 0xd9, 0x1f, 0x2e, 0x69, 0xa5, 0x45, 0x0c, 0xf4, 0x46, 0x9a, 0xf3, 0x33,
+	0x3f, 0xe7, 0xfb, 0xbe, 0x73, 0xb8, 0xa0, 0xaf, 0xc3, 0x40, 0x06, 0xcf, 0x5c, 0x26, 0xd9, 0x53,
+	0x5c, 0x92, 0x0a, 0xfe, 0x6b, 0x7f, 0x0d, 0x9a, 0xbd, 0xe0, 0x5c, 0xda, 0xde, 0x1b, 0x4e, 0x3e,
+	0x01, 0x4d, 0x2e, 0xbc, 0xd9, 0xb5, 0xcf, 0x85, 0x30, 0x0a, 0xc7, 0x85, 0x93, 0x02, 0xdd, 0x38,
+	0xc8, 0x01, 0x54, 0x5e, 0x7b, 0xae, 0x5c, 0x18, 0x45, 0x8c, 0xc4, 0x06, 0x79, 0x00, 0xd5, 0x25,
+	0xf7, 0xe7, 0x72, 0x61, 0x94, 0xd0, 0x9d, 0x58, 0xed, 0x53, 0xd8, 0xa7, 0x41, 0xe4, 0xbb, 0x67,
+	0x2c, 0xc4, 0xb3, 0x0f, 0xa1, 0xee, 0x7a, 0x6c, 0xc5, 0x25, 0x0f, 0x93, 0xa3, 0x33, 0xbb, 0xfd,
+	0x15, 0xd4, 0xc7, 0xde, 0x9a, 0xff, 0xaf, 0x3c, 0x42, 0xa0, 0xfc, 0x9a, 0x2d, 0x97, 0xc9, 0x03,
+	0x70, 0xad, 0x0a, 0x30, 0xfd, 0xf9, 0x32, 0xde, 0x9c, 0x3d, 0xb1, 0xb0, 0xf3, 0xc4, 0x05, 0xf7,
+	0xe6, 0x0b, 0x99, 0x6c, 0x4c, 0xac, 0xed, 0x72, 0x4b, 0x3b, 0xe5, 0xb6, 0x7f, 0x03, 0x8d, 0xce,
+	0x82, 0xf9, 0x3e, 0x5f, 0xe2, 0xd1, 0x0f, 0xa0, 0xea, 0x47, 0xab, 0x69, 0xf2, 0x2a, 0x8d, 0x26,
+	0xd6, 0x0f, 0x1d, 0xae, 0x6a, 0x3a, 0xe3, 0x6c, 0xf5, 0x41, 0x7b, 0x29, 0xb4, 0xec, 0xef, 0x22,
+	0x16, 0xf2, 0x49, 0x34, 0xfd, 0x90, 0xc2, 0x52, 0x9c, 0x4a, 0x39, 0x9c, 0xfe, 0x5d, 0x84, 0xc6,
+	0x38, 0x0c, 0xae, 0xbc, 0x04, 0xaa, 0x27, 0x50, 0xbd, 0x62, 0x2b, 0x6f, 0x79, 0x83, 0x47, 0xb6,
+	0x9e, 0x1f, 0xc4, 0xba, 0x78, 0x9a, 0xe4, 0xf4, 0x30, 0x46, 0x93, 0x1c, 0xa2, 0x43, 0x29, 0x64,
+	0xaf, 0xf1, 0x1a, 0x8d, 0xaa, 0x25, 0xf9, 0x0c, 0x2a, 0x42, 0x09, 0x07, 0x2f, 0x69, 0x3c, 0xd7,
+	0x93, 0xed, 0x99, 0x98, 0x68, 0x1c, 0x26, 0xbf, 0x00, 0x2d, 0x54, 0x3a, 0x70, 0xa6, 0x2c, 0x34,
+	0xca, 0x98, 0x7b, 0x2f, 0xc9, 0xcd, 0xeb, 0x83, 0xd6, 0xc3, 0xc4, 0x22, 0x8f, 0xa0, 0xbc, 0xf6,
+	0xd6, 0xdc, 0xa8, 0x60, 0xf2, 0x9d, 0xf4, 0x5d, 0x89, 0x40, 0x28, 0x06, 0xd5, 0xf5, 0x4c, 0xd1,
+	0x6e, 0x54, 0xb7, 0xae, 0xcf, 0xa4, 0x40, 0xe3, 0x30, 0x79, 0x02, 0xb5, 0x59, 0xcc, 0xa2, 0x51,
+	0xc3, 0x4c, 0x92, 0x64, 0xe6, 0xb8, 0xa5, 0x69, 0x8a, 0xba, 0x7a, 0xca, 0xd9, 0xca, 0xa8, 0x6f,
+	0x5d, 0x9d, 0xf2, 0x48, 0x31, 0x48, 0x7e, 0x09, 0x0d, 0x81, 0xec, 0x38, 0x32, 0x9a, 0x72, 0x43,
+	0xc3, 0xdc, 0xfb, 0x69, 0xfd, 0x5b, 0xbc, 0x51, 0x10, 0x99, 0xdd, 0xfe, 0x47, 0x09, 0xc0, 0x14,
+	0x22, 0x08, 0xe5, 0x8a, 0xfb, 0x52, 0x09, 0x7d, 0xc5, 0x24, 0x0f, 0x3d, 0xb6, 0x4c, 0x64, 0x91,
+	0xd9, 0x8a, 0x40, 0x9f, 0xad, 0x78, 0x82, 0x37, 0xae, 0xc9, 0x67, 0x50, 0x16, 0xde, 0x1b, 0x6e,
+	0xc0, 0x56, 0x19, 0x39, 0x4a, 0x29, 0xc6, 0xc9, 0x17, 0x70, 0x30, 0x5b, 0xf0, 0x95, 0x37, 0x63,
+	0x4b, 0x67, 0x16, 0xac, 0xd6, 0x81, 0xf0, 0xa4, 0x17, 0xf8, 0x88, 0xbd, 0x46, 0xef, 0xa5, 0xb1,
+	0xce, 0x26, 0x44, 0x3e, 0x06, 0xed, 0x2a, 0x08, 0x57, 0xce, 0x3c, 0x10, 0x12, 0x61, 0xd7, 0x68,
+	0x5d, 0x39, 0x5e, 0x04, 0x42, 0x92, 0x47, 0xd0, 0xcc, 0xce, 0xc3, 0x84, 0x2a, 0x26, 0xec, 0xa7,
+	0x4e, 0x4c, 0xfa, 0x09, 0x34, 0xae, 0xbc, 0x79, 0xa4, 0x30, 0xb9, 0x59, 0x73, 0x84, 0x5a, 0xa3,
+	0x10, 0xbb, 0x26, 0x37, 0x6b, 0x4e, 0x3e, 0x82, 0xba, 0x88, 0xa6, 0x71, 0xb4, 0x8e, 0xd1, 0x9a,
+	0x88, 0xa6, 0x18, 0xb2, 0x60, 0xff, 0xca, 0xe3, 0x4b, 0xd7, 0x11, 0x92, 0xc9, 0x48, 0x18, 0xda,
+	0x71, 0xe9, 0xa4, 0xf1, 0xbc, 0x9d, 0x32, 0x9a, 0x21, 0xf6, 0xb4, 0xa7, 0xb2, 0x6c, 0x4c, 0xb2,
+	0x7c, 0x19, 0xde, 0xd0, 0xc6, 0xd5, 0xc6, 0x43, 0x3e, 0x87, 0x3b, 0x22, 0x9a, 0xcf, 0xb9, 0x90,
+	0xdc, 0x75, 0x14, 0x12, 0xc2, 0x68, 0x1c, 0x97, 0x4e, 0x34, 0xda, 0xca, 0xdc, 0x0a, 0x25, 0x71,
+	0x48, 0x41, 0xdf, 0x3d, 0x49, 0xe9, 0xfb, 0x9a, 0xdf, 0x24, 0x3c, 0xa8, 0x25, 0x39, 0x81, 0xca,
+	0x2b, 0xb6, 0x8c, 0x62, 0x0e, 0x5a, 0x19, 0xde, 0xb9, 0x9d, 0x34, 0x4e, 0xf8, 0xaa, 0xf8, 0xab,
+	0xc2, 0xef, 0xca, 0xf5, 0x92, 0x5e, 0x6e, 0xff, 0xb1, 0x04, 0xd5, 0x1e, 0xd6, 0x4c, 0x5a, 0x50,
+	0xf4, 0xdc, 0xe4, 0xbc, 0xa2, 0xe7, 0x2a, 0x88, 0xd7, 0x2c, 0xe4, 0xbe, 0x74, 0x3c, 0x37, 0xa1,
+	0xb5, 0x1e, 0x3b, 0xfa, 0xae, 0xea, 0x6e, 0x6f, 0xc5, 0xe6, 0x1c, 0x7b, 0x69, 0x9f, 0xc6, 0x86,
+	0x82, 0x0c, 0x17, 0x6a, 0x47, 0x4c, 0x5e, 0x0d, 0xed, 0xbe, 0x9b, 0x1b, 0x28, 0x95, 0xad, 0x81,
+	0x92, 0xea, 0xa6, 0x9a, 0xd3, 0xcd, 0x23, 0x68, 0x2a, 0x34, 0x9c, 0x57, 0x3c, 0x94, 0x8a, 0x2f,
+	0x24, 0xa7, 0x48, 0xf7, 0x95, 0xf3, 0x0f, 0x89, 0x0f, 0xc1, 0x53, 0x49, 0x8b, 0x20, 0xf4, 0xde,
+	0x04, 0xbe, 0x64, 0x4b, 0x64, 0xa9, 0x48, 0x5b, 0xca, 0x7d, 0x9e, 0x79, 0xc9, 0x09, 0xdc, 0x59,
+	0x31, 0xcf, 0x47, 0x80, 0x9d, 0xab, 0x65, 0xc0, 0x24, 0x36, 0x40, 0xf1, 0x7c, 0x8f, 0x36, 0x55,
+	0x40, 0x41, 0xdc, 0x53, 0x6e, 0xf2, 0x33, 0x68, 0x6e, 0x32, 0x85, 0x0c, 0x51, 0xb8, 0xda, 0xf9,
+	0x1e, 0x6d, 0xa4, 0x79, 0xb6, 0x0c, 0xc9, 0x17, 0x00, 0x2c, 0x63, 0xd8, 0x68, 0xa0, 0xb6, 0xef,
+	0xbe, 0x47, 0x3d, 0xcd, 0x25, 0xa9, 0x22, 0x57, 0x4c, 0x08, 0x63, 0x1f, 0x1f, 0x88, 0xeb, 0xb3,
+	0x06, 0x68, 0xd9, 0x65, 0xed, 0x3f, 0x97, 0x40, 0xb7, 0xd7, 0x7c, 0xe6, 0x5d, 0x79, 0x33, 0xa6,
+	0x04, 0x4e, 0x83, 0xd7, 0x3f, 0x8e, 0x90, 0x43, 0xa8, 0x67, 0x7d, 0xa3, 0x38, 0xa9, 0xd0, 0xcc,
+	0xce, 0x61, 0x5f, 0xbe, 0x15, 0xfb, 0x4a, 0x0e, 0xfb, 0x7c, 0x8f, 0x57, 0x77, 0x7a, 0xfc, 0x00,
+	0x2a, 0xb3, 0x20, 0xf2, 0x25, 0xf2, 0x51, 0xa1, 0xb1, 0xa1, 0x4e, 0xc1, 0x2e, 0x8f, 0x7b, 0x04,
+	0xd7, 0xe4, 0x3e, 0x54, 0x63, 0x06, 0x11, 0x6a, 0x8d, 0x56, 0x90, 0xba, 0xcc, 0xbd, 0x30, 0x60,
+	0xe3, 0x3e, 0xff, 0x10, 0x44, 0xf3, 0x4a, 0xdb, 0xdf, 0x56, 0xda, 0xc7, 0xa0, 0x89, 0xa9, 0x93,
+	0x14, 0xdc, 0x8c, 0x4b, 0x10, 0xd3, 0x61, 0x5c, 0xf2, 0x13, 0xc4, 0x50, 0x3a, 0xd7, 0x9e, 0xef,
+	0x1a, 0x2d, 0xec, 0x93, 0x6c, 0x5c, 0xb3, 0x50, 0xbe, 0xf4, 0x7c, 0x17, 0x41, 0xc5, 0x55, 0xfb,
+	0x6f, 0x65, 0xa8, 0x4f, 0x42, 0xce, 0x87, 0x81, 0xfb, 0x7e, 0x7f, 0x6c, 0x50, 0x2d, 0xde, 0x8a,
+	0x6a, 0x29, 0x87, 0x6a, 0x86, 0x5c, 0x39, 0x8f, 0x5c, 0x1e, 0xeb, 0xca, 0x0e, 0xd6, 0x8f, 0xa1,
+	0x2c, 0xd6, 0x7c, 0x96, 0xfc, 0x58, 0x3c, 0x4c, 0x67, 0xf5, 0x8e, 0x46, 0x28, 0x26, 0x91, 0x9f,
+	0x43, 0x35, 0x1e, 0x5c, 0xc9, 0x2f, 0x46, 0x33, 0x6b, 0x7d, 0xe5, 0xa4, 0x49, 0x90, 0x3c, 0x86,
+	0xbb, 0x6c, 0x36, 0x8b, 0x56, 0xd1, 0x92, 0xa9, 0x89, 0x13, 0xbf, 0xa8, 0x8e, 0x2f, 0xd2, 0x73,
+	0x81, 0x0e, 0x3e, 0xee, 0x73, 0xf5, 0x95, 0xc4, 0x5e, 0xf1, 0x74, 0xba, 0xa5, 0x30, 0xa5, 0x78,
+	0xd0, 0x24, 0xbc, 0x2d, 0x4b, 0xd8, 0x91, 0xa5, 0x1a, 0xc5, 0xc1, 0x6a, 0x1d, 0xa9, 0xfb, 0xb0,
+	0x05, 0x1a, 0xf8, 0x03, 0xbf, 0x9f, 0x3a, 0x2f, 0x98, 0x10, 0xe4, 0x53, 0x00, 0x19, 0x48, 0xb6,
+	0x74, 0xb2, 0x26, 0x51, 0x9f, 0x35, 0xca, 0x83, 0xe1, 0x2f, 0xa1, 0xa1, 0x02, 0xe9, 0xb0, 0x6d,
+	0xfe, 0xe0, 0x74, 0x03, 0x95, 0x16, 0xaf, 0x49, 0x67, 0x67, 0x44, 0xb7, 0xb0, 0x88, 0xe3, 0x9d,
+	0x22, 0xfe, 0xfb, 0x80, 0xfe, 0x7f, 0xcc, 0xdd, 0xf6, 0x5f, 0x0a, 0xa0, 0x29, 0xa9, 0x4d, 0x54,
+	0x7d, 0xe4, 0x18, 0x1a, 0x2e, 0x17, 0xde, 0xdc, 0x47, 0x46, 0x93, 0x53, 0xf3, 0xae, 0x1f, 0x25,
+	0xb3, 0x43, 0xa8, 0x7f, 0x17, 0x31, 0x5f, 0x7a, 0xf2, 0x06, 0x95, 0x56, 0xa2, 0x99, 0xad, 0x6e,
+	0x0a, 0x66, 0xb3, 0x28, 0x0c, 0xb9, 0x3f, 0xe3, 0x02, 0xf5, 0x56, 0xa1, 0x79, 0x97, 0xea, 0x29,
+	0x3f, 0x70, 0x55, 0x4b, 0x09, 0xa3, 0x8a, 0xbf, 0x43, 0x35, 0x65, 0xf7, 0x5d, 0xd1, 0xbe, 0x00,
+	0xc8, 0xde, 0x2c, 0xc8, 0x43, 0xa8, 0x49, 0x26, 0xae, 0x9d, 0xac, 0x1d, 0xaa, 0xca, 0xec, 0xbb,
+	0xea, 0x13, 0x47, 0xf5, 0x8e, 0x30, 0x8a, 0xc7, 0xa5, 0xdc, 0x27, 0x4e, 0xb6, 0x95, 0xc6, 0xe1,
+	0xd3, 0x45, 0x82, 0x6b, 0x97, 0x8b, 0x59, 0xe8, 0xad, 0xb1, 0xce, 0x9f, 0xc2, 0xa7, 0xbd, 0xbe,
+	0x35, 0xe8, 0x3a, 0x5d, 0xcb, 0xee, 0xd0, 0xfe, 0x78, 0xd2, 0x1f, 0x0d, 0x9d, 0xcb, 0xa1, 0x3d,
+	0xb6, 0x3a, 0xfd, 0x5e, 0xdf, 0xea, 0xea, 0x7b, 0xa4, 0x0e, 0x65, 0xbb, 0xff, 0xad, 0xa5, 0x17,
+	0x48, 0x0b, 0xc0, 0xb4, 0xed, 0x11, 0x9d, 0x5c, 0x58, 0xc3, 0x89, 0x5e, 0x54, 0xf6, 0xc5, 0xa8,
+	0x6b, 0x0d, 0x1c, 0x8c, 0x97, 0x48, 0x0d, 0x4a, 0xe6, 0x60, 0xa0, 0x97, 0x4f, 0x7f, 0x0b, 0x8d,
+	0x1c, 0x0f, 0xe4, 0x13, 0x30, 0xe2, 0x4b, 0xec, 0x89, 0x39, 0xb9, 0xb4, 0x77, 0xce, 0xaf, 0x42,
+	0x71, 0xf4, 0x52, 0x2f, 0x10, 0x80, 0xea, 0x37, 0xd6, 0x60, 0x30, 0xfa, 0x5a, 0x2f, 0x9e, 0x86,
+	0x50, 0x4f, 0x07, 0x03, 0xf9, 0x08, 0xee, 0x8f, 0x4d, 0x3a, 0x71, 0x5e, 0xf6, 0x87, 0xdd, 0x9d,
+	0xad, 0x87, 0xf0, 0x60, 0x13, 0xba, 0x30, 0x87, 0x97, 0x3d, 0xb3, 0x33, 0xb9, 0xa4, 0x56, 0x57,
+	0x2f, 0x90, 0x07, 0x40, 0x36, 0x31, 0x7b, 0x62, 0x0e, 0xbb, 0x26, 0xed, 0xea, 0x45, 0xf2, 0x10,
+	0xee, 0x6d, 0xfc, 0xe3, 0x4b, 0xda, 0x39, 0x37, 0x6d, 0xab, 0xab, 0x97, 0x4e, 0xff, 0x55, 0x80,
+	0xe6, 0xd6, 0x47, 0x2d, 0x39, 0x82, 0xc3, 0x31, 0x1d, 0xf5, 0xfa, 0x03, 0xcb, 0xe9, 0x99, 0x17,
+	0xfd, 0xc1, 0x37, 0x3b, 0xd7, 0x1b, 0x70, 0xb0, 0x13, 0xb7, 0xcf, 0x2d, 0x6b, 0xa2, 0x17, 0x54,
+	0xc5, 0x3b, 0x11, 0x3a, 0xba, 0x1c, 0x76, 0x9d, 0x33, 0x93, 0x26, 0x4f, 0xd8, 0x8e, 0x8e, 0xfb,
+	0x63, 0x05, 0xe0, 0xfb, 0x07, 0x9a, 0xc3, 0x17, 0x03, 0x4b, 0x2f, 0x63, 0xa5, 0xdb, 0x91, 0xce,
+	0xb9, 0x39, 0x1c, 0x5a, 0x03, 0xbd, 0x72, 0xcb, 0x71, 0x67, 0x96, 0x79, 0xa1, 0x57, 0x6f, 0x79,
+	0xbf, 0xfd, 0xfb, 0x4b, 0x93, 0x5a, 0xce, 0xe4, 0xf2, 0xcc, 0xd2, 0x6b, 0xa7, 0x01, 0xdc, 0xa5,
+	0x7c, 0x16, 0xcc, 0x7d, 0xfc, 0xc1, 0x4a, 0xc8, 0x6a, 0xc3, 0x11, 0xb5, 0x3a, 0xa3, 0x17, 0xc3,
+	0x3e, 0x6a, 0xe1, 0x56, 0xca, 0x1a, 0x50, 0x1b, 0x5b, 0xc3, 0x6e, 0x7f, 0xf8, 0x22, 0x56, 0xc5,
+	0x98, 0x8e, 0x3a, 0x96, 0x6d, 0x2b, 0xbb, 0x48, 0x9a, 0xa0, 0x75, 0x46, 0x17, 0xe3, 0x81, 0x35,
+	0x51, 0xb0, 0x2a, 0x5a, 0x7b, 0x66, 0x7f, 0x60, 0x75, 0xf5, 0xf2, 0xd9, 0xe3, 0xbf, 0xbe, 0x3d,
+	0x2a, 0x7c, 0xff, 0xf6, 0xa8, 0xf0, 0xcf, 0xb7, 0x47, 0x85, 0x3f, 0xbd, 0x3b, 0xda, 0xfb, 0xfe,
+	0xdd, 0xd1, 0xde, 0xdf, 0xdf, 0x1d, 0xed, 0x7d, 0x7b, 0xf7, 0xe9, 0xb3, 0xf5, 0xf5, 0xfc, 0x19,
+	0x2a, 0xf7, 0xd7, 0xf8, 0x77, 0x5a, 0xc5, 0x7f, 0x5f, 0xfe, 0x67, 0x00, 0x02, 0xa9, 0xb8, 0x73,
+	0x93, 0x0e, 0x00, 0x00,
+}
+
+func (m *SheetSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Assortment != nil {
-		l = m.Assortment.Size()
-		n += 1 + l + sovData(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *SheetSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SheetSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Length))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if m.Mass != 0 {
-		n += 5
+	if m.Width != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Width))))
+		i--
+		dAtA[i] = 0x11
 	}
-	return n
+	if m.Thickness != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Thickness))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Figure_MainSizeFloat) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RoundBarSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundBarSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundBarSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	n += 5
-	return n
+	if m.Diameter != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Diameter))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Figure_MainSizeStr) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PipeSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PipeSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipeSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MainSizeStr)
-	n += 1 + l + sovData(uint64(l))
-	return n
+	if m.Wall != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Wall))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Diameter != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Diameter))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
 }
-func (m *SpecificationRow) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *AngleSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AngleSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AngleSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.Thickness != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Thickness))))
+		i--
+		dAtA[i] = 0x19
 	}
-	l = len(m.ParentId)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.Height != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Height))))
+		i--
+		dAtA[i] = 0x11
 	}
-	if m.Position != 0 {
-		n += 1 + sovData(uint64(m.Position))
+	if m.Width != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Width))))
+		i--
+		dAtA[i] = 0x9
 	}
-	l = len(m.Number)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ChannelSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Height))))
+		i--
+		dAtA[i] = 0x11
 	}
-	l = len(m.Material)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Count != 0 {
-		n += 1 + sovData(uint64(m.Count))
+	return len(dAtA) - i, nil
+}
+
+func (m *BeamSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Size_)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *BeamSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeamSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Height))))
+		i--
+		dAtA[i] = 0x11
 	}
-	l = len(m.SizeV)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.SizeH)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *SquareTubeSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Assortment != nil {
-		l = m.Assortment.Size()
-		n += 1 + l + sovData(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *SquareTubeSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SquareTubeSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Wall != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Wall))))
+		i--
+		dAtA[i] = 0x19
 	}
-	l = len(m.ImageId)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.Height != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Height))))
+		i--
+		dAtA[i] = 0x11
 	}
-	l = len(m.SbNumber)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.Width != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Width))))
+		i--
+		dAtA[i] = 0x9
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TreeNode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProfileSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfileSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.SquareTube != nil {
+		{
+			size, err := m.SquareTube.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Number)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.Beam != nil {
+		{
+			size, err := m.Beam.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.Channel != nil {
+		{
+			size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Count != 0 {
-		n += 1 + sovData(uint64(m.Count))
+	if m.Angle != nil {
+		{
+			size, err := m.Angle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Material)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.Pipe != nil {
+		{
+			size, err := m.Pipe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Spec != nil {
-		l = m.Spec.Size()
-		n += 1 + l + sovData(uint64(l))
+	if m.RoundBar != nil {
+		{
+			size, err := m.RoundBar.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Figure != nil {
-		l = m.Figure.Size()
-		n += 1 + l + sovData(uint64(l))
+	if m.Sheet != nil {
+		{
+			size, err := m.Sheet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AccumulatedCount != 0 {
-		n += 1 + sovData(uint64(m.AccumulatedCount))
+	if len(m.Raw) > 0 {
+		i -= len(m.Raw)
+		copy(dAtA[i:], m.Raw)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Raw)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Leaves) > 0 {
-		for _, e := range m.Leaves {
-			l = e.Size()
-			n += 1 + l + sovData(uint64(l))
+	if m.Family != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Family))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Assortment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Assortment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Assortment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SuggestedSizes) > 0 {
+		for iNdEx := len(m.SuggestedSizes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SuggestedSizes[iNdEx])
+			copy(dAtA[i:], m.SuggestedSizes[iNdEx])
+			i = encodeVarintData(dAtA, i, uint64(len(m.SuggestedSizes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Size_ != nil {
+		{
+			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.FieldStatus) > 0 {
+		for k := range m.FieldStatus {
+			v := m.FieldStatus[k]
+			baseI := i
+			i = encodeVarintData(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintData(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintData(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SubType) > 0 {
+		i -= len(m.SubType)
+		copy(dAtA[i:], m.SubType)
+		i = encodeVarintData(dAtA, i, uint64(len(m.SubType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FigureType) > 0 {
+		i -= len(m.FigureType)
+		copy(dAtA[i:], m.FigureType)
+		i = encodeVarintData(dAtA, i, uint64(len(m.FigureType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ChemicalGost) > 0 {
+		i -= len(m.ChemicalGost)
+		copy(dAtA[i:], m.ChemicalGost)
+		i = encodeVarintData(dAtA, i, uint64(len(m.ChemicalGost)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FormGost) > 0 {
+		i -= len(m.FormGost)
+		copy(dAtA[i:], m.FormGost)
+		i = encodeVarintData(dAtA, i, uint64(len(m.FormGost)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChemicalComposition) > 0 {
+		i -= len(m.ChemicalComposition)
+		copy(dAtA[i:], m.ChemicalComposition)
+		i = encodeVarintData(dAtA, i, uint64(len(m.ChemicalComposition)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Material) > 0 {
+		i -= len(m.Material)
+		copy(dAtA[i:], m.Material)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Material)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Figure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Figure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Figure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mass != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Mass))))
+		i--
+		dAtA[i] = 0x65
+	}
+	if m.Assortment != nil {
+		{
+			size, err := m.Assortment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MainSize != nil {
+		{
+			size := m.MainSize.Size()
+			i -= size
+			if _, err := m.MainSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.SizeHorizontal != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.SizeHorizontal))))
+		i--
+		dAtA[i] = 0x45
+	}
+	if m.SizeVertical != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.SizeVertical))))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ImageId) > 0 {
+		i -= len(m.ImageId)
+		copy(dAtA[i:], m.ImageId)
+		i = encodeVarintData(dAtA, i, uint64(len(m.ImageId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintData(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Figure_MainSizeFloat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Figure_MainSizeFloat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MainSizeFloat))))
+	i--
+	dAtA[i] = 0x4d
+	return len(dAtA) - i, nil
+}
+func (m *Figure_MainSizeStr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Figure_MainSizeStr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.MainSizeStr)
+	copy(dAtA[i:], m.MainSizeStr)
+	i = encodeVarintData(dAtA, i, uint64(len(m.MainSizeStr)))
+	i--
+	dAtA[i] = 0x52
+	return len(dAtA) - i, nil
+}
+func (m *SpecificationRow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecificationRow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecificationRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartKind != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.PartKind))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.SbNumber) > 0 {
+		i -= len(m.SbNumber)
+		copy(dAtA[i:], m.SbNumber)
+		i = encodeVarintData(dAtA, i, uint64(len(m.SbNumber)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ImageId) > 0 {
+		i -= len(m.ImageId)
+		copy(dAtA[i:], m.ImageId)
+		i = encodeVarintData(dAtA, i, uint64(len(m.ImageId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Assortment != nil {
+		{
+			size, err := m.Assortment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.SizeH) > 0 {
+		i -= len(m.SizeH)
+		copy(dAtA[i:], m.SizeH)
+		i = encodeVarintData(dAtA, i, uint64(len(m.SizeH)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SizeV) > 0 {
+		i -= len(m.SizeV)
+		copy(dAtA[i:], m.SizeV)
+		i = encodeVarintData(dAtA, i, uint64(len(m.SizeV)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Size_) > 0 {
+		i -= len(m.Size_)
+		copy(dAtA[i:], m.Size_)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Size_)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Count != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Material) > 0 {
+		i -= len(m.Material)
+		copy(dAtA[i:], m.Material)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Material)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Position != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintData(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FieldStatus) > 0 {
+		for k := range m.FieldStatus {
+			v := m.FieldStatus[k]
+			baseI := i
+			i = encodeVarintData(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintData(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintData(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.MassStatus != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.MassStatus))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TotalMass != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TotalMass))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.ComputedMass != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ComputedMass))))
+		i--
+		dAtA[i] = 0x59
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintData(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Leaves) > 0 {
+		for iNdEx := len(m.Leaves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leaves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintData(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.AccumulatedCount != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.AccumulatedCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Figure != nil {
+		{
+			size, err := m.Figure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Material) > 0 {
+		i -= len(m.Material)
+		copy(dAtA[i:], m.Material)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Material)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Count != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeIds) > 0 {
+		for iNdEx := len(m.NodeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NodeIds[iNdEx])
+			copy(dAtA[i:], m.NodeIds[iNdEx])
+			i = encodeVarintData(dAtA, i, uint64(len(m.NodeIds[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Occurrences != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Occurrences))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Designation) > 0 {
+		i -= len(m.Designation)
+		copy(dAtA[i:], m.Designation)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Designation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parts) > 0 {
+		for iNdEx := len(m.Parts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintData(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintData(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintData(dAtA []byte, offset int, v uint64) int {
+	offset -= sovData(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SheetSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Thickness != 0 {
+		n += 9
+	}
+	if m.Width != 0 {
+		n += 9
+	}
+	if m.Length != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *RoundBarSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Diameter != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *PipeSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Diameter != 0 {
+		n += 9
+	}
+	if m.Wall != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *AngleSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Width != 0 {
+		n += 9
+	}
+	if m.Height != 0 {
+		n += 9
+	}
+	if m.Thickness != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ChannelSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *BeamSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *SquareTubeSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Width != 0 {
+		n += 9
+	}
+	if m.Height != 0 {
+		n += 9
+	}
+	if m.Wall != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ProfileSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Family != 0 {
+		n += 1 + sovData(uint64(m.Family))
+	}
+	l = len(m.Raw)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Sheet != nil {
+		l = m.Sheet.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.RoundBar != nil {
+		l = m.RoundBar.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Pipe != nil {
+		l = m.Pipe.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Angle != nil {
+		l = m.Angle.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Channel != nil {
+		l = m.Channel.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Beam != nil {
+		l = m.Beam.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.SquareTube != nil {
+		l = m.SquareTube.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	return n
+}
+
+func (m *Assortment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Material)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.ChemicalComposition)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.FormGost)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.ChemicalGost)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.FigureType)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.SubType)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if len(m.FieldStatus) > 0 {
+		for k, v := range m.FieldStatus {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovData(uint64(len(k))) + 1 + sovData(uint64(v))
+			n += mapEntrySize + 1 + sovData(uint64(mapEntrySize))
+		}
+	}
+	if m.Size_ != nil {
+		l = m.Size_.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if len(m.SuggestedSizes) > 0 {
+		for _, s := range m.SuggestedSizes {
+			l = len(s)
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Figure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.ImageId)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.SizeVertical != 0 {
+		n += 5
+	}
+	if m.SizeHorizontal != 0 {
+		n += 5
+	}
+	if m.MainSize != nil {
+		n += m.MainSize.Size()
+	}
+	if m.Assortment != nil {
+		l = m.Assortment.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Mass != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *Figure_MainSizeFloat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 5
+	return n
+}
+func (m *Figure_MainSizeStr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MainSizeStr)
+	n += 1 + l + sovData(uint64(l))
+	return n
+}
+func (m *SpecificationRow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovData(uint64(m.Position))
+	}
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Material)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovData(uint64(m.Count))
+	}
+	l = len(m.Size_)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.SizeV)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.SizeH)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Assortment != nil {
+		l = m.Assortment.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.ImageId)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.SbNumber)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.PartKind != 0 {
+		n += 1 + sovData(uint64(m.PartKind))
+	}
+	return n
+}
+
+func (m *TreeNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovData(uint64(m.Count))
+	}
+	l = len(m.Material)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Figure != nil {
+		l = m.Figure.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.AccumulatedCount != 0 {
+		n += 1 + sovData(uint64(m.AccumulatedCount))
+	}
+	if len(m.Leaves) > 0 {
+		for _, e := range m.Leaves {
+			l = e.Size()
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.ComputedMass != 0 {
+		n += 9
+	}
+	if m.TotalMass != 0 {
+		n += 9
+	}
+	if m.MassStatus != 0 {
+		n += 1 + sovData(uint64(m.MassStatus))
+	}
+	if len(m.FieldStatus) > 0 {
+		for k, v := range m.FieldStatus {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovData(uint64(len(k))) + 1 + sovData(uint64(v))
+			n += mapEntrySize + 1 + sovData(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PartTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Designation)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovData(uint64(m.Quantity))
+	}
+	if m.Occurrences != 0 {
+		n += 1 + sovData(uint64(m.Occurrences))
+	}
+	if len(m.NodeIds) > 0 {
+		for _, s := range m.NodeIds {
+			l = len(s)
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PartTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if len(m.Parts) > 0 {
+		for _, e := range m.Parts {
+			l = e.Size()
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovData(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozData(x uint64) (n int) {
+	return sovData(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SheetSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SheetSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SheetSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thickness", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Thickness = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Width = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Length = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundBarSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundBarSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundBarSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diameter", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Diameter = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipeSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipeSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipeSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diameter", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Diameter = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wall", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Wall = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AngleSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AngleSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AngleSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Width = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Height = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thickness", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Thickness = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Number = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Height = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeamSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeamSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeamSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Number = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Height = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SquareTubeSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SquareTubeSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SquareTubeSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Width = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Height = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wall", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Wall = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfileSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
+			}
+			m.Family = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Family |= ProfileFamily(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Raw = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sheet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sheet == nil {
+				m.Sheet = &SheetSize{}
+			}
+			if err := m.Sheet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundBar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RoundBar == nil {
+				m.RoundBar = &RoundBarSize{}
+			}
+			if err := m.RoundBar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipe == nil {
+				m.Pipe = &PipeSize{}
+			}
+			if err := m.Pipe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Angle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Angle == nil {
+				m.Angle = &AngleSize{}
+			}
+			if err := m.Angle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Channel == nil {
+				m.Channel = &ChannelSize{}
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Beam == nil {
+				m.Beam = &BeamSize{}
+			}
+			if err := m.Beam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareTube", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SquareTube == nil {
+				m.SquareTube = &SquareTubeSize{}
+			}
+			if err := m.SquareTube.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Assortment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Assortment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Assortment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Material", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Material = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChemicalComposition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChemicalComposition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FormGost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FormGost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChemicalGost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChemicalGost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FigureType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FigureType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FieldStatus == nil {
+				m.FieldStatus = make(map[string]FieldStatus)
+			}
+			var mapkey string
+			var mapvalue FieldStatus
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowData
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowData
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthData
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthData
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowData
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= FieldStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipData(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthData
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FieldStatus[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Size_ == nil {
+				m.Size_ = &ProfileSize{}
+			}
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestedSizes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuggestedSizes = append(m.SuggestedSizes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.ParentId)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
-	}
-	return n
-}
 
-func sovData(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozData(x uint64) (n int) {
-	return sovData(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Assortment) Unmarshal(dAtA []byte) error {
+func (m *Figure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1446,15 +4411,15 @@ func (m *Assortment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Assortment: wiretype end group for non-group")
+			return fmt.Errorf("proto: Figure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Assortment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Figure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Material", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1482,11 +4447,11 @@ func (m *Assortment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Material = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1514,13 +4479,13 @@ func (m *Assortment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ParentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -1530,31 +4495,29 @@ func (m *Assortment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Size_ == nil {
-				m.Size_ = &types.Any{}
-			}
-			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Image = append(m.Image[:0], dAtA[iNdEx:postIndex]...)
+			if m.Image == nil {
+				m.Image = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChemicalComposition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1582,11 +4545,11 @@ func (m *Assortment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChemicalComposition = string(dAtA[iNdEx:postIndex])
+			m.ImageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FormGost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1614,11 +4577,11 @@ func (m *Assortment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FormGost = string(dAtA[iNdEx:postIndex])
+			m.Number = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChemicalGost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1646,43 +4609,44 @@ func (m *Assortment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChemicalGost = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FigureType", wireType)
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeVertical", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowData
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthData
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.SizeVertical = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeHorizontal", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.SizeHorizontal = float32(math.Float32frombits(v))
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MainSizeFloat", wireType)
 			}
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FigureType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.MainSize = &Figure_MainSizeFloat{float32(math.Float32frombits(v))}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MainSizeStr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1710,11 +4674,11 @@ func (m *Assortment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubType = string(dAtA[iNdEx:postIndex])
+			m.MainSize = &Figure_MainSizeStr{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 9:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assortment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1741,90 +4705,24 @@ func (m *Assortment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FieldStatus == nil {
-				m.FieldStatus = make(map[string]FieldStatus)
+			if m.Assortment == nil {
+				m.Assortment = &Assortment{}
 			}
-			var mapkey string
-			var mapvalue FieldStatus
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowData
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowData
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthData
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthData
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowData
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= FieldStatus(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipData(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthData
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Assortment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.FieldStatus[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 12:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mass", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Mass = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(dAtA[iNdEx:])
@@ -1846,7 +4744,7 @@ func (m *Assortment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Figure) Unmarshal(dAtA []byte) error {
+func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1869,10 +4767,10 @@ func (m *Figure) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Figure: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpecificationRow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Figure: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpecificationRow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1940,10 +4838,29 @@ func (m *Figure) Unmarshal(dAtA []byte) error {
 			m.ParentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -1953,29 +4870,27 @@ func (m *Figure) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = append(m.Image[:0], dAtA[iNdEx:postIndex]...)
-			if m.Image == nil {
-				m.Image = []byte{}
-			}
+			m.Number = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2003,11 +4918,11 @@ func (m *Figure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Material", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2035,11 +4950,30 @@ func (m *Figure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Number = string(dAtA[iNdEx:postIndex])
+			m.Material = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2067,44 +5001,43 @@ func (m *Figure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Size_ = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeVertical", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeV", wireType)
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.SizeVertical = float32(math.Float32frombits(v))
-		case 8:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeHorizontal", wireType)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.SizeHorizontal = float32(math.Float32frombits(v))
-		case 9:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MainSizeFloat", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.MainSize = &Figure_MainSizeFloat{float32(math.Float32frombits(v))}
+			}
+			m.SizeV = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MainSizeStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeH", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2132,7 +5065,7 @@ func (m *Figure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MainSize = &Figure_MainSizeStr{string(dAtA[iNdEx:postIndex])}
+			m.SizeH = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
@@ -2171,69 +5104,8 @@ func (m *Figure) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 12:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mass", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Mass = float32(math.Float32frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipData(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthData
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowData
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpecificationRow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpecificationRow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2261,11 +5133,11 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ImageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SbNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2293,13 +5165,13 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentId = string(dAtA[iNdEx:postIndex])
+			m.SbNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartKind", wireType)
 			}
-			m.Position = 0
+			m.PartKind = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2309,14 +5181,64 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Position |= int32(b&0x7F) << shift
+				m.PartKind |= PartKind(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreeNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2344,11 +5266,11 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Number = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2376,11 +5298,11 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Number = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Material", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2408,9 +5330,9 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Material = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
@@ -2429,9 +5351,9 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Material", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2459,13 +5381,13 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Size_ = string(dAtA[iNdEx:postIndex])
+			m.Material = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeV", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2475,29 +5397,33 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SizeV = string(dAtA[iNdEx:postIndex])
+			if m.Spec == nil {
+				m.Spec = &SpecificationRow{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeH", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Figure", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2507,27 +5433,50 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SizeH = string(dAtA[iNdEx:postIndex])
+			if m.Figure == nil {
+				m.Figure = &Figure{}
+			}
+			if err := m.Figure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedCount", wireType)
+			}
+			m.AccumulatedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccumulatedCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assortment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2554,16 +5503,14 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Assortment == nil {
-				m.Assortment = &Assortment{}
-			}
-			if err := m.Assortment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Leaves = append(m.Leaves, &TreeNode{})
+			if err := m.Leaves[len(m.Leaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2591,13 +5538,54 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageId = string(dAtA[iNdEx:postIndex])
+			m.ParentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputedMass", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ComputedMass = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMass", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TotalMass = float64(math.Float64frombits(v))
 		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MassStatus", wireType)
+			}
+			m.MassStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MassStatus |= FieldStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SbNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2607,23 +5595,104 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SbNumber = string(dAtA[iNdEx:postIndex])
+			if m.FieldStatus == nil {
+				m.FieldStatus = make(map[string]FieldStatus)
+			}
+			var mapkey string
+			var mapvalue FieldStatus
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowData
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowData
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthData
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthData
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowData
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= FieldStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipData(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthData
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FieldStatus[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2646,7 +5715,7 @@ func (m *SpecificationRow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TreeNode) Unmarshal(dAtA []byte) error {
+func (m *PartTotal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2669,15 +5738,15 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartTotal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartTotal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Designation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2705,7 +5774,7 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Designation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2773,9 +5842,9 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			m.Count = 0
+			m.Quantity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2785,16 +5854,16 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				m.Quantity |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Material", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Occurrences", wireType)
 			}
-			var stringLen uint64
+			m.Occurrences = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2804,29 +5873,16 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Occurrences |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthData
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Material = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2836,88 +5892,79 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &SpecificationRow{}
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeIds = append(m.NodeIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Figure", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowData
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthData
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthData
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Figure == nil {
-				m.Figure = &Figure{}
-			}
-			if err := m.Figure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedCount", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.AccumulatedCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowData
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccumulatedCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leaves", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2927,31 +5974,29 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leaves = append(m.Leaves, &TreeNode{})
-			if err := m.Leaves[len(m.Leaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2961,23 +6006,25 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentId = string(dAtA[iNdEx:postIndex])
+			m.Parts = append(m.Parts, &PartTotal{})
+			if err := m.Parts[len(m.Parts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,629 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	datatypes "gorm.io/datatypes"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type DatasetExportORM struct {
+	ArchiveKey        string
+	ArchiveSha256     string
+	ArchiveSize       int64
+	Comment           string
+	CreatedAt         *time.Time
+	CreatedBy         string
+	Error             string
+	ExcludedTaskCount int32
+	FinishedAt        *time.Time
+	Id                uint64
+	ImageCount        int32
+	ManifestKey       string
+	Name              string `gorm:"uniqueIndex:idx_dataset_exports_name_version"`
+	Selection         *datatypes.JSONType[DatasetSelection]
+	StartedAt         *time.Time
+	Status            int32 `gorm:"index:idx_dataset_exports_status"`
+	TaskCount         int32
+	Version           int32 `gorm:"uniqueIndex:idx_dataset_exports_name_version"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (DatasetExportORM) TableName() string {
+	return "dataset_exports"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *DatasetExport) ToORM(ctx context.Context) (DatasetExportORM, error) {
+	to := DatasetExportORM{}
+	var err error
+	if prehook, ok := interface{}(m).(DatasetExportWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Version = m.Version
+	to.Status = int32(m.Status)
+	to.Error = m.Error
+	to.CreatedBy = m.CreatedBy
+	to.Comment = m.Comment
+	to.ArchiveKey = m.ArchiveKey
+	to.ManifestKey = m.ManifestKey
+	to.ArchiveSha256 = m.ArchiveSha256
+	to.ArchiveSize = m.ArchiveSize
+	to.TaskCount = m.TaskCount
+	to.ImageCount = m.ImageCount
+	to.ExcludedTaskCount = m.ExcludedTaskCount
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.StartedAt != nil {
+		t := m.StartedAt.AsTime()
+		to.StartedAt = &t
+	}
+	if m.FinishedAt != nil {
+		t := m.FinishedAt.AsTime()
+		to.FinishedAt = &t
+	}
+	if posthook, ok := interface{}(m).(DatasetExportWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *DatasetExportORM) ToPB(ctx context.Context) (DatasetExport, error) {
+	to := DatasetExport{}
+	var err error
+	if prehook, ok := interface{}(m).(DatasetExportWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Version = m.Version
+	to.Status = DatasetExportStatus(m.Status)
+	to.Error = m.Error
+	to.CreatedBy = m.CreatedBy
+	to.Comment = m.Comment
+	to.ArchiveKey = m.ArchiveKey
+	to.ManifestKey = m.ManifestKey
+	to.ArchiveSha256 = m.ArchiveSha256
+	to.ArchiveSize = m.ArchiveSize
+	to.TaskCount = m.TaskCount
+	to.ImageCount = m.ImageCount
+	to.ExcludedTaskCount = m.ExcludedTaskCount
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.StartedAt != nil {
+		to.StartedAt = timestamppb.New(*m.StartedAt)
+	}
+	if m.FinishedAt != nil {
+		to.FinishedAt = timestamppb.New(*m.FinishedAt)
+	}
+	if posthook, ok := interface{}(m).(DatasetExportWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type DatasetExport the arg will be the target, the caller the one being converted from
+
+// DatasetExportBeforeToORM called before default ToORM code
+type DatasetExportWithBeforeToORM interface {
+	BeforeToORM(context.Context, *DatasetExportORM) error
+}
+
+// DatasetExportAfterToORM called after default ToORM code
+type DatasetExportWithAfterToORM interface {
+	AfterToORM(context.Context, *DatasetExportORM) error
+}
+
+// DatasetExportBeforeToPB called before default ToPB code
+type DatasetExportWithBeforeToPB interface {
+	BeforeToPB(context.Context, *DatasetExport) error
+}
+
+// DatasetExportAfterToPB called after default ToPB code
+type DatasetExportWithAfterToPB interface {
+	AfterToPB(context.Context, *DatasetExport) error
+}
+
+// DefaultCreateDatasetExport executes a basic gorm create call
+func DefaultCreateDatasetExport(ctx context.Context, in *DatasetExport, db *gorm.DB) (*DatasetExport, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DatasetExportORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadDatasetExport(ctx context.Context, in *DatasetExport, db *gorm.DB) (*DatasetExport, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := DatasetExportORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(DatasetExportORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DatasetExportORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteDatasetExport(ctx context.Context, in *DatasetExport, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&DatasetExportORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type DatasetExportORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteDatasetExportSet(ctx context.Context, in []*DatasetExport, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&DatasetExportORM{})).(DatasetExportORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&DatasetExportORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&DatasetExportORM{})).(DatasetExportORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type DatasetExportORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*DatasetExport, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*DatasetExport, *gorm.DB) error
+}
+
+// DefaultStrictUpdateDatasetExport clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateDatasetExport(ctx context.Context, in *DatasetExport, db *gorm.DB) (*DatasetExport, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateDatasetExport")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &DatasetExportORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type DatasetExportORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchDatasetExport executes a basic gorm update call with patch behavior
+func DefaultPatchDatasetExport(ctx context.Context, in *DatasetExport, updateMask *field_mask.FieldMask, db *gorm.DB) (*DatasetExport, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj DatasetExport
+	var err error
+	if hook, ok := interface{}(&pbObj).(DatasetExportWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadDatasetExport(ctx, &DatasetExport{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(DatasetExportWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskDatasetExport(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(DatasetExportWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateDatasetExport(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(DatasetExportWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type DatasetExportWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *DatasetExport, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *DatasetExport, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *DatasetExport, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *DatasetExport, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetDatasetExport executes a bulk gorm update call with patch behavior
+func DefaultPatchSetDatasetExport(ctx context.Context, objects []*DatasetExport, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*DatasetExport, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*DatasetExport, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchDatasetExport(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskDatasetExport patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskDatasetExport(ctx context.Context, patchee *DatasetExport, patcher *DatasetExport, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*DatasetExport, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedSelection bool
+	var updatedCreatedAt bool
+	var updatedStartedAt bool
+	var updatedFinishedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Version" {
+			patchee.Version = patcher.Version
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"Error" {
+			patchee.Error = patcher.Error
+			continue
+		}
+		if f == prefix+"CreatedBy" {
+			patchee.CreatedBy = patcher.CreatedBy
+			continue
+		}
+		if f == prefix+"Comment" {
+			patchee.Comment = patcher.Comment
+			continue
+		}
+		if !updatedSelection && strings.HasPrefix(f, prefix+"Selection.") {
+			if patcher.Selection == nil {
+				patchee.Selection = nil
+				continue
+			}
+			if patchee.Selection == nil {
+				patchee.Selection = &DatasetSelection{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Selection."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Selection, patchee.Selection, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Selection" {
+			updatedSelection = true
+			patchee.Selection = patcher.Selection
+			continue
+		}
+		if f == prefix+"ArchiveKey" {
+			patchee.ArchiveKey = patcher.ArchiveKey
+			continue
+		}
+		if f == prefix+"ManifestKey" {
+			patchee.ManifestKey = patcher.ManifestKey
+			continue
+		}
+		if f == prefix+"ArchiveSha256" {
+			patchee.ArchiveSha256 = patcher.ArchiveSha256
+			continue
+		}
+		if f == prefix+"ArchiveSize" {
+			patchee.ArchiveSize = patcher.ArchiveSize
+			continue
+		}
+		if f == prefix+"TaskCount" {
+			patchee.TaskCount = patcher.TaskCount
+			continue
+		}
+		if f == prefix+"ImageCount" {
+			patchee.ImageCount = patcher.ImageCount
+			continue
+		}
+		if f == prefix+"ExcludedTaskCount" {
+			patchee.ExcludedTaskCount = patcher.ExcludedTaskCount
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedStartedAt && strings.HasPrefix(f, prefix+"StartedAt.") {
+			if patcher.StartedAt == nil {
+				patchee.StartedAt = nil
+				continue
+			}
+			if patchee.StartedAt == nil {
+				patchee.StartedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"StartedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.StartedAt, patchee.StartedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"StartedAt" {
+			updatedStartedAt = true
+			patchee.StartedAt = patcher.StartedAt
+			continue
+		}
+		if !updatedFinishedAt && strings.HasPrefix(f, prefix+"FinishedAt.") {
+			if patcher.FinishedAt == nil {
+				patchee.FinishedAt = nil
+				continue
+			}
+			if patchee.FinishedAt == nil {
+				patchee.FinishedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"FinishedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.FinishedAt, patchee.FinishedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"FinishedAt" {
+			updatedFinishedAt = true
+			patchee.FinishedAt = patcher.FinishedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListDatasetExport executes a gorm list call
+func DefaultListDatasetExport(ctx context.Context, db *gorm.DB) ([]*DatasetExport, error) {
+	in := DatasetExport{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []DatasetExportORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DatasetExportORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*DatasetExport{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type DatasetExportORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DatasetExportORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]DatasetExportORM) error
+}
@@ -1,6 +1,10 @@
 package proto
 
-import "context"
+import (
+	"context"
+
+	"gorm.io/datatypes"
+)
 
 func file_models_proto_init() {
 
@@ -18,6 +22,49 @@ func file_proto_data_proto_init() {
 func (d *DataRecognitionTaskORM) AfterToPB(ctx context.Context, task *DataRecognitionTask) error {
 	node := d.RecognitionResult.Data()
 	task.RecognitionResult = &node
+	if d.FrontendResult != nil {
+		edited := d.FrontendResult.Data()
+		task.FrontendResult = &edited
+	}
+
+	return nil
+}
+
+func (m *DataRecognitionTask) AfterToORM(ctx context.Context, task *DataRecognitionTaskORM) error {
+	if m.RecognitionResult != nil {
+		result := datatypes.NewJSONType(*m.RecognitionResult)
+		task.RecognitionResult = &result
+	}
+	if m.FrontendResult != nil {
+		edited := datatypes.NewJSONType(*m.FrontendResult)
+		task.FrontendResult = &edited
+	}
+
+	return nil
+}
+
+func (e *CostEstimateORM) AfterToPB(ctx context.Context, estimate *CostEstimate) error {
+	if e.Breakdown != nil {
+		node := e.Breakdown.Data()
+		estimate.Breakdown = &node
+	}
+	if e.PriceList != nil {
+		list := e.PriceList.Data()
+		estimate.PriceList = &list
+	}
+
+	return nil
+}
+
+func (r *TreeRevisionORM) AfterToPB(ctx context.Context, revision *TreeRevision) error {
+	if r.Tree != nil {
+		tree := r.Tree.Data()
+		revision.Tree = &tree
+	}
+	if r.Diff != nil {
+		diff := r.Diff.Data()
+		revision.Diff = &diff
+	}
 
 	return nil
 }
@@ -0,0 +1,501 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type MaterialDensityORM struct {
+	ClientId  *uint64 `gorm:"index:idx_material_densities_client_id"`
+	CreatedAt *time.Time
+	Density   float64
+	Id        uint64
+	Material  string
+	UpdatedAt *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (MaterialDensityORM) TableName() string {
+	return "material_densities"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *MaterialDensity) ToORM(ctx context.Context) (MaterialDensityORM, error) {
+	to := MaterialDensityORM{}
+	var err error
+	if prehook, ok := interface{}(m).(MaterialDensityWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Material = m.Material
+	to.Density = m.Density
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(MaterialDensityWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *MaterialDensityORM) ToPB(ctx context.Context) (MaterialDensity, error) {
+	to := MaterialDensity{}
+	var err error
+	if prehook, ok := interface{}(m).(MaterialDensityWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Material = m.Material
+	to.Density = m.Density
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(MaterialDensityWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type MaterialDensity the arg will be the target, the caller the one being converted from
+
+// MaterialDensityBeforeToORM called before default ToORM code
+type MaterialDensityWithBeforeToORM interface {
+	BeforeToORM(context.Context, *MaterialDensityORM) error
+}
+
+// MaterialDensityAfterToORM called after default ToORM code
+type MaterialDensityWithAfterToORM interface {
+	AfterToORM(context.Context, *MaterialDensityORM) error
+}
+
+// MaterialDensityBeforeToPB called before default ToPB code
+type MaterialDensityWithBeforeToPB interface {
+	BeforeToPB(context.Context, *MaterialDensity) error
+}
+
+// MaterialDensityAfterToPB called after default ToPB code
+type MaterialDensityWithAfterToPB interface {
+	AfterToPB(context.Context, *MaterialDensity) error
+}
+
+// DefaultCreateMaterialDensity executes a basic gorm create call
+func DefaultCreateMaterialDensity(ctx context.Context, in *MaterialDensity, db *gorm.DB) (*MaterialDensity, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MaterialDensityORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadMaterialDensity(ctx context.Context, in *MaterialDensity, db *gorm.DB) (*MaterialDensity, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := MaterialDensityORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(MaterialDensityORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MaterialDensityORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMaterialDensity(ctx context.Context, in *MaterialDensity, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&MaterialDensityORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type MaterialDensityORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMaterialDensitySet(ctx context.Context, in []*MaterialDensity, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&MaterialDensityORM{})).(MaterialDensityORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&MaterialDensityORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&MaterialDensityORM{})).(MaterialDensityORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type MaterialDensityORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*MaterialDensity, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*MaterialDensity, *gorm.DB) error
+}
+
+// DefaultStrictUpdateMaterialDensity clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateMaterialDensity(ctx context.Context, in *MaterialDensity, db *gorm.DB) (*MaterialDensity, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMaterialDensity")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &MaterialDensityORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type MaterialDensityORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchMaterialDensity executes a basic gorm update call with patch behavior
+func DefaultPatchMaterialDensity(ctx context.Context, in *MaterialDensity, updateMask *field_mask.FieldMask, db *gorm.DB) (*MaterialDensity, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj MaterialDensity
+	var err error
+	if hook, ok := interface{}(&pbObj).(MaterialDensityWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadMaterialDensity(ctx, &MaterialDensity{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(MaterialDensityWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskMaterialDensity(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(MaterialDensityWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateMaterialDensity(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(MaterialDensityWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type MaterialDensityWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *MaterialDensity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *MaterialDensity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *MaterialDensity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *MaterialDensity, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetMaterialDensity executes a bulk gorm update call with patch behavior
+func DefaultPatchSetMaterialDensity(ctx context.Context, objects []*MaterialDensity, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MaterialDensity, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*MaterialDensity, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchMaterialDensity(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskMaterialDensity patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMaterialDensity(ctx context.Context, patchee *MaterialDensity, patcher *MaterialDensity, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MaterialDensity, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"Material" {
+			patchee.Material = patcher.Material
+			continue
+		}
+		if f == prefix+"Density" {
+			patchee.Density = patcher.Density
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListMaterialDensity executes a gorm list call
+func DefaultListMaterialDensity(ctx context.Context, db *gorm.DB) ([]*MaterialDensity, error) {
+	in := MaterialDensity{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []MaterialDensityORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialDensityORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*MaterialDensity{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MaterialDensityORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialDensityORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MaterialDensityORM) error
+}
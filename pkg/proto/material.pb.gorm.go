@@ -0,0 +1,1010 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type MaterialGradeORM struct {
+	CreatedAt   *time.Time
+	Density     float64
+	Description string
+	Equivalents string
+	Grade       string `gorm:"uniqueIndex:idx_material_grades_grade"`
+	Id          uint64
+	PriceGroup  string
+	Standard    string
+	UpdatedAt   *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (MaterialGradeORM) TableName() string {
+	return "material_grades"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *MaterialGrade) ToORM(ctx context.Context) (MaterialGradeORM, error) {
+	to := MaterialGradeORM{}
+	var err error
+	if prehook, ok := interface{}(m).(MaterialGradeWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Grade = m.Grade
+	to.Standard = m.Standard
+	to.Density = m.Density
+	to.Equivalents = m.Equivalents
+	to.PriceGroup = m.PriceGroup
+	to.Description = m.Description
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(MaterialGradeWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *MaterialGradeORM) ToPB(ctx context.Context) (MaterialGrade, error) {
+	to := MaterialGrade{}
+	var err error
+	if prehook, ok := interface{}(m).(MaterialGradeWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Grade = m.Grade
+	to.Standard = m.Standard
+	to.Density = m.Density
+	to.Equivalents = m.Equivalents
+	to.PriceGroup = m.PriceGroup
+	to.Description = m.Description
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(MaterialGradeWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type MaterialGrade the arg will be the target, the caller the one being converted from
+
+// MaterialGradeBeforeToORM called before default ToORM code
+type MaterialGradeWithBeforeToORM interface {
+	BeforeToORM(context.Context, *MaterialGradeORM) error
+}
+
+// MaterialGradeAfterToORM called after default ToORM code
+type MaterialGradeWithAfterToORM interface {
+	AfterToORM(context.Context, *MaterialGradeORM) error
+}
+
+// MaterialGradeBeforeToPB called before default ToPB code
+type MaterialGradeWithBeforeToPB interface {
+	BeforeToPB(context.Context, *MaterialGrade) error
+}
+
+// MaterialGradeAfterToPB called after default ToPB code
+type MaterialGradeWithAfterToPB interface {
+	AfterToPB(context.Context, *MaterialGrade) error
+}
+
+type UnmatchedMaterialORM struct {
+	CreatedAt   *time.Time
+	Id          uint64
+	LastTaskId  string
+	Occurrences int64
+	Text        string `gorm:"uniqueIndex:idx_unmatched_materials_text"`
+	UpdatedAt   *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (UnmatchedMaterialORM) TableName() string {
+	return "unmatched_materials"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *UnmatchedMaterial) ToORM(ctx context.Context) (UnmatchedMaterialORM, error) {
+	to := UnmatchedMaterialORM{}
+	var err error
+	if prehook, ok := interface{}(m).(UnmatchedMaterialWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	to.Occurrences = m.Occurrences
+	to.LastTaskId = m.LastTaskId
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(UnmatchedMaterialWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *UnmatchedMaterialORM) ToPB(ctx context.Context) (UnmatchedMaterial, error) {
+	to := UnmatchedMaterial{}
+	var err error
+	if prehook, ok := interface{}(m).(UnmatchedMaterialWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	to.Occurrences = m.Occurrences
+	to.LastTaskId = m.LastTaskId
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(UnmatchedMaterialWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type UnmatchedMaterial the arg will be the target, the caller the one being converted from
+
+// UnmatchedMaterialBeforeToORM called before default ToORM code
+type UnmatchedMaterialWithBeforeToORM interface {
+	BeforeToORM(context.Context, *UnmatchedMaterialORM) error
+}
+
+// UnmatchedMaterialAfterToORM called after default ToORM code
+type UnmatchedMaterialWithAfterToORM interface {
+	AfterToORM(context.Context, *UnmatchedMaterialORM) error
+}
+
+// UnmatchedMaterialBeforeToPB called before default ToPB code
+type UnmatchedMaterialWithBeforeToPB interface {
+	BeforeToPB(context.Context, *UnmatchedMaterial) error
+}
+
+// UnmatchedMaterialAfterToPB called after default ToPB code
+type UnmatchedMaterialWithAfterToPB interface {
+	AfterToPB(context.Context, *UnmatchedMaterial) error
+}
+
+// DefaultCreateMaterialGrade executes a basic gorm create call
+func DefaultCreateMaterialGrade(ctx context.Context, in *MaterialGrade, db *gorm.DB) (*MaterialGrade, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MaterialGradeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadMaterialGrade(ctx context.Context, in *MaterialGrade, db *gorm.DB) (*MaterialGrade, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := MaterialGradeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(MaterialGradeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MaterialGradeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMaterialGrade(ctx context.Context, in *MaterialGrade, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&MaterialGradeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type MaterialGradeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMaterialGradeSet(ctx context.Context, in []*MaterialGrade, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&MaterialGradeORM{})).(MaterialGradeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&MaterialGradeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&MaterialGradeORM{})).(MaterialGradeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type MaterialGradeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*MaterialGrade, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*MaterialGrade, *gorm.DB) error
+}
+
+// DefaultStrictUpdateMaterialGrade clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateMaterialGrade(ctx context.Context, in *MaterialGrade, db *gorm.DB) (*MaterialGrade, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMaterialGrade")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &MaterialGradeORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type MaterialGradeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchMaterialGrade executes a basic gorm update call with patch behavior
+func DefaultPatchMaterialGrade(ctx context.Context, in *MaterialGrade, updateMask *field_mask.FieldMask, db *gorm.DB) (*MaterialGrade, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj MaterialGrade
+	var err error
+	if hook, ok := interface{}(&pbObj).(MaterialGradeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadMaterialGrade(ctx, &MaterialGrade{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(MaterialGradeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskMaterialGrade(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(MaterialGradeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateMaterialGrade(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(MaterialGradeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type MaterialGradeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *MaterialGrade, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *MaterialGrade, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *MaterialGrade, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *MaterialGrade, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetMaterialGrade executes a bulk gorm update call with patch behavior
+func DefaultPatchSetMaterialGrade(ctx context.Context, objects []*MaterialGrade, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MaterialGrade, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*MaterialGrade, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchMaterialGrade(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskMaterialGrade patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMaterialGrade(ctx context.Context, patchee *MaterialGrade, patcher *MaterialGrade, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MaterialGrade, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Grade" {
+			patchee.Grade = patcher.Grade
+			continue
+		}
+		if f == prefix+"Standard" {
+			patchee.Standard = patcher.Standard
+			continue
+		}
+		if f == prefix+"Density" {
+			patchee.Density = patcher.Density
+			continue
+		}
+		if f == prefix+"Equivalents" {
+			patchee.Equivalents = patcher.Equivalents
+			continue
+		}
+		if f == prefix+"PriceGroup" {
+			patchee.PriceGroup = patcher.PriceGroup
+			continue
+		}
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListMaterialGrade executes a gorm list call
+func DefaultListMaterialGrade(ctx context.Context, db *gorm.DB) ([]*MaterialGrade, error) {
+	in := MaterialGrade{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []MaterialGradeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MaterialGradeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*MaterialGrade{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MaterialGradeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MaterialGradeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MaterialGradeORM) error
+}
+
+// DefaultCreateUnmatchedMaterial executes a basic gorm create call
+func DefaultCreateUnmatchedMaterial(ctx context.Context, in *UnmatchedMaterial, db *gorm.DB) (*UnmatchedMaterial, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UnmatchedMaterialORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadUnmatchedMaterial(ctx context.Context, in *UnmatchedMaterial, db *gorm.DB) (*UnmatchedMaterial, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := UnmatchedMaterialORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(UnmatchedMaterialORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UnmatchedMaterialORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteUnmatchedMaterial(ctx context.Context, in *UnmatchedMaterial, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&UnmatchedMaterialORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type UnmatchedMaterialORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteUnmatchedMaterialSet(ctx context.Context, in []*UnmatchedMaterial, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&UnmatchedMaterialORM{})).(UnmatchedMaterialORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&UnmatchedMaterialORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&UnmatchedMaterialORM{})).(UnmatchedMaterialORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type UnmatchedMaterialORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*UnmatchedMaterial, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*UnmatchedMaterial, *gorm.DB) error
+}
+
+// DefaultStrictUpdateUnmatchedMaterial clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateUnmatchedMaterial(ctx context.Context, in *UnmatchedMaterial, db *gorm.DB) (*UnmatchedMaterial, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUnmatchedMaterial")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &UnmatchedMaterialORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type UnmatchedMaterialORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchUnmatchedMaterial executes a basic gorm update call with patch behavior
+func DefaultPatchUnmatchedMaterial(ctx context.Context, in *UnmatchedMaterial, updateMask *field_mask.FieldMask, db *gorm.DB) (*UnmatchedMaterial, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj UnmatchedMaterial
+	var err error
+	if hook, ok := interface{}(&pbObj).(UnmatchedMaterialWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadUnmatchedMaterial(ctx, &UnmatchedMaterial{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(UnmatchedMaterialWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskUnmatchedMaterial(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(UnmatchedMaterialWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateUnmatchedMaterial(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(UnmatchedMaterialWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type UnmatchedMaterialWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *UnmatchedMaterial, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *UnmatchedMaterial, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *UnmatchedMaterial, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *UnmatchedMaterial, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetUnmatchedMaterial executes a bulk gorm update call with patch behavior
+func DefaultPatchSetUnmatchedMaterial(ctx context.Context, objects []*UnmatchedMaterial, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*UnmatchedMaterial, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*UnmatchedMaterial, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchUnmatchedMaterial(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskUnmatchedMaterial patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskUnmatchedMaterial(ctx context.Context, patchee *UnmatchedMaterial, patcher *UnmatchedMaterial, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*UnmatchedMaterial, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Text" {
+			patchee.Text = patcher.Text
+			continue
+		}
+		if f == prefix+"Occurrences" {
+			patchee.Occurrences = patcher.Occurrences
+			continue
+		}
+		if f == prefix+"LastTaskId" {
+			patchee.LastTaskId = patcher.LastTaskId
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListUnmatchedMaterial executes a gorm list call
+func DefaultListUnmatchedMaterial(ctx context.Context, db *gorm.DB) ([]*UnmatchedMaterial, error) {
+	in := UnmatchedMaterial{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []UnmatchedMaterialORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UnmatchedMaterialORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*UnmatchedMaterial{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type UnmatchedMaterialORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UnmatchedMaterialORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]UnmatchedMaterialORM) error
+}
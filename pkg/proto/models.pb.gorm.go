@@ -16,16 +16,18 @@ import (
 )
 
 type ClientORM struct {
-	CreatedAt  int64
-	Id         uint64
-	Inn        string
-	Name       string
-	Ogrn       string
-	OwnerFio   string
-	Quota      int64
-	TotalQuota int64
-	UpdatedAt  int64
-	Users      []*ClientUserORM `gorm:"foreignKey:ClientId;references:Id"`
+	CreatedAt           int64
+	Id                  uint64
+	Inn                 string
+	Name                string
+	Ogrn                string
+	OwnerFio            string
+	Quota               int64
+	Sandbox             bool
+	TotalQuota          int64
+	TrainingDataConsent int32
+	UpdatedAt           int64
+	Users               []*ClientUserORM `gorm:"foreignKey:ClientId;references:Id"`
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -52,6 +54,8 @@ func (m *Client) ToORM(ctx context.Context) (ClientORM, error) {
 	to.OwnerFio = m.OwnerFio
 	to.Inn = m.Inn
 	to.Ogrn = m.Ogrn
+	to.Sandbox = m.Sandbox
+	to.TrainingDataConsent = int32(m.TrainingDataConsent)
 	for _, v := range m.Users {
 		if v != nil {
 			if tempUsers, cErr := v.ToORM(ctx); cErr == nil {
@@ -88,6 +92,8 @@ func (m *ClientORM) ToPB(ctx context.Context) (Client, error) {
 	to.OwnerFio = m.OwnerFio
 	to.Inn = m.Inn
 	to.Ogrn = m.Ogrn
+	to.Sandbox = m.Sandbox
+	to.TrainingDataConsent = TrainingDataConsent(m.TrainingDataConsent)
 	for _, v := range m.Users {
 		if v != nil {
 			if tempUsers, cErr := v.ToPB(ctx); cErr == nil {
@@ -312,16 +318,21 @@ type AdminWithAfterToPB interface {
 }
 
 type DataRecognitionTaskORM struct {
+	ApprovedAt                 *time.Time
+	ApprovedById               *uint64
 	Client                     *ClientORM `gorm:"foreignKey:ClientId;references:Id"`
 	ClientId                   *uint64
 	CreatedAt                  *time.Time
+	CreatedById                *uint64 `gorm:"index:idx_data_recognition_tasks_created_by_id"`
 	Error                      string
 	FrontendResult             *datatypes.JSONType[TreeNode]
-	FrontendResultFlat         *types.Jsonb   `gorm:"type:jsonb"`
-	FrontendResultUnrecognized *types.Jsonb   `gorm:"type:jsonb"`
-	Id                         string         `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	FrontendResultFlat         *types.Jsonb `gorm:"type:jsonb"`
+	FrontendResultUnrecognized *types.Jsonb `gorm:"type:jsonb"`
+	Id                         string       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ModelVersion               string
 	ProcessedImages            pq.StringArray `gorm:"type:text[]"`
 	RecognitionResult          *datatypes.JSONType[TreeNode]
+	Sandbox                    bool
 	SourceImages               pq.StringArray `gorm:"type:text[]"`
 	Status                     int32
 	StatusText                 string
@@ -356,6 +367,10 @@ func (m *DataRecognitionTask) ToORM(ctx context.Context) (DataRecognitionTaskORM
 	to.Error = m.Error
 	to.WorkerId = m.WorkerId
 	to.StatusText = m.StatusText
+	to.Sandbox = m.Sandbox
+	to.CreatedById = m.CreatedById
+	to.ModelVersion = m.ModelVersion
+	to.ApprovedById = m.ApprovedById
 	if m.SourceImages != nil {
 		to.SourceImages = make(pq.StringArray, len(m.SourceImages))
 		copy(to.SourceImages, m.SourceImages)
@@ -378,6 +393,10 @@ func (m *DataRecognitionTask) ToORM(ctx context.Context) (DataRecognitionTaskORM
 		t := m.UpdatedAt.AsTime()
 		to.UpdatedAt = &t
 	}
+	if m.ApprovedAt != nil {
+		t := m.ApprovedAt.AsTime()
+		to.ApprovedAt = &t
+	}
 	if posthook, ok := interface{}(m).(DataRecognitionTaskWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -406,6 +425,10 @@ func (m *DataRecognitionTaskORM) ToPB(ctx context.Context) (DataRecognitionTask,
 	to.Error = m.Error
 	to.WorkerId = m.WorkerId
 	to.StatusText = m.StatusText
+	to.Sandbox = m.Sandbox
+	to.CreatedById = m.CreatedById
+	to.ModelVersion = m.ModelVersion
+	to.ApprovedById = m.ApprovedById
 	if m.SourceImages != nil {
 		to.SourceImages = make(pq.StringArray, len(m.SourceImages))
 		copy(to.SourceImages, m.SourceImages)
@@ -426,6 +449,9 @@ func (m *DataRecognitionTaskORM) ToPB(ctx context.Context) (DataRecognitionTask,
 	if m.UpdatedAt != nil {
 		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
 	}
+	if m.ApprovedAt != nil {
+		to.ApprovedAt = timestamppb.New(*m.ApprovedAt)
+	}
 	if posthook, ok := interface{}(m).(DataRecognitionTaskWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -455,6 +481,92 @@ type DataRecognitionTaskWithAfterToPB interface {
 	AfterToPB(context.Context, *DataRecognitionTask) error
 }
 
+type TaskStatusEventORM struct {
+	ClientId   uint64 `gorm:"index:idx_task_status_events_client_id"`
+	CreatedAt  *time.Time
+	FromStatus int32
+	Id         uint64
+	Status     int32
+	TaskId     string `gorm:"type:uuid;index:idx_task_status_events_task_id"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TaskStatusEventORM) TableName() string {
+	return "task_status_events"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TaskStatusEvent) ToORM(ctx context.Context) (TaskStatusEventORM, error) {
+	to := TaskStatusEventORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TaskStatusEventWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.ClientId = m.ClientId
+	to.FromStatus = int32(m.FromStatus)
+	to.Status = int32(m.Status)
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(TaskStatusEventWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TaskStatusEventORM) ToPB(ctx context.Context) (TaskStatusEvent, error) {
+	to := TaskStatusEvent{}
+	var err error
+	if prehook, ok := interface{}(m).(TaskStatusEventWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.ClientId = m.ClientId
+	to.FromStatus = Status(m.FromStatus)
+	to.Status = Status(m.Status)
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(TaskStatusEventWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TaskStatusEvent the arg will be the target, the caller the one being converted from
+
+// TaskStatusEventBeforeToORM called before default ToORM code
+type TaskStatusEventWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TaskStatusEventORM) error
+}
+
+// TaskStatusEventAfterToORM called after default ToORM code
+type TaskStatusEventWithAfterToORM interface {
+	AfterToORM(context.Context, *TaskStatusEventORM) error
+}
+
+// TaskStatusEventBeforeToPB called before default ToPB code
+type TaskStatusEventWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TaskStatusEvent) error
+}
+
+// TaskStatusEventAfterToPB called after default ToPB code
+type TaskStatusEventWithAfterToPB interface {
+	AfterToPB(context.Context, *TaskStatusEvent) error
+}
+
 // DefaultCreateClient executes a basic gorm create call
 func DefaultCreateClient(ctx context.Context, in *Client, db *gorm.DB) (*Client, error) {
 	if in == nil {
@@ -777,6 +889,14 @@ func DefaultApplyFieldMaskClient(ctx context.Context, patchee *Client, patcher *
 			patchee.Ogrn = patcher.Ogrn
 			continue
 		}
+		if f == prefix+"Sandbox" {
+			patchee.Sandbox = patcher.Sandbox
+			continue
+		}
+		if f == prefix+"TrainingDataConsent" {
+			patchee.TrainingDataConsent = patcher.TrainingDataConsent
+			continue
+		}
 		if f == prefix+"Users" {
 			patchee.Users = patcher.Users
 			continue
@@ -1864,6 +1984,7 @@ func DefaultApplyFieldMaskDataRecognitionTask(ctx context.Context, patchee *Data
 	var updatedFrontendResultFlat bool
 	var updatedCreatedAt bool
 	var updatedUpdatedAt bool
+	var updatedApprovedAt bool
 	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
@@ -1906,6 +2027,22 @@ func DefaultApplyFieldMaskDataRecognitionTask(ctx context.Context, patchee *Data
 			patchee.StatusText = patcher.StatusText
 			continue
 		}
+		if f == prefix+"Sandbox" {
+			patchee.Sandbox = patcher.Sandbox
+			continue
+		}
+		if f == prefix+"CreatedById" {
+			patchee.CreatedById = patcher.CreatedById
+			continue
+		}
+		if f == prefix+"ModelVersion" {
+			patchee.ModelVersion = patcher.ModelVersion
+			continue
+		}
+		if f == prefix+"ApprovedById" {
+			patchee.ApprovedById = patcher.ApprovedById
+			continue
+		}
 		if f == prefix+"SourceImages" {
 			patchee.SourceImages = patcher.SourceImages
 			continue
@@ -2016,6 +2153,29 @@ func DefaultApplyFieldMaskDataRecognitionTask(ctx context.Context, patchee *Data
 			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
+		if !updatedApprovedAt && strings.HasPrefix(f, prefix+"ApprovedAt.") {
+			if patcher.ApprovedAt == nil {
+				patchee.ApprovedAt = nil
+				continue
+			}
+			if patchee.ApprovedAt == nil {
+				patchee.ApprovedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ApprovedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ApprovedAt, patchee.ApprovedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ApprovedAt" {
+			updatedApprovedAt = true
+			patchee.ApprovedAt = patcher.ApprovedAt
+			continue
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -2071,3 +2231,380 @@ type DataRecognitionTaskORMWithBeforeListFind interface {
 type DataRecognitionTaskORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]DataRecognitionTaskORM) error
 }
+
+// DefaultCreateTaskStatusEvent executes a basic gorm create call
+func DefaultCreateTaskStatusEvent(ctx context.Context, in *TaskStatusEvent, db *gorm.DB) (*TaskStatusEvent, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TaskStatusEventORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadTaskStatusEvent(ctx context.Context, in *TaskStatusEvent, db *gorm.DB) (*TaskStatusEvent, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TaskStatusEventORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TaskStatusEventORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TaskStatusEventORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTaskStatusEvent(ctx context.Context, in *TaskStatusEvent, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TaskStatusEventORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TaskStatusEventORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTaskStatusEventSet(ctx context.Context, in []*TaskStatusEvent, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TaskStatusEventORM{})).(TaskStatusEventORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TaskStatusEventORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TaskStatusEventORM{})).(TaskStatusEventORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TaskStatusEventORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TaskStatusEvent, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TaskStatusEvent, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTaskStatusEvent clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTaskStatusEvent(ctx context.Context, in *TaskStatusEvent, db *gorm.DB) (*TaskStatusEvent, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTaskStatusEvent")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &TaskStatusEventORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TaskStatusEventORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTaskStatusEvent executes a basic gorm update call with patch behavior
+func DefaultPatchTaskStatusEvent(ctx context.Context, in *TaskStatusEvent, updateMask *field_mask.FieldMask, db *gorm.DB) (*TaskStatusEvent, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TaskStatusEvent
+	var err error
+	if hook, ok := interface{}(&pbObj).(TaskStatusEventWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTaskStatusEvent(ctx, &TaskStatusEvent{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TaskStatusEventWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTaskStatusEvent(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TaskStatusEventWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTaskStatusEvent(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TaskStatusEventWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TaskStatusEventWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TaskStatusEvent, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TaskStatusEvent, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TaskStatusEvent, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TaskStatusEvent, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTaskStatusEvent executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTaskStatusEvent(ctx context.Context, objects []*TaskStatusEvent, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TaskStatusEvent, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TaskStatusEvent, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTaskStatusEvent(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTaskStatusEvent patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTaskStatusEvent(ctx context.Context, patchee *TaskStatusEvent, patcher *TaskStatusEvent, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TaskStatusEvent, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TaskId" {
+			patchee.TaskId = patcher.TaskId
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"FromStatus" {
+			patchee.FromStatus = patcher.FromStatus
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTaskStatusEvent executes a gorm list call
+func DefaultListTaskStatusEvent(ctx context.Context, db *gorm.DB) ([]*TaskStatusEvent, error) {
+	in := TaskStatusEvent{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TaskStatusEventORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskStatusEventORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TaskStatusEvent{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TaskStatusEventORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskStatusEventORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TaskStatusEventORM) error
+}
@@ -0,0 +1,1448 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type NotificationPreferencesORM struct {
+	CreatedAt      *time.Time
+	Id             uint64
+	Language       string
+	QuotaLow       bool
+	QuotaThreshold int64
+	TaskCompleted  bool
+	TaskFailed     bool
+	UpdatedAt      *time.Time
+	UserId         uint64 `gorm:"uniqueIndex:idx_notification_preferences_user_id"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (NotificationPreferencesORM) TableName() string {
+	return "notification_preferences"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *NotificationPreferences) ToORM(ctx context.Context) (NotificationPreferencesORM, error) {
+	to := NotificationPreferencesORM{}
+	var err error
+	if prehook, ok := interface{}(m).(NotificationPreferencesWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.UserId = m.UserId
+	to.Language = m.Language
+	to.TaskCompleted = m.TaskCompleted
+	to.TaskFailed = m.TaskFailed
+	to.QuotaLow = m.QuotaLow
+	to.QuotaThreshold = m.QuotaThreshold
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(NotificationPreferencesWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *NotificationPreferencesORM) ToPB(ctx context.Context) (NotificationPreferences, error) {
+	to := NotificationPreferences{}
+	var err error
+	if prehook, ok := interface{}(m).(NotificationPreferencesWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.UserId = m.UserId
+	to.Language = m.Language
+	to.TaskCompleted = m.TaskCompleted
+	to.TaskFailed = m.TaskFailed
+	to.QuotaLow = m.QuotaLow
+	to.QuotaThreshold = m.QuotaThreshold
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(NotificationPreferencesWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type NotificationPreferences the arg will be the target, the caller the one being converted from
+
+// NotificationPreferencesBeforeToORM called before default ToORM code
+type NotificationPreferencesWithBeforeToORM interface {
+	BeforeToORM(context.Context, *NotificationPreferencesORM) error
+}
+
+// NotificationPreferencesAfterToORM called after default ToORM code
+type NotificationPreferencesWithAfterToORM interface {
+	AfterToORM(context.Context, *NotificationPreferencesORM) error
+}
+
+// NotificationPreferencesBeforeToPB called before default ToPB code
+type NotificationPreferencesWithBeforeToPB interface {
+	BeforeToPB(context.Context, *NotificationPreferences) error
+}
+
+// NotificationPreferencesAfterToPB called after default ToPB code
+type NotificationPreferencesWithAfterToPB interface {
+	AfterToPB(context.Context, *NotificationPreferences) error
+}
+
+type NotificationORM struct {
+	Attempts  int32
+	Body      string
+	CreatedAt *time.Time
+	Email     string
+	Error     string
+	EventKey  string `gorm:"uniqueIndex:idx_notifications_user_id_event_key"`
+	Failed    bool
+	Id        uint64
+	Kind      int32
+	Subject   string
+	UserId    uint64 `gorm:"uniqueIndex:idx_notifications_user_id_event_key"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (NotificationORM) TableName() string {
+	return "notifications"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Notification) ToORM(ctx context.Context) (NotificationORM, error) {
+	to := NotificationORM{}
+	var err error
+	if prehook, ok := interface{}(m).(NotificationWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.UserId = m.UserId
+	to.EventKey = m.EventKey
+	to.Kind = int32(m.Kind)
+	to.Email = m.Email
+	to.Subject = m.Subject
+	to.Failed = m.Failed
+	to.Attempts = m.Attempts
+	to.Error = m.Error
+	to.Body = m.Body
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(NotificationWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *NotificationORM) ToPB(ctx context.Context) (Notification, error) {
+	to := Notification{}
+	var err error
+	if prehook, ok := interface{}(m).(NotificationWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.UserId = m.UserId
+	to.EventKey = m.EventKey
+	to.Kind = NotificationKind(m.Kind)
+	to.Email = m.Email
+	to.Subject = m.Subject
+	to.Failed = m.Failed
+	to.Attempts = m.Attempts
+	to.Error = m.Error
+	to.Body = m.Body
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(NotificationWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Notification the arg will be the target, the caller the one being converted from
+
+// NotificationBeforeToORM called before default ToORM code
+type NotificationWithBeforeToORM interface {
+	BeforeToORM(context.Context, *NotificationORM) error
+}
+
+// NotificationAfterToORM called after default ToORM code
+type NotificationWithAfterToORM interface {
+	AfterToORM(context.Context, *NotificationORM) error
+}
+
+// NotificationBeforeToPB called before default ToPB code
+type NotificationWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Notification) error
+}
+
+// NotificationAfterToPB called after default ToPB code
+type NotificationWithAfterToPB interface {
+	AfterToPB(context.Context, *Notification) error
+}
+
+type EventCursorORM struct {
+	Name               string `gorm:"primaryKey"`
+	QuotaTransactionId uint64
+	TaskStatusEventId  uint64
+	UpdatedAt          *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (EventCursorORM) TableName() string {
+	return "event_cursors"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *EventCursor) ToORM(ctx context.Context) (EventCursorORM, error) {
+	to := EventCursorORM{}
+	var err error
+	if prehook, ok := interface{}(m).(EventCursorWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Name = m.Name
+	to.TaskStatusEventId = m.TaskStatusEventId
+	to.QuotaTransactionId = m.QuotaTransactionId
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(EventCursorWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *EventCursorORM) ToPB(ctx context.Context) (EventCursor, error) {
+	to := EventCursor{}
+	var err error
+	if prehook, ok := interface{}(m).(EventCursorWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Name = m.Name
+	to.TaskStatusEventId = m.TaskStatusEventId
+	to.QuotaTransactionId = m.QuotaTransactionId
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(EventCursorWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type EventCursor the arg will be the target, the caller the one being converted from
+
+// EventCursorBeforeToORM called before default ToORM code
+type EventCursorWithBeforeToORM interface {
+	BeforeToORM(context.Context, *EventCursorORM) error
+}
+
+// EventCursorAfterToORM called after default ToORM code
+type EventCursorWithAfterToORM interface {
+	AfterToORM(context.Context, *EventCursorORM) error
+}
+
+// EventCursorBeforeToPB called before default ToPB code
+type EventCursorWithBeforeToPB interface {
+	BeforeToPB(context.Context, *EventCursor) error
+}
+
+// EventCursorAfterToPB called after default ToPB code
+type EventCursorWithAfterToPB interface {
+	AfterToPB(context.Context, *EventCursor) error
+}
+
+// DefaultCreateNotificationPreferences executes a basic gorm create call
+func DefaultCreateNotificationPreferences(ctx context.Context, in *NotificationPreferences, db *gorm.DB) (*NotificationPreferences, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NotificationPreferencesORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadNotificationPreferences(ctx context.Context, in *NotificationPreferences, db *gorm.DB) (*NotificationPreferences, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := NotificationPreferencesORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(NotificationPreferencesORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NotificationPreferencesORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteNotificationPreferences(ctx context.Context, in *NotificationPreferences, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&NotificationPreferencesORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type NotificationPreferencesORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteNotificationPreferencesSet(ctx context.Context, in []*NotificationPreferences, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&NotificationPreferencesORM{})).(NotificationPreferencesORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&NotificationPreferencesORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&NotificationPreferencesORM{})).(NotificationPreferencesORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type NotificationPreferencesORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*NotificationPreferences, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*NotificationPreferences, *gorm.DB) error
+}
+
+// DefaultStrictUpdateNotificationPreferences clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateNotificationPreferences(ctx context.Context, in *NotificationPreferences, db *gorm.DB) (*NotificationPreferences, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateNotificationPreferences")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &NotificationPreferencesORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type NotificationPreferencesORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchNotificationPreferences executes a basic gorm update call with patch behavior
+func DefaultPatchNotificationPreferences(ctx context.Context, in *NotificationPreferences, updateMask *field_mask.FieldMask, db *gorm.DB) (*NotificationPreferences, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj NotificationPreferences
+	var err error
+	if hook, ok := interface{}(&pbObj).(NotificationPreferencesWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadNotificationPreferences(ctx, &NotificationPreferences{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(NotificationPreferencesWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskNotificationPreferences(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(NotificationPreferencesWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateNotificationPreferences(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(NotificationPreferencesWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type NotificationPreferencesWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *NotificationPreferences, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *NotificationPreferences, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *NotificationPreferences, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *NotificationPreferences, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetNotificationPreferences executes a bulk gorm update call with patch behavior
+func DefaultPatchSetNotificationPreferences(ctx context.Context, objects []*NotificationPreferences, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*NotificationPreferences, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*NotificationPreferences, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchNotificationPreferences(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskNotificationPreferences patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskNotificationPreferences(ctx context.Context, patchee *NotificationPreferences, patcher *NotificationPreferences, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*NotificationPreferences, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if f == prefix+"Language" {
+			patchee.Language = patcher.Language
+			continue
+		}
+		if f == prefix+"TaskCompleted" {
+			patchee.TaskCompleted = patcher.TaskCompleted
+			continue
+		}
+		if f == prefix+"TaskFailed" {
+			patchee.TaskFailed = patcher.TaskFailed
+			continue
+		}
+		if f == prefix+"QuotaLow" {
+			patchee.QuotaLow = patcher.QuotaLow
+			continue
+		}
+		if f == prefix+"QuotaThreshold" {
+			patchee.QuotaThreshold = patcher.QuotaThreshold
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListNotificationPreferences executes a gorm list call
+func DefaultListNotificationPreferences(ctx context.Context, db *gorm.DB) ([]*NotificationPreferences, error) {
+	in := NotificationPreferences{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []NotificationPreferencesORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationPreferencesORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*NotificationPreferences{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type NotificationPreferencesORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationPreferencesORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]NotificationPreferencesORM) error
+}
+
+// DefaultCreateNotification executes a basic gorm create call
+func DefaultCreateNotification(ctx context.Context, in *Notification, db *gorm.DB) (*Notification, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NotificationORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadNotification(ctx context.Context, in *Notification, db *gorm.DB) (*Notification, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := NotificationORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(NotificationORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NotificationORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteNotification(ctx context.Context, in *Notification, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&NotificationORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type NotificationORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteNotificationSet(ctx context.Context, in []*Notification, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&NotificationORM{})).(NotificationORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&NotificationORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&NotificationORM{})).(NotificationORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type NotificationORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Notification, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Notification, *gorm.DB) error
+}
+
+// DefaultStrictUpdateNotification clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateNotification(ctx context.Context, in *Notification, db *gorm.DB) (*Notification, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateNotification")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &NotificationORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type NotificationORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchNotification executes a basic gorm update call with patch behavior
+func DefaultPatchNotification(ctx context.Context, in *Notification, updateMask *field_mask.FieldMask, db *gorm.DB) (*Notification, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Notification
+	var err error
+	if hook, ok := interface{}(&pbObj).(NotificationWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadNotification(ctx, &Notification{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(NotificationWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskNotification(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(NotificationWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateNotification(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(NotificationWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type NotificationWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Notification, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Notification, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Notification, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Notification, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetNotification executes a bulk gorm update call with patch behavior
+func DefaultPatchSetNotification(ctx context.Context, objects []*Notification, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Notification, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Notification, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchNotification(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskNotification patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskNotification(ctx context.Context, patchee *Notification, patcher *Notification, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Notification, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if f == prefix+"EventKey" {
+			patchee.EventKey = patcher.EventKey
+			continue
+		}
+		if f == prefix+"Kind" {
+			patchee.Kind = patcher.Kind
+			continue
+		}
+		if f == prefix+"Email" {
+			patchee.Email = patcher.Email
+			continue
+		}
+		if f == prefix+"Subject" {
+			patchee.Subject = patcher.Subject
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListNotification executes a gorm list call
+func DefaultListNotification(ctx context.Context, db *gorm.DB) ([]*Notification, error) {
+	in := Notification{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []NotificationORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NotificationORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Notification{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type NotificationORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NotificationORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]NotificationORM) error
+}
+
+// DefaultCreateEventCursor executes a basic gorm create call
+func DefaultCreateEventCursor(ctx context.Context, in *EventCursor, db *gorm.DB) (*EventCursor, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type EventCursorORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadEventCursor(ctx context.Context, in *EventCursor, db *gorm.DB) (*EventCursor, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Name == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := EventCursorORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(EventCursorORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type EventCursorORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteEventCursor(ctx context.Context, in *EventCursor, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Name == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&EventCursorORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type EventCursorORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteEventCursorSet(ctx context.Context, in []*EventCursor, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Name == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Name)
+	}
+	if hook, ok := (interface{}(&EventCursorORM{})).(EventCursorORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("name in (?)", keys).Delete(&EventCursorORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&EventCursorORM{})).(EventCursorORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type EventCursorORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*EventCursor, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*EventCursor, *gorm.DB) error
+}
+
+// DefaultStrictUpdateEventCursor clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateEventCursor(ctx context.Context, in *EventCursor, db *gorm.DB) (*EventCursor, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateEventCursor")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &EventCursorORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("name=?", ormObj.Name).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type EventCursorORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchEventCursor executes a basic gorm update call with patch behavior
+func DefaultPatchEventCursor(ctx context.Context, in *EventCursor, updateMask *field_mask.FieldMask, db *gorm.DB) (*EventCursor, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj EventCursor
+	var err error
+	if hook, ok := interface{}(&pbObj).(EventCursorWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&pbObj).(EventCursorWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskEventCursor(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(EventCursorWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateEventCursor(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(EventCursorWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type EventCursorWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *EventCursor, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *EventCursor, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *EventCursor, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *EventCursor, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetEventCursor executes a bulk gorm update call with patch behavior
+func DefaultPatchSetEventCursor(ctx context.Context, objects []*EventCursor, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*EventCursor, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*EventCursor, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchEventCursor(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskEventCursor patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskEventCursor(ctx context.Context, patchee *EventCursor, patcher *EventCursor, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*EventCursor, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"TaskStatusEventId" {
+			patchee.TaskStatusEventId = patcher.TaskStatusEventId
+			continue
+		}
+		if f == prefix+"QuotaTransactionId" {
+			patchee.QuotaTransactionId = patcher.QuotaTransactionId
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListEventCursor executes a gorm list call
+func DefaultListEventCursor(ctx context.Context, db *gorm.DB) ([]*EventCursor, error) {
+	in := EventCursor{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("name")
+	ormResponse := []EventCursorORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EventCursorORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*EventCursor{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type EventCursorORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EventCursorORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]EventCursorORM) error
+}
@@ -0,0 +1,1635 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type QuoteORM struct {
+	ClientId        uint64 `gorm:"index:idx_quotes_client_id"`
+	CreatedAt       *time.Time
+	CurrentRevision int32
+	CustomerDetails string
+	CustomerName    string
+	Id              uint64
+	Revisions       []*QuoteRevisionORM `gorm:"foreignKey:QuoteId;references:Id"`
+	TaskId          string              `gorm:"type:uuid;index:idx_quotes_task_id"`
+	Title           string
+	UpdatedAt       *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (QuoteORM) TableName() string {
+	return "quotes"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Quote) ToORM(ctx context.Context) (QuoteORM, error) {
+	to := QuoteORM{}
+	var err error
+	if prehook, ok := interface{}(m).(QuoteWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.ClientId = m.ClientId
+	to.Title = m.Title
+	to.CustomerName = m.CustomerName
+	to.CustomerDetails = m.CustomerDetails
+	to.CurrentRevision = m.CurrentRevision
+	for _, v := range m.Revisions {
+		if v != nil {
+			if tempRevisions, cErr := v.ToORM(ctx); cErr == nil {
+				to.Revisions = append(to.Revisions, &tempRevisions)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Revisions = append(to.Revisions, nil)
+		}
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(QuoteWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *QuoteORM) ToPB(ctx context.Context) (Quote, error) {
+	to := Quote{}
+	var err error
+	if prehook, ok := interface{}(m).(QuoteWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.ClientId = m.ClientId
+	to.Title = m.Title
+	to.CustomerName = m.CustomerName
+	to.CustomerDetails = m.CustomerDetails
+	to.CurrentRevision = m.CurrentRevision
+	for _, v := range m.Revisions {
+		if v != nil {
+			if tempRevisions, cErr := v.ToPB(ctx); cErr == nil {
+				to.Revisions = append(to.Revisions, &tempRevisions)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Revisions = append(to.Revisions, nil)
+		}
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(QuoteWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Quote the arg will be the target, the caller the one being converted from
+
+// QuoteBeforeToORM called before default ToORM code
+type QuoteWithBeforeToORM interface {
+	BeforeToORM(context.Context, *QuoteORM) error
+}
+
+// QuoteAfterToORM called after default ToORM code
+type QuoteWithAfterToORM interface {
+	AfterToORM(context.Context, *QuoteORM) error
+}
+
+// QuoteBeforeToPB called before default ToPB code
+type QuoteWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Quote) error
+}
+
+// QuoteAfterToPB called after default ToPB code
+type QuoteWithAfterToPB interface {
+	AfterToPB(context.Context, *Quote) error
+}
+
+type QuoteRevisionORM struct {
+	Cost             float64
+	CreatedAt        *time.Time
+	CreatedBy        uint64
+	Currency         string
+	DiscountAmount   float64
+	DiscountPercent  float64
+	GrossAmount      float64
+	Id               uint64
+	Items            []*QuoteItemORM `gorm:"foreignKey:QuoteRevisionId;references:Id"`
+	MarginPercent    float64
+	NetAmount        float64
+	Notes            string
+	PriceListId      uint64
+	PriceListVersion int32
+	QuoteId          *uint64 `gorm:"index:idx_quote_revisions_quote_id"`
+	Revision         int32
+	TotalAmount      float64
+	ValidDays        int32
+	VatAmount        float64
+	VatPercent       float64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (QuoteRevisionORM) TableName() string {
+	return "quote_revisions"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *QuoteRevision) ToORM(ctx context.Context) (QuoteRevisionORM, error) {
+	to := QuoteRevisionORM{}
+	var err error
+	if prehook, ok := interface{}(m).(QuoteRevisionWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.QuoteId = m.QuoteId
+	to.Revision = m.Revision
+	to.CreatedBy = m.CreatedBy
+	to.Currency = m.Currency
+	to.PriceListId = m.PriceListId
+	to.PriceListVersion = m.PriceListVersion
+	to.MarginPercent = m.MarginPercent
+	to.DiscountPercent = m.DiscountPercent
+	to.VatPercent = m.VatPercent
+	to.Cost = m.Cost
+	to.GrossAmount = m.GrossAmount
+	to.DiscountAmount = m.DiscountAmount
+	to.NetAmount = m.NetAmount
+	to.VatAmount = m.VatAmount
+	to.TotalAmount = m.TotalAmount
+	to.Notes = m.Notes
+	to.ValidDays = m.ValidDays
+	for _, v := range m.Items {
+		if v != nil {
+			if tempItems, cErr := v.ToORM(ctx); cErr == nil {
+				to.Items = append(to.Items, &tempItems)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Items = append(to.Items, nil)
+		}
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(QuoteRevisionWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *QuoteRevisionORM) ToPB(ctx context.Context) (QuoteRevision, error) {
+	to := QuoteRevision{}
+	var err error
+	if prehook, ok := interface{}(m).(QuoteRevisionWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.QuoteId = m.QuoteId
+	to.Revision = m.Revision
+	to.CreatedBy = m.CreatedBy
+	to.Currency = m.Currency
+	to.PriceListId = m.PriceListId
+	to.PriceListVersion = m.PriceListVersion
+	to.MarginPercent = m.MarginPercent
+	to.DiscountPercent = m.DiscountPercent
+	to.VatPercent = m.VatPercent
+	to.Cost = m.Cost
+	to.GrossAmount = m.GrossAmount
+	to.DiscountAmount = m.DiscountAmount
+	to.NetAmount = m.NetAmount
+	to.VatAmount = m.VatAmount
+	to.TotalAmount = m.TotalAmount
+	to.Notes = m.Notes
+	to.ValidDays = m.ValidDays
+	for _, v := range m.Items {
+		if v != nil {
+			if tempItems, cErr := v.ToPB(ctx); cErr == nil {
+				to.Items = append(to.Items, &tempItems)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Items = append(to.Items, nil)
+		}
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(QuoteRevisionWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type QuoteRevision the arg will be the target, the caller the one being converted from
+
+// QuoteRevisionBeforeToORM called before default ToORM code
+type QuoteRevisionWithBeforeToORM interface {
+	BeforeToORM(context.Context, *QuoteRevisionORM) error
+}
+
+// QuoteRevisionAfterToORM called after default ToORM code
+type QuoteRevisionWithAfterToORM interface {
+	AfterToORM(context.Context, *QuoteRevisionORM) error
+}
+
+// QuoteRevisionBeforeToPB called before default ToPB code
+type QuoteRevisionWithBeforeToPB interface {
+	BeforeToPB(context.Context, *QuoteRevision) error
+}
+
+// QuoteRevisionAfterToPB called after default ToPB code
+type QuoteRevisionWithAfterToPB interface {
+	AfterToPB(context.Context, *QuoteRevision) error
+}
+
+type QuoteItemORM struct {
+	Amount          float64
+	Cost            float64
+	Id              uint64
+	Mass            float64
+	Material        string
+	Name            string
+	NodeId          string
+	Number          string
+	Position        int32
+	Quantity        int32
+	QuoteRevisionId *uint64 `gorm:"index:idx_quote_items_quote_revision_id"`
+	UnitCost        float64
+	UnitPrice       float64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (QuoteItemORM) TableName() string {
+	return "quote_items"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *QuoteItem) ToORM(ctx context.Context) (QuoteItemORM, error) {
+	to := QuoteItemORM{}
+	var err error
+	if prehook, ok := interface{}(m).(QuoteItemWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.QuoteRevisionId = m.QuoteRevisionId
+	to.Position = m.Position
+	to.NodeId = m.NodeId
+	to.Number = m.Number
+	to.Name = m.Name
+	to.Material = m.Material
+	to.Quantity = m.Quantity
+	to.UnitCost = m.UnitCost
+	to.Cost = m.Cost
+	to.UnitPrice = m.UnitPrice
+	to.Amount = m.Amount
+	to.Mass = m.Mass
+	if posthook, ok := interface{}(m).(QuoteItemWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *QuoteItemORM) ToPB(ctx context.Context) (QuoteItem, error) {
+	to := QuoteItem{}
+	var err error
+	if prehook, ok := interface{}(m).(QuoteItemWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.QuoteRevisionId = m.QuoteRevisionId
+	to.Position = m.Position
+	to.NodeId = m.NodeId
+	to.Number = m.Number
+	to.Name = m.Name
+	to.Material = m.Material
+	to.Quantity = m.Quantity
+	to.UnitCost = m.UnitCost
+	to.Cost = m.Cost
+	to.UnitPrice = m.UnitPrice
+	to.Amount = m.Amount
+	to.Mass = m.Mass
+	if posthook, ok := interface{}(m).(QuoteItemWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type QuoteItem the arg will be the target, the caller the one being converted from
+
+// QuoteItemBeforeToORM called before default ToORM code
+type QuoteItemWithBeforeToORM interface {
+	BeforeToORM(context.Context, *QuoteItemORM) error
+}
+
+// QuoteItemAfterToORM called after default ToORM code
+type QuoteItemWithAfterToORM interface {
+	AfterToORM(context.Context, *QuoteItemORM) error
+}
+
+// QuoteItemBeforeToPB called before default ToPB code
+type QuoteItemWithBeforeToPB interface {
+	BeforeToPB(context.Context, *QuoteItem) error
+}
+
+// QuoteItemAfterToPB called after default ToPB code
+type QuoteItemWithAfterToPB interface {
+	AfterToPB(context.Context, *QuoteItem) error
+}
+
+// DefaultCreateQuote executes a basic gorm create call
+func DefaultCreateQuote(ctx context.Context, in *Quote, db *gorm.DB) (*Quote, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Preload("Revisions").Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type QuoteORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadQuote(ctx context.Context, in *Quote, db *gorm.DB) (*Quote, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := QuoteORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(QuoteORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type QuoteORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteQuote(ctx context.Context, in *Quote, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&QuoteORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type QuoteORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteQuoteSet(ctx context.Context, in []*Quote, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&QuoteORM{})).(QuoteORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&QuoteORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&QuoteORM{})).(QuoteORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type QuoteORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Quote, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Quote, *gorm.DB) error
+}
+
+// DefaultStrictUpdateQuote clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateQuote(ctx context.Context, in *Quote, db *gorm.DB) (*Quote, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateQuote")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &QuoteORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterRevisions := QuoteRevisionORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterRevisions.QuoteId = new(uint64)
+	*filterRevisions.QuoteId = ormObj.Id
+	if err = db.Where(filterRevisions).Delete(QuoteRevisionORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Preload("Revisions").Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type QuoteORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchQuote executes a basic gorm update call with patch behavior
+func DefaultPatchQuote(ctx context.Context, in *Quote, updateMask *field_mask.FieldMask, db *gorm.DB) (*Quote, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Quote
+	var err error
+	if hook, ok := interface{}(&pbObj).(QuoteWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadQuote(ctx, &Quote{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(QuoteWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskQuote(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(QuoteWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateQuote(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(QuoteWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type QuoteWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Quote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Quote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Quote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Quote, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetQuote executes a bulk gorm update call with patch behavior
+func DefaultPatchSetQuote(ctx context.Context, objects []*Quote, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Quote, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Quote, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchQuote(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskQuote patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskQuote(ctx context.Context, patchee *Quote, patcher *Quote, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Quote, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TaskId" {
+			patchee.TaskId = patcher.TaskId
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if f == prefix+"CustomerName" {
+			patchee.CustomerName = patcher.CustomerName
+			continue
+		}
+		if f == prefix+"CustomerDetails" {
+			patchee.CustomerDetails = patcher.CustomerDetails
+			continue
+		}
+		if f == prefix+"CurrentRevision" {
+			patchee.CurrentRevision = patcher.CurrentRevision
+			continue
+		}
+		if f == prefix+"Revisions" {
+			patchee.Revisions = patcher.Revisions
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListQuote executes a gorm list call
+func DefaultListQuote(ctx context.Context, db *gorm.DB) ([]*Quote, error) {
+	in := Quote{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []QuoteORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Quote{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type QuoteORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]QuoteORM) error
+}
+
+// DefaultCreateQuoteRevision executes a basic gorm create call
+func DefaultCreateQuoteRevision(ctx context.Context, in *QuoteRevision, db *gorm.DB) (*QuoteRevision, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Preload("Items").Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type QuoteRevisionORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadQuoteRevision(ctx context.Context, in *QuoteRevision, db *gorm.DB) (*QuoteRevision, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := QuoteRevisionORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(QuoteRevisionORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type QuoteRevisionORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteQuoteRevision(ctx context.Context, in *QuoteRevision, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&QuoteRevisionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type QuoteRevisionORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteQuoteRevisionSet(ctx context.Context, in []*QuoteRevision, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&QuoteRevisionORM{})).(QuoteRevisionORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&QuoteRevisionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&QuoteRevisionORM{})).(QuoteRevisionORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type QuoteRevisionORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*QuoteRevision, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*QuoteRevision, *gorm.DB) error
+}
+
+// DefaultStrictUpdateQuoteRevision clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateQuoteRevision(ctx context.Context, in *QuoteRevision, db *gorm.DB) (*QuoteRevision, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateQuoteRevision")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &QuoteRevisionORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterItems := QuoteItemORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterItems.QuoteRevisionId = new(uint64)
+	*filterItems.QuoteRevisionId = ormObj.Id
+	if err = db.Where(filterItems).Delete(QuoteItemORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Preload("Items").Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type QuoteRevisionORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchQuoteRevision executes a basic gorm update call with patch behavior
+func DefaultPatchQuoteRevision(ctx context.Context, in *QuoteRevision, updateMask *field_mask.FieldMask, db *gorm.DB) (*QuoteRevision, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj QuoteRevision
+	var err error
+	if hook, ok := interface{}(&pbObj).(QuoteRevisionWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadQuoteRevision(ctx, &QuoteRevision{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(QuoteRevisionWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskQuoteRevision(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(QuoteRevisionWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateQuoteRevision(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(QuoteRevisionWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type QuoteRevisionWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *QuoteRevision, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *QuoteRevision, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *QuoteRevision, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *QuoteRevision, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetQuoteRevision executes a bulk gorm update call with patch behavior
+func DefaultPatchSetQuoteRevision(ctx context.Context, objects []*QuoteRevision, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*QuoteRevision, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*QuoteRevision, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchQuoteRevision(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskQuoteRevision patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskQuoteRevision(ctx context.Context, patchee *QuoteRevision, patcher *QuoteRevision, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*QuoteRevision, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"QuoteId" {
+			patchee.QuoteId = patcher.QuoteId
+			continue
+		}
+		if f == prefix+"Revision" {
+			patchee.Revision = patcher.Revision
+			continue
+		}
+		if f == prefix+"CreatedBy" {
+			patchee.CreatedBy = patcher.CreatedBy
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"PriceListId" {
+			patchee.PriceListId = patcher.PriceListId
+			continue
+		}
+		if f == prefix+"PriceListVersion" {
+			patchee.PriceListVersion = patcher.PriceListVersion
+			continue
+		}
+		if f == prefix+"MarginPercent" {
+			patchee.MarginPercent = patcher.MarginPercent
+			continue
+		}
+		if f == prefix+"DiscountPercent" {
+			patchee.DiscountPercent = patcher.DiscountPercent
+			continue
+		}
+		if f == prefix+"VatPercent" {
+			patchee.VatPercent = patcher.VatPercent
+			continue
+		}
+		if f == prefix+"Cost" {
+			patchee.Cost = patcher.Cost
+			continue
+		}
+		if f == prefix+"GrossAmount" {
+			patchee.GrossAmount = patcher.GrossAmount
+			continue
+		}
+		if f == prefix+"DiscountAmount" {
+			patchee.DiscountAmount = patcher.DiscountAmount
+			continue
+		}
+		if f == prefix+"NetAmount" {
+			patchee.NetAmount = patcher.NetAmount
+			continue
+		}
+		if f == prefix+"VatAmount" {
+			patchee.VatAmount = patcher.VatAmount
+			continue
+		}
+		if f == prefix+"TotalAmount" {
+			patchee.TotalAmount = patcher.TotalAmount
+			continue
+		}
+		if f == prefix+"Notes" {
+			patchee.Notes = patcher.Notes
+			continue
+		}
+		if f == prefix+"ValidDays" {
+			patchee.ValidDays = patcher.ValidDays
+			continue
+		}
+		if f == prefix+"Items" {
+			patchee.Items = patcher.Items
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListQuoteRevision executes a gorm list call
+func DefaultListQuoteRevision(ctx context.Context, db *gorm.DB) ([]*QuoteRevision, error) {
+	in := QuoteRevision{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []QuoteRevisionORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteRevisionORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*QuoteRevision{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type QuoteRevisionORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteRevisionORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]QuoteRevisionORM) error
+}
+
+// DefaultCreateQuoteItem executes a basic gorm create call
+func DefaultCreateQuoteItem(ctx context.Context, in *QuoteItem, db *gorm.DB) (*QuoteItem, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type QuoteItemORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadQuoteItem(ctx context.Context, in *QuoteItem, db *gorm.DB) (*QuoteItem, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := QuoteItemORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(QuoteItemORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type QuoteItemORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteQuoteItem(ctx context.Context, in *QuoteItem, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&QuoteItemORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type QuoteItemORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteQuoteItemSet(ctx context.Context, in []*QuoteItem, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&QuoteItemORM{})).(QuoteItemORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&QuoteItemORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&QuoteItemORM{})).(QuoteItemORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type QuoteItemORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*QuoteItem, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*QuoteItem, *gorm.DB) error
+}
+
+// DefaultStrictUpdateQuoteItem clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateQuoteItem(ctx context.Context, in *QuoteItem, db *gorm.DB) (*QuoteItem, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateQuoteItem")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &QuoteItemORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type QuoteItemORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchQuoteItem executes a basic gorm update call with patch behavior
+func DefaultPatchQuoteItem(ctx context.Context, in *QuoteItem, updateMask *field_mask.FieldMask, db *gorm.DB) (*QuoteItem, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj QuoteItem
+	var err error
+	if hook, ok := interface{}(&pbObj).(QuoteItemWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadQuoteItem(ctx, &QuoteItem{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(QuoteItemWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskQuoteItem(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(QuoteItemWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateQuoteItem(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(QuoteItemWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type QuoteItemWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *QuoteItem, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *QuoteItem, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *QuoteItem, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *QuoteItem, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetQuoteItem executes a bulk gorm update call with patch behavior
+func DefaultPatchSetQuoteItem(ctx context.Context, objects []*QuoteItem, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*QuoteItem, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*QuoteItem, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchQuoteItem(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskQuoteItem patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskQuoteItem(ctx context.Context, patchee *QuoteItem, patcher *QuoteItem, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*QuoteItem, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"QuoteRevisionId" {
+			patchee.QuoteRevisionId = patcher.QuoteRevisionId
+			continue
+		}
+		if f == prefix+"Position" {
+			patchee.Position = patcher.Position
+			continue
+		}
+		if f == prefix+"NodeId" {
+			patchee.NodeId = patcher.NodeId
+			continue
+		}
+		if f == prefix+"Number" {
+			patchee.Number = patcher.Number
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Material" {
+			patchee.Material = patcher.Material
+			continue
+		}
+		if f == prefix+"Quantity" {
+			patchee.Quantity = patcher.Quantity
+			continue
+		}
+		if f == prefix+"UnitCost" {
+			patchee.UnitCost = patcher.UnitCost
+			continue
+		}
+		if f == prefix+"Cost" {
+			patchee.Cost = patcher.Cost
+			continue
+		}
+		if f == prefix+"UnitPrice" {
+			patchee.UnitPrice = patcher.UnitPrice
+			continue
+		}
+		if f == prefix+"Amount" {
+			patchee.Amount = patcher.Amount
+			continue
+		}
+		if f == prefix+"Mass" {
+			patchee.Mass = patcher.Mass
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListQuoteItem executes a gorm list call
+func DefaultListQuoteItem(ctx context.Context, db *gorm.DB) ([]*QuoteItem, error) {
+	in := QuoteItem{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []QuoteItemORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(QuoteItemORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*QuoteItem{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type QuoteItemORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type QuoteItemORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]QuoteItemORM) error
+}
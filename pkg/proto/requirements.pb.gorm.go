@@ -0,0 +1,1526 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type WasteFactorORM struct {
+	AssortmentType string
+	ClientId       *uint64 `gorm:"index:idx_waste_factors_client_id"`
+	CreatedAt      *time.Time
+	Id             uint64
+	Material       string
+	Percent        float64
+	UpdatedAt      *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WasteFactorORM) TableName() string {
+	return "waste_factors"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *WasteFactor) ToORM(ctx context.Context) (WasteFactorORM, error) {
+	to := WasteFactorORM{}
+	var err error
+	if prehook, ok := interface{}(m).(WasteFactorWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.AssortmentType = m.AssortmentType
+	to.Material = m.Material
+	to.Percent = m.Percent
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(WasteFactorWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *WasteFactorORM) ToPB(ctx context.Context) (WasteFactor, error) {
+	to := WasteFactor{}
+	var err error
+	if prehook, ok := interface{}(m).(WasteFactorWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.AssortmentType = m.AssortmentType
+	to.Material = m.Material
+	to.Percent = m.Percent
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(WasteFactorWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type WasteFactor the arg will be the target, the caller the one being converted from
+
+// WasteFactorBeforeToORM called before default ToORM code
+type WasteFactorWithBeforeToORM interface {
+	BeforeToORM(context.Context, *WasteFactorORM) error
+}
+
+// WasteFactorAfterToORM called after default ToORM code
+type WasteFactorWithAfterToORM interface {
+	AfterToORM(context.Context, *WasteFactorORM) error
+}
+
+// WasteFactorBeforeToPB called before default ToPB code
+type WasteFactorWithBeforeToPB interface {
+	BeforeToPB(context.Context, *WasteFactor) error
+}
+
+// WasteFactorAfterToPB called after default ToPB code
+type WasteFactorWithAfterToPB interface {
+	AfterToPB(context.Context, *WasteFactor) error
+}
+
+type StockLengthORM struct {
+	AssortmentType string
+	ClientId       *uint64 `gorm:"index:idx_stock_lengths_client_id"`
+	CreatedAt      *time.Time
+	Id             uint64
+	Kerf           float64
+	Length         float64
+	Material       string
+	Size           string
+	UpdatedAt      *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (StockLengthORM) TableName() string {
+	return "stock_lengths"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *StockLength) ToORM(ctx context.Context) (StockLengthORM, error) {
+	to := StockLengthORM{}
+	var err error
+	if prehook, ok := interface{}(m).(StockLengthWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.AssortmentType = m.AssortmentType
+	to.Material = m.Material
+	to.Size = m.Size
+	to.Length = m.Length
+	to.Kerf = m.Kerf
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(StockLengthWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *StockLengthORM) ToPB(ctx context.Context) (StockLength, error) {
+	to := StockLength{}
+	var err error
+	if prehook, ok := interface{}(m).(StockLengthWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.AssortmentType = m.AssortmentType
+	to.Material = m.Material
+	to.Size = m.Size
+	to.Length = m.Length
+	to.Kerf = m.Kerf
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(StockLengthWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type StockLength the arg will be the target, the caller the one being converted from
+
+// StockLengthBeforeToORM called before default ToORM code
+type StockLengthWithBeforeToORM interface {
+	BeforeToORM(context.Context, *StockLengthORM) error
+}
+
+// StockLengthAfterToORM called after default ToORM code
+type StockLengthWithAfterToORM interface {
+	AfterToORM(context.Context, *StockLengthORM) error
+}
+
+// StockLengthBeforeToPB called before default ToPB code
+type StockLengthWithBeforeToPB interface {
+	BeforeToPB(context.Context, *StockLength) error
+}
+
+// StockLengthAfterToPB called after default ToPB code
+type StockLengthWithAfterToPB interface {
+	AfterToPB(context.Context, *StockLength) error
+}
+
+type SheetFormatORM struct {
+	ClientId  *uint64 `gorm:"index:idx_sheet_formats_client_id"`
+	CreatedAt *time.Time
+	Gap       float64
+	Id        uint64
+	Length    float64
+	Material  string
+	Thickness float64
+	UpdatedAt *time.Time
+	Width     float64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (SheetFormatORM) TableName() string {
+	return "sheet_formats"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *SheetFormat) ToORM(ctx context.Context) (SheetFormatORM, error) {
+	to := SheetFormatORM{}
+	var err error
+	if prehook, ok := interface{}(m).(SheetFormatWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Material = m.Material
+	to.Thickness = m.Thickness
+	to.Width = m.Width
+	to.Length = m.Length
+	to.Gap = m.Gap
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(SheetFormatWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *SheetFormatORM) ToPB(ctx context.Context) (SheetFormat, error) {
+	to := SheetFormat{}
+	var err error
+	if prehook, ok := interface{}(m).(SheetFormatWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Material = m.Material
+	to.Thickness = m.Thickness
+	to.Width = m.Width
+	to.Length = m.Length
+	to.Gap = m.Gap
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(SheetFormatWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type SheetFormat the arg will be the target, the caller the one being converted from
+
+// SheetFormatBeforeToORM called before default ToORM code
+type SheetFormatWithBeforeToORM interface {
+	BeforeToORM(context.Context, *SheetFormatORM) error
+}
+
+// SheetFormatAfterToORM called after default ToORM code
+type SheetFormatWithAfterToORM interface {
+	AfterToORM(context.Context, *SheetFormatORM) error
+}
+
+// SheetFormatBeforeToPB called before default ToPB code
+type SheetFormatWithBeforeToPB interface {
+	BeforeToPB(context.Context, *SheetFormat) error
+}
+
+// SheetFormatAfterToPB called after default ToPB code
+type SheetFormatWithAfterToPB interface {
+	AfterToPB(context.Context, *SheetFormat) error
+}
+
+// DefaultCreateWasteFactor executes a basic gorm create call
+func DefaultCreateWasteFactor(ctx context.Context, in *WasteFactor, db *gorm.DB) (*WasteFactor, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type WasteFactorORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadWasteFactor(ctx context.Context, in *WasteFactor, db *gorm.DB) (*WasteFactor, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := WasteFactorORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(WasteFactorORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type WasteFactorORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteWasteFactor(ctx context.Context, in *WasteFactor, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&WasteFactorORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type WasteFactorORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteWasteFactorSet(ctx context.Context, in []*WasteFactor, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&WasteFactorORM{})).(WasteFactorORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&WasteFactorORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&WasteFactorORM{})).(WasteFactorORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type WasteFactorORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*WasteFactor, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*WasteFactor, *gorm.DB) error
+}
+
+// DefaultStrictUpdateWasteFactor clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateWasteFactor(ctx context.Context, in *WasteFactor, db *gorm.DB) (*WasteFactor, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateWasteFactor")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &WasteFactorORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type WasteFactorORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchWasteFactor executes a basic gorm update call with patch behavior
+func DefaultPatchWasteFactor(ctx context.Context, in *WasteFactor, updateMask *field_mask.FieldMask, db *gorm.DB) (*WasteFactor, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj WasteFactor
+	var err error
+	if hook, ok := interface{}(&pbObj).(WasteFactorWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadWasteFactor(ctx, &WasteFactor{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(WasteFactorWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskWasteFactor(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(WasteFactorWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateWasteFactor(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(WasteFactorWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type WasteFactorWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *WasteFactor, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *WasteFactor, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *WasteFactor, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *WasteFactor, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetWasteFactor executes a bulk gorm update call with patch behavior
+func DefaultPatchSetWasteFactor(ctx context.Context, objects []*WasteFactor, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*WasteFactor, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*WasteFactor, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchWasteFactor(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskWasteFactor patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskWasteFactor(ctx context.Context, patchee *WasteFactor, patcher *WasteFactor, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*WasteFactor, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"AssortmentType" {
+			patchee.AssortmentType = patcher.AssortmentType
+			continue
+		}
+		if f == prefix+"Material" {
+			patchee.Material = patcher.Material
+			continue
+		}
+		if f == prefix+"Percent" {
+			patchee.Percent = patcher.Percent
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListWasteFactor executes a gorm list call
+func DefaultListWasteFactor(ctx context.Context, db *gorm.DB) ([]*WasteFactor, error) {
+	in := WasteFactor{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []WasteFactorORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WasteFactorORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*WasteFactor{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type WasteFactorORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WasteFactorORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]WasteFactorORM) error
+}
+
+// DefaultCreateStockLength executes a basic gorm create call
+func DefaultCreateStockLength(ctx context.Context, in *StockLength, db *gorm.DB) (*StockLength, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type StockLengthORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadStockLength(ctx context.Context, in *StockLength, db *gorm.DB) (*StockLength, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := StockLengthORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(StockLengthORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type StockLengthORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteStockLength(ctx context.Context, in *StockLength, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&StockLengthORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type StockLengthORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteStockLengthSet(ctx context.Context, in []*StockLength, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&StockLengthORM{})).(StockLengthORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&StockLengthORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&StockLengthORM{})).(StockLengthORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type StockLengthORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*StockLength, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*StockLength, *gorm.DB) error
+}
+
+// DefaultStrictUpdateStockLength clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateStockLength(ctx context.Context, in *StockLength, db *gorm.DB) (*StockLength, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateStockLength")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &StockLengthORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type StockLengthORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchStockLength executes a basic gorm update call with patch behavior
+func DefaultPatchStockLength(ctx context.Context, in *StockLength, updateMask *field_mask.FieldMask, db *gorm.DB) (*StockLength, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj StockLength
+	var err error
+	if hook, ok := interface{}(&pbObj).(StockLengthWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadStockLength(ctx, &StockLength{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(StockLengthWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskStockLength(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(StockLengthWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateStockLength(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(StockLengthWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type StockLengthWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *StockLength, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *StockLength, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *StockLength, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *StockLength, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetStockLength executes a bulk gorm update call with patch behavior
+func DefaultPatchSetStockLength(ctx context.Context, objects []*StockLength, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*StockLength, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*StockLength, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchStockLength(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskStockLength patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskStockLength(ctx context.Context, patchee *StockLength, patcher *StockLength, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*StockLength, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"AssortmentType" {
+			patchee.AssortmentType = patcher.AssortmentType
+			continue
+		}
+		if f == prefix+"Material" {
+			patchee.Material = patcher.Material
+			continue
+		}
+		if f == prefix+"Size" {
+			patchee.Size = patcher.Size
+			continue
+		}
+		if f == prefix+"Length" {
+			patchee.Length = patcher.Length
+			continue
+		}
+		if f == prefix+"Kerf" {
+			patchee.Kerf = patcher.Kerf
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListStockLength executes a gorm list call
+func DefaultListStockLength(ctx context.Context, db *gorm.DB) ([]*StockLength, error) {
+	in := StockLength{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []StockLengthORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StockLengthORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*StockLength{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type StockLengthORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StockLengthORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]StockLengthORM) error
+}
+
+// DefaultCreateSheetFormat executes a basic gorm create call
+func DefaultCreateSheetFormat(ctx context.Context, in *SheetFormat, db *gorm.DB) (*SheetFormat, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SheetFormatORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadSheetFormat(ctx context.Context, in *SheetFormat, db *gorm.DB) (*SheetFormat, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := SheetFormatORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(SheetFormatORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SheetFormatORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSheetFormat(ctx context.Context, in *SheetFormat, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&SheetFormatORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type SheetFormatORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSheetFormatSet(ctx context.Context, in []*SheetFormat, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&SheetFormatORM{})).(SheetFormatORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&SheetFormatORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&SheetFormatORM{})).(SheetFormatORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type SheetFormatORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*SheetFormat, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*SheetFormat, *gorm.DB) error
+}
+
+// DefaultStrictUpdateSheetFormat clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateSheetFormat(ctx context.Context, in *SheetFormat, db *gorm.DB) (*SheetFormat, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateSheetFormat")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &SheetFormatORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type SheetFormatORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchSheetFormat executes a basic gorm update call with patch behavior
+func DefaultPatchSheetFormat(ctx context.Context, in *SheetFormat, updateMask *field_mask.FieldMask, db *gorm.DB) (*SheetFormat, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj SheetFormat
+	var err error
+	if hook, ok := interface{}(&pbObj).(SheetFormatWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadSheetFormat(ctx, &SheetFormat{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(SheetFormatWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskSheetFormat(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(SheetFormatWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateSheetFormat(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(SheetFormatWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type SheetFormatWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *SheetFormat, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *SheetFormat, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *SheetFormat, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *SheetFormat, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetSheetFormat executes a bulk gorm update call with patch behavior
+func DefaultPatchSetSheetFormat(ctx context.Context, objects []*SheetFormat, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*SheetFormat, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*SheetFormat, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchSheetFormat(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskSheetFormat patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSheetFormat(ctx context.Context, patchee *SheetFormat, patcher *SheetFormat, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*SheetFormat, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"Material" {
+			patchee.Material = patcher.Material
+			continue
+		}
+		if f == prefix+"Thickness" {
+			patchee.Thickness = patcher.Thickness
+			continue
+		}
+		if f == prefix+"Width" {
+			patchee.Width = patcher.Width
+			continue
+		}
+		if f == prefix+"Length" {
+			patchee.Length = patcher.Length
+			continue
+		}
+		if f == prefix+"Gap" {
+			patchee.Gap = patcher.Gap
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListSheetFormat executes a gorm list call
+func DefaultListSheetFormat(ctx context.Context, db *gorm.DB) ([]*SheetFormat, error) {
+	in := SheetFormat{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []SheetFormatORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SheetFormatORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*SheetFormat{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type SheetFormatORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SheetFormatORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]SheetFormatORM) error
+}
@@ -0,0 +1,541 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	datatypes "gorm.io/datatypes"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type TreeRevisionORM struct {
+	Action     string
+	Author     string
+	AuthorId   *uint64 `gorm:"index:idx_tree_revisions_author_id"`
+	CreatedAt  *time.Time
+	Diff       *datatypes.JSONType[TreeDiff]
+	Id         uint64
+	RevertedTo *int32
+	Revision   int32  `gorm:"uniqueIndex:idx_tree_revisions_task_revision"`
+	TaskId     string `gorm:"type:uuid;uniqueIndex:idx_tree_revisions_task_revision"`
+	Tree       *datatypes.JSONType[TreeNode]
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TreeRevisionORM) TableName() string {
+	return "tree_revisions"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TreeRevision) ToORM(ctx context.Context) (TreeRevisionORM, error) {
+	to := TreeRevisionORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TreeRevisionWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.Revision = m.Revision
+	to.AuthorId = m.AuthorId
+	to.Author = m.Author
+	to.Action = m.Action
+	to.RevertedTo = m.RevertedTo
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(TreeRevisionWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TreeRevisionORM) ToPB(ctx context.Context) (TreeRevision, error) {
+	to := TreeRevision{}
+	var err error
+	if prehook, ok := interface{}(m).(TreeRevisionWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.Revision = m.Revision
+	to.AuthorId = m.AuthorId
+	to.Author = m.Author
+	to.Action = m.Action
+	to.RevertedTo = m.RevertedTo
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(TreeRevisionWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TreeRevision the arg will be the target, the caller the one being converted from
+
+// TreeRevisionBeforeToORM called before default ToORM code
+type TreeRevisionWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TreeRevisionORM) error
+}
+
+// TreeRevisionAfterToORM called after default ToORM code
+type TreeRevisionWithAfterToORM interface {
+	AfterToORM(context.Context, *TreeRevisionORM) error
+}
+
+// TreeRevisionBeforeToPB called before default ToPB code
+type TreeRevisionWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TreeRevision) error
+}
+
+// TreeRevisionAfterToPB called after default ToPB code
+type TreeRevisionWithAfterToPB interface {
+	AfterToPB(context.Context, *TreeRevision) error
+}
+
+// DefaultCreateTreeRevision executes a basic gorm create call
+func DefaultCreateTreeRevision(ctx context.Context, in *TreeRevision, db *gorm.DB) (*TreeRevision, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TreeRevisionORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadTreeRevision(ctx context.Context, in *TreeRevision, db *gorm.DB) (*TreeRevision, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TreeRevisionORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TreeRevisionORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TreeRevisionORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTreeRevision(ctx context.Context, in *TreeRevision, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TreeRevisionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TreeRevisionORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTreeRevisionSet(ctx context.Context, in []*TreeRevision, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TreeRevisionORM{})).(TreeRevisionORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TreeRevisionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TreeRevisionORM{})).(TreeRevisionORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TreeRevisionORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TreeRevision, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TreeRevision, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTreeRevision clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTreeRevision(ctx context.Context, in *TreeRevision, db *gorm.DB) (*TreeRevision, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTreeRevision")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &TreeRevisionORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TreeRevisionORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTreeRevision executes a basic gorm update call with patch behavior
+func DefaultPatchTreeRevision(ctx context.Context, in *TreeRevision, updateMask *field_mask.FieldMask, db *gorm.DB) (*TreeRevision, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TreeRevision
+	var err error
+	if hook, ok := interface{}(&pbObj).(TreeRevisionWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTreeRevision(ctx, &TreeRevision{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TreeRevisionWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTreeRevision(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TreeRevisionWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTreeRevision(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TreeRevisionWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TreeRevisionWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TreeRevision, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TreeRevision, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TreeRevision, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TreeRevision, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTreeRevision executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTreeRevision(ctx context.Context, objects []*TreeRevision, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TreeRevision, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TreeRevision, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTreeRevision(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTreeRevision patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTreeRevision(ctx context.Context, patchee *TreeRevision, patcher *TreeRevision, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TreeRevision, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedTree bool
+	var updatedDiff bool
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TaskId" {
+			patchee.TaskId = patcher.TaskId
+			continue
+		}
+		if f == prefix+"Revision" {
+			patchee.Revision = patcher.Revision
+			continue
+		}
+		if f == prefix+"AuthorId" {
+			patchee.AuthorId = patcher.AuthorId
+			continue
+		}
+		if f == prefix+"Author" {
+			patchee.Author = patcher.Author
+			continue
+		}
+		if f == prefix+"Action" {
+			patchee.Action = patcher.Action
+			continue
+		}
+		if f == prefix+"RevertedTo" {
+			patchee.RevertedTo = patcher.RevertedTo
+			continue
+		}
+		if !updatedTree && strings.HasPrefix(f, prefix+"Tree.") {
+			if patcher.Tree == nil {
+				patchee.Tree = nil
+				continue
+			}
+			if patchee.Tree == nil {
+				patchee.Tree = &TreeNode{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Tree."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Tree, patchee.Tree, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Tree" {
+			updatedTree = true
+			patchee.Tree = patcher.Tree
+			continue
+		}
+		if !updatedDiff && strings.HasPrefix(f, prefix+"Diff.") {
+			if patcher.Diff == nil {
+				patchee.Diff = nil
+				continue
+			}
+			if patchee.Diff == nil {
+				patchee.Diff = &TreeDiff{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Diff."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Diff, patchee.Diff, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Diff" {
+			updatedDiff = true
+			patchee.Diff = patcher.Diff
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTreeRevision executes a gorm list call
+func DefaultListTreeRevision(ctx context.Context, db *gorm.DB) ([]*TreeRevision, error) {
+	in := TreeRevision{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TreeRevisionORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TreeRevisionORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TreeRevision{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TreeRevisionORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TreeRevisionORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TreeRevisionORM) error
+}
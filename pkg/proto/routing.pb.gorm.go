@@ -0,0 +1,613 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type OperationRuleORM struct {
+	ClientId            *uint64 `gorm:"index:idx_operation_rules_client_id"`
+	CreatedAt           *time.Time
+	Enabled             bool
+	FigureType          string
+	Id                  uint64
+	LabourHoursPerKg    float64
+	LabourHoursPerMetre float64
+	LabourHoursPerUnit  float64
+	MachineHoursPerKg   float64
+	MachineHoursPerUnit float64
+	Material            string
+	MaxLength           float64
+	MaxMass             float64
+	MinLength           float64
+	MinMass             float64
+	Name                string
+	Operation           string
+	Priority            int32
+	SetupHours          float64
+	SubType             string
+	Target              int32
+	UpdatedAt           *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (OperationRuleORM) TableName() string {
+	return "operation_rules"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *OperationRule) ToORM(ctx context.Context) (OperationRuleORM, error) {
+	to := OperationRuleORM{}
+	var err error
+	if prehook, ok := interface{}(m).(OperationRuleWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Name = m.Name
+	to.Operation = m.Operation
+	to.Priority = m.Priority
+	to.Enabled = m.Enabled
+	to.Target = int32(m.Target)
+	to.FigureType = m.FigureType
+	to.SubType = m.SubType
+	to.Material = m.Material
+	to.MinMass = m.MinMass
+	to.MaxMass = m.MaxMass
+	to.MinLength = m.MinLength
+	to.MaxLength = m.MaxLength
+	to.SetupHours = m.SetupHours
+	to.LabourHoursPerUnit = m.LabourHoursPerUnit
+	to.LabourHoursPerKg = m.LabourHoursPerKg
+	to.LabourHoursPerMetre = m.LabourHoursPerMetre
+	to.MachineHoursPerUnit = m.MachineHoursPerUnit
+	to.MachineHoursPerKg = m.MachineHoursPerKg
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(OperationRuleWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *OperationRuleORM) ToPB(ctx context.Context) (OperationRule, error) {
+	to := OperationRule{}
+	var err error
+	if prehook, ok := interface{}(m).(OperationRuleWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Name = m.Name
+	to.Operation = m.Operation
+	to.Priority = m.Priority
+	to.Enabled = m.Enabled
+	to.Target = OperationRuleTarget(m.Target)
+	to.FigureType = m.FigureType
+	to.SubType = m.SubType
+	to.Material = m.Material
+	to.MinMass = m.MinMass
+	to.MaxMass = m.MaxMass
+	to.MinLength = m.MinLength
+	to.MaxLength = m.MaxLength
+	to.SetupHours = m.SetupHours
+	to.LabourHoursPerUnit = m.LabourHoursPerUnit
+	to.LabourHoursPerKg = m.LabourHoursPerKg
+	to.LabourHoursPerMetre = m.LabourHoursPerMetre
+	to.MachineHoursPerUnit = m.MachineHoursPerUnit
+	to.MachineHoursPerKg = m.MachineHoursPerKg
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(OperationRuleWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type OperationRule the arg will be the target, the caller the one being converted from
+
+// OperationRuleBeforeToORM called before default ToORM code
+type OperationRuleWithBeforeToORM interface {
+	BeforeToORM(context.Context, *OperationRuleORM) error
+}
+
+// OperationRuleAfterToORM called after default ToORM code
+type OperationRuleWithAfterToORM interface {
+	AfterToORM(context.Context, *OperationRuleORM) error
+}
+
+// OperationRuleBeforeToPB called before default ToPB code
+type OperationRuleWithBeforeToPB interface {
+	BeforeToPB(context.Context, *OperationRule) error
+}
+
+// OperationRuleAfterToPB called after default ToPB code
+type OperationRuleWithAfterToPB interface {
+	AfterToPB(context.Context, *OperationRule) error
+}
+
+// DefaultCreateOperationRule executes a basic gorm create call
+func DefaultCreateOperationRule(ctx context.Context, in *OperationRule, db *gorm.DB) (*OperationRule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type OperationRuleORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadOperationRule(ctx context.Context, in *OperationRule, db *gorm.DB) (*OperationRule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := OperationRuleORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(OperationRuleORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type OperationRuleORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteOperationRule(ctx context.Context, in *OperationRule, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&OperationRuleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type OperationRuleORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteOperationRuleSet(ctx context.Context, in []*OperationRule, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&OperationRuleORM{})).(OperationRuleORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&OperationRuleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&OperationRuleORM{})).(OperationRuleORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type OperationRuleORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*OperationRule, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*OperationRule, *gorm.DB) error
+}
+
+// DefaultStrictUpdateOperationRule clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateOperationRule(ctx context.Context, in *OperationRule, db *gorm.DB) (*OperationRule, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateOperationRule")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &OperationRuleORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type OperationRuleORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchOperationRule executes a basic gorm update call with patch behavior
+func DefaultPatchOperationRule(ctx context.Context, in *OperationRule, updateMask *field_mask.FieldMask, db *gorm.DB) (*OperationRule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj OperationRule
+	var err error
+	if hook, ok := interface{}(&pbObj).(OperationRuleWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadOperationRule(ctx, &OperationRule{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(OperationRuleWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskOperationRule(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(OperationRuleWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateOperationRule(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(OperationRuleWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type OperationRuleWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *OperationRule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *OperationRule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *OperationRule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *OperationRule, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetOperationRule executes a bulk gorm update call with patch behavior
+func DefaultPatchSetOperationRule(ctx context.Context, objects []*OperationRule, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*OperationRule, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*OperationRule, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchOperationRule(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskOperationRule patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskOperationRule(ctx context.Context, patchee *OperationRule, patcher *OperationRule, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*OperationRule, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Operation" {
+			patchee.Operation = patcher.Operation
+			continue
+		}
+		if f == prefix+"Priority" {
+			patchee.Priority = patcher.Priority
+			continue
+		}
+		if f == prefix+"Enabled" {
+			patchee.Enabled = patcher.Enabled
+			continue
+		}
+		if f == prefix+"Target" {
+			patchee.Target = patcher.Target
+			continue
+		}
+		if f == prefix+"FigureType" {
+			patchee.FigureType = patcher.FigureType
+			continue
+		}
+		if f == prefix+"SubType" {
+			patchee.SubType = patcher.SubType
+			continue
+		}
+		if f == prefix+"Material" {
+			patchee.Material = patcher.Material
+			continue
+		}
+		if f == prefix+"MinMass" {
+			patchee.MinMass = patcher.MinMass
+			continue
+		}
+		if f == prefix+"MaxMass" {
+			patchee.MaxMass = patcher.MaxMass
+			continue
+		}
+		if f == prefix+"MinLength" {
+			patchee.MinLength = patcher.MinLength
+			continue
+		}
+		if f == prefix+"MaxLength" {
+			patchee.MaxLength = patcher.MaxLength
+			continue
+		}
+		if f == prefix+"SetupHours" {
+			patchee.SetupHours = patcher.SetupHours
+			continue
+		}
+		if f == prefix+"LabourHoursPerUnit" {
+			patchee.LabourHoursPerUnit = patcher.LabourHoursPerUnit
+			continue
+		}
+		if f == prefix+"LabourHoursPerKg" {
+			patchee.LabourHoursPerKg = patcher.LabourHoursPerKg
+			continue
+		}
+		if f == prefix+"LabourHoursPerMetre" {
+			patchee.LabourHoursPerMetre = patcher.LabourHoursPerMetre
+			continue
+		}
+		if f == prefix+"MachineHoursPerUnit" {
+			patchee.MachineHoursPerUnit = patcher.MachineHoursPerUnit
+			continue
+		}
+		if f == prefix+"MachineHoursPerKg" {
+			patchee.MachineHoursPerKg = patcher.MachineHoursPerKg
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListOperationRule executes a gorm list call
+func DefaultListOperationRule(ctx context.Context, db *gorm.DB) ([]*OperationRule, error) {
+	in := OperationRule{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []OperationRuleORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OperationRuleORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*OperationRule{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type OperationRuleORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OperationRuleORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]OperationRuleORM) error
+}
@@ -0,0 +1,999 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type ValidationRuleORM struct {
+	ClientId    *uint64 `gorm:"index:idx_validation_rules_client_id"`
+	Code        string
+	CreatedAt   *time.Time
+	Description string
+	Enabled     bool
+	Id          uint64
+	Pattern     string
+	UpdatedAt   *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ValidationRuleORM) TableName() string {
+	return "validation_rules"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ValidationRule) ToORM(ctx context.Context) (ValidationRuleORM, error) {
+	to := ValidationRuleORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ValidationRuleWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Code = m.Code
+	to.Enabled = m.Enabled
+	to.Pattern = m.Pattern
+	to.Description = m.Description
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(ValidationRuleWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ValidationRuleORM) ToPB(ctx context.Context) (ValidationRule, error) {
+	to := ValidationRule{}
+	var err error
+	if prehook, ok := interface{}(m).(ValidationRuleWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Code = m.Code
+	to.Enabled = m.Enabled
+	to.Pattern = m.Pattern
+	to.Description = m.Description
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(ValidationRuleWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ValidationRule the arg will be the target, the caller the one being converted from
+
+// ValidationRuleBeforeToORM called before default ToORM code
+type ValidationRuleWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ValidationRuleORM) error
+}
+
+// ValidationRuleAfterToORM called after default ToORM code
+type ValidationRuleWithAfterToORM interface {
+	AfterToORM(context.Context, *ValidationRuleORM) error
+}
+
+// ValidationRuleBeforeToPB called before default ToPB code
+type ValidationRuleWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ValidationRule) error
+}
+
+// ValidationRuleAfterToPB called after default ToPB code
+type ValidationRuleWithAfterToPB interface {
+	AfterToPB(context.Context, *ValidationRule) error
+}
+
+type ValidationIssueORM struct {
+	Code      string
+	CreatedAt *time.Time
+	Field     string
+	Id        uint64
+	Message   string
+	Name      string
+	NodeId    string
+	Number    string
+	TaskId    string `gorm:"type:uuid;index:idx_validation_issues_task_id"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ValidationIssueORM) TableName() string {
+	return "validation_issues"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ValidationIssue) ToORM(ctx context.Context) (ValidationIssueORM, error) {
+	to := ValidationIssueORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ValidationIssueWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.NodeId = m.NodeId
+	to.Number = m.Number
+	to.Name = m.Name
+	to.Field = m.Field
+	to.Code = m.Code
+	to.Message = m.Message
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(ValidationIssueWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ValidationIssueORM) ToPB(ctx context.Context) (ValidationIssue, error) {
+	to := ValidationIssue{}
+	var err error
+	if prehook, ok := interface{}(m).(ValidationIssueWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TaskId = m.TaskId
+	to.NodeId = m.NodeId
+	to.Number = m.Number
+	to.Name = m.Name
+	to.Field = m.Field
+	to.Code = m.Code
+	to.Message = m.Message
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(ValidationIssueWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ValidationIssue the arg will be the target, the caller the one being converted from
+
+// ValidationIssueBeforeToORM called before default ToORM code
+type ValidationIssueWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ValidationIssueORM) error
+}
+
+// ValidationIssueAfterToORM called after default ToORM code
+type ValidationIssueWithAfterToORM interface {
+	AfterToORM(context.Context, *ValidationIssueORM) error
+}
+
+// ValidationIssueBeforeToPB called before default ToPB code
+type ValidationIssueWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ValidationIssue) error
+}
+
+// ValidationIssueAfterToPB called after default ToPB code
+type ValidationIssueWithAfterToPB interface {
+	AfterToPB(context.Context, *ValidationIssue) error
+}
+
+// DefaultCreateValidationRule executes a basic gorm create call
+func DefaultCreateValidationRule(ctx context.Context, in *ValidationRule, db *gorm.DB) (*ValidationRule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ValidationRuleORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadValidationRule(ctx context.Context, in *ValidationRule, db *gorm.DB) (*ValidationRule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ValidationRuleORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ValidationRuleORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ValidationRuleORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteValidationRule(ctx context.Context, in *ValidationRule, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ValidationRuleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ValidationRuleORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteValidationRuleSet(ctx context.Context, in []*ValidationRule, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ValidationRuleORM{})).(ValidationRuleORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ValidationRuleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ValidationRuleORM{})).(ValidationRuleORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ValidationRuleORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ValidationRule, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ValidationRule, *gorm.DB) error
+}
+
+// DefaultStrictUpdateValidationRule clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateValidationRule(ctx context.Context, in *ValidationRule, db *gorm.DB) (*ValidationRule, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateValidationRule")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ValidationRuleORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ValidationRuleORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchValidationRule executes a basic gorm update call with patch behavior
+func DefaultPatchValidationRule(ctx context.Context, in *ValidationRule, updateMask *field_mask.FieldMask, db *gorm.DB) (*ValidationRule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj ValidationRule
+	var err error
+	if hook, ok := interface{}(&pbObj).(ValidationRuleWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadValidationRule(ctx, &ValidationRule{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ValidationRuleWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskValidationRule(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ValidationRuleWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateValidationRule(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ValidationRuleWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ValidationRuleWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ValidationRule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ValidationRule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ValidationRule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ValidationRule, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetValidationRule executes a bulk gorm update call with patch behavior
+func DefaultPatchSetValidationRule(ctx context.Context, objects []*ValidationRule, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ValidationRule, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*ValidationRule, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchValidationRule(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskValidationRule patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskValidationRule(ctx context.Context, patchee *ValidationRule, patcher *ValidationRule, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ValidationRule, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"Code" {
+			patchee.Code = patcher.Code
+			continue
+		}
+		if f == prefix+"Enabled" {
+			patchee.Enabled = patcher.Enabled
+			continue
+		}
+		if f == prefix+"Pattern" {
+			patchee.Pattern = patcher.Pattern
+			continue
+		}
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListValidationRule executes a gorm list call
+func DefaultListValidationRule(ctx context.Context, db *gorm.DB) ([]*ValidationRule, error) {
+	in := ValidationRule{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ValidationRuleORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationRuleORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*ValidationRule{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ValidationRuleORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationRuleORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ValidationRuleORM) error
+}
+
+// DefaultCreateValidationIssue executes a basic gorm create call
+func DefaultCreateValidationIssue(ctx context.Context, in *ValidationIssue, db *gorm.DB) (*ValidationIssue, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ValidationIssueORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadValidationIssue(ctx context.Context, in *ValidationIssue, db *gorm.DB) (*ValidationIssue, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ValidationIssueORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ValidationIssueORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ValidationIssueORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteValidationIssue(ctx context.Context, in *ValidationIssue, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ValidationIssueORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ValidationIssueORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteValidationIssueSet(ctx context.Context, in []*ValidationIssue, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ValidationIssueORM{})).(ValidationIssueORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ValidationIssueORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ValidationIssueORM{})).(ValidationIssueORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ValidationIssueORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ValidationIssue, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ValidationIssue, *gorm.DB) error
+}
+
+// DefaultStrictUpdateValidationIssue clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateValidationIssue(ctx context.Context, in *ValidationIssue, db *gorm.DB) (*ValidationIssue, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateValidationIssue")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ValidationIssueORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ValidationIssueORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchValidationIssue executes a basic gorm update call with patch behavior
+func DefaultPatchValidationIssue(ctx context.Context, in *ValidationIssue, updateMask *field_mask.FieldMask, db *gorm.DB) (*ValidationIssue, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj ValidationIssue
+	var err error
+	if hook, ok := interface{}(&pbObj).(ValidationIssueWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadValidationIssue(ctx, &ValidationIssue{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ValidationIssueWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskValidationIssue(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ValidationIssueWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateValidationIssue(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ValidationIssueWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ValidationIssueWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ValidationIssue, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ValidationIssue, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ValidationIssue, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ValidationIssue, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetValidationIssue executes a bulk gorm update call with patch behavior
+func DefaultPatchSetValidationIssue(ctx context.Context, objects []*ValidationIssue, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ValidationIssue, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*ValidationIssue, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchValidationIssue(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskValidationIssue patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskValidationIssue(ctx context.Context, patchee *ValidationIssue, patcher *ValidationIssue, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ValidationIssue, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TaskId" {
+			patchee.TaskId = patcher.TaskId
+			continue
+		}
+		if f == prefix+"NodeId" {
+			patchee.NodeId = patcher.NodeId
+			continue
+		}
+		if f == prefix+"Number" {
+			patchee.Number = patcher.Number
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Field" {
+			patchee.Field = patcher.Field
+			continue
+		}
+		if f == prefix+"Code" {
+			patchee.Code = patcher.Code
+			continue
+		}
+		if f == prefix+"Message" {
+			patchee.Message = patcher.Message
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListValidationIssue executes a gorm list call
+func DefaultListValidationIssue(ctx context.Context, db *gorm.DB) ([]*ValidationIssue, error) {
+	in := ValidationIssue{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ValidationIssueORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ValidationIssueORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*ValidationIssue{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ValidationIssueORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ValidationIssueORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ValidationIssueORM) error
+}
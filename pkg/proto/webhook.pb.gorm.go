@@ -0,0 +1,1151 @@
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	types "github.com/infobloxopen/protoc-gen-gorm/types"
+	pq "github.com/lib/pq"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type WebhookEndpointORM struct {
+	ClientId            uint64 `gorm:"index:idx_webhook_endpoints_client_id"`
+	ConsecutiveFailures int32
+	CreatedAt           *time.Time
+	DisabledReason      string
+	Enabled             bool
+	Events              pq.StringArray `gorm:"type:text[]"`
+	Id                  string         `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Secret              string
+	UpdatedAt           *time.Time
+	Url                 string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WebhookEndpointORM) TableName() string {
+	return "webhook_endpoints"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *WebhookEndpoint) ToORM(ctx context.Context) (WebhookEndpointORM, error) {
+	to := WebhookEndpointORM{}
+	var err error
+	if prehook, ok := interface{}(m).(WebhookEndpointWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Url = m.Url
+	to.Secret = m.Secret
+	if m.Events != nil {
+		to.Events = make(pq.StringArray, len(m.Events))
+		copy(to.Events, m.Events)
+	}
+	to.Enabled = m.Enabled
+	to.ConsecutiveFailures = m.ConsecutiveFailures
+	to.DisabledReason = m.DisabledReason
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(WebhookEndpointWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *WebhookEndpointORM) ToPB(ctx context.Context) (WebhookEndpoint, error) {
+	to := WebhookEndpoint{}
+	var err error
+	if prehook, ok := interface{}(m).(WebhookEndpointWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ClientId = m.ClientId
+	to.Url = m.Url
+	to.Secret = m.Secret
+	if m.Events != nil {
+		to.Events = make(pq.StringArray, len(m.Events))
+		copy(to.Events, m.Events)
+	}
+	to.Enabled = m.Enabled
+	to.ConsecutiveFailures = m.ConsecutiveFailures
+	to.DisabledReason = m.DisabledReason
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(WebhookEndpointWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type WebhookEndpoint the arg will be the target, the caller the one being converted from
+
+// WebhookEndpointBeforeToORM called before default ToORM code
+type WebhookEndpointWithBeforeToORM interface {
+	BeforeToORM(context.Context, *WebhookEndpointORM) error
+}
+
+// WebhookEndpointAfterToORM called after default ToORM code
+type WebhookEndpointWithAfterToORM interface {
+	AfterToORM(context.Context, *WebhookEndpointORM) error
+}
+
+// WebhookEndpointBeforeToPB called before default ToPB code
+type WebhookEndpointWithBeforeToPB interface {
+	BeforeToPB(context.Context, *WebhookEndpoint) error
+}
+
+// WebhookEndpointAfterToPB called after default ToPB code
+type WebhookEndpointWithAfterToPB interface {
+	AfterToPB(context.Context, *WebhookEndpoint) error
+}
+
+type WebhookDeliveryORM struct {
+	Attempts      int32
+	ClientId      uint64 `gorm:"index:idx_webhook_deliveries_client_id"`
+	CreatedAt     *time.Time
+	DeliveredAt   *time.Time
+	EndpointId    string `gorm:"type:uuid;uniqueIndex:idx_webhook_deliveries_endpoint_id_event_key"`
+	Error         string
+	Event         string
+	EventKey      string       `gorm:"uniqueIndex:idx_webhook_deliveries_endpoint_id_event_key"`
+	Id            string       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	NextAttemptAt *time.Time   `gorm:"index:idx_webhook_deliveries_status_next_attempt_at"`
+	Payload       *types.Jsonb `gorm:"type:jsonb"`
+	ReplayOf      string
+	ResponseCode  int32
+	Status        int32 `gorm:"index:idx_webhook_deliveries_status_next_attempt_at"`
+	TaskId        string
+	UpdatedAt     *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WebhookDeliveryORM) TableName() string {
+	return "webhook_deliveries"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *WebhookDelivery) ToORM(ctx context.Context) (WebhookDeliveryORM, error) {
+	to := WebhookDeliveryORM{}
+	var err error
+	if prehook, ok := interface{}(m).(WebhookDeliveryWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.EndpointId = m.EndpointId
+	to.ClientId = m.ClientId
+	to.EventKey = m.EventKey
+	to.Event = m.Event
+	to.TaskId = m.TaskId
+	if m.Payload != nil {
+		to.Payload = &types.Jsonb{[]byte(m.Payload.Value)}
+	}
+	to.Status = int32(m.Status)
+	to.Attempts = m.Attempts
+	if m.NextAttemptAt != nil {
+		t := m.NextAttemptAt.AsTime()
+		to.NextAttemptAt = &t
+	}
+	to.ResponseCode = m.ResponseCode
+	to.Error = m.Error
+	to.ReplayOf = m.ReplayOf
+	if m.DeliveredAt != nil {
+		t := m.DeliveredAt.AsTime()
+		to.DeliveredAt = &t
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(WebhookDeliveryWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *WebhookDeliveryORM) ToPB(ctx context.Context) (WebhookDelivery, error) {
+	to := WebhookDelivery{}
+	var err error
+	if prehook, ok := interface{}(m).(WebhookDeliveryWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.EndpointId = m.EndpointId
+	to.ClientId = m.ClientId
+	to.EventKey = m.EventKey
+	to.Event = m.Event
+	to.TaskId = m.TaskId
+	if m.Payload != nil {
+		to.Payload = &types.JSONValue{Value: string(m.Payload.RawMessage)}
+	}
+	to.Status = WebhookDeliveryStatus(m.Status)
+	to.Attempts = m.Attempts
+	if m.NextAttemptAt != nil {
+		to.NextAttemptAt = timestamppb.New(*m.NextAttemptAt)
+	}
+	to.ResponseCode = m.ResponseCode
+	to.Error = m.Error
+	to.ReplayOf = m.ReplayOf
+	if m.DeliveredAt != nil {
+		to.DeliveredAt = timestamppb.New(*m.DeliveredAt)
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(WebhookDeliveryWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type WebhookDelivery the arg will be the target, the caller the one being converted from
+
+// WebhookDeliveryBeforeToORM called before default ToORM code
+type WebhookDeliveryWithBeforeToORM interface {
+	BeforeToORM(context.Context, *WebhookDeliveryORM) error
+}
+
+// WebhookDeliveryAfterToORM called after default ToORM code
+type WebhookDeliveryWithAfterToORM interface {
+	AfterToORM(context.Context, *WebhookDeliveryORM) error
+}
+
+// WebhookDeliveryBeforeToPB called before default ToPB code
+type WebhookDeliveryWithBeforeToPB interface {
+	BeforeToPB(context.Context, *WebhookDelivery) error
+}
+
+// WebhookDeliveryAfterToPB called after default ToPB code
+type WebhookDeliveryWithAfterToPB interface {
+	AfterToPB(context.Context, *WebhookDelivery) error
+}
+
+// DefaultCreateWebhookEndpoint executes a basic gorm create call
+func DefaultCreateWebhookEndpoint(ctx context.Context, in *WebhookEndpoint, db *gorm.DB) (*WebhookEndpoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type WebhookEndpointORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadWebhookEndpoint(ctx context.Context, in *WebhookEndpoint, db *gorm.DB) (*WebhookEndpoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := WebhookEndpointORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(WebhookEndpointORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type WebhookEndpointORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteWebhookEndpoint(ctx context.Context, in *WebhookEndpoint, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&WebhookEndpointORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type WebhookEndpointORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteWebhookEndpointSet(ctx context.Context, in []*WebhookEndpoint, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&WebhookEndpointORM{})).(WebhookEndpointORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&WebhookEndpointORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&WebhookEndpointORM{})).(WebhookEndpointORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type WebhookEndpointORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*WebhookEndpoint, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*WebhookEndpoint, *gorm.DB) error
+}
+
+// DefaultStrictUpdateWebhookEndpoint clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateWebhookEndpoint(ctx context.Context, in *WebhookEndpoint, db *gorm.DB) (*WebhookEndpoint, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateWebhookEndpoint")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &WebhookEndpointORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type WebhookEndpointORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchWebhookEndpoint executes a basic gorm update call with patch behavior
+func DefaultPatchWebhookEndpoint(ctx context.Context, in *WebhookEndpoint, updateMask *field_mask.FieldMask, db *gorm.DB) (*WebhookEndpoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj WebhookEndpoint
+	var err error
+	if hook, ok := interface{}(&pbObj).(WebhookEndpointWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadWebhookEndpoint(ctx, &WebhookEndpoint{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(WebhookEndpointWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskWebhookEndpoint(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(WebhookEndpointWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateWebhookEndpoint(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(WebhookEndpointWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type WebhookEndpointWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *WebhookEndpoint, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *WebhookEndpoint, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *WebhookEndpoint, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *WebhookEndpoint, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetWebhookEndpoint executes a bulk gorm update call with patch behavior
+func DefaultPatchSetWebhookEndpoint(ctx context.Context, objects []*WebhookEndpoint, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*WebhookEndpoint, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*WebhookEndpoint, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchWebhookEndpoint(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskWebhookEndpoint patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskWebhookEndpoint(ctx context.Context, patchee *WebhookEndpoint, patcher *WebhookEndpoint, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*WebhookEndpoint, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"Url" {
+			patchee.Url = patcher.Url
+			continue
+		}
+		if f == prefix+"Secret" {
+			patchee.Secret = patcher.Secret
+			continue
+		}
+		if f == prefix+"Events" {
+			patchee.Events = patcher.Events
+			continue
+		}
+		if f == prefix+"Enabled" {
+			patchee.Enabled = patcher.Enabled
+			continue
+		}
+		if f == prefix+"ConsecutiveFailures" {
+			patchee.ConsecutiveFailures = patcher.ConsecutiveFailures
+			continue
+		}
+		if f == prefix+"DisabledReason" {
+			patchee.DisabledReason = patcher.DisabledReason
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListWebhookEndpoint executes a gorm list call
+func DefaultListWebhookEndpoint(ctx context.Context, db *gorm.DB) ([]*WebhookEndpoint, error) {
+	in := WebhookEndpoint{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []WebhookEndpointORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookEndpointORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*WebhookEndpoint{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type WebhookEndpointORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookEndpointORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]WebhookEndpointORM) error
+}
+
+// DefaultCreateWebhookDelivery executes a basic gorm create call
+func DefaultCreateWebhookDelivery(ctx context.Context, in *WebhookDelivery, db *gorm.DB) (*WebhookDelivery, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type WebhookDeliveryORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadWebhookDelivery(ctx context.Context, in *WebhookDelivery, db *gorm.DB) (*WebhookDelivery, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := WebhookDeliveryORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(WebhookDeliveryORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type WebhookDeliveryORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteWebhookDelivery(ctx context.Context, in *WebhookDelivery, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&WebhookDeliveryORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type WebhookDeliveryORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteWebhookDeliverySet(ctx context.Context, in []*WebhookDelivery, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&WebhookDeliveryORM{})).(WebhookDeliveryORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&WebhookDeliveryORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&WebhookDeliveryORM{})).(WebhookDeliveryORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type WebhookDeliveryORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*WebhookDelivery, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*WebhookDelivery, *gorm.DB) error
+}
+
+// DefaultStrictUpdateWebhookDelivery clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateWebhookDelivery(ctx context.Context, in *WebhookDelivery, db *gorm.DB) (*WebhookDelivery, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateWebhookDelivery")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &WebhookDeliveryORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type WebhookDeliveryORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchWebhookDelivery executes a basic gorm update call with patch behavior
+func DefaultPatchWebhookDelivery(ctx context.Context, in *WebhookDelivery, updateMask *field_mask.FieldMask, db *gorm.DB) (*WebhookDelivery, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj WebhookDelivery
+	var err error
+	if hook, ok := interface{}(&pbObj).(WebhookDeliveryWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadWebhookDelivery(ctx, &WebhookDelivery{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(WebhookDeliveryWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskWebhookDelivery(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(WebhookDeliveryWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateWebhookDelivery(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(WebhookDeliveryWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type WebhookDeliveryWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *WebhookDelivery, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *WebhookDelivery, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *WebhookDelivery, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *WebhookDelivery, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetWebhookDelivery executes a bulk gorm update call with patch behavior
+func DefaultPatchSetWebhookDelivery(ctx context.Context, objects []*WebhookDelivery, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*WebhookDelivery, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*WebhookDelivery, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchWebhookDelivery(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskWebhookDelivery patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskWebhookDelivery(ctx context.Context, patchee *WebhookDelivery, patcher *WebhookDelivery, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*WebhookDelivery, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedPayload bool
+	var updatedNextAttemptAt bool
+	var updatedDeliveredAt bool
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"EndpointId" {
+			patchee.EndpointId = patcher.EndpointId
+			continue
+		}
+		if f == prefix+"ClientId" {
+			patchee.ClientId = patcher.ClientId
+			continue
+		}
+		if f == prefix+"EventKey" {
+			patchee.EventKey = patcher.EventKey
+			continue
+		}
+		if f == prefix+"Event" {
+			patchee.Event = patcher.Event
+			continue
+		}
+		if f == prefix+"TaskId" {
+			patchee.TaskId = patcher.TaskId
+			continue
+		}
+		if !updatedPayload && strings.HasPrefix(f, prefix+"Payload") {
+			patchee.Payload = patcher.Payload
+			updatedPayload = true
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"Attempts" {
+			patchee.Attempts = patcher.Attempts
+			continue
+		}
+		if !updatedNextAttemptAt && strings.HasPrefix(f, prefix+"NextAttemptAt.") {
+			if patcher.NextAttemptAt == nil {
+				patchee.NextAttemptAt = nil
+				continue
+			}
+			if patchee.NextAttemptAt == nil {
+				patchee.NextAttemptAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"NextAttemptAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.NextAttemptAt, patchee.NextAttemptAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"NextAttemptAt" {
+			updatedNextAttemptAt = true
+			patchee.NextAttemptAt = patcher.NextAttemptAt
+			continue
+		}
+		if f == prefix+"ResponseCode" {
+			patchee.ResponseCode = patcher.ResponseCode
+			continue
+		}
+		if f == prefix+"Error" {
+			patchee.Error = patcher.Error
+			continue
+		}
+		if f == prefix+"ReplayOf" {
+			patchee.ReplayOf = patcher.ReplayOf
+			continue
+		}
+		if !updatedDeliveredAt && strings.HasPrefix(f, prefix+"DeliveredAt.") {
+			if patcher.DeliveredAt == nil {
+				patchee.DeliveredAt = nil
+				continue
+			}
+			if patchee.DeliveredAt == nil {
+				patchee.DeliveredAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DeliveredAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DeliveredAt, patchee.DeliveredAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"DeliveredAt" {
+			updatedDeliveredAt = true
+			patchee.DeliveredAt = patcher.DeliveredAt
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListWebhookDelivery executes a gorm list call
+func DefaultListWebhookDelivery(ctx context.Context, db *gorm.DB) ([]*WebhookDelivery, error) {
+	in := WebhookDelivery{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []WebhookDeliveryORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebhookDeliveryORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*WebhookDelivery{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type WebhookDeliveryORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebhookDeliveryORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]WebhookDeliveryORM) error
+}
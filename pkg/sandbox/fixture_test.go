@@ -0,0 +1,22 @@
+package sandbox_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/sandbox"
+	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the embedded result is generated from the test fixture, run go generate after changing it
+func TestRecognitionResultIsTheTestFixture(t *testing.T) {
+	var fixture proto.TreeNode
+	require.NoError(t, json.Unmarshal(testutils.RecognitionTestFile, &fixture))
+
+	result, err := sandbox.RecognitionResult()
+	require.NoError(t, err)
+	assert.Equal(t, fixture.String(), result.String())
+}
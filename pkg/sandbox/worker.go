@@ -0,0 +1,49 @@
+package sandbox
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+// WorkerID is reported as the worker of tasks processed in sandbox mode
+const WorkerID = "sandbox"
+
+// recognitionResult is the sample recognition result of the tests, copied from pkg/testutils/nodes.json
+//
+//go:generate cp ../testutils/nodes.json recognition_result.json
+//go:embed recognition_result.json
+var recognitionResult []byte
+
+// RecognitionResult returns the deterministic tree every sandbox task is recognized as
+func RecognitionResult() (proto.TreeNode, error) {
+	var node proto.TreeNode
+	if err := json.Unmarshal(recognitionResult, &node); err != nil {
+		return node, fmt.Errorf("failed to load sandbox recognition result: %w", err)
+	}
+
+	return node, nil
+}
+
+// ProcessImages completes image processing by passing source images through unchanged
+func ProcessImages(task *proto.DataRecognitionTaskORM) {
+	task.WorkerId = WorkerID
+	task.ProcessedImages = append(task.ProcessedImages[:0:0], task.SourceImages...)
+	task.Status = int32(proto.Status_STATUS_IMAGES_COMPLETED)
+}
+
+// Recognize completes recognition with the fixture result. Like a worker result, the returned
+// tree still has to go through the recognition pipeline to be stored on the task.
+func Recognize(task *proto.DataRecognitionTaskORM) (*proto.TreeNode, error) {
+	node, err := RecognitionResult()
+	if err != nil {
+		return nil, err
+	}
+
+	task.WorkerId = WorkerID
+	task.Status = int32(proto.Status_STATUS_RECOGNITION_COMPLETED)
+
+	return &node, nil
+}
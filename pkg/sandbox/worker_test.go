@@ -0,0 +1,38 @@
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecognitionResultIsDeterministic(t *testing.T) {
+	first, err := RecognitionResult()
+	require.NoError(t, err)
+	second, err := RecognitionResult()
+	require.NoError(t, err)
+
+	assert.Equal(t, "Root", first.Name)
+	assert.NotEmpty(t, first.Leaves)
+	assert.Equal(t, first.String(), second.String())
+}
+
+func TestWorker(t *testing.T) {
+	task := &proto.DataRecognitionTaskORM{
+		Status:       int32(proto.Status_STATUS_IMAGES_PENDING),
+		SourceImages: pq.StringArray{"a.jpg", "b.jpg"},
+	}
+
+	ProcessImages(task)
+	assert.Equal(t, int32(proto.Status_STATUS_IMAGES_COMPLETED), task.Status)
+	assert.Equal(t, task.SourceImages, task.ProcessedImages)
+
+	result, err := Recognize(task)
+	require.NoError(t, err)
+	assert.Equal(t, int32(proto.Status_STATUS_RECOGNITION_COMPLETED), task.Status)
+	assert.Equal(t, WorkerID, task.WorkerId)
+	assert.Equal(t, "Root", result.Name)
+}
@@ -0,0 +1,109 @@
+package accuracy
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/treediff"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// Fields measured for accuracy
+const (
+	FieldDesignation    = "designation"
+	FieldName           = "name"
+	FieldCount          = "count"
+	FieldMaterial       = "material"
+	FieldAssortmentSize = "assortment_size"
+	// nodes added, removed or moved by the users
+	FieldStructure = "structure"
+)
+
+// Fields lists the measured fields in display order
+var Fields = []string{FieldDesignation, FieldName, FieldCount, FieldMaterial, FieldAssortmentSize, FieldStructure}
+
+// values return the normalized value of a field of a node, empty when the node has none
+var values = map[string]func(node *proto.TreeNode) string{
+	FieldDesignation: func(node *proto.TreeNode) string {
+		number := node.Number
+		if number == "" && node.Spec != nil {
+			number = node.Spec.Number
+		}
+		return types.NormalizeDesignation(number)
+	},
+	FieldName: func(node *proto.TreeNode) string {
+		name := node.Name
+		if name == "" && node.Spec != nil {
+			name = node.Spec.Name
+		}
+		return strings.ToLower(strings.Join(strings.Fields(name), " "))
+	},
+	FieldCount: func(node *proto.TreeNode) string {
+		count := node.Count
+		if count == 0 && node.Spec != nil {
+			count = node.Spec.Count
+		}
+		if count == 0 {
+			return ""
+		}
+		return strconv.Itoa(int(count))
+	},
+	FieldMaterial: func(node *proto.TreeNode) string {
+		return types.NormalizeMaterial(types.NodeMaterial(node))
+	},
+	FieldAssortmentSize: func(node *proto.TreeNode) string {
+		size := types.NodeProfileSize(node)
+		if size == nil {
+			return ""
+		}
+		return types.FormatProfileSize(size)
+	},
+}
+
+// Compute compares the recognized tree with the final one and counts the corrected nodes per field.
+// A field is compared for the matching nodes having a value in either tree, the roots are not compared.
+// The structure counts the recognized and the added nodes, the added, removed and moved ones are corrected.
+func Compute(recognized, final *proto.TreeNode) []*proto.RecognitionMetricORM {
+	metrics := make(map[string]*proto.RecognitionMetricORM, len(Fields))
+	for _, field := range Fields {
+		metrics[field] = &proto.RecognitionMetricORM{Field: field}
+	}
+
+	pairs := treediff.Match(recognized, final)
+	for _, pair := range pairs {
+		if pair.Before == recognized {
+			continue
+		}
+		metrics[FieldStructure].Total++
+		for field, value := range values {
+			before, after := value(pair.Before), value(pair.After)
+			if before == "" && after == "" {
+				continue
+			}
+			metrics[field].Total++
+			if before != after {
+				metrics[field].Corrected++
+			}
+		}
+	}
+
+	diff := treediff.Diff(recognized, final)
+	structure := metrics[FieldStructure]
+	structure.Total += diff.Removed + diff.Added
+	structure.Corrected += diff.Removed + diff.Added + diff.Moved
+
+	result := make([]*proto.RecognitionMetricORM, 0, len(Fields))
+	for _, field := range Fields {
+		result = append(result, metrics[field])
+	}
+	return result
+}
+
+// Accuracy returns the share of the compared values kept by the users, 1 when nothing was compared
+func Accuracy(total, corrected int64) float64 {
+	if total == 0 {
+		return 1
+	}
+	return 1 - float64(corrected)/float64(total)
+}
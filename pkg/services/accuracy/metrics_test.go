@@ -0,0 +1,81 @@
+package accuracy
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func metricsByField(metrics []*proto.RecognitionMetricORM) map[string]*proto.RecognitionMetricORM {
+	result := make(map[string]*proto.RecognitionMetricORM)
+	for _, metric := range metrics {
+		result[metric.Field] = metric
+	}
+	return result
+}
+
+func TestCompute(t *testing.T) {
+	recognized := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{
+				Id: "frame", Number: "СФ-01.00.000", Name: "Рама", Count: 1,
+				Leaves: []*proto.TreeNode{
+					{Id: "plate", Number: "CФ-01.00.001", Name: "Пластина", Count: 4, Material: "Лист 10 Ст3"},
+					{Id: "rib", Number: "СФ-01.00.002", Name: "Ребро", Count: 2, Material: "Лист 8 Ст3"},
+				},
+			},
+			{Id: "noise", Name: "Штамп"},
+		},
+	}
+	final := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{
+				Id: "frame", Number: "СФ-01.00.000", Name: "рама", Count: 1,
+				Leaves: []*proto.TreeNode{
+					// the designation differs in spelling only
+					{Id: "plate", Number: "СФ-01.00.001", Name: "Пластина", Count: 6, Material: "Лист 12 Ст3"},
+					{Id: "rib", Number: "СФ-01.00.002", Name: "Ребро", Count: 2, Material: "Лист 8 Ст3"},
+				},
+			},
+			{Id: "bolt", Name: "Болт М12", Count: 8},
+		},
+	}
+
+	metrics := Compute(recognized, final)
+	require.Len(t, metrics, len(Fields))
+	for i, field := range Fields {
+		assert.Equal(t, field, metrics[i].Field)
+	}
+
+	byField := metricsByField(metrics)
+	assert.Equal(t, int32(3), byField[FieldDesignation].Total)
+	assert.Equal(t, int32(0), byField[FieldDesignation].Corrected)
+	assert.Equal(t, int32(3), byField[FieldName].Total)
+	assert.Equal(t, int32(0), byField[FieldName].Corrected)
+	assert.Equal(t, int32(3), byField[FieldCount].Total)
+	assert.Equal(t, int32(1), byField[FieldCount].Corrected)
+	assert.Equal(t, int32(2), byField[FieldMaterial].Total)
+	assert.Equal(t, int32(1), byField[FieldMaterial].Corrected)
+	assert.Equal(t, int32(2), byField[FieldAssortmentSize].Total)
+	assert.Equal(t, int32(1), byField[FieldAssortmentSize].Corrected)
+
+	// three matched nodes, the removed noise and the added bolt
+	assert.Equal(t, int32(5), byField[FieldStructure].Total)
+	assert.Equal(t, int32(2), byField[FieldStructure].Corrected)
+}
+
+func TestComputeUnchanged(t *testing.T) {
+	tree := &proto.TreeNode{Id: "root", Leaves: []*proto.TreeNode{{Id: "plate", Number: "СФ-01.00.001", Count: 4}}}
+	for _, metric := range Compute(tree, tree) {
+		assert.Zero(t, metric.Corrected, metric.Field)
+	}
+}
+
+func TestAccuracy(t *testing.T) {
+	assert.Equal(t, 1.0, Accuracy(0, 0))
+	assert.Equal(t, 0.75, Accuracy(4, 1))
+}
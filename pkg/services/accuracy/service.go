@@ -0,0 +1,152 @@
+package accuracy
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Groupings of the report
+const (
+	GroupNone    = ""
+	GroupVersion = "version"
+	GroupClient  = "client"
+	GroupWeek    = "week"
+)
+
+// groupColumns are the SQL expressions of the groupings, the model version falls back to the worker
+var groupColumns = map[string]string{
+	GroupNone:    "''",
+	GroupVersion: "COALESCE(NULLIF(model_version, ''), worker_id)",
+	GroupClient:  "CAST(client_id AS TEXT)",
+	GroupWeek:    "TO_CHAR(DATE_TRUNC('week', approved_at), 'YYYY-MM-DD')",
+}
+
+// Filter selects the metrics of a report, zero values select all
+type Filter struct {
+	ClientID uint64
+	// model version, or worker when the model version is not reported
+	Version string
+	Since   time.Time
+}
+
+// Total is the sum of the metrics of a field within a group of a report
+type Total struct {
+	Group     string
+	Field     string
+	Total     int64
+	Corrected int64
+}
+
+// Service measures the recognition accuracy from the user corrections of approved tasks
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+// Record replaces the stored metrics of the task with the ones of its recognized and current trees
+func Record(ctx context.Context, tx *gorm.DB, task *proto.DataRecognitionTaskORM) ([]*proto.RecognitionMetricORM, error) {
+	if task.RecognitionResult == nil {
+		return nil, types.ErrNoRecognizedTree
+	}
+	final, err := types.TaskTree(task)
+	if err != nil {
+		return nil, err
+	}
+	recognized := task.RecognitionResult.Data()
+
+	metrics := Compute(&recognized, final)
+	for _, metric := range metrics {
+		metric.TaskId, metric.WorkerId, metric.ModelVersion, metric.ApprovedAt = task.Id, task.WorkerId, task.ModelVersion, task.ApprovedAt
+		if task.ClientId != nil {
+			metric.ClientId = *task.ClientId
+		}
+	}
+
+	if err := tx.WithContext(ctx).Where("task_id = ?", task.Id).Delete(&proto.RecognitionMetricORM{}).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.WithContext(ctx).Create(&metrics).Error; err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+// Approve marks the current tree of a completed task of the client as final and records its recognition
+// accuracy. Approving again replaces the metrics with the ones of the current tree.
+func (s *Service) Approve(ctx context.Context, clientID, userID uint64, taskID string) (*proto.TaskAccuracy, error) {
+	var result *proto.TaskAccuracy
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		task, err := types.CompletedTask(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), clientID, taskID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		task.ApprovedAt, task.ApprovedById = &now, &userID
+		metrics, err := Record(ctx, tx, task)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(task).Select("approved_at", "approved_by_id").Updates(task).Error; err != nil {
+			return err
+		}
+
+		result = &proto.TaskAccuracy{TaskId: task.Id, ApprovedAt: timestamppb.New(now)}
+		for _, metric := range metrics {
+			result.Fields = append(result.Fields, &proto.FieldAccuracy{
+				Field:     metric.Field,
+				Total:     int64(metric.Total),
+				Corrected: int64(metric.Corrected),
+				Accuracy:  Accuracy(int64(metric.Total), int64(metric.Corrected)),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Totals sums the metrics selected by the filter per field and group, ordered by group and field
+func (s *Service) Totals(ctx context.Context, filter Filter, group string) ([]Total, error) {
+	column, ok := groupColumns[group]
+	if !ok {
+		return nil, errors.New("unknown grouping " + group)
+	}
+
+	query := s.db.WithContext(ctx).Model(&proto.RecognitionMetricORM{})
+	if filter.ClientID != 0 {
+		query = query.Where("client_id = ?", filter.ClientID)
+	}
+	if filter.Version != "" {
+		query = query.Where(groupColumns[GroupVersion]+" = ?", filter.Version)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("approved_at >= ?", filter.Since)
+	}
+
+	grouping := "field"
+	if group != GroupNone {
+		grouping = column + ", field"
+	}
+	var totals []Total
+	err := query.Select(column + " AS \"group\", field, SUM(total) AS total, SUM(corrected) AS corrected").
+		Group(grouping).Order("1, field").Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
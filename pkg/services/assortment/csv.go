@@ -0,0 +1,190 @@
+package assortment
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// CSVHeader is the header of assortment standard CSV files, one row per size of the series.
+// The family is one of FamilyCodes; rows of a standard without sizes only name the standard.
+var CSVHeader = []string{"designation", "title", "family", "size"}
+
+// ErrInvalidCSV is returned for assortment standard files that cannot be imported
+var ErrInvalidCSV = errors.New("invalid assortment standards csv")
+
+// FamilyCodes are the profile family codes of the CSV files, e.g. pipe for PROFILE_FAMILY_PIPE
+var FamilyCodes = familyCodes()
+
+func familyCodes() []string {
+	var codes []string
+	for value := int32(1); proto.ProfileFamily_name[value] != ""; value++ {
+		codes = append(codes, FamilyCode(proto.ProfileFamily(value)))
+	}
+
+	return codes
+}
+
+// FamilyCode returns the CSV code of a profile family, an empty string for an unspecified family
+func FamilyCode(family proto.ProfileFamily) string {
+	if family == proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimPrefix(family.String(), "PROFILE_FAMILY_"))
+}
+
+// ParseFamilyCode reads a profile family code, empty codes are unspecified
+func ParseFamilyCode(code string) (proto.ProfileFamily, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if code == "" {
+		return proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED, nil
+	}
+	value, ok := proto.ProfileFamily_value["PROFILE_FAMILY_"+strings.ToUpper(code)]
+	if !ok || value == 0 {
+		return 0, fmt.Errorf("unknown family %q", code)
+	}
+
+	return proto.ProfileFamily(value), nil
+}
+
+// WriteCSV writes the standards with their size series
+func WriteCSV(w io.Writer, standards []*proto.AssortmentStandardORM) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return err
+	}
+	for _, std := range standards {
+		family := FamilyCode(proto.ProfileFamily(std.Family))
+		if len(std.Sizes) == 0 {
+			if err := writer.Write([]string{std.Designation, std.Title, family, ""}); err != nil {
+				return err
+			}
+		}
+		for _, size := range std.Sizes {
+			if err := writer.Write([]string{std.Designation, std.Title, family, size.Size}); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// ReadCSV parses standards with their size series, grouping the rows by designation in file order.
+// Both comma and semicolon separated files are accepted. Sizes are stored as written by CanonicalSize.
+func ReadCSV(r io.Reader) ([]*proto.AssortmentStandardORM, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimPrefix(string(data), "\ufeff")
+
+	reader := csv.NewReader(strings.NewReader(text))
+	firstLine, _, _ := strings.Cut(text, "\n")
+	if strings.Contains(firstLine, ";") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCSV, err)
+	}
+
+	var (
+		standards []*proto.AssortmentStandardORM
+		byKey     = make(map[string]*proto.AssortmentStandardORM)
+		sizes     = make(map[string]map[string]bool)
+	)
+	for i, record := range records {
+		line := i + 1
+		for len(record) < len(CSVHeader) {
+			record = append(record, "")
+		}
+		designation := strings.Join(strings.Fields(record[0]), " ")
+		switch {
+		case designation == "" && strings.TrimSpace(record[3]) == "":
+			continue
+		case i == 0 && strings.EqualFold(designation, CSVHeader[0]):
+			continue
+		case designation == "":
+			return nil, fmt.Errorf("%w: line %d: designation is empty", ErrInvalidCSV, line)
+		}
+
+		family, err := ParseFamilyCode(record[2])
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCSV, line, err)
+		}
+
+		key := Key(designation)
+		std, ok := byKey[key]
+		if !ok {
+			std = &proto.AssortmentStandardORM{Designation: designation, Family: int32(family)}
+			byKey[key] = std
+			sizes[key] = make(map[string]bool)
+			standards = append(standards, std)
+		}
+		if title := strings.TrimSpace(record[1]); title != "" {
+			std.Title = title
+		}
+		if family != proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED {
+			if std.Family != 0 && std.Family != int32(family) {
+				return nil, fmt.Errorf("%w: line %d: family %q differs from the previous rows of %s", ErrInvalidCSV, line, record[2], designation)
+			}
+			std.Family = int32(family)
+		}
+
+		size, err := readSize(record[3], proto.ProfileFamily(std.Family))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCSV, line, err)
+		}
+		if size != "" && !sizes[key][size] {
+			sizes[key][size] = true
+			std.Sizes = append(std.Sizes, &proto.AssortmentStandardSizeORM{Size: size})
+		}
+	}
+
+	return standards, nil
+}
+
+// readSize formats a size of the series; sizes of a standard of a known family must be readable as that family
+func readSize(text string, family proto.ProfileFamily) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || family == proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED {
+		return text, nil
+	}
+	size, ok := types.ParseProfileSize(text, types.ProfileFamilyWord(family))
+	if !ok || size.Family != family {
+		return "", fmt.Errorf("size %q is not a %s size", text, FamilyCode(family))
+	}
+
+	return types.FormatProfileSize(size), nil
+}
+
+// ParseSizes reads a size series written one size per line or separated by semicolons
+func ParseSizes(text string, family proto.ProfileFamily) ([]*proto.AssortmentStandardSizeORM, error) {
+	var (
+		sizes []*proto.AssortmentStandardSizeORM
+		seen  = make(map[string]bool)
+	)
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool { return r == '\n' || r == ';' }) {
+		size, err := readSize(field, family)
+		if err != nil {
+			return nil, err
+		}
+		if size != "" && !seen[size] {
+			seen[size] = true
+			sizes = append(sizes, &proto.AssortmentStandardSizeORM{Size: size})
+		}
+	}
+
+	return sizes, nil
+}
@@ -0,0 +1,66 @@
+package assortment
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	standards := []*proto.AssortmentStandardORM{
+		{Designation: "ГОСТ 8732-78", Title: "Трубы бесшовные", Family: int32(proto.ProfileFamily_PROFILE_FAMILY_PIPE), Sizes: []*proto.AssortmentStandardSizeORM{{Size: "57×3.5"}, {Size: "76×3.5"}}},
+		{Designation: "ГОСТ 2590-2006", Family: int32(proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR)},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, WriteCSV(&b, standards))
+	parsed, err := ReadCSV(&b)
+	require.NoError(t, err)
+	assert.Equal(t, standards, parsed)
+}
+
+func TestReadCSVSemicolons(t *testing.T) {
+	text := "\ufeffdesignation;title;family;size\n" +
+		"ГОСТ 8732-78;Трубы бесшовные;pipe;57x3,5\n" +
+		"ГОСТ  8732-78;;;57х3,5\n" +
+		"ГОСТ 8732-78;;pipe;Ø76x4\n" +
+		"\n" +
+		"ГОСТ 8240-97;Швеллеры;channel;10P\n"
+
+	standards, err := ReadCSV(strings.NewReader(text))
+	require.NoError(t, err)
+	require.Len(t, standards, 2)
+	assert.Equal(t, "Трубы бесшовные", standards[0].Title)
+	assert.Equal(t, int32(proto.ProfileFamily_PROFILE_FAMILY_PIPE), standards[0].Family)
+	require.Len(t, standards[0].Sizes, 2)
+	assert.Equal(t, "57×3.5", standards[0].Sizes[0].Size)
+	assert.Equal(t, "76×4", standards[0].Sizes[1].Size)
+	assert.Equal(t, "10П", standards[1].Sizes[0].Size)
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	for _, text := range []string{
+		";title;pipe;57x3",
+		"ГОСТ 8732-78;;tube;57x3",
+		"ГОСТ 8732-78;;pipe;57",
+		"ГОСТ 8732-78;;pipe;57x3\nГОСТ 8732-78;;sheet;10",
+	} {
+		_, err := ReadCSV(strings.NewReader(text))
+		assert.True(t, errors.Is(err, ErrInvalidCSV), text)
+	}
+}
+
+func TestParseSizes(t *testing.T) {
+	sizes, err := ParseSizes("10П\n10У; 12P\n10П\n", proto.ProfileFamily_PROFILE_FAMILY_CHANNEL)
+	require.NoError(t, err)
+	require.Len(t, sizes, 3)
+	assert.Equal(t, "12П", sizes[2].Size)
+
+	_, err = ParseSizes("50x5\nL50", proto.ProfileFamily_PROFILE_FAMILY_ANGLE)
+	assert.Error(t, err)
+}
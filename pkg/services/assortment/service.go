@@ -0,0 +1,102 @@
+package assortment
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/gorm"
+)
+
+// Service validates recognized assortments against the reference database of assortment standards
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+// Standards loads the reference database of assortment standards
+func (s *Service) Standards(ctx context.Context) (*Standards, error) {
+	var entries []*proto.AssortmentStandardORM
+	if err := s.db.WithContext(ctx).Preload("Sizes").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	return NewStandards(entries), nil
+}
+
+// Annotate validates the assortments of the tree, flagging invalid sizes in their field status
+func (s *Service) Annotate(ctx context.Context, tree *proto.TreeNode) error {
+	standards, err := s.Standards(ctx)
+	if err != nil {
+		return err
+	}
+
+	standards.Validate(tree)
+	return nil
+}
+
+// TaskValidation validates the assortments of a completed task of the client
+func (s *Service) TaskValidation(ctx context.Context, clientID uint64, taskID string) (*proto.AssortmentValidation, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := types.TaskTree(task)
+	if err != nil {
+		return nil, err
+	}
+	standards, err := s.Standards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := standards.Validate(tree)
+	result.TaskId = task.Id
+	return result, nil
+}
+
+// Import stores imported standards, replacing the size series of the standards already in the
+// database. A standard imported without a title or family keeps its stored one.
+func (s *Service) Import(ctx context.Context, standards []*proto.AssortmentStandardORM) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		for _, imported := range standards {
+			var stored proto.AssortmentStandardORM
+			err := tx.Where("designation = ?", imported.Designation).First(&stored).Error
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				imported.CreatedAt = &now
+				imported.UpdatedAt = &now
+				if err := tx.Create(imported).Error; err != nil {
+					return err
+				}
+				continue
+			case err != nil:
+				return err
+			}
+
+			if err := tx.Where("assortment_standard_id = ?", stored.Id).Delete(&proto.AssortmentStandardSizeORM{}).Error; err != nil {
+				return err
+			}
+			if imported.Title != "" {
+				stored.Title = imported.Title
+			}
+			if imported.Family != 0 {
+				stored.Family = imported.Family
+			}
+			stored.Sizes = imported.Sizes
+			stored.UpdatedAt = &now
+			if err := tx.Save(&stored).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,268 @@
+package assortment
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// Assortment field status keys set by the validation
+const (
+	SizeStatusField     = types.SizeStatusField
+	StandardStatusField = "form_gost"
+)
+
+// MaxSuggestions is the number of closest sizes suggested for a size missing from the series
+const MaxSuggestions = 3
+
+var (
+	// designationPattern matches a standard designation, e.g. ГОСТ 8732-78 or ГОСТ Р 52246-2016
+	designationPattern = regexp.MustCompile(`(?i)(?:ГОСТ|GOST|ОСТ|ТУ)\s*(?:Р\s*)?\d[\d.\-–—]*\d`)
+	// yearPattern matches the year a ГОСТ designation ends with
+	yearPattern   = regexp.MustCompile(`^(ГОСТ(?:Р)?[\d.]+)-(?:\d{2}|\d{4})$`)
+	numberPattern = regexp.MustCompile(`\d+(?:\.\d+)?`)
+)
+
+// Key normalises a designation for comparison: upper case, no spaces, one dash and ГОСТ for bare numbers
+func Key(designation string) string {
+	key := strings.ToUpper(strings.Join(strings.Fields(designation), ""))
+	key = strings.NewReplacer("–", "-", "—", "-", "GOST", "ГОСТ", "P", "Р").Replace(key)
+	if key != "" && key[0] >= '0' && key[0] <= '9' {
+		key = "ГОСТ" + key
+	}
+
+	return key
+}
+
+// baseKey drops the year of a ГОСТ designation key, as drawings often cite an earlier edition
+func baseKey(key string) string {
+	if match := yearPattern.FindStringSubmatch(key); match != nil {
+		return match[1]
+	}
+
+	return key
+}
+
+type standard struct {
+	designation string
+	family      proto.ProfileFamily
+	// sizes of the series as formatted by types.FormatProfileSize, in series order
+	sizes []string
+	known map[string]bool
+}
+
+// Standards is the reference database of assortment standards recognized assortments are validated against
+type Standards struct {
+	byKey  map[string]*standard
+	byBase map[string]*standard
+}
+
+func NewStandards(entries []*proto.AssortmentStandardORM) *Standards {
+	s := &Standards{byKey: make(map[string]*standard), byBase: make(map[string]*standard)}
+	for _, entry := range entries {
+		std := &standard{
+			designation: entry.Designation,
+			family:      proto.ProfileFamily(entry.Family),
+			known:       make(map[string]bool),
+		}
+		for _, size := range entry.Sizes {
+			text := CanonicalSize(size.Size, std.family)
+			if text != "" && !std.known[text] {
+				std.known[text] = true
+				std.sizes = append(std.sizes, text)
+			}
+		}
+		key := Key(entry.Designation)
+		s.byKey[key] = std
+		if _, ok := s.byBase[baseKey(key)]; !ok {
+			s.byBase[baseKey(key)] = std
+		}
+	}
+
+	return s
+}
+
+// CanonicalSize writes a size of the family the way the validation compares sizes, e.g. 57×3.5 for
+// "57x3,5" of a pipe standard. Sizes that cannot be read are returned trimmed.
+func CanonicalSize(text string, family proto.ProfileFamily) string {
+	size, ok := types.ParseProfileSize(text, types.ProfileFamilyWord(family))
+	if !ok {
+		return strings.TrimSpace(text)
+	}
+
+	return types.FormatProfileSize(size)
+}
+
+// lookup finds the standard of a designation, falling back to another edition of the same ГОСТ
+func (s *Standards) lookup(designation string) *standard {
+	key := Key(designation)
+	if key == "" {
+		return nil
+	}
+	if std, ok := s.byKey[key]; ok {
+		return std
+	}
+
+	return s.byBase[baseKey(key)]
+}
+
+// Check validates a size against the standard of the designation. It returns nil for an unknown standard.
+func (s *Standards) Check(designation string, size *proto.ProfileSize) *proto.AssortmentCheck {
+	std := s.lookup(designation)
+	if std == nil || size == nil {
+		return nil
+	}
+
+	text := types.FormatProfileSize(size)
+	check := &proto.AssortmentCheck{FormGost: designation, Size: text, Standard: std.designation}
+	switch {
+	case std.family != proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED && std.family != size.Family:
+		check.FamilyMismatch = true
+	case len(std.sizes) == 0 || std.known[text]:
+		// a standard without a series only names the profile
+		check.SizeValid = true
+	default:
+		check.Suggestions = std.closest(text)
+	}
+
+	return check
+}
+
+// closest returns the sizes of the series nearest to the size, comparing the numbers one by one
+func (std *standard) closest(text string) []string {
+	numbers := sizeNumbers(text)
+	type candidate struct {
+		size     string
+		distance float64
+	}
+	candidates := make([]candidate, 0, len(std.sizes))
+	for _, size := range std.sizes {
+		candidates = append(candidates, candidate{size: size, distance: sizeDistance(numbers, sizeNumbers(size))})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	var suggestions []string
+	for _, c := range candidates[:min(MaxSuggestions, len(candidates))] {
+		suggestions = append(suggestions, c.size)
+	}
+	return suggestions
+}
+
+func sizeNumbers(text string) []float64 {
+	var numbers []float64
+	for _, match := range numberPattern.FindAllString(text, -1) {
+		if value, err := strconv.ParseFloat(match, 64); err == nil {
+			numbers = append(numbers, value)
+		}
+	}
+
+	return numbers
+}
+
+// sizeDistance sums the relative differences of the numbers, a missing number counts as fully different
+func sizeDistance(a, b []float64) float64 {
+	distance := math.Abs(float64(len(a) - len(b)))
+	for i := 0; i < min(len(a), len(b)); i++ {
+		if largest := math.Max(a[i], b[i]); largest > 0 {
+			distance += math.Abs(a[i]-b[i]) / largest
+		}
+	}
+
+	return distance
+}
+
+// NodeStandard returns the standard of the node's assortment: the recognized form standard,
+// or else the standard written in the profile part of the material
+func NodeStandard(node *proto.TreeNode) string {
+	if assortment := nodeAssortment(node); assortment != nil && strings.TrimSpace(assortment.FormGost) != "" {
+		return strings.TrimSpace(assortment.FormGost)
+	}
+	profile, _, _ := strings.Cut(types.NodeMaterial(node), "/")
+
+	return designationPattern.FindString(profile)
+}
+
+func nodeAssortment(node *proto.TreeNode) *proto.Assortment {
+	if node.Figure != nil && node.Figure.Assortment != nil {
+		return node.Figure.Assortment
+	}
+	if node.Spec != nil {
+		return node.Spec.Assortment
+	}
+
+	return nil
+}
+
+// Validate checks the assortments of the tree against their standards. An invalid size is flagged
+// YELLOW in the field status of the assortment with the closest sizes of the series suggested, and a
+// standard for another profile family flags the standard; valid sizes are marked OK. Standard and
+// purchased rows are skipped, assortments of unknown standards are reported but not flagged.
+func (s *Standards) Validate(root *proto.TreeNode) *proto.AssortmentValidation {
+	result := &proto.AssortmentValidation{}
+	unknown := make(map[string]bool)
+
+	var walk func(node *proto.TreeNode)
+	walk = func(node *proto.TreeNode) {
+		s.validateNode(node, result, unknown)
+		for _, leaf := range node.Leaves {
+			walk(leaf)
+		}
+	}
+	walk(root)
+
+	return result
+}
+
+func (s *Standards) validateNode(node *proto.TreeNode, result *proto.AssortmentValidation, unknown map[string]bool) {
+	if node.Spec != nil && (node.Spec.PartKind == proto.PartKind_PART_KIND_STANDARD || node.Spec.PartKind == proto.PartKind_PART_KIND_PURCHASED) {
+		return
+	}
+	designation := NodeStandard(node)
+	size := types.NodeProfileSize(node)
+	if designation == "" || size == nil {
+		return
+	}
+
+	check := s.Check(designation, size)
+	if check == nil {
+		if key := Key(designation); !unknown[key] {
+			unknown[key] = true
+			result.UnknownStandards = append(result.UnknownStandards, designation)
+		}
+		return
+	}
+	check.NodeId = node.Id
+	check.Number = node.Number
+	check.Name = node.Name
+	if !check.SizeValid {
+		result.Invalid++
+	}
+	result.Checks = append(result.Checks, check)
+	markAssortment(nodeAssortment(node), check)
+}
+
+func markAssortment(assortment *proto.Assortment, check *proto.AssortmentCheck) {
+	if assortment == nil {
+		return
+	}
+	if assortment.FieldStatus == nil {
+		assortment.FieldStatus = make(map[string]proto.FieldStatus)
+	}
+
+	assortment.SuggestedSizes = check.Suggestions
+	switch {
+	case check.FamilyMismatch:
+		assortment.FieldStatus[StandardStatusField] = proto.FieldStatus_YELLOW
+	case check.SizeValid:
+		assortment.FieldStatus[SizeStatusField] = proto.FieldStatus_OK
+	default:
+		assortment.FieldStatus[SizeStatusField] = proto.FieldStatus_YELLOW
+	}
+}
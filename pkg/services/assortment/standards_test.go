@@ -0,0 +1,114 @@
+package assortment
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStandards() *Standards {
+	sizes := func(values ...string) []*proto.AssortmentStandardSizeORM {
+		var result []*proto.AssortmentStandardSizeORM
+		for _, v := range values {
+			result = append(result, &proto.AssortmentStandardSizeORM{Size: v})
+		}
+		return result
+	}
+	return NewStandards([]*proto.AssortmentStandardORM{
+		{Designation: "ГОСТ 8732-78", Family: int32(proto.ProfileFamily_PROFILE_FAMILY_PIPE), Sizes: sizes("57x3", "57x3,5", "57x4", "76x3,5")},
+		{Designation: "ГОСТ 19903-2015", Family: int32(proto.ProfileFamily_PROFILE_FAMILY_SHEET), Sizes: sizes("8", "10", "12")},
+		{Designation: "ГОСТ 8509-93", Family: int32(proto.ProfileFamily_PROFILE_FAMILY_ANGLE), Sizes: sizes("50x5", "63x5")},
+		{Designation: "ГОСТ 8240-97", Family: int32(proto.ProfileFamily_PROFILE_FAMILY_CHANNEL), Sizes: sizes("10П", "10У", "12П")},
+		{Designation: "ГОСТ 2590-2006", Family: int32(proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR)},
+	})
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "ГОСТ8732-78", Key("ГОСТ 8732–78"))
+	assert.Equal(t, "ГОСТ8732-78", Key("gost 8732-78"))
+	assert.Equal(t, "ГОСТ8732-78", Key("8732-78"))
+	assert.Equal(t, "ГОСТР52246-2016", Key("ГОСТ P 52246-2016"))
+	assert.Equal(t, "ГОСТ19903", baseKey(Key("ГОСТ 19903-74")))
+	assert.Equal(t, "ТУ14-1-1234", baseKey(Key("ТУ 14-1-1234")))
+}
+
+func TestStandardsCheck(t *testing.T) {
+	standards := testStandards()
+	pipe := &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_PIPE, Pipe: &proto.PipeSize{Diameter: 57, Wall: 3.5}}
+
+	check := standards.Check("ГОСТ 8732-78", pipe)
+	require.NotNil(t, check)
+	assert.True(t, check.SizeValid)
+	assert.Equal(t, "ГОСТ 8732-78", check.Standard)
+
+	odd := &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_PIPE, Pipe: &proto.PipeSize{Diameter: 57, Wall: 3.6}}
+	check = standards.Check("ГОСТ 8732-78", odd)
+	require.NotNil(t, check)
+	assert.False(t, check.SizeValid)
+	assert.Equal(t, []string{"57×3.5", "57×4", "57×3"}, check.Suggestions)
+
+	// another edition of the standard
+	sheet := &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_SHEET, Sheet: &proto.SheetSize{Thickness: 10, Width: 1500}}
+	check = standards.Check("ГОСТ 19903-74", sheet)
+	require.NotNil(t, check)
+	assert.True(t, check.SizeValid)
+
+	check = standards.Check("ГОСТ 8509-93", pipe)
+	require.NotNil(t, check)
+	assert.True(t, check.FamilyMismatch)
+	assert.False(t, check.SizeValid)
+
+	// a standard without a series accepts any size of its family
+	bar := &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR, RoundBar: &proto.RoundBarSize{Diameter: 31}}
+	check = standards.Check("ГОСТ 2590-2006", bar)
+	require.NotNil(t, check)
+	assert.True(t, check.SizeValid)
+
+	assert.Nil(t, standards.Check("ГОСТ 10704-91", pipe))
+}
+
+func TestStandardsValidate(t *testing.T) {
+	root := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{Id: "pipe", Number: "1", Spec: &proto.SpecificationRow{Size_: "57x3,6", Material: "Труба ГОСТ 8732-78/20"}},
+			{Id: "sheet", Spec: &proto.SpecificationRow{Material: "Лист 10 ГОСТ 19903-2015/09Г2С"}},
+			{Id: "channel", Figure: &proto.Figure{
+				MainSize:   &proto.Figure_MainSizeStr{MainSizeStr: "10"},
+				Assortment: &proto.Assortment{Name: "Швеллер", FormGost: "ГОСТ 8240-97"},
+			}},
+			{Id: "angle", Figure: &proto.Figure{
+				MainSize:   &proto.Figure_MainSizeStr{MainSizeStr: "Ø20"},
+				Assortment: &proto.Assortment{FormGost: "ГОСТ 8509-93"},
+			}},
+			{Id: "unknown", Spec: &proto.SpecificationRow{Material: "Труба 57x3 ГОСТ 10704-91/20"}},
+			{Id: "bolt", Spec: &proto.SpecificationRow{Size_: "M12x40", Material: "ГОСТ 7798-70", PartKind: proto.PartKind_PART_KIND_STANDARD}},
+		},
+	}
+
+	// recognized trees have their sizes typed before the validation
+	types.AnnotateSizes(root)
+	result := testStandards().Validate(root)
+	require.Len(t, result.Checks, 4)
+	assert.Equal(t, int32(3), result.Invalid)
+	assert.Equal(t, []string{"ГОСТ 10704-91"}, result.UnknownStandards)
+
+	assert.Equal(t, "pipe", result.Checks[0].NodeId)
+	assert.Equal(t, "57×3.6", result.Checks[0].Size)
+	assert.Equal(t, []string{"57×3.5", "57×4", "57×3"}, root.Leaves[0].Spec.Assortment.SuggestedSizes)
+	assert.Equal(t, proto.FieldStatus_YELLOW, root.Leaves[0].Spec.Assortment.FieldStatus[SizeStatusField])
+
+	assert.True(t, result.Checks[1].SizeValid)
+	assert.Equal(t, proto.FieldStatus_OK, root.Leaves[1].Spec.Assortment.FieldStatus[SizeStatusField])
+
+	channel := root.Leaves[2].Figure.Assortment
+	assert.Equal(t, proto.FieldStatus_YELLOW, channel.FieldStatus[SizeStatusField])
+	assert.Equal(t, []string{"10П", "10У", "12П"}, channel.SuggestedSizes)
+
+	angle := root.Leaves[3].Figure.Assortment
+	assert.Equal(t, proto.FieldStatus_YELLOW, angle.FieldStatus[StandardStatusField])
+	assert.Empty(t, angle.SuggestedSizes)
+}
@@ -0,0 +1,167 @@
+package costing
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+// Row kinds and units of the price list CSV
+const (
+	csvKindMaterial  = "material"
+	csvKindOperation = "operation"
+	csvUnitKg        = "kg"
+	csvUnitMetre     = "m"
+	csvUnitHour      = "h"
+)
+
+// CSVHeader is the header of price list CSV files. Material rows hold the grade, the optional
+// assortment type, the unit (kg or m) and the price; operation rows hold the operation code
+// with the labour rate in price and the machine rate in machine_price.
+var CSVHeader = []string{"kind", "name", "assortment_type", "unit", "price", "machine_price"}
+
+// ErrInvalidCSV is returned for price list files that cannot be imported
+var ErrInvalidCSV = errors.New("invalid price list csv")
+
+// WriteCSV writes the materials and operation rates of the list
+func WriteCSV(w io.Writer, list *proto.PriceListORM) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return err
+	}
+	for _, m := range list.Materials {
+		unit := csvUnitKg
+		if proto.PriceUnit(m.Unit) == proto.PriceUnit_PRICE_UNIT_METRE {
+			unit = csvUnitMetre
+		}
+		if err := writer.Write([]string{
+			csvKindMaterial, m.Material, m.AssortmentType, unit, formatCSVNumber(m.Price), "",
+		}); err != nil {
+			return err
+		}
+	}
+	for _, op := range list.Operations {
+		if err := writer.Write([]string{
+			csvKindOperation, op.Operation, "", csvUnitHour, formatCSVNumber(op.LabourRate), formatCSVNumber(op.MachineRate),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// ReadCSV parses materials and operation rates. Both comma and semicolon separated files
+// are accepted, the latter with decimal commas as saved by spreadsheets in the Russian locale.
+func ReadCSV(r io.Reader) ([]*proto.MaterialPriceORM, []*proto.OperationRateORM, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	text := strings.TrimPrefix(string(data), "\ufeff")
+
+	reader := csv.NewReader(strings.NewReader(text))
+	firstLine, _, _ := strings.Cut(text, "\n")
+	if strings.Contains(firstLine, ";") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidCSV, err)
+	}
+
+	var (
+		materials  []*proto.MaterialPriceORM
+		operations []*proto.OperationRateORM
+	)
+	for i, record := range records {
+		line := i + 1
+		for len(record) < len(CSVHeader) {
+			record = append(record, "")
+		}
+		kind := strings.ToLower(strings.TrimSpace(record[0]))
+		name := strings.TrimSpace(record[1])
+		switch {
+		case kind == "" && name == "":
+			continue
+		case i == 0 && kind == CSVHeader[0]:
+			continue
+		case name == "":
+			return nil, nil, fmt.Errorf("%w: line %d: name is empty", ErrInvalidCSV, line)
+		}
+
+		price, err := parseCSVNumber(record[4])
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: line %d: invalid price %q", ErrInvalidCSV, line, record[4])
+		}
+
+		switch kind {
+		case csvKindMaterial:
+			unit, err := parseCSVUnit(record[3])
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCSV, line, err)
+			}
+			materials = append(materials, &proto.MaterialPriceORM{
+				Material:       name,
+				AssortmentType: strings.TrimSpace(record[2]),
+				Unit:           int32(unit),
+				Price:          price,
+			})
+		case csvKindOperation:
+			machineRate, err := parseCSVNumber(record[5])
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: line %d: invalid machine price %q", ErrInvalidCSV, line, record[5])
+			}
+			operations = append(operations, &proto.OperationRateORM{
+				Operation:   name,
+				LabourRate:  price,
+				MachineRate: machineRate,
+			})
+		default:
+			return nil, nil, fmt.Errorf("%w: line %d: unknown kind %q", ErrInvalidCSV, line, record[0])
+		}
+	}
+
+	return materials, operations, nil
+}
+
+func parseCSVUnit(value string) (proto.PriceUnit, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", csvUnitKg, "кг":
+		return proto.PriceUnit_PRICE_UNIT_KG, nil
+	case csvUnitMetre, "м":
+		return proto.PriceUnit_PRICE_UNIT_METRE, nil
+	default:
+		return 0, fmt.Errorf("unknown unit %q", value)
+	}
+}
+
+// parseCSVNumber reads a non-negative number, empty values are zero
+func parseCSVNumber(value string) (float64, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), ",", ".")
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if number < 0 {
+		return 0, fmt.Errorf("negative number %v", number)
+	}
+
+	return number, nil
+}
+
+func formatCSVNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
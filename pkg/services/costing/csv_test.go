@@ -0,0 +1,68 @@
+package costing
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	list := &proto.PriceListORM{
+		Materials: []*proto.MaterialPriceORM{
+			{Material: "Ст3сп", Price: 85.5},
+			{Material: "Ст20", AssortmentType: "pipe", Price: 300, Unit: int32(proto.PriceUnit_PRICE_UNIT_METRE)},
+		},
+		Operations: []*proto.OperationRateORM{
+			{Operation: "welding", LabourRate: 1500, MachineRate: 800},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, list))
+	assert.Equal(t, "kind,name,assortment_type,unit,price,machine_price\n"+
+		"material,Ст3сп,,kg,85.5,\n"+
+		"material,Ст20,pipe,m,300,\n"+
+		"operation,welding,,h,1500,800\n", buf.String())
+
+	materials, operations, err := ReadCSV(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, list.Materials, materials)
+	assert.Equal(t, list.Operations, operations)
+}
+
+func TestReadCSVSemicolons(t *testing.T) {
+	data := "\ufeffkind;name;assortment_type;unit;price;machine_price\n" +
+		"material;Ст3сп;;кг;85,50;\n" +
+		"\n" +
+		"material;09Г2С;sheet;м;120\n" +
+		"operation;cutting;;h;1 000;\n"
+
+	_, _, err := ReadCSV(strings.NewReader(data))
+	require.Error(t, err, "spaces inside numbers are rejected")
+
+	materials, operations, err := ReadCSV(strings.NewReader(strings.Replace(data, "1 000", "1000,5", 1)))
+	require.NoError(t, err)
+	require.Len(t, materials, 2)
+	assert.Equal(t, 85.5, materials[0].Price)
+	assert.Equal(t, int32(proto.PriceUnit_PRICE_UNIT_METRE), materials[1].Unit)
+	assert.Equal(t, "sheet", materials[1].AssortmentType)
+	require.Len(t, operations, 1)
+	assert.Equal(t, 1000.5, operations[0].LabourRate)
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	for name, data := range map[string]string{
+		"unknown kind":   "rate,Ст3,,kg,10,",
+		"empty name":     "material,,,kg,10,",
+		"unknown unit":   "material,Ст3,,t,10,",
+		"negative price": "material,Ст3,,kg,-1,",
+	} {
+		_, _, err := ReadCSV(strings.NewReader(data))
+		assert.True(t, errors.Is(err, ErrInvalidCSV), name)
+	}
+}
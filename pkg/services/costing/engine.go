@@ -0,0 +1,250 @@
+package costing
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/requirements"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// PriceTable is a price list prepared for lookups
+type PriceTable struct {
+	ListID                uint64
+	Version               int32
+	Currency              string
+	ProcessingPerPart     float64
+	ProcessingPerAssembly float64
+
+	// sorted by descending key length so the most specific grade wins
+	materials  []materialPrice
+	operations map[string]*proto.OperationRateORM
+}
+
+type materialPrice struct {
+	key            string
+	assortmentType string
+	price          Price
+}
+
+// Price is a material price per kg or per metre
+type Price struct {
+	Value float64
+	Unit  proto.PriceUnit
+}
+
+// NewPriceTable builds a lookup table from a price list with preloaded materials and operations
+func NewPriceTable(list *proto.PriceListORM) *PriceTable {
+	t := &PriceTable{
+		ListID:                list.Id,
+		Version:               list.Version,
+		Currency:              list.Currency,
+		ProcessingPerPart:     list.ProcessingCostPerPart,
+		ProcessingPerAssembly: list.ProcessingCostPerAssembly,
+		operations:            map[string]*proto.OperationRateORM{},
+	}
+	for _, m := range list.Materials {
+		key := types.NormalizeMaterial(m.Material)
+		if key == "" {
+			continue
+		}
+		t.materials = append(t.materials, materialPrice{
+			key:            key,
+			assortmentType: strings.ToLower(strings.TrimSpace(m.AssortmentType)),
+			price:          Price{Value: m.Price, Unit: proto.PriceUnit(m.Unit)},
+		})
+	}
+	// prices of a specific assortment type win over the generic price of the same grade
+	sort.SliceStable(t.materials, func(i, j int) bool {
+		a, b := t.materials[i], t.materials[j]
+		if len(a.key) != len(b.key) {
+			return len(a.key) > len(b.key)
+		}
+		return a.assortmentType != "" && b.assortmentType == ""
+	})
+	for _, op := range list.Operations {
+		t.operations[op.Operation] = op
+	}
+
+	return t
+}
+
+// MaterialPrice returns the price of the longest grade contained in material
+// that applies to the assortment type
+func (t *PriceTable) MaterialPrice(material, assortmentType string) (Price, bool) {
+	normalized := types.NormalizeMaterial(material)
+	if normalized == "" {
+		return Price{}, false
+	}
+	assortmentType = strings.ToLower(strings.TrimSpace(assortmentType))
+	for _, m := range t.materials {
+		if m.assortmentType != "" && m.assortmentType != assortmentType {
+			continue
+		}
+		if strings.Contains(normalized, m.key) {
+			return m.price, true
+		}
+	}
+
+	return Price{}, false
+}
+
+// OperationCost returns the cost of the routed hours of an operation
+func (t *PriceTable) OperationCost(op *proto.RoutingOperation) (float64, bool) {
+	rate, ok := t.operations[op.Operation]
+	if !ok {
+		return 0, false
+	}
+
+	return op.LabourHours*rate.LabourRate + op.MachineHours*rate.MachineRate, true
+}
+
+// Estimate computes the cost breakdown of the tree. Parts are costed as mass × price per kg
+// or length × price per metre plus the per-part processing rate; sub-assemblies add their
+// assembly rate to the costs of their children. Hours of the routing, when given, are charged
+// at the operation rates of the price list. With the stock usage of a cutting plan the material
+// of profile parts is charged for the stock bars consumed rather than the part length.
+// Quantities come from accumulated_count, so totals cover the whole product.
+func Estimate(root *proto.TreeNode, prices *PriceTable, route *proto.RoutingNode, usage *requirements.StockUsage) *proto.CostNode {
+	return estimateNode(root, prices, route, usage, true)
+}
+
+func estimateNode(node *proto.TreeNode, prices *PriceTable, route *proto.RoutingNode, usage *requirements.StockUsage, isRoot bool) *proto.CostNode {
+	cost := &proto.CostNode{
+		NodeId:   node.Id,
+		Number:   node.Number,
+		Name:     node.Name,
+		Material: types.NodeMaterial(node),
+		Quantity: types.NodeQuantity(node),
+	}
+
+	if len(node.Leaves) > 0 {
+		for i, leaf := range node.Leaves {
+			var childRoute *proto.RoutingNode
+			if route != nil && i < len(route.Children) {
+				childRoute = route.Children[i]
+			}
+			child := estimateNode(leaf, prices, childRoute, usage, false)
+			cost.Children = append(cost.Children, child)
+			cost.MaterialCost += child.MaterialCost
+			cost.ProcessingCost += child.ProcessingCost
+			cost.TotalMass += child.TotalMass
+		}
+		if !isRoot {
+			cost.ProcessingCost += prices.ProcessingPerAssembly * float64(cost.Quantity)
+		}
+	} else {
+		estimatePart(node, cost, prices)
+		applyStockUsage(node, cost, usage)
+	}
+	if route != nil {
+		estimateOperations(route, cost, prices)
+	}
+
+	cost.MaterialCost = roundMoney(cost.MaterialCost)
+	cost.ProcessingCost = roundMoney(cost.ProcessingCost)
+	cost.TotalCost = roundMoney(cost.MaterialCost + cost.ProcessingCost)
+	cost.TotalMass = math.Round(cost.TotalMass*1000) / 1000
+	if cost.Quantity > 0 {
+		cost.UnitCost = roundMoney(cost.TotalCost / float64(cost.Quantity))
+	}
+
+	return cost
+}
+
+func estimatePart(node *proto.TreeNode, cost *proto.CostNode, prices *PriceTable) {
+	quantity := float64(cost.Quantity)
+
+	cost.UnitProcessingCost = prices.ProcessingPerPart
+	cost.ProcessingCost = cost.UnitProcessingCost * quantity
+
+	var assortmentType string
+	if node.Figure != nil {
+		cost.UnitMass = float64(node.Figure.Mass)
+		cost.UnitLength = math.Max(float64(node.Figure.SizeVertical), float64(node.Figure.SizeHorizontal)) / 1000
+		if node.Figure.Assortment != nil {
+			assortmentType = node.Figure.Assortment.FigureType
+		}
+	}
+	if cost.UnitMass > 0 {
+		cost.TotalMass = cost.UnitMass * quantity
+	}
+
+	price, ok := prices.MaterialPrice(cost.Material, assortmentType)
+	switch {
+	case ok && price.Unit == proto.PriceUnit_PRICE_UNIT_METRE:
+		if cost.UnitLength <= 0 {
+			cost.Warnings = append(cost.Warnings, "length is unknown")
+			return
+		}
+		cost.PricePerMetre = price.Value
+		cost.UnitMaterialCost = roundMoney(cost.UnitLength * price.Value)
+		cost.MaterialCost = cost.UnitLength * price.Value * quantity
+	case cost.UnitMass <= 0:
+		cost.Warnings = append(cost.Warnings, "mass is unknown")
+	case !ok:
+		cost.Warnings = append(cost.Warnings, fmt.Sprintf("no price for material %q", cost.Material))
+	default:
+		cost.PricePerKg = price.Value
+		cost.UnitMaterialCost = roundMoney(cost.UnitMass * price.Value)
+		cost.MaterialCost = cost.UnitMass * price.Value * quantity
+	}
+}
+
+// applyStockUsage charges the material of a profile part for its share of the stock bars
+func applyStockUsage(node *proto.TreeNode, cost *proto.CostNode, usage *requirements.StockUsage) {
+	factor := usage.Factor(node)
+	if factor <= 0 || cost.MaterialCost == 0 {
+		return
+	}
+
+	cost.StockFactor = factor
+	cost.UnitMaterialCost = roundMoney(cost.UnitMaterialCost * factor)
+	cost.MaterialCost *= factor
+}
+
+// estimateOperations charges the operations routed to the node itself
+func estimateOperations(route *proto.RoutingNode, cost *proto.CostNode, prices *PriceTable) {
+	var total float64
+	for _, op := range route.Operations {
+		value, ok := prices.OperationCost(op)
+		if !ok {
+			cost.Warnings = append(cost.Warnings, fmt.Sprintf("no rate for operation %q", op.Operation))
+			continue
+		}
+		total += value
+	}
+	if total == 0 {
+		return
+	}
+
+	cost.OperationCost = roundMoney(total)
+	cost.ProcessingCost += total
+	if len(cost.Children) == 0 && cost.Quantity > 0 {
+		cost.UnitProcessingCost = roundMoney(cost.ProcessingCost / float64(cost.Quantity))
+	}
+}
+
+// CountIncomplete returns the number of parts with warnings
+func CountIncomplete(node *proto.CostNode) int32 {
+	if len(node.Children) == 0 {
+		if len(node.Warnings) > 0 {
+			return 1
+		}
+		return 0
+	}
+
+	var count int32
+	for _, child := range node.Children {
+		count += CountIncomplete(child)
+	}
+
+	return count
+}
+
+func roundMoney(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,210 @@
+package costing
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/requirements"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPriceTable() *PriceTable {
+	priceListID := uint64(1)
+	return NewPriceTable(&proto.PriceListORM{
+		Id:                        priceListID,
+		Currency:                  "RUB",
+		ProcessingCostPerPart:     100,
+		ProcessingCostPerAssembly: 500,
+		Materials: []*proto.MaterialPriceORM{
+			{PriceListId: &priceListID, Material: "Ст3", Price: 80},
+			{PriceListId: &priceListID, Material: "Ст3сп", Price: 90},
+			{PriceListId: &priceListID, Material: "09Г2С", Price: 120},
+		},
+	})
+}
+
+func part(id, material string, mass float32, count, accumulated int32) *proto.TreeNode {
+	return &proto.TreeNode{
+		Id:               id,
+		Name:             id,
+		Material:         material,
+		Count:            count,
+		AccumulatedCount: accumulated,
+		Figure:           &proto.Figure{Mass: mass},
+	}
+}
+
+func TestMaterialPrice(t *testing.T) {
+	prices := testPriceTable()
+
+	price, ok := prices.MaterialPrice("Лист Б-ПУ-14 ГОСТ 19903/СТ3СП ГОСТ 14637-89", "")
+	require.True(t, ok)
+	assert.Equal(t, 90.0, price.Value, "the most specific grade wins")
+
+	price, ok = prices.MaterialPrice("Круг 20 ГОСТ 2590 / Ст 3", "")
+	require.True(t, ok)
+	assert.Equal(t, 80.0, price.Value)
+
+	_, ok = prices.MaterialPrice("Сборочный чертеж", "")
+	assert.False(t, ok)
+	_, ok = prices.MaterialPrice("", "")
+	assert.False(t, ok)
+}
+
+func TestEstimate(t *testing.T) {
+	root := &proto.TreeNode{
+		Id:    "root",
+		Name:  "Root",
+		Count: 1,
+		Leaves: []*proto.TreeNode{
+			{
+				Id:               "assembly",
+				Number:           "01.00",
+				Count:            2,
+				AccumulatedCount: 2,
+				Leaves: []*proto.TreeNode{
+					part("plate", "Лист 10 Ст3сп", 1.5, 2, 4),
+					part("rib", "Лист 8 09Г2С", 0.5, 1, 2),
+				},
+			},
+			part("beam", "Круг 20 ГОСТ 2590/Ст3", 2, 1, 1),
+			part("unknown", "Пластик", 1, 1, 1),
+			part("drawing", "", 0, 0, 0),
+		},
+	}
+
+	result := Estimate(root, testPriceTable(), nil, nil)
+
+	require.Len(t, result.Children, 4)
+	assembly := result.Children[0]
+
+	plate := assembly.Children[0]
+	assert.Equal(t, int32(4), plate.Quantity)
+	assert.Equal(t, 90.0, plate.PricePerKg)
+	assert.Equal(t, 135.0, plate.UnitMaterialCost)
+	assert.Equal(t, 540.0, plate.MaterialCost)
+	assert.Equal(t, 400.0, plate.ProcessingCost)
+	assert.Equal(t, 940.0, plate.TotalCost)
+	assert.Equal(t, 235.0, plate.UnitCost)
+	assert.Equal(t, 6.0, plate.TotalMass)
+
+	rib := assembly.Children[1]
+	assert.Equal(t, 120.0, rib.MaterialCost)
+	assert.Equal(t, 200.0, rib.ProcessingCost)
+
+	// two assemblies add their assembly rate to the parts
+	assert.Equal(t, 660.0, assembly.MaterialCost)
+	assert.Equal(t, 1600.0, assembly.ProcessingCost)
+	assert.Equal(t, 2260.0, assembly.TotalCost)
+	assert.Equal(t, 1130.0, assembly.UnitCost)
+	assert.Equal(t, 7.0, assembly.TotalMass)
+
+	unknown := result.Children[2]
+	assert.Zero(t, unknown.MaterialCost)
+	assert.Equal(t, 100.0, unknown.ProcessingCost)
+	assert.Len(t, unknown.Warnings, 1)
+
+	drawing := result.Children[3]
+	assert.Equal(t, int32(1), drawing.Quantity, "nodes without counts are a single unit")
+	assert.Equal(t, []string{"mass is unknown"}, drawing.Warnings)
+
+	// the root is a container and has no assembly cost of its own
+	assert.Equal(t, 820.0, result.MaterialCost)
+	assert.Equal(t, 1900.0, result.ProcessingCost)
+	assert.Equal(t, 2720.0, result.TotalCost)
+	assert.Equal(t, 10.0, result.TotalMass)
+	assert.Equal(t, int32(2), CountIncomplete(result))
+}
+
+func TestMaterialPriceByAssortmentType(t *testing.T) {
+	prices := NewPriceTable(&proto.PriceListORM{
+		Materials: []*proto.MaterialPriceORM{
+			{Material: "Ст3", Price: 80},
+			{Material: "Ст3", AssortmentType: "Pipe", Price: 350, Unit: int32(proto.PriceUnit_PRICE_UNIT_METRE)},
+		},
+	})
+
+	price, ok := prices.MaterialPrice("Труба 57х3,5 / Ст3", "pipe")
+	require.True(t, ok)
+	assert.Equal(t, Price{Value: 350, Unit: proto.PriceUnit_PRICE_UNIT_METRE}, price)
+
+	price, ok = prices.MaterialPrice("Лист 10 Ст3", "sheet")
+	require.True(t, ok)
+	assert.Equal(t, Price{Value: 80, Unit: proto.PriceUnit_PRICE_UNIT_KG}, price)
+}
+
+func TestEstimateWithLengthPricesAndOperations(t *testing.T) {
+	prices := NewPriceTable(&proto.PriceListORM{
+		Id:                    3,
+		Version:               4,
+		ProcessingCostPerPart: 10,
+		Materials: []*proto.MaterialPriceORM{
+			{Material: "Ст20", AssortmentType: "pipe", Price: 300, Unit: int32(proto.PriceUnit_PRICE_UNIT_METRE)},
+		},
+		Operations: []*proto.OperationRateORM{
+			{Operation: "cutting", LabourRate: 1000, MachineRate: 500},
+		},
+	})
+	assert.Equal(t, int32(4), prices.Version)
+
+	pipe := part("pipe", "Труба 57х3,5 ГОСТ 8732 / Ст20", 3, 2, 2)
+	pipe.Figure.SizeHorizontal = 1500
+	pipe.Figure.Assortment = &proto.Assortment{FigureType: "pipe"}
+	noLength := part("stub", "Труба 57х3,5 / Ст20", 1, 1, 1)
+	noLength.Figure.Assortment = &proto.Assortment{FigureType: "pipe"}
+	root := &proto.TreeNode{Id: "root", Leaves: []*proto.TreeNode{pipe, noLength}}
+
+	route := &proto.RoutingNode{
+		NodeId: "root",
+		Children: []*proto.RoutingNode{
+			{NodeId: "pipe", Operations: []*proto.RoutingOperation{
+				{Operation: "cutting", LabourHours: 0.5, MachineHours: 0.2},
+				{Operation: "painting", LabourHours: 1},
+			}},
+			{NodeId: "stub"},
+		},
+	}
+
+	result := Estimate(root, prices, route, nil)
+	require.Len(t, result.Children, 2)
+
+	costed := result.Children[0]
+	assert.Equal(t, 1.5, costed.UnitLength)
+	assert.Equal(t, 300.0, costed.PricePerMetre)
+	assert.Equal(t, 450.0, costed.UnitMaterialCost)
+	assert.Equal(t, 900.0, costed.MaterialCost)
+	// 0.5 h × 1000 + 0.2 h × 500 on top of the per-part rate
+	assert.Equal(t, 600.0, costed.OperationCost)
+	assert.Equal(t, 620.0, costed.ProcessingCost)
+	assert.Equal(t, 310.0, costed.UnitProcessingCost)
+	assert.Equal(t, []string{`no rate for operation "painting"`}, costed.Warnings)
+
+	assert.Equal(t, []string{"length is unknown"}, result.Children[1].Warnings)
+	assert.Equal(t, 900.0, result.MaterialCost)
+	assert.Equal(t, 630.0, result.ProcessingCost)
+	assert.Equal(t, int32(2), CountIncomplete(result))
+}
+
+func TestEstimateStockUsage(t *testing.T) {
+	pipe := part("pipe", "Труба 57х3,5/Ст3", 10, 1, 3)
+	pipe.Figure.SizeVertical, pipe.Figure.SizeHorizontal = 57, 1500
+	root := &proto.TreeNode{Id: "root", Count: 1, Leaves: []*proto.TreeNode{pipe, part("plate", "Лист 10 Ст3", 5, 1, 1)}}
+
+	// three pieces of 1.5 m take one default 6 m bar
+	aggregator := requirements.NewAggregator()
+	aggregator.Add(root)
+	usage := requirements.Usage(aggregator.Cutting(requirements.NewStockLengths(nil)), nil)
+
+	breakdown := Estimate(root, testPriceTable(), nil, usage)
+	require.Len(t, breakdown.Children, 2)
+	cost := breakdown.Children[0]
+	assert.Equal(t, 1.3333, cost.StockFactor)
+	assert.Equal(t, 1066.64, cost.UnitMaterialCost)
+	assert.Equal(t, 3199.92, cost.MaterialCost)
+	assert.Equal(t, 30.0, cost.TotalMass)
+
+	plate := breakdown.Children[1]
+	assert.Equal(t, 0.0, plate.StockFactor)
+	assert.Equal(t, 400.0, plate.MaterialCost)
+}
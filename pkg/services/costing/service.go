@@ -0,0 +1,151 @@
+package costing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/purchase"
+	"github.com/bazilio91/sferra-cloud/pkg/services/requirements"
+	"github.com/bazilio91/sferra-cloud/pkg/services/routing"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrNoPriceList      = errors.New("no price list in effect for the client")
+	ErrEstimateNotFound = errors.New("cost estimate not found")
+)
+
+// Service estimates manufacturing costs of recognized tasks
+type Service struct {
+	db           *gorm.DB
+	routing      *routing.Service
+	purchase     *purchase.Service
+	requirements *requirements.Service
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{
+		db:           db,
+		routing:      routing.NewService(db),
+		purchase:     purchase.NewService(db),
+		requirements: requirements.NewService(db),
+	}
+}
+
+// ClientPriceList returns the price list in effect for the client at the given time with its
+// materials and operation rates. Lists of the client win over the default shared lists,
+// and the most recently started list wins among several in effect.
+func (s *Service) ClientPriceList(ctx context.Context, clientID uint64, at time.Time) (*proto.PriceListORM, error) {
+	var lists []proto.PriceListORM
+	err := s.db.WithContext(ctx).Preload("Materials").Preload("Operations").
+		Where("client_id = ? OR (client_id IS NULL AND is_default = ?)", clientID, true).
+		Where("valid_from IS NULL OR valid_from <= ?", at).
+		Where("valid_to IS NULL OR valid_to > ?", at).
+		Order("client_id IS NULL, valid_from DESC NULLS LAST, updated_at DESC").
+		Limit(1).Find(&lists).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(lists) == 0 {
+		return nil, ErrNoPriceList
+	}
+
+	return &lists[0], nil
+}
+
+// EstimateTask computes the cost of a completed task of the client and stores it,
+// replacing the previous estimate
+func (s *Service) EstimateTask(ctx context.Context, clientID uint64, taskID string) (*proto.CostEstimateORM, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := types.TaskTree(task)
+	if err != nil {
+		return nil, err
+	}
+	// standard and purchased items are bought, not made
+	if tree, err = s.purchase.Manufactured(ctx, tree); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	list, err := s.ClientPriceList(ctx, clientID, now)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.routing.ClientRules(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	// profile parts consume whole stock bars
+	usage, err := s.requirements.TreeUsage(ctx, clientID, tree)
+	if err != nil {
+		return nil, err
+	}
+	prices := NewPriceTable(list)
+	breakdown := Estimate(tree, prices, routing.Route(tree, rules), usage)
+
+	estimate := &proto.CostEstimateORM{
+		Id:               uuid.New().String(),
+		TaskId:           task.Id,
+		ClientId:         clientID,
+		PriceListId:      prices.ListID,
+		PriceListVersion: prices.Version,
+		Currency:         prices.Currency,
+		MaterialCost:     breakdown.MaterialCost,
+		ProcessingCost:   breakdown.ProcessingCost,
+		TotalCost:        breakdown.TotalCost,
+		TotalMass:        breakdown.TotalMass,
+		IncompleteParts:  CountIncomplete(breakdown),
+		CreatedAt:        &now,
+		UpdatedAt:        &now,
+	}
+	if estimate.Breakdown, err = types.JSONValue(breakdown); err != nil {
+		return nil, err
+	}
+	snapshot, err := list.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if estimate.PriceList, err = types.JSONValue(&snapshot); err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "task_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"client_id", "price_list_id", "price_list_version", "price_list", "currency", "material_cost", "processing_cost",
+			"total_cost", "total_mass", "incomplete_parts", "breakdown", "updated_at",
+		}),
+	}).Create(estimate).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to save cost estimate: %w", err)
+	}
+
+	return s.GetEstimate(ctx, clientID, task.Id)
+}
+
+// GetEstimate returns the stored estimate of a task of the client
+func (s *Service) GetEstimate(ctx context.Context, clientID uint64, taskID string) (*proto.CostEstimateORM, error) {
+	if _, err := uuid.Parse(taskID); err != nil {
+		return nil, ErrEstimateNotFound
+	}
+
+	var estimate proto.CostEstimateORM
+	err := s.db.WithContext(ctx).First(&estimate, "task_id = ? AND client_id = ?", taskID, clientID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrEstimateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &estimate, nil
+}
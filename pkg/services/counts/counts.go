@@ -0,0 +1,110 @@
+package counts
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// Accumulate recomputes the accumulated counts of the tree top-down: the accumulated count of a node is
+// its count times the number of units of its parent in the whole product. The root is a container of
+// drawings and keeps its counts. Nodes without a count take the units of their parent, so the accumulated
+// count of every node is its quantity, see types.NodeQuantity. Counts above math.MaxInt32 are rejected
+// with ErrCountOverflow.
+func Accumulate(root *proto.TreeNode) error {
+	for _, leaf := range root.Leaves {
+		if err := accumulate(leaf, int64(types.NodeQuantity(root))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func accumulate(node *proto.TreeNode, parentQuantity int64) error {
+	quantity := parentQuantity
+	if count := int64(nodeCount(node)); count > 0 {
+		quantity = count * parentQuantity
+	}
+	if quantity > math.MaxInt32 {
+		return fmt.Errorf("%w: node %q needs %d units", ErrCountOverflow, node.Id, quantity)
+	}
+	node.AccumulatedCount = int32(quantity)
+	for _, leaf := range node.Leaves {
+		if err := accumulate(leaf, quantity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nodeCount returns the count of the node, falling back to the count of its specification row
+func nodeCount(node *proto.TreeNode) int32 {
+	if node.Count > 0 {
+		return node.Count
+	}
+	if node.Spec != nil && node.Spec.Count > 0 {
+		return node.Spec.Count
+	}
+
+	return 0
+}
+
+// PartDesignation returns the key of the part a node names: the normalized designation without the
+// document code, so the assembly drawing and the assembly count as one part. It returns an empty
+// string for nodes without a designation.
+func PartDesignation(node *proto.TreeNode) string {
+	number := strings.TrimSpace(node.Number)
+	if number == "" {
+		return ""
+	}
+	designation, err := types.ParseDesignation(number)
+	if err != nil {
+		return types.NormalizeDesignation(number)
+	}
+	if designation.Variant != "" {
+		return designation.Base() + "-" + designation.Variant
+	}
+
+	return designation.Base()
+}
+
+// Totals sums the accumulated counts of the specification rows of the tree by part designation.
+// Drawings without a specification row are not occurrences of a part, rows without a designation are skipped.
+// The totals are sorted by designation.
+func Totals(root *proto.TreeNode) []*proto.PartTotal {
+	totals := make(map[string]*proto.PartTotal)
+	var walk func(node *proto.TreeNode)
+	walk = func(node *proto.TreeNode) {
+		if key := PartDesignation(node); node != root && node.Spec != nil && key != "" {
+			total, ok := totals[key]
+			if !ok {
+				total = &proto.PartTotal{Designation: key, Number: node.Number}
+				totals[key] = total
+			}
+			if total.Name == "" {
+				total.Name = node.Name
+			}
+			total.Quantity += int64(node.AccumulatedCount)
+			total.Occurrences++
+			total.NodeIds = append(total.NodeIds, node.Id)
+		}
+		for _, leaf := range node.Leaves {
+			walk(leaf)
+		}
+	}
+	walk(root)
+
+	parts := make([]*proto.PartTotal, 0, len(totals))
+	for _, total := range totals {
+		parts = append(parts, total)
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Designation < parts[j].Designation })
+
+	return parts
+}
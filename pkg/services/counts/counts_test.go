@@ -0,0 +1,102 @@
+package counts
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTree() *proto.TreeNode {
+	spec := func(count int32) *proto.SpecificationRow { return &proto.SpecificationRow{Count: count} }
+	return &proto.TreeNode{
+		Id:    "root",
+		Count: 1,
+		Leaves: []*proto.TreeNode{
+			{
+				Id: "drawing", Number: "23.00.27-ТХ.02.01.00.00.00.СБ", Count: 1,
+				Leaves: []*proto.TreeNode{
+					{
+						Id: "bracket", Number: "23.00.27-ТХ.02.01.01.00.00", Name: "кронштейн", Count: 2, AccumulatedCount: 7, Spec: spec(2),
+						Leaves: []*proto.TreeNode{
+							{Id: "plate", Number: "23.00.27-ТХ.02.01.01.00.01", Name: "плита", Count: 3, AccumulatedCount: 3, Spec: spec(3)},
+							{Id: "gasket", Number: "23.00.27-ТХ.02.01.01.00.02-01", Name: "прокладка", Spec: spec(4)},
+						},
+					},
+					{Id: "plate2", Number: "23.00.27-ТХ. 02.01.01.00.01", Count: 1, Spec: spec(1)},
+					{Id: "sensor", Name: "датчик", Count: 0, AccumulatedCount: 5, Spec: spec(0)},
+				},
+			},
+			{Id: "sheet", Number: "23.00.27-ТХ.02.01.05.00.01", Count: 0, AccumulatedCount: 3},
+		},
+	}
+}
+
+func TestAccumulate(t *testing.T) {
+	tree := testTree()
+	require.NoError(t, Accumulate(tree))
+
+	drawing, bracket := tree.Leaves[0], tree.Leaves[0].Leaves[0]
+	assert.Equal(t, int32(0), tree.AccumulatedCount)
+	assert.Equal(t, int32(1), drawing.AccumulatedCount)
+	assert.Equal(t, int32(2), bracket.AccumulatedCount)
+	assert.Equal(t, int32(6), bracket.Leaves[0].AccumulatedCount)
+	// the count of the specification row stands in for a missing count
+	assert.Equal(t, int32(8), bracket.Leaves[1].AccumulatedCount)
+	// nodes without a count take the units of their parent
+	assert.Equal(t, int32(1), drawing.Leaves[2].AccumulatedCount)
+	assert.Equal(t, int32(1), tree.Leaves[1].AccumulatedCount)
+
+	// an edited count propagates to the whole subtree
+	bracket.Count = 5
+	require.NoError(t, Accumulate(tree))
+	assert.Equal(t, int32(15), bracket.Leaves[0].AccumulatedCount)
+}
+
+func TestAccumulatePassesQuantityThroughUncountedNodes(t *testing.T) {
+	tree := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{Id: "frame", Count: 2, Leaves: []*proto.TreeNode{
+				{Id: "section", AccumulatedCount: 9, Leaves: []*proto.TreeNode{
+					{Id: "rib", Count: 3},
+				}},
+			}},
+		},
+	}
+	require.NoError(t, Accumulate(tree))
+
+	section := tree.Leaves[0].Leaves[0]
+	assert.Equal(t, int32(2), section.AccumulatedCount)
+	assert.Equal(t, int32(6), section.Leaves[0].AccumulatedCount)
+}
+
+func TestAccumulateRejectsOverflow(t *testing.T) {
+	tree := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{Id: "frame", Count: 100000, Leaves: []*proto.TreeNode{
+				{Id: "bolt", Count: 100000},
+			}},
+		},
+	}
+	assert.ErrorIs(t, Accumulate(tree), ErrCountOverflow)
+}
+
+func TestTotals(t *testing.T) {
+	tree := testTree()
+	require.NoError(t, Accumulate(tree))
+
+	assert.Equal(t, []*proto.PartTotal{
+		{Designation: "23.00.27-ТХ.02.01.01.00.00", Number: "23.00.27-ТХ.02.01.01.00.00", Name: "кронштейн", Quantity: 2, Occurrences: 1, NodeIds: []string{"bracket"}},
+		{Designation: "23.00.27-ТХ.02.01.01.00.01", Number: "23.00.27-ТХ.02.01.01.00.01", Name: "плита", Quantity: 7, Occurrences: 2, NodeIds: []string{"plate", "plate2"}},
+		{Designation: "23.00.27-ТХ.02.01.01.00.02-01", Number: "23.00.27-ТХ.02.01.01.00.02-01", Name: "прокладка", Quantity: 8, Occurrences: 1, NodeIds: []string{"gasket"}},
+	}, Totals(tree))
+}
+
+func TestPartDesignation(t *testing.T) {
+	assert.Equal(t, "23.00.27-ТХ.02.01.05.00.00", PartDesignation(&proto.TreeNode{Number: "23.00.27ТХ.02.01.05.00,00.СБ"}))
+	assert.Equal(t, "БОЛТМ12", PartDesignation(&proto.TreeNode{Number: "Болт М12"}))
+	assert.Equal(t, "", PartDesignation(&proto.TreeNode{Number: " "}))
+}
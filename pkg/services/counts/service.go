@@ -0,0 +1,60 @@
+package counts
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ErrCountOverflow is returned when an accumulated count does not fit into the count field
+var ErrCountOverflow = errors.New("accumulated count exceeds the limit")
+
+// Service reports the part quantities of recognized trees
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+// AccumulateTask recomputes the accumulated counts of the recognized and the user-edited results of the task
+func AccumulateTask(task *proto.DataRecognitionTaskORM) error {
+	if task.RecognitionResult != nil {
+		tree := task.RecognitionResult.Data()
+		if err := Accumulate(&tree); err != nil {
+			return err
+		}
+		result := datatypes.NewJSONType(tree)
+		task.RecognitionResult = &result
+	}
+	if task.FrontendResult != nil {
+		tree := task.FrontendResult.Data()
+		if err := Accumulate(&tree); err != nil {
+			return err
+		}
+		result := datatypes.NewJSONType(tree)
+		task.FrontendResult = &result
+	}
+
+	return nil
+}
+
+// TaskPartTotals returns the part quantities of the current tree of a completed task of the client
+func (s *Service) TaskPartTotals(ctx context.Context, clientID uint64, taskID string) (*proto.PartTotals, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := types.TaskTree(task)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.PartTotals{TaskId: task.Id, Parts: Totals(tree)}, nil
+}
@@ -0,0 +1,179 @@
+package dataset
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ManifestPath is the path of the manifest in the archive
+const ManifestPath = "manifest.json"
+
+// Storage reads the task images and stores the archives, removing those left incomplete
+type Storage interface {
+	GetObject(ctx context.Context, key string) (io.ReadCloser, string, error)
+	UploadImage(ctx context.Context, key string, reader io.Reader) error
+	DeleteObject(ctx context.Context, key string) error
+}
+
+// Task is a selected task with its provenance not stored in the task itself
+type Task struct {
+	*proto.DataRecognitionTaskORM
+	Consent proto.TrainingDataConsent
+	// latest revision of the frontend result
+	Revision int32
+}
+
+// MarshalManifest encodes the manifest as indented JSON with the proto field names
+func MarshalManifest(manifest *proto.DatasetManifest) ([]byte, error) {
+	return protojson.MarshalOptions{Multiline: true, UseProtoNames: true}.Marshal(manifest)
+}
+
+// Archive writes every task to a directory of the zip archive with its source and processed images,
+// final tree as annotation.json and node image links as image_links.json. The manifest is written last
+// with the task entries appended to it.
+func Archive(ctx context.Context, storage Storage, w io.Writer, manifest *proto.DatasetManifest, tasks []*Task) error {
+	zw := zip.NewWriter(w)
+
+	for _, task := range tasks {
+		entry, err := archiveTask(ctx, storage, zw, task)
+		if err != nil {
+			return fmt.Errorf("task %s: %w", task.Id, err)
+		}
+		manifest.Tasks = append(manifest.Tasks, entry)
+	}
+
+	data, err := MarshalManifest(manifest)
+	if err != nil {
+		return err
+	}
+	if _, err := writeFile(zw, ManifestPath, bytes.NewReader(data)); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func archiveTask(ctx context.Context, storage Storage, zw *zip.Writer, task *Task) (*proto.DatasetTask, error) {
+	tree, err := types.TaskTree(task.DataRecognitionTaskORM)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := "tasks/" + task.Id
+	entry := &proto.DatasetTask{
+		TaskId:       task.Id,
+		Consent:      task.Consent,
+		WorkerId:     task.WorkerId,
+		ModelVersion: task.ModelVersion,
+		Revision:     task.Revision,
+	}
+	if task.ClientId != nil {
+		entry.ClientId = *task.ClientId
+	}
+	if task.CreatedAt != nil {
+		entry.CreatedAt = timestamppb.New(*task.CreatedAt)
+	}
+	if task.ApprovedAt != nil {
+		entry.ApprovedAt = timestamppb.New(*task.ApprovedAt)
+	}
+
+	// node image ids are matched by the storage key or its file name, processed images first
+	paths := map[string]string{}
+	if entry.SourceImages, err = archiveImages(ctx, storage, zw, dir+"/source", task.SourceImages, paths); err != nil {
+		return nil, err
+	}
+	if entry.ProcessedImages, err = archiveImages(ctx, storage, zw, dir+"/processed", task.ProcessedImages, paths); err != nil {
+		return nil, err
+	}
+
+	if entry.Annotation, err = writeJSON(zw, dir+"/annotation.json", tree); err != nil {
+		return nil, err
+	}
+	if entry.ImageLinks, err = writeJSON(zw, dir+"/image_links.json", imageLinks(tree, paths)); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func archiveImages(ctx context.Context, storage Storage, zw *zip.Writer, dir string, keys []string, paths map[string]string) ([]*proto.DatasetFile, error) {
+	files := make([]*proto.DatasetFile, 0, len(keys))
+	for i, key := range keys {
+		reader, _, err := storage.GetObject(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("image %s: %w", key, err)
+		}
+		file, err := writeFile(zw, fmt.Sprintf("%s/%03d_%s", dir, i+1, path.Base(key)), reader)
+		reader.Close()
+		if err != nil {
+			return nil, fmt.Errorf("image %s: %w", key, err)
+		}
+		file.SourceKey = key
+		files = append(files, file)
+
+		paths[key], paths[path.Base(key)] = file.Path, file.Path
+	}
+
+	return files, nil
+}
+
+// imageLinks lists the image ids of the spec rows and figures of the tree nodes in pre-order
+func imageLinks(tree *proto.TreeNode, paths map[string]string) []*proto.DatasetImageLink {
+	links := []*proto.DatasetImageLink{}
+
+	var walk func(node *proto.TreeNode)
+	walk = func(node *proto.TreeNode) {
+		var ids []string
+		if node.Spec != nil && node.Spec.ImageId != "" {
+			ids = append(ids, node.Spec.ImageId)
+		}
+		if node.Figure != nil && node.Figure.ImageId != "" && (len(ids) == 0 || ids[0] != node.Figure.ImageId) {
+			ids = append(ids, node.Figure.ImageId)
+		}
+		for _, id := range ids {
+			links = append(links, &proto.DatasetImageLink{NodeId: node.Id, ImageId: id, Path: paths[id]})
+		}
+
+		for _, leaf := range node.Leaves {
+			walk(leaf)
+		}
+	}
+	walk(tree)
+
+	return links
+}
+
+func writeJSON(zw *zip.Writer, name string, value any) (*proto.DatasetFile, error) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return writeFile(zw, name, bytes.NewReader(data))
+}
+
+func writeFile(zw *zip.Writer, name string, reader io.Reader) (*proto.DatasetFile, error) {
+	w, err := zw.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(w, hash), reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.DatasetFile{Path: name, Sha256: hex.EncodeToString(hash.Sum(nil)), Size: size}, nil
+}
@@ -0,0 +1,141 @@
+package dataset_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/dataset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/datatypes"
+)
+
+type memoryStorage map[string][]byte
+
+func (s memoryStorage) GetObject(_ context.Context, key string) (io.ReadCloser, string, error) {
+	data, ok := s[key]
+	if !ok {
+		return nil, "", errors.New("NoSuchKey")
+	}
+	return io.NopCloser(bytes.NewReader(data)), "image/jpeg", nil
+}
+
+func (s memoryStorage) UploadImage(_ context.Context, key string, reader io.Reader) error {
+	data, err := io.ReadAll(reader)
+	s[key] = data
+	return err
+}
+
+func (s memoryStorage) DeleteObject(_ context.Context, key string) error {
+	delete(s, key)
+	return nil
+}
+
+func readArchive(t *testing.T, data []byte) map[string][]byte {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+	for _, file := range reader.File {
+		r, err := file.Open()
+		require.NoError(t, err)
+		files[file.Name], err = io.ReadAll(r)
+		require.NoError(t, err)
+		r.Close()
+	}
+	return files
+}
+
+func testTask() *dataset.Task {
+	clientID := uint64(7)
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	recognized := datatypes.NewJSONType(proto.TreeNode{Id: "root"})
+	final := datatypes.NewJSONType(proto.TreeNode{
+		Id:     "root",
+		Number: "СФ-01.00.000",
+		Leaves: []*proto.TreeNode{
+			{Id: "plate", Number: "СФ-01.00.001", Count: 6, Spec: &proto.SpecificationRow{ImageId: "crop-1.png"}},
+			{Id: "bolt", Figure: &proto.Figure{ImageId: "elsewhere.png"}},
+		},
+	})
+
+	return &dataset.Task{
+		DataRecognitionTaskORM: &proto.DataRecognitionTaskORM{
+			Id:                "3f1b6c1e-6a47-4f3c-9d3e-2f0c8a1d5b10",
+			ClientId:          &clientID,
+			WorkerId:          "worker-1",
+			ModelVersion:      "v3",
+			SourceImages:      []string{"images/7/task/sheet.jpg"},
+			ProcessedImages:   []string{"processed/7/task/crop-1.png"},
+			RecognitionResult: &recognized,
+			FrontendResult:    &final,
+			CreatedAt:         &now,
+			ApprovedAt:        &now,
+		},
+		Consent:  proto.TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_IN,
+		Revision: 3,
+	}
+}
+
+func TestArchive(t *testing.T) {
+	storage := memoryStorage{
+		"images/7/task/sheet.jpg":     []byte("sheet"),
+		"processed/7/task/crop-1.png": []byte("crop"),
+	}
+	manifest := &proto.DatasetManifest{Name: "sferra", Version: 2}
+
+	var buf bytes.Buffer
+	require.NoError(t, dataset.Archive(context.Background(), storage, &buf, manifest, []*dataset.Task{testTask()}))
+	files := readArchive(t, buf.Bytes())
+
+	dir := "tasks/3f1b6c1e-6a47-4f3c-9d3e-2f0c8a1d5b10/"
+	assert.Equal(t, []byte("sheet"), files[dir+"source/001_sheet.jpg"])
+	assert.Equal(t, []byte("crop"), files[dir+"processed/001_crop-1.png"])
+
+	var tree proto.TreeNode
+	require.NoError(t, json.Unmarshal(files[dir+"annotation.json"], &tree))
+	assert.Equal(t, int32(6), tree.Leaves[0].Count)
+
+	var links []*proto.DatasetImageLink
+	require.NoError(t, json.Unmarshal(files[dir+"image_links.json"], &links))
+	require.Len(t, links, 2)
+	assert.Equal(t, "plate", links[0].NodeId)
+	assert.Equal(t, dir+"processed/001_crop-1.png", links[0].Path)
+	assert.Equal(t, "bolt", links[1].NodeId)
+	assert.Empty(t, links[1].Path)
+
+	var decoded proto.DatasetManifest
+	require.NoError(t, protojson.Unmarshal(files[dataset.ManifestPath], &decoded))
+	assert.Equal(t, "sferra", decoded.Name)
+	require.Len(t, decoded.Tasks, 1)
+	task := decoded.Tasks[0]
+	assert.Equal(t, uint64(7), task.ClientId)
+	assert.Equal(t, "v3", task.ModelVersion)
+	assert.Equal(t, int32(3), task.Revision)
+	assert.Equal(t, proto.TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_IN, task.Consent)
+	assert.Equal(t, "images/7/task/sheet.jpg", task.SourceImages[0].SourceKey)
+
+	// the manifest hashes match the archived files
+	for _, file := range []*proto.DatasetFile{task.SourceImages[0], task.ProcessedImages[0], task.Annotation, task.ImageLinks} {
+		sum := sha256.Sum256(files[file.Path])
+		assert.Equal(t, hex.EncodeToString(sum[:]), file.Sha256, file.Path)
+		assert.Equal(t, int64(len(files[file.Path])), file.Size, file.Path)
+	}
+}
+
+func TestArchiveMissingImage(t *testing.T) {
+	manifest := &proto.DatasetManifest{Name: "sferra", Version: 1}
+
+	err := dataset.Archive(context.Background(), memoryStorage{}, io.Discard, manifest, []*dataset.Task{testTask()})
+	assert.ErrorContains(t, err, "images/7/task/sheet.jpg")
+}
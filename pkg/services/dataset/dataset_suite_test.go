@@ -0,0 +1,36 @@
+package dataset_test
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	ctx             context.Context
+	testDBContainer *testutils.TestDBContainer
+	DB              *gorm.DB
+)
+
+func TestDatasets(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Datasets Suite")
+}
+
+var _ = BeforeSuite(func() {
+	ctx = context.Background()
+
+	var err error
+	testDBContainer, DB, err = testutils.StartTestDB(ctx)
+	Expect(err).NotTo(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	err := testutils.StopTestDBContainer(ctx, testDBContainer)
+	Expect(err).NotTo(HaveOccurred())
+	DB = nil
+})
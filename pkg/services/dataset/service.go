@@ -0,0 +1,333 @@
+package dataset
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	ExportInterval = 10 * time.Second
+
+	// keyPrefix is the storage prefix of the dataset archives
+	keyPrefix = "datasets"
+)
+
+var (
+	ErrInvalidName    = errors.New("dataset name must consist of lowercase latin letters, digits, dashes and underscores")
+	ErrInvalidTaskID  = errors.New("invalid task id")
+	ErrExportNotFound = errors.New("dataset export not found")
+)
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Service packages the completed tasks of the consenting clients into versioned training datasets
+type Service struct {
+	db      *gorm.DB
+	storage Storage
+}
+
+func NewService(db *gorm.DB, storage Storage) *Service {
+	return &Service{db: db, storage: storage}
+}
+
+// Create queues an export of the selected tasks as the next version of the named dataset
+func (s *Service) Create(ctx context.Context, name, createdBy, comment string, selection *proto.DatasetSelection) (*proto.DatasetExportORM, error) {
+	if !namePattern.MatchString(name) {
+		return nil, ErrInvalidName
+	}
+	for _, id := range selection.TaskIds {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTaskID, id)
+		}
+	}
+
+	value, err := types.JSONValue(selection)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	export := &proto.DatasetExportORM{
+		Name:      name,
+		Status:    int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_PENDING),
+		CreatedBy: createdBy,
+		Comment:   comment,
+		Selection: value,
+		CreatedAt: &now,
+	}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&proto.DatasetExportORM{}).Where("name = ?", name).
+			Select("COALESCE(MAX(version), 0) + 1").Scan(&export.Version).Error; err != nil {
+			return err
+		}
+		return tx.Create(export).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// Run exports the queued datasets every ExportInterval until ctx is done. Exports left running by a
+// stopped server are failed first.
+func (s *Service) Run(ctx context.Context) {
+	err := s.db.WithContext(ctx).Model(&proto.DatasetExportORM{}).
+		Where("status = ?", int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_RUNNING)).
+		Updates(map[string]interface{}{
+			"status":      int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_FAILED),
+			"error":       "interrupted",
+			"finished_at": time.Now(),
+		}).Error
+	if err != nil {
+		log.Printf("datasets: %v", err)
+	}
+
+	ticker := time.NewTicker(ExportInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			exported, err := s.ExportNext(ctx)
+			if err != nil {
+				log.Printf("datasets: %v", err)
+			}
+			if !exported {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExportNext runs the oldest queued export, reporting whether there was one. A failed export is
+// saved with its error.
+func (s *Service) ExportNext(ctx context.Context) (bool, error) {
+	var export proto.DatasetExportORM
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ?", int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_PENDING)).
+			Order("id").First(&export).Error
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		export.Status, export.StartedAt = int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_RUNNING), &now
+		return tx.Model(&export).Select("status", "started_at").Updates(&export).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	exportErr := s.export(ctx, &export)
+	now := time.Now()
+	export.FinishedAt = &now
+	export.Status = int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_COMPLETED)
+	if exportErr != nil {
+		export.Status = int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_FAILED)
+		export.Error = exportErr.Error()
+	}
+	if err := s.db.WithContext(ctx).Save(&export).Error; err != nil {
+		return true, err
+	}
+
+	return true, exportErr
+}
+
+// Open reads a file of a completed export, the archive or its manifest
+func (s *Service) Open(ctx context.Context, export *proto.DatasetExportORM, manifest bool) (io.ReadCloser, error) {
+	key := export.ArchiveKey
+	if manifest {
+		key = export.ManifestKey
+	}
+	if export.Status != int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_COMPLETED) || key == "" {
+		return nil, ErrExportNotFound
+	}
+
+	reader, _, err := s.storage.GetObject(ctx, key)
+	return reader, err
+}
+
+func (s *Service) export(ctx context.Context, export *proto.DatasetExportORM) error {
+	var selection proto.DatasetSelection
+	if export.Selection != nil {
+		data, err := export.Selection.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &selection); err != nil {
+			return err
+		}
+	}
+
+	tasks, excluded, err := s.selectTasks(ctx, &selection)
+	if err != nil {
+		return err
+	}
+
+	manifest := &proto.DatasetManifest{
+		Name:              export.Name,
+		Version:           export.Version,
+		ExportId:          export.Id,
+		CreatedBy:         export.CreatedBy,
+		Comment:           export.Comment,
+		Selection:         &selection,
+		ExcludedTaskCount: excluded,
+	}
+	if export.CreatedAt != nil {
+		manifest.CreatedAt = timestamppb.New(*export.CreatedAt)
+	}
+
+	file, err := os.CreateTemp("", "dataset-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	hash := sha256.New()
+	if err := Archive(ctx, s.storage, io.MultiWriter(file, hash), manifest, tasks); err != nil {
+		return err
+	}
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	data, err := MarshalManifest(manifest)
+	if err != nil {
+		return err
+	}
+	prefix := fmt.Sprintf("%s/%s/v%d/", keyPrefix, export.Name, export.Version)
+	if err := s.storage.UploadImage(ctx, prefix+"dataset.zip", file); err != nil {
+		return err
+	}
+	if err := s.storage.UploadImage(ctx, prefix+ManifestPath, bytes.NewReader(data)); err != nil {
+		// an archive without its manifest is not a dataset, remove it even when the export is interrupted
+		if err := s.storage.DeleteObject(context.WithoutCancel(ctx), prefix+"dataset.zip"); err != nil {
+			log.Printf("datasets: failed to delete the archive of export %d: %v", export.Id, err)
+		}
+		return err
+	}
+
+	export.ArchiveKey, export.ManifestKey = prefix+"dataset.zip", prefix+ManifestPath
+	export.ArchiveSha256, export.ArchiveSize = hex.EncodeToString(hash.Sum(nil)), size
+	export.TaskCount, export.ExcludedTaskCount = int32(len(tasks)), excluded
+	for _, task := range manifest.Tasks {
+		export.ImageCount += int32(len(task.SourceImages) + len(task.ProcessedImages))
+	}
+
+	return nil
+}
+
+// selectTasks loads the completed non-sandbox tasks matching the selection of the clients consenting to
+// training, the oldest first, and counts the matching tasks excluded for consent
+func (s *Service) selectTasks(ctx context.Context, selection *proto.DatasetSelection) ([]*Task, int32, error) {
+	matching := func() *gorm.DB {
+		query := s.db.WithContext(ctx).Model(&proto.DataRecognitionTaskORM{}).
+			Where("status = ? AND sandbox = ?", int32(proto.Status_STATUS_PROCESSING_COMPLETED), false).
+			Where("recognition_result IS NOT NULL")
+		if len(selection.ClientIds) > 0 {
+			query = query.Where("client_id IN ?", selection.ClientIds)
+		}
+		if len(selection.TaskIds) > 0 {
+			query = query.Where("id IN ?", selection.TaskIds)
+		}
+		if selection.ApprovedOnly {
+			query = query.Where("approved_at IS NOT NULL")
+		}
+		if selection.Since != nil {
+			query = query.Where("created_at >= ?", selection.Since.AsTime())
+		}
+		if selection.Until != nil {
+			query = query.Where("created_at < ?", selection.Until.AsTime())
+		}
+		return query
+	}
+
+	var clients []proto.ClientORM
+	if err := s.db.WithContext(ctx).Select("id", "training_data_consent").
+		Where("training_data_consent = ?", int32(proto.TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_IN)).Find(&clients).Error; err != nil {
+		return nil, 0, err
+	}
+	clientConsents := make(map[uint64]proto.TrainingDataConsent, len(clients))
+	clientIDs := make([]uint64, 0, len(clients))
+	for _, client := range clients {
+		clientConsents[client.Id] = proto.TrainingDataConsent(client.TrainingDataConsent)
+		clientIDs = append(clientIDs, client.Id)
+	}
+
+	var total, allowed int64
+	if err := matching().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := matching().Where("client_id IN ?", clientIDs).Count(&allowed).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var orms []*proto.DataRecognitionTaskORM
+	query := matching().Where("client_id IN ?", clientIDs).Order("created_at, id")
+	if selection.Limit > 0 {
+		query = query.Limit(int(selection.Limit))
+	}
+	if err := query.Find(&orms).Error; err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]string, 0, len(orms))
+	for _, task := range orms {
+		ids = append(ids, task.Id)
+	}
+	var revisions []struct {
+		TaskId   string
+		Revision int32
+	}
+	if err := s.db.WithContext(ctx).Model(&proto.TreeRevisionORM{}).Select("task_id, MAX(revision) AS revision").
+		Where("task_id IN ?", ids).Group("task_id").Scan(&revisions).Error; err != nil {
+		return nil, 0, err
+	}
+	latest := make(map[string]int32, len(revisions))
+	for _, revision := range revisions {
+		latest[revision.TaskId] = revision.Revision
+	}
+
+	tasks := make([]*Task, 0, len(orms))
+	for _, task := range orms {
+		tasks = append(tasks, &Task{
+			DataRecognitionTaskORM: task,
+			Consent:                clientConsents[*task.ClientId],
+			Revision:               latest[task.Id],
+		})
+	}
+
+	return tasks, int32(total - allowed), nil
+}
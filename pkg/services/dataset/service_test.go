@@ -0,0 +1,180 @@
+package dataset_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/dataset"
+	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/datatypes"
+)
+
+// manifestlessStorage fails to store manifests
+type manifestlessStorage struct {
+	memoryStorage
+}
+
+func (s manifestlessStorage) UploadImage(ctx context.Context, key string, reader io.Reader) error {
+	if strings.HasSuffix(key, dataset.ManifestPath) {
+		return errors.New("upload failed")
+	}
+	return s.memoryStorage.UploadImage(ctx, key, reader)
+}
+
+var _ = Describe("Service", func() {
+	var (
+		storage memoryStorage
+		service *dataset.Service
+		tasks   map[proto.TrainingDataConsent]string
+	)
+
+	createClient := func(name string, consent proto.TrainingDataConsent) uint64 {
+		client, err := testutils.CreateTestClient(DB, name, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(DB.Model(&proto.ClientORM{}).Where("id = ?", client.Id).
+			Update("training_data_consent", int32(consent)).Error).NotTo(HaveOccurred())
+		return client.Id
+	}
+
+	createTask := func(clientID uint64, status proto.Status) string {
+		result := datatypes.NewJSONType(proto.TreeNode{Id: "root", Leaves: []*proto.TreeNode{{Id: "plate", Count: 2}}})
+		now := time.Now()
+		task := &proto.DataRecognitionTaskORM{
+			Id:                uuid.New().String(),
+			ClientId:          &clientID,
+			Status:            int32(status),
+			ModelVersion:      "v1",
+			SourceImages:      []string{"images/" + uuid.New().String() + ".jpg"},
+			RecognitionResult: &result,
+			CreatedAt:         &now,
+			UpdatedAt:         &now,
+		}
+		Expect(DB.Create(task).Error).NotTo(HaveOccurred())
+		storage[task.SourceImages[0]] = []byte("image")
+		return task.Id
+	}
+
+	export := func(selection *proto.DatasetSelection) (*proto.DatasetExportORM, *proto.DatasetManifest) {
+		created, err := service.Create(ctx, "sferra", "admin@example.com", "", selection)
+		Expect(err).NotTo(HaveOccurred())
+
+		exported, err := service.ExportNext(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exported).To(BeTrue())
+
+		var result proto.DatasetExportORM
+		Expect(DB.First(&result, created.Id).Error).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_COMPLETED)), result.Error)
+
+		archive := storage[result.ArchiveKey]
+		reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reader.File).NotTo(BeEmpty())
+
+		manifest := &proto.DatasetManifest{}
+		Expect(protojson.Unmarshal(storage[result.ManifestKey], manifest)).To(Succeed())
+		return &result, manifest
+	}
+
+	BeforeEach(func() {
+		testutils.ClearDatabase(DB)
+		storage = memoryStorage{}
+		service = dataset.NewService(DB, storage)
+
+		tasks = map[proto.TrainingDataConsent]string{}
+		for consent, name := range map[proto.TrainingDataConsent]string{
+			proto.TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_IN:    "Opted In",
+			proto.TrainingDataConsent_TRAINING_DATA_CONSENT_UNDECIDED: "Undecided",
+			proto.TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_OUT:   "Opted Out",
+		} {
+			clientID := createClient(name, consent)
+			tasks[consent] = createTask(clientID, proto.Status_STATUS_PROCESSING_COMPLETED)
+			createTask(clientID, proto.Status_STATUS_RECOGNITION_PROCESSING)
+		}
+	})
+
+	It("should export the completed tasks of the opted in clients", func() {
+		result, manifest := export(&proto.DatasetSelection{})
+
+		Expect(result.Version).To(Equal(int32(1)))
+		Expect(result.TaskCount).To(Equal(int32(1)))
+		Expect(result.ImageCount).To(Equal(int32(1)))
+		Expect(result.ExcludedTaskCount).To(Equal(int32(2)))
+		Expect(result.ArchiveSha256).To(HaveLen(64))
+
+		Expect(manifest.Name).To(Equal("sferra"))
+		Expect(manifest.ExportId).To(Equal(result.Id))
+		Expect(manifest.CreatedBy).To(Equal("admin@example.com"))
+		Expect(manifest.Tasks).To(HaveLen(1))
+		Expect(manifest.Tasks[0].TaskId).To(Equal(tasks[proto.TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_IN]))
+		Expect(manifest.Tasks[0].ModelVersion).To(Equal("v1"))
+	})
+
+	It("should never export undecided or opted out clients", func() {
+		selected := []string{
+			tasks[proto.TrainingDataConsent_TRAINING_DATA_CONSENT_UNDECIDED],
+			tasks[proto.TrainingDataConsent_TRAINING_DATA_CONSENT_OPT_OUT],
+		}
+		result, manifest := export(&proto.DatasetSelection{TaskIds: selected})
+
+		Expect(result.TaskCount).To(BeZero())
+		Expect(result.ExcludedTaskCount).To(Equal(int32(2)))
+		Expect(manifest.Tasks).To(BeEmpty())
+
+		// the next export of the name is a new version
+		result, _ = export(&proto.DatasetSelection{ApprovedOnly: true})
+		Expect(result.Version).To(Equal(int32(2)))
+		Expect(result.TaskCount).To(BeZero())
+	})
+
+	It("should fail exports with missing images", func() {
+		storage = memoryStorage{}
+		service = dataset.NewService(DB, storage)
+		_, err := service.Create(ctx, "sferra", "", "", &proto.DatasetSelection{})
+		Expect(err).NotTo(HaveOccurred())
+
+		exported, err := service.ExportNext(ctx)
+		Expect(exported).To(BeTrue())
+		Expect(err).To(HaveOccurred())
+
+		var result proto.DatasetExportORM
+		Expect(DB.First(&result).Error).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(int32(proto.DatasetExportStatus_DATASET_EXPORT_STATUS_FAILED)))
+		Expect(result.Error).NotTo(BeEmpty())
+
+		exported, err = service.ExportNext(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exported).To(BeFalse())
+	})
+
+	It("should remove the archive when the manifest is not stored", func() {
+		service = dataset.NewService(DB, manifestlessStorage{storage})
+		_, err := service.Create(ctx, "sferra", "", "", &proto.DatasetSelection{})
+		Expect(err).NotTo(HaveOccurred())
+
+		exported, err := service.ExportNext(ctx)
+		Expect(exported).To(BeTrue())
+		Expect(err).To(HaveOccurred())
+		for key := range storage {
+			Expect(key).NotTo(HaveSuffix("dataset.zip"))
+		}
+	})
+
+	It("should reject invalid names and task ids", func() {
+		_, err := service.Create(ctx, "Sferra Dataset", "", "", &proto.DatasetSelection{})
+		Expect(err).To(MatchError(dataset.ErrInvalidName))
+
+		_, err = service.Create(ctx, "sferra", "", "", &proto.DatasetSelection{TaskIds: []string{"unknown"}})
+		Expect(err).To(MatchError(dataset.ErrInvalidTaskID))
+	})
+})
@@ -0,0 +1,201 @@
+package editor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/counts"
+	"github.com/bazilio91/sferra-cloud/pkg/services/revision"
+	"github.com/bazilio91/sferra-cloud/pkg/services/validation"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	gormtypes "github.com/infobloxopen/protoc-gen-gorm/types"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Edit changes the tree, returning the edited tree, which may be a new one
+type Edit func(tree *proto.TreeNode) (*proto.TreeNode, error)
+
+// Change describes an edit of the tree of a task by a user, recorded in the revision history
+type Change struct {
+	ClientID uint64
+	UserID   uint64
+	TaskID   string
+	// action of the revision, e.g. update_node
+	Action     string
+	RevertedTo *int32
+}
+
+// Service applies user edits to the frontend result of tasks
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+// SyncFlat rebuilds the flat frontend result of the task from its current tree
+func SyncFlat(task *proto.DataRecognitionTaskORM) error {
+	tree, err := types.TaskTree(task)
+	if errors.Is(err, types.ErrNoRecognizedTree) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	flat, err := json.Marshal(types.FlattenTree(*tree))
+	if err != nil {
+		return err
+	}
+	task.FrontendResultFlat = &gormtypes.Jsonb{RawMessage: flat}
+	return nil
+}
+
+// Tree returns the current tree of a completed task of the client: the frontend result once edited,
+// the recognized tree before
+func (s *Service) Tree(ctx context.Context, clientID uint64, taskID string) (*proto.TreeNode, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.TaskTree(task)
+}
+
+// clone returns a deep copy of the tree
+func clone(tree *proto.TreeNode) (*proto.TreeNode, error) {
+	data, err := json.Marshal(tree)
+	if err != nil {
+		return nil, err
+	}
+
+	var result proto.TreeNode
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Edit applies the edit to the current tree of a completed task of the client and stores the result as the
+// frontend result. The edited tree is checked for a valid structure, its accumulated counts are recomputed,
+// it is validated with the client's rules and the flat frontend result is rebuilt from it. The result is
+// recorded as a revision of the task unless the tree did not change.
+func (s *Service) Edit(ctx context.Context, change *Change, edit Edit) (*proto.TreeNode, error) {
+	var edited *proto.TreeNode
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the lock keeps concurrent edits from overwriting each other
+		task, err := types.CompletedTask(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), change.ClientID, change.TaskID)
+		if err != nil {
+			return err
+		}
+		tree, err := types.TaskTree(task)
+		if err != nil {
+			return err
+		}
+		before, err := clone(tree)
+		if err != nil {
+			return err
+		}
+
+		edited, err = edit(tree)
+		if err != nil {
+			return err
+		}
+		if err := Check(edited); err != nil {
+			return err
+		}
+		LinkParents(edited)
+		if err := counts.Accumulate(edited); err != nil {
+			return err
+		}
+		if err := validation.NewService(tx).Apply(ctx, change.ClientID, task.Id, edited); err != nil {
+			return err
+		}
+		if _, err := revision.Record(ctx, tx, task.Id, change.UserID, change.Action, before, edited, change.RevertedTo); err != nil {
+			return err
+		}
+
+		result := datatypes.NewJSONType(*edited)
+		task.FrontendResult = &result
+		if err := SyncFlat(task); err != nil {
+			return err
+		}
+		now := time.Now()
+		task.UpdatedAt = &now
+
+		return tx.Model(task).Select("frontend_result", "frontend_result_flat", "updated_at").Updates(task).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return edited, nil
+}
+
+// AddNode attaches a new node to a node of the task tree
+func (s *Service) AddNode(ctx context.Context, clientID, userID uint64, taskID, parentID string, index *int, node *proto.TreeNode) (*proto.TreeNode, error) {
+	change := &Change{ClientID: clientID, UserID: userID, TaskID: taskID, Action: revision.ActionAddNode}
+	return s.Edit(ctx, change, func(tree *proto.TreeNode) (*proto.TreeNode, error) {
+		return tree, AddNode(tree, parentID, index, node)
+	})
+}
+
+// MoveNode attaches a node of the task tree to another node
+func (s *Service) MoveNode(ctx context.Context, clientID, userID uint64, taskID, nodeID, parentID string, index *int) (*proto.TreeNode, error) {
+	change := &Change{ClientID: clientID, UserID: userID, TaskID: taskID, Action: revision.ActionMoveNode}
+	return s.Edit(ctx, change, func(tree *proto.TreeNode) (*proto.TreeNode, error) {
+		return tree, MoveNode(tree, nodeID, parentID, index)
+	})
+}
+
+// DeleteNode removes a node of the task tree with its subtree
+func (s *Service) DeleteNode(ctx context.Context, clientID, userID uint64, taskID, nodeID string) (*proto.TreeNode, error) {
+	change := &Change{ClientID: clientID, UserID: userID, TaskID: taskID, Action: revision.ActionDeleteNode}
+	return s.Edit(ctx, change, func(tree *proto.TreeNode) (*proto.TreeNode, error) {
+		return tree, DeleteNode(tree, nodeID)
+	})
+}
+
+// UpdateNode sets fields of a node of the task tree
+func (s *Service) UpdateNode(ctx context.Context, clientID, userID uint64, taskID, nodeID string, fields map[string]json.RawMessage) (*proto.TreeNode, error) {
+	change := &Change{ClientID: clientID, UserID: userID, TaskID: taskID, Action: revision.ActionUpdateNode}
+	return s.Edit(ctx, change, func(tree *proto.TreeNode) (*proto.TreeNode, error) {
+		return tree, UpdateNode(tree, nodeID, fields)
+	})
+}
+
+// Patch applies an RFC 6902 JSON Patch to the task tree
+func (s *Service) Patch(ctx context.Context, clientID, userID uint64, taskID string, patch []byte) (*proto.TreeNode, error) {
+	change := &Change{ClientID: clientID, UserID: userID, TaskID: taskID, Action: revision.ActionPatch}
+	return s.Edit(ctx, change, func(tree *proto.TreeNode) (*proto.TreeNode, error) {
+		return ApplyPatch(tree, patch)
+	})
+}
+
+// Revert restores the tree of a revision of the task, revision 0 restores the recognized tree.
+// The restored tree is stored as a new revision.
+func (s *Service) Revert(ctx context.Context, clientID, userID uint64, taskID string, number int32) (*proto.TreeNode, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := revision.TaskRevision(ctx, s.db, task, number)
+	if err != nil {
+		return nil, err
+	}
+	if target.Tree == nil {
+		return nil, revision.ErrRevisionNotFound
+	}
+	restored := target.Tree.Data()
+
+	change := &Change{ClientID: clientID, UserID: userID, TaskID: taskID, Action: revision.ActionRevert, RevertedTo: &number}
+	return s.Edit(ctx, change, func(tree *proto.TreeNode) (*proto.TreeNode, error) {
+		return &restored, nil
+	})
+}
@@ -0,0 +1,232 @@
+package editor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTree  = errors.New("invalid tree")
+	ErrInvalidEdit  = errors.New("invalid edit")
+	ErrNodeNotFound = errors.New("node not found")
+)
+
+// structuralFields are the node fields changed by adding, moving and deleting nodes only
+var structuralFields = map[string]bool{"id": true, "leaves": true, "parent_id": true}
+
+// Check validates the structure of the tree: every node has an id unique in the tree, appears in it once,
+// and its parent reference, when set, names the node it is attached to
+func Check(root *proto.TreeNode) error {
+	ids := make(map[string]bool)
+	visited := make(map[*proto.TreeNode]bool)
+	var check func(node, parent *proto.TreeNode) error
+	check = func(node, parent *proto.TreeNode) error {
+		if node == nil {
+			return fmt.Errorf("%w: empty node", ErrInvalidTree)
+		}
+		if visited[node] {
+			return fmt.Errorf("%w: node %q is its own ancestor or appears twice", ErrInvalidTree, node.Id)
+		}
+		visited[node] = true
+		if node.Id == "" {
+			return fmt.Errorf("%w: node without id", ErrInvalidTree)
+		}
+		if ids[node.Id] {
+			return fmt.Errorf("%w: duplicate node id %q", ErrInvalidTree, node.Id)
+		}
+		ids[node.Id] = true
+		if parent != nil && node.ParentId != "" && node.ParentId != parent.Id {
+			return fmt.Errorf("%w: node %q references parent %q but is attached to %q", ErrInvalidTree, node.Id, node.ParentId, parent.Id)
+		}
+		if parent == nil && node.ParentId != "" {
+			return fmt.Errorf("%w: root references parent %q", ErrInvalidTree, node.ParentId)
+		}
+
+		for _, leaf := range node.Leaves {
+			if err := check(leaf, node); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return check(root, nil)
+}
+
+// LinkParents sets the parent reference of every node to the node it is attached to
+func LinkParents(root *proto.TreeNode) {
+	for _, leaf := range root.Leaves {
+		leaf.ParentId = root.Id
+		LinkParents(leaf)
+	}
+}
+
+// find returns the node with the id and its parent, nil for the root
+func find(root *proto.TreeNode, id string) (*proto.TreeNode, *proto.TreeNode, error) {
+	if root.Id == id {
+		return root, nil, nil
+	}
+	var search func(node *proto.TreeNode) (*proto.TreeNode, *proto.TreeNode)
+	search = func(node *proto.TreeNode) (*proto.TreeNode, *proto.TreeNode) {
+		for _, leaf := range node.Leaves {
+			if leaf.Id == id {
+				return leaf, node
+			}
+			if found, parent := search(leaf); found != nil {
+				return found, parent
+			}
+		}
+		return nil, nil
+	}
+	if node, parent := search(root); node != nil {
+		return node, parent, nil
+	}
+
+	return nil, nil, fmt.Errorf("%w: %q", ErrNodeNotFound, id)
+}
+
+// insert attaches the node to the parent at the index, appending it for a nil or out of range index
+func insert(parent, node *proto.TreeNode, index *int) {
+	if index == nil || *index < 0 || *index >= len(parent.Leaves) {
+		parent.Leaves = append(parent.Leaves, node)
+		return
+	}
+
+	parent.Leaves = append(parent.Leaves[:*index], append([]*proto.TreeNode{node}, parent.Leaves[*index:]...)...)
+}
+
+// detach removes the node from the leaves of its parent
+func detach(parent, node *proto.TreeNode) {
+	for i, leaf := range parent.Leaves {
+		if leaf == node {
+			parent.Leaves = append(parent.Leaves[:i], parent.Leaves[i+1:]...)
+			return
+		}
+	}
+}
+
+// AddNode attaches a new node with its leaves to the parent at the index, appending it when the index is nil.
+// Nodes without an id get a generated one.
+func AddNode(root *proto.TreeNode, parentID string, index *int, node *proto.TreeNode) error {
+	parent, _, err := find(root, parentID)
+	if err != nil {
+		return err
+	}
+	var assign func(node *proto.TreeNode)
+	assign = func(node *proto.TreeNode) {
+		if node.Id == "" {
+			node.Id = uuid.New().String()
+		}
+		for _, leaf := range node.Leaves {
+			assign(leaf)
+		}
+	}
+	assign(node)
+	node.ParentId = parent.Id
+
+	insert(parent, node, index)
+	return nil
+}
+
+// MoveNode attaches the node to another parent, or to another position of the same parent, at the index.
+// The root cannot be moved and a node cannot be moved into its own subtree.
+func MoveNode(root *proto.TreeNode, nodeID, parentID string, index *int) error {
+	node, current, err := find(root, nodeID)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("%w: the root cannot be moved", ErrInvalidEdit)
+	}
+	if _, _, err := find(node, parentID); err == nil {
+		return fmt.Errorf("%w: node %q cannot be moved into its own subtree", ErrInvalidEdit, nodeID)
+	}
+	parent, _, err := find(root, parentID)
+	if err != nil {
+		return err
+	}
+
+	detach(current, node)
+	node.ParentId = parent.Id
+	insert(parent, node, index)
+	return nil
+}
+
+// DeleteNode removes the node with its subtree. The root cannot be deleted.
+func DeleteNode(root *proto.TreeNode, nodeID string) error {
+	node, parent, err := find(root, nodeID)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return fmt.Errorf("%w: the root cannot be deleted", ErrInvalidEdit)
+	}
+
+	detach(parent, node)
+	return nil
+}
+
+// UpdateNode sets the fields of the node given as a JSON object keyed by the JSON field names, other fields
+// are kept. The id, leaves and parent reference change with the structural edits only.
+func UpdateNode(root *proto.TreeNode, nodeID string, fields map[string]json.RawMessage) error {
+	node, _, err := find(root, nodeID)
+	if err != nil {
+		return err
+	}
+	for name := range fields {
+		if structuralFields[name] {
+			return fmt.Errorf("%w: field %q cannot be updated", ErrInvalidEdit, name)
+		}
+	}
+
+	current, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(current, &merged); err != nil {
+		return err
+	}
+	for name, value := range fields {
+		merged[name] = value
+	}
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+
+	var updated proto.TreeNode
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEdit, err)
+	}
+	updated.Id, updated.ParentId, updated.Leaves = node.Id, node.ParentId, node.Leaves
+	*node = updated
+	return nil
+}
+
+// ApplyPatch applies an RFC 6902 JSON Patch to the JSON document of the tree and returns the patched tree
+func ApplyPatch(root *proto.TreeNode, patch []byte) (*proto.TreeNode, error) {
+	operations, err := jsonpatch.DecodePatch(patch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEdit, err)
+	}
+	document, err := json.Marshal(root)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := operations.Apply(document)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEdit, err)
+	}
+
+	var tree proto.TreeNode
+	if err := json.Unmarshal(patched, &tree); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEdit, err)
+	}
+	return &tree, nil
+}
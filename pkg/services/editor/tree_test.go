@@ -0,0 +1,147 @@
+package editor
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTree() *proto.TreeNode {
+	return &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{
+				Id: "frame", Number: "СФ-01.00.000", Count: 1,
+				Leaves: []*proto.TreeNode{
+					{Id: "plate", Number: "СФ-01.00.001", Count: 4, Material: "Лист 10/Ст3"},
+					{Id: "rib", Number: "СФ-01.00.002", Count: 2},
+				},
+			},
+			{Id: "bracket", Number: "СФ-02.00.000", Count: 1},
+		},
+	}
+}
+
+func ids(nodes []*proto.TreeNode) []string {
+	var result []string
+	for _, node := range nodes {
+		result = append(result, node.Id)
+	}
+	return result
+}
+
+func TestCheck(t *testing.T) {
+	tree := testTree()
+	require.NoError(t, Check(tree))
+
+	LinkParents(tree)
+	assert.Equal(t, "frame", tree.Leaves[0].Leaves[1].ParentId)
+	require.NoError(t, Check(tree))
+
+	duplicate := testTree()
+	duplicate.Leaves[1].Id = "plate"
+	assert.ErrorIs(t, Check(duplicate), ErrInvalidTree)
+
+	wrongParent := testTree()
+	wrongParent.Leaves[0].Leaves[0].ParentId = "bracket"
+	assert.ErrorIs(t, Check(wrongParent), ErrInvalidTree)
+
+	cycle := testTree()
+	cycle.Leaves[0].Leaves[0].Leaves = []*proto.TreeNode{cycle.Leaves[0]}
+	assert.ErrorIs(t, Check(cycle), ErrInvalidTree)
+
+	withoutID := testTree()
+	withoutID.Leaves[1].Id = ""
+	assert.ErrorIs(t, Check(withoutID), ErrInvalidTree)
+}
+
+func TestAddNode(t *testing.T) {
+	tree := testTree()
+	index := 1
+	node := &proto.TreeNode{Name: "косынка", Leaves: []*proto.TreeNode{{Name: "пластина"}}}
+	require.NoError(t, AddNode(tree, "frame", &index, node))
+
+	frame := tree.Leaves[0]
+	require.Len(t, frame.Leaves, 3)
+	assert.Same(t, node, frame.Leaves[1])
+	assert.NotEmpty(t, node.Id)
+	assert.NotEmpty(t, node.Leaves[0].Id)
+	assert.Equal(t, "frame", node.ParentId)
+	require.NoError(t, Check(tree))
+
+	require.NoError(t, AddNode(tree, "root", nil, &proto.TreeNode{Id: "tail"}))
+	assert.Equal(t, "tail", tree.Leaves[2].Id)
+	assert.ErrorIs(t, AddNode(tree, "lost", nil, &proto.TreeNode{}), ErrNodeNotFound)
+}
+
+func TestMoveNode(t *testing.T) {
+	tree := testTree()
+	index := 0
+	require.NoError(t, MoveNode(tree, "rib", "bracket", &index))
+	assert.Equal(t, []string{"plate"}, ids(tree.Leaves[0].Leaves))
+	assert.Equal(t, []string{"rib"}, ids(tree.Leaves[1].Leaves))
+	assert.Equal(t, "bracket", tree.Leaves[1].Leaves[0].ParentId)
+
+	// reorder among the leaves of the same parent
+	require.NoError(t, MoveNode(tree, "bracket", "root", &index))
+	assert.Equal(t, []string{"bracket", "frame"}, ids(tree.Leaves))
+
+	assert.ErrorIs(t, MoveNode(tree, "bracket", "rib", nil), ErrInvalidEdit)
+	assert.ErrorIs(t, MoveNode(tree, "bracket", "bracket", nil), ErrInvalidEdit)
+	assert.ErrorIs(t, MoveNode(tree, "root", "frame", nil), ErrInvalidEdit)
+	assert.ErrorIs(t, MoveNode(tree, "frame", "lost", nil), ErrNodeNotFound)
+	require.NoError(t, Check(tree))
+}
+
+func TestDeleteNode(t *testing.T) {
+	tree := testTree()
+	require.NoError(t, DeleteNode(tree, "frame"))
+	assert.Equal(t, []string{"bracket"}, ids(tree.Leaves))
+
+	assert.ErrorIs(t, DeleteNode(tree, "root"), ErrInvalidEdit)
+	assert.ErrorIs(t, DeleteNode(tree, "plate"), ErrNodeNotFound)
+}
+
+func TestUpdateNode(t *testing.T) {
+	tree := testTree()
+	require.NoError(t, UpdateNode(tree, "plate", map[string]json.RawMessage{
+		"count":    json.RawMessage(`6`),
+		"material": json.RawMessage(`"Лист 12/09Г2С"`),
+	}))
+	plate := tree.Leaves[0].Leaves[0]
+	assert.Equal(t, int32(6), plate.Count)
+	assert.Equal(t, "Лист 12/09Г2С", plate.Material)
+	assert.Equal(t, "СФ-01.00.001", plate.Number)
+
+	require.NoError(t, UpdateNode(tree, "frame", map[string]json.RawMessage{"name": json.RawMessage(`"рама"`)}))
+	assert.Len(t, tree.Leaves[0].Leaves, 2)
+
+	assert.ErrorIs(t, UpdateNode(tree, "plate", map[string]json.RawMessage{"leaves": json.RawMessage(`[]`)}), ErrInvalidEdit)
+	assert.ErrorIs(t, UpdateNode(tree, "plate", map[string]json.RawMessage{"count": json.RawMessage(`"six"`)}), ErrInvalidEdit)
+	assert.ErrorIs(t, UpdateNode(tree, "lost", nil), ErrNodeNotFound)
+}
+
+func TestApplyPatch(t *testing.T) {
+	patched, err := ApplyPatch(testTree(), []byte(`[
+		{"op": "replace", "path": "/leaves/0/leaves/0/count", "value": 8},
+		{"op": "remove", "path": "/leaves/1"},
+		{"op": "add", "path": "/leaves/0/leaves/-", "value": {"id": "gusset", "name": "косынка"}}
+	]`))
+	require.NoError(t, err)
+	assert.Equal(t, int32(8), patched.Leaves[0].Leaves[0].Count)
+	assert.Equal(t, []string{"frame"}, ids(patched.Leaves))
+	assert.Equal(t, []string{"plate", "rib", "gusset"}, ids(patched.Leaves[0].Leaves))
+
+	_, err = ApplyPatch(testTree(), []byte(`[{"op": "remove", "path": "/leaves/5"}]`))
+	assert.ErrorIs(t, err, ErrInvalidEdit)
+	_, err = ApplyPatch(testTree(), []byte(`{"op": "remove"}`))
+	assert.ErrorIs(t, err, ErrInvalidEdit)
+
+	// the structure is checked by the service once the patch applies
+	duplicate, err := ApplyPatch(testTree(), []byte(`[{"op": "copy", "from": "/leaves/1", "path": "/leaves/-"}]`))
+	require.NoError(t, err)
+	assert.ErrorIs(t, Check(duplicate), ErrInvalidTree)
+}
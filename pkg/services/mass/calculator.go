@@ -0,0 +1,187 @@
+package mass
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// DefaultDensity is the density of carbon steel in kg/m³ used when no density matches the material
+const DefaultDensity = 7850
+
+// DefaultTolerancePercent is the deviation of the recognized mass from the computed one that is still accepted
+const DefaultTolerancePercent = 10
+
+// Densities selects the density of a part material
+type Densities struct {
+	// client entries first, then by descending material length
+	entries []*proto.MaterialDensityORM
+}
+
+func NewDensities(entries []*proto.MaterialDensityORM) *Densities {
+	sorted := make([]*proto.MaterialDensityORM, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Density > 0 && types.NormalizeMaterial(entry.Material) != "" {
+			sorted = append(sorted, entry)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if (sorted[i].ClientId != nil) != (sorted[j].ClientId != nil) {
+			return sorted[i].ClientId != nil
+		}
+		return len(types.NormalizeMaterial(sorted[i].Material)) > len(types.NormalizeMaterial(sorted[j].Material))
+	})
+
+	return &Densities{entries: sorted}
+}
+
+// Density returns the density in kg/m³ of the first entry whose grade the material contains
+func (d *Densities) Density(material string) float64 {
+	if d != nil {
+		normalized := types.NormalizeMaterial(material)
+		for _, entry := range d.entries {
+			if strings.Contains(normalized, types.NormalizeMaterial(entry.Material)) {
+				return entry.Density
+			}
+		}
+	}
+
+	return DefaultDensity
+}
+
+// UnitMass computes the theoretical mass in kg of one unit of a part from its typed size, its
+// drawing dimensions and the density. The profile length is the longer drawing dimension and
+// the sheet area is the drawing bounding box. It returns false when the mass cannot be computed.
+func UnitMass(node *proto.TreeNode, density float64) (float64, bool) {
+	size := types.NodeProfileSize(node)
+	if size == nil || density <= 0 {
+		return 0, false
+	}
+
+	var vertical, horizontal float64
+	if node.Figure != nil {
+		vertical, horizontal = float64(node.Figure.SizeVertical), float64(node.Figure.SizeHorizontal)
+	}
+	length := math.Max(vertical, horizontal)
+
+	// cross-section area in mm² of profiles, or the linear mass in kg/m at the steel density
+	var section, linear float64
+	switch {
+	case size.Sheet != nil:
+		area := vertical * horizontal
+		if area <= 0 {
+			area = size.Sheet.Width * size.Sheet.Length
+		}
+		return volumeMass(size.Sheet.Thickness*area, density)
+	case size.RoundBar != nil:
+		section = math.Pi * size.RoundBar.Diameter * size.RoundBar.Diameter / 4
+	case size.Pipe != nil:
+		inner := size.Pipe.Diameter - 2*size.Pipe.Wall
+		section = math.Pi * (size.Pipe.Diameter*size.Pipe.Diameter - inner*inner) / 4
+	case size.Angle != nil:
+		section = (size.Angle.Width + size.Angle.Height - size.Angle.Thickness) * size.Angle.Thickness
+	case size.SquareTube != nil:
+		tube := size.SquareTube
+		section = tube.Width*tube.Height - (tube.Width-2*tube.Wall)*(tube.Height-2*tube.Wall)
+	case size.Channel != nil:
+		linear = channelMasses[size.Channel.Number]
+	case size.Beam != nil:
+		linear = beamMasses[size.Beam.Number]
+	}
+
+	switch {
+	case length <= 0:
+		return 0, false
+	case section > 0:
+		return volumeMass(section*length, density)
+	case linear > 0:
+		return linear * length / 1000 * density / steelDensity, true
+	}
+
+	return 0, false
+}
+
+// volumeMass converts a volume in mm³ to a mass in kg
+func volumeMass(volume, density float64) (float64, bool) {
+	if volume <= 0 {
+		return 0, false
+	}
+
+	return volume * 1e-9 * density, true
+}
+
+// Annotate computes the masses of every node of the tree and compares the recognized masses of
+// the parts with the computed ones. Parts whose recognized mass deviates by more than the tolerance
+// get a YELLOW mass status, other compared parts OK. Assemblies weigh the sum of their leaves by count.
+func Annotate(root *proto.TreeNode, densities *Densities, tolerancePercent float64) *proto.MassReport {
+	report := &proto.MassReport{TolerancePercent: tolerancePercent}
+	annotate(root, densities, tolerancePercent, report)
+	report.TotalMass = root.TotalMass
+
+	return report
+}
+
+// annotate sets the masses of the node and returns its unit mass
+func annotate(node *proto.TreeNode, densities *Densities, tolerancePercent float64, report *proto.MassReport) float64 {
+	var leaves float64
+	for _, leaf := range node.Leaves {
+		count := float64(leaf.Count)
+		if count <= 0 {
+			count = 1
+		}
+		leaves += annotate(leaf, densities, tolerancePercent, report) * count
+	}
+
+	var recognized float64
+	if node.Figure != nil {
+		recognized = float64(node.Figure.Mass)
+	}
+
+	unit := leaves
+	node.MassStatus = proto.FieldStatus_FIELD_STATUS_UNSPECIFIED
+	if len(node.Leaves) == 0 {
+		material := types.NodeMaterial(node)
+		density := densities.Density(material)
+		computed, ok := UnitMass(node, density)
+		check := &proto.MassCheck{
+			NodeId:         node.Id,
+			Number:         node.Number,
+			Name:           node.Name,
+			Material:       material,
+			RecognizedMass: round(recognized),
+		}
+		if ok {
+			check.Density = density
+			check.ComputedMass = round(computed)
+			unit = computed
+		}
+		if ok && recognized > 0 {
+			check.DeviationPercent = math.Round((recognized-computed)/computed*10000) / 100
+			check.Mismatch = math.Abs(check.DeviationPercent) > tolerancePercent
+			node.MassStatus = proto.FieldStatus_OK
+			if check.Mismatch {
+				node.MassStatus = proto.FieldStatus_YELLOW
+				report.Mismatches++
+			}
+		}
+		if ok || recognized > 0 {
+			report.Parts = append(report.Parts, check)
+		}
+	}
+	if unit <= 0 {
+		unit = recognized
+	}
+
+	node.ComputedMass = round(unit)
+	node.TotalMass = round(unit * float64(types.NodeQuantity(node)))
+
+	return unit
+}
+
+// round rounds a mass in kg to grams
+func round(value float64) float64 {
+	return math.Round(value*1000) / 1000
+}
@@ -0,0 +1,111 @@
+package mass
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func figure(length float32, mass float32) *proto.Figure {
+	return &proto.Figure{SizeVertical: 50, SizeHorizontal: length, Mass: mass}
+}
+
+func TestDensities(t *testing.T) {
+	clientID := uint64(1)
+	densities := NewDensities([]*proto.MaterialDensityORM{
+		{Material: "АМг", Density: 2700},
+		{Material: "АМг6", Density: 2640},
+		{ClientId: &clientID, Material: "12Х18Н10Т", Density: 7920},
+		{Material: "Ст3", Density: 0},
+	})
+
+	assert.Equal(t, 2640.0, densities.Density("Лист 5 АМг6"))
+	assert.Equal(t, 2700.0, densities.Density("Лист 5 АМг2"))
+	assert.Equal(t, 7920.0, densities.Density("Труба 12х18н10т"))
+	assert.Equal(t, float64(DefaultDensity), densities.Density("Лист 10 Ст3"))
+	assert.Equal(t, float64(DefaultDensity), (*Densities)(nil).Density("АМг6"))
+}
+
+func TestUnitMass(t *testing.T) {
+	tests := []struct {
+		name string
+		node *proto.TreeNode
+		want float64
+	}{
+		{"pipe", &proto.TreeNode{Material: "Труба 57х3,5 ГОСТ 8732-78/20", Figure: figure(1500, 0)}, 6.927},
+		{"angle", &proto.TreeNode{Figure: &proto.Figure{
+			SizeHorizontal: 2000, MainSize: &proto.Figure_MainSizeStr{MainSizeStr: "L50x5"}, Assortment: &proto.Assortment{Material: "Ст3"},
+		}}, 7.458},
+		{"sheet", &proto.TreeNode{Material: "Лист 10 09Г2С", Figure: &proto.Figure{SizeVertical: 1000, SizeHorizontal: 1400}}, 109.9},
+		{"round bar", &proto.TreeNode{Material: "Круг 20 ГОСТ 2590-2006/Ст45", Figure: figure(1000, 0)}, 2.466},
+		{"square tube", &proto.TreeNode{Material: "Труба проф. 40х40х3 ГОСТ 30245/Ст3", Figure: figure(1000, 0)}, 3.485},
+		{"channel", &proto.TreeNode{Material: "Швеллер 10П ГОСТ 8240-97/Ст3", Figure: figure(1200, 0)}, 10.308},
+		{"beam", &proto.TreeNode{Material: "Двутавр 20Б1 ГОСТ 26020-83/С255", Figure: figure(1000, 0)}, 22.4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mass, ok := UnitMass(tt.node, DefaultDensity)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, round(mass))
+		})
+	}
+
+	_, ok := UnitMass(&proto.TreeNode{Material: "Труба 57х3,5/20"}, DefaultDensity)
+	assert.False(t, ok, "a profile needs a length")
+	_, ok = UnitMass(&proto.TreeNode{Material: "Ст3", Figure: figure(1000, 0)}, DefaultDensity)
+	assert.False(t, ok, "a material without a size")
+	_, ok = UnitMass(&proto.TreeNode{Material: "Швеллер 7П/Ст3", Figure: figure(1000, 0)}, DefaultDensity)
+	assert.False(t, ok, "an unknown profile number")
+}
+
+func TestAnnotate(t *testing.T) {
+	root := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{Id: "frame", Count: 2, AccumulatedCount: 2, Leaves: []*proto.TreeNode{
+				{Id: "pipe", Material: "Труба 57х3,5 ГОСТ 8732-78/20", Count: 2, AccumulatedCount: 4, Figure: figure(1500, 10)},
+				{Id: "angle", Count: 1, AccumulatedCount: 2, Figure: &proto.Figure{
+					SizeHorizontal: 2000, MainSize: &proto.Figure_MainSizeStr{MainSizeStr: "L50x5"}, Assortment: &proto.Assortment{Material: "Ст3"},
+				}},
+			}},
+			{Id: "plate", Material: "Лист 10 09Г2С", Count: 1, Figure: &proto.Figure{SizeVertical: 1000, SizeHorizontal: 1400, Mass: 110}},
+			{Id: "cover", Material: "Лист 5 АМг6", Count: 3, Figure: &proto.Figure{SizeVertical: 500, SizeHorizontal: 500}},
+			{Id: "channel", Material: "Швеллер 10П ГОСТ 8240-97/Ст3", Count: 1, Figure: figure(1200, 0)},
+			{Id: "bolt", Name: "Болт", Count: 4, Figure: &proto.Figure{Mass: 0.1}},
+			{Id: "blank", Material: "Ст3", Count: 1},
+		},
+	}
+	densities := NewDensities([]*proto.MaterialDensityORM{{Material: "АМг6", Density: 2640}})
+
+	report := Annotate(root, densities, DefaultTolerancePercent)
+
+	frame, pipe, angle := root.Leaves[0], root.Leaves[0].Leaves[0], root.Leaves[0].Leaves[1]
+	assert.Equal(t, 6.927, pipe.ComputedMass)
+	assert.Equal(t, 27.707, pipe.TotalMass)
+	assert.Equal(t, proto.FieldStatus_YELLOW, pipe.MassStatus)
+	assert.Equal(t, proto.FieldStatus_FIELD_STATUS_UNSPECIFIED, angle.MassStatus)
+	assert.Equal(t, 21.311, frame.ComputedMass)
+	assert.Equal(t, 42.622, frame.TotalMass)
+	assert.Equal(t, proto.FieldStatus_OK, root.Leaves[1].MassStatus)
+	assert.Equal(t, 3.3, root.Leaves[2].ComputedMass)
+	// the bolt keeps its recognized mass
+	assert.Equal(t, 0.1, root.Leaves[4].ComputedMass)
+	assert.Equal(t, 0.0, root.Leaves[5].TotalMass)
+	assert.Equal(t, 173.13, root.TotalMass)
+
+	assert.Equal(t, 173.13, report.TotalMass)
+	assert.Equal(t, float64(DefaultTolerancePercent), report.TolerancePercent)
+	assert.Equal(t, int32(1), report.Mismatches)
+	require.Len(t, report.Parts, 6)
+	check := report.Parts[0]
+	assert.Equal(t, "pipe", check.NodeId)
+	assert.Equal(t, 10.0, check.RecognizedMass)
+	assert.Equal(t, 6.927, check.ComputedMass)
+	assert.Equal(t, 44.37, check.DeviationPercent)
+	assert.True(t, check.Mismatch)
+	assert.Equal(t, 0.09, report.Parts[2].DeviationPercent)
+	assert.Equal(t, 2640.0, report.Parts[3].Density)
+	assert.Zero(t, report.Parts[5].ComputedMass)
+}
@@ -0,0 +1,27 @@
+package mass
+
+// steelDensity is the density in kg/m³ the linear masses of the profile tables are given for
+const steelDensity = 7850
+
+// channelMasses are the linear masses in kg/m of channels by profile number, ГОСТ 8240-97.
+// A number without a series is the old profile of the same dimensions as the У series.
+var channelMasses = map[string]float64{
+	"5У": 4.84, "6.5У": 5.90, "8У": 7.05, "10У": 8.59, "12У": 10.4, "14У": 12.3, "16У": 14.2,
+	"18У": 16.3, "20У": 18.4, "22У": 21.0, "24У": 24.0, "27У": 27.7, "30У": 31.8, "33У": 36.5,
+	"36У": 41.9, "40У": 48.3,
+	"5П": 4.84, "6.5П": 5.90, "8П": 7.05, "10П": 8.59, "12П": 10.4, "14П": 12.3, "16П": 14.2,
+	"18П": 16.3, "20П": 18.4, "22П": 21.0, "24П": 24.0, "27П": 27.7, "30П": 31.8,
+	"5": 4.84, "6.5": 5.90, "8": 7.05, "10": 8.59, "12": 10.4, "14": 12.3, "16": 14.2,
+	"18": 16.3, "20": 18.4, "22": 21.0, "24": 24.0, "27": 27.7, "30": 31.8, "33": 36.5,
+	"36": 41.9, "40": 48.3,
+}
+
+// beamMasses are the linear masses in kg/m of I-beams by profile number: ГОСТ 26020-83 for the
+// Б series and ГОСТ 8239-89 for numbers without a series
+var beamMasses = map[string]float64{
+	"10Б1": 8.1, "12Б1": 8.7, "12Б2": 10.4, "14Б1": 10.5, "14Б2": 12.9, "16Б1": 12.7, "16Б2": 15.8,
+	"18Б1": 15.4, "18Б2": 18.8, "20Б1": 22.4, "23Б1": 25.8, "26Б1": 28.0, "26Б2": 31.2, "30Б1": 32.9,
+	"30Б2": 36.6, "35Б1": 38.9, "35Б2": 43.3, "40Б1": 48.1, "40Б2": 54.7,
+	"10": 9.46, "12": 11.5, "14": 13.7, "16": 15.9, "18": 18.4, "20": 21.0, "22": 24.0,
+	"24": 27.3, "27": 31.5, "30": 36.5, "33": 42.2, "36": 48.6, "40": 57.0,
+}
@@ -0,0 +1,73 @@
+package mass
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/gorm"
+)
+
+// Service computes and reconciles the masses of recognized trees
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+// ClientDensities loads the client's material densities together with the densities shared by all
+// clients and the densities of the material reference catalog grades and their equivalents
+func (s *Service) ClientDensities(ctx context.Context, clientID uint64) (*Densities, error) {
+	var entries []*proto.MaterialDensityORM
+	err := s.db.WithContext(ctx).Where("client_id = ? OR client_id IS NULL", clientID).Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var grades []*proto.MaterialGradeORM
+	if err := s.db.WithContext(ctx).Where("density > 0").Find(&grades).Error; err != nil {
+		return nil, err
+	}
+	for _, grade := range grades {
+		for _, name := range append([]string{grade.Grade}, strings.Split(grade.Equivalents, ",")...) {
+			entries = append(entries, &proto.MaterialDensityORM{Material: strings.TrimSpace(name), Density: grade.Density})
+		}
+	}
+
+	return NewDensities(entries), nil
+}
+
+// Annotate computes the masses of the tree with the client's densities and the default tolerance
+func (s *Service) Annotate(ctx context.Context, clientID uint64, tree *proto.TreeNode) error {
+	densities, err := s.ClientDensities(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	Annotate(tree, densities, DefaultTolerancePercent)
+	return nil
+}
+
+// TaskMassReport reconciles the masses of a completed task of the client with the given tolerance in percent
+func (s *Service) TaskMassReport(ctx context.Context, clientID uint64, taskID string, tolerancePercent float64) (*proto.MassReport, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := types.TaskTree(task)
+	if err != nil {
+		return nil, err
+	}
+	densities, err := s.ClientDensities(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := Annotate(tree, densities, tolerancePercent)
+	report.TaskId = task.Id
+	return report, nil
+}
@@ -0,0 +1,227 @@
+package material
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// Confidences of the ways a catalog grade matches a recognized material
+const (
+	ConfidenceExact      = 1.0
+	ConfidenceSuffix     = 0.9
+	ConfidenceContained  = 0.8
+	ConfidenceMisspelled = 0.7
+	// standardBonus is added when the material names the standard of the grade
+	standardBonus = 0.05
+)
+
+// MinConfidence is the lowest confidence of a match; weaker materials are unmatched
+const MinConfidence = ConfidenceMisspelled
+
+var (
+	// standardPattern matches standard references, e.g. ГОСТ 19281-2014 or ТУ 14-1-1234
+	standardPattern = regexp.MustCompile(`(?i)(?:ГОСТ|ОСТ|ТУ|DIN|ISO|EN)\s*(?:Р\s*)?[\d.\-–]+`)
+	tokenSeparators = regexp.MustCompile(`[\s,;()«»"]+`)
+	// deoxidationSuffix matches the deoxidation degree and category written after a grade, e.g. сп5
+	deoxidationSuffix = regexp.MustCompile(`^(?:сп|пс|кп)\d?$`)
+)
+
+// lookAlikes maps the Latin letters recognition confuses with Cyrillic ones to the Cyrillic letter
+var lookAlikes = strings.NewReplacer(
+	"a", "а", "b", "в", "c", "с", "e", "е", "h", "н", "k", "к", "m", "м",
+	"o", "о", "p", "р", "t", "т", "x", "х", "y", "у",
+)
+
+// Key normalises a grade for comparison: lower case, Cyrillic look-alikes and no separators
+func Key(grade string) string {
+	key := lookAlikes.Replace(strings.ToLower(grade))
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '_', '\t':
+			return -1
+		}
+		return r
+	}, key)
+}
+
+type catalogKey struct {
+	key   string
+	grade *proto.MaterialGradeORM
+}
+
+// Catalog maps recognized materials to the material reference catalog
+type Catalog struct {
+	// grade and equivalent keys by descending length
+	keys []catalogKey
+}
+
+func NewCatalog(grades []*proto.MaterialGradeORM) *Catalog {
+	var keys []catalogKey
+	for _, grade := range grades {
+		names := append([]string{grade.Grade}, strings.Split(grade.Equivalents, ",")...)
+		for _, name := range names {
+			if key := Key(name); key != "" {
+				keys = append(keys, catalogKey{key: key, grade: grade})
+			}
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return len([]rune(keys[i].key)) > len([]rune(keys[j].key))
+	})
+
+	return &Catalog{keys: keys}
+}
+
+// Match maps a recognized material to the best matching catalog grade. The grade is read after
+// the slash of a profile material such as "Лист 10 ГОСТ 19903/09Г2С". Without a match of at least
+// MinConfidence the match has no grade and a zero confidence.
+func (c *Catalog) Match(text string) *proto.MaterialMatch {
+	match := &proto.MaterialMatch{Text: strings.TrimSpace(text)}
+	if c == nil || match.Text == "" {
+		return match
+	}
+
+	part := match.Text
+	if _, grade, ok := strings.Cut(part, "/"); ok && strings.TrimSpace(grade) != "" {
+		part = grade
+	}
+	standards := standardPattern.FindAllString(part, -1)
+	tokens := gradeTokens(standardPattern.ReplaceAllString(part, " "))
+
+	var (
+		best     *proto.MaterialGradeORM
+		bestConf float64
+		bestPos  int
+	)
+	for _, entry := range c.keys {
+		for pos, token := range tokens {
+			confidence := tokenConfidence(token, entry.key)
+			if confidence == 0 {
+				continue
+			}
+			if entry.grade.Standard != "" && namesStandard(standards, entry.grade.Standard) {
+				confidence += standardBonus
+			}
+			// the grade is written after the size, so later tokens win ties
+			if confidence > bestConf || confidence == bestConf && pos > bestPos {
+				best, bestConf, bestPos = entry.grade, confidence, pos
+			}
+		}
+	}
+	if best == nil || bestConf < MinConfidence {
+		return match
+	}
+
+	match.GradeId = best.Id
+	match.Grade = best.Grade
+	match.Standard = best.Standard
+	match.Density = best.Density
+	match.PriceGroup = best.PriceGroup
+	match.Confidence = min(bestConf, ConfidenceExact)
+	return match
+}
+
+// gradeTokens splits a material into the keys of its words and of adjacent word pairs,
+// as grades such as "Ст 3" or "AISI 304" are written with a space
+func gradeTokens(text string) []string {
+	var words []string
+	for _, word := range tokenSeparators.Split(text, -1) {
+		if key := Key(word); key != "" {
+			words = append(words, key)
+		}
+	}
+	tokens := append([]string(nil), words...)
+	for i := 0; i+1 < len(words); i++ {
+		tokens = append(tokens, words[i]+words[i+1])
+	}
+
+	return tokens
+}
+
+func tokenConfidence(token, key string) float64 {
+	switch {
+	case token == key:
+		return ConfidenceExact
+	case !hasLetter(token):
+		// numbers only match exactly, they are sizes as often as grades
+		return 0
+	case strings.HasPrefix(token, key) && deoxidationSuffix.MatchString(strings.TrimPrefix(token, key)):
+		return ConfidenceSuffix
+	case len([]rune(key)) >= 4 && strings.Contains(token, key):
+		return ConfidenceContained
+	case len([]rune(key)) >= 5 && distance(token, key) == 1:
+		return ConfidenceMisspelled
+	}
+
+	return 0
+}
+
+func hasLetter(text string) bool {
+	return strings.IndexFunc(text, func(r rune) bool {
+		return r < '0' || r > '9'
+	}) >= 0
+}
+
+func namesStandard(standards []string, standard string) bool {
+	key := Key(standard)
+	for _, s := range standards {
+		if Key(s) == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// distance returns the Levenshtein distance of two strings
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+// Normalise matches the materials of the tree, one match per distinct material text in tree order
+func (c *Catalog) Normalise(root *proto.TreeNode) []*proto.MaterialMatch {
+	var (
+		matches []*proto.MaterialMatch
+		byText  = make(map[string]*proto.MaterialMatch)
+	)
+	var walk func(node *proto.TreeNode)
+	walk = func(node *proto.TreeNode) {
+		if text := strings.TrimSpace(types.NodeMaterial(node)); text != "" {
+			match, ok := byText[text]
+			if !ok {
+				match = c.Match(text)
+				byText[text] = match
+				matches = append(matches, match)
+			}
+			match.NodeIds = append(match.NodeIds, node.Id)
+		}
+		for _, leaf := range node.Leaves {
+			walk(leaf)
+		}
+	}
+	walk(root)
+
+	return matches
+}
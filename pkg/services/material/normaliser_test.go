@@ -0,0 +1,83 @@
+package material
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCatalog() *Catalog {
+	return NewCatalog([]*proto.MaterialGradeORM{
+		{Id: 1, Grade: "09Г2С", Standard: "ГОСТ 19281-2014", Density: 7850, Equivalents: "S355, 09G2S", PriceGroup: "низколегированная"},
+		{Id: 2, Grade: "Ст3", Standard: "ГОСТ 380-2005", Density: 7850, Equivalents: "S235"},
+		{Id: 3, Grade: "20", Standard: "ГОСТ 1050-2013", Density: 7850},
+		{Id: 4, Grade: "12Х18Н10Т", Density: 7920, Equivalents: "AISI 321"},
+	})
+}
+
+func TestCatalogMatch(t *testing.T) {
+	catalog := testCatalog()
+	tests := []struct {
+		text       string
+		grade      string
+		confidence float64
+	}{
+		{"Сталь 09Г2С ГОСТ 19281-2014", "09Г2С", 1},
+		{"09Г2С", "09Г2С", 1},
+		{"Лист 10 ГОСТ 19903-2015/09G2S", "09Г2С", 1},
+		{"S355J2", "09Г2С", 0.8},
+		{"Ст3сп", "Ст3", 0.9},
+		{"Ст3пс5 ГОСТ 380-2005", "Ст3", 0.95},
+		{"Cт 3", "Ст3", 1},
+		{"Труба 57х3,5 ГОСТ 8732-78/20", "20", 1},
+		{"Сталь09Г2С", "09Г2С", 0.8},
+		{"О9Г2С", "09Г2С", 0.7},
+		{"Лист 20 Ст3", "Ст3", 1},
+		{"AISI 321", "12Х18Н10Т", 1},
+		{"Лист 20", "20", 1},
+		{"Резина ТМКЩ", "", 0},
+		{"", "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			match := catalog.Match(tt.text)
+			assert.Equal(t, tt.grade, match.Grade)
+			assert.InDelta(t, tt.confidence, match.Confidence, 1e-9)
+		})
+	}
+
+	match := catalog.Match("Сталь 09Г2С")
+	assert.Equal(t, uint64(1), match.GradeId)
+	assert.Equal(t, "ГОСТ 19281-2014", match.Standard)
+	assert.Equal(t, 7850.0, match.Density)
+	assert.Equal(t, "низколегированная", match.PriceGroup)
+	assert.Zero(t, (*Catalog)(nil).Match("09Г2С").Confidence)
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "09г2с", Key("09Г2C"))
+	assert.Equal(t, "ст3", Key("Cт 3"))
+	assert.Equal(t, "12х18н10т", Key("12X18H10T"))
+}
+
+func TestCatalogNormalise(t *testing.T) {
+	root := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{Id: "a", Material: "Лист 10 ГОСТ 19903-2015/09Г2С"},
+			{Id: "b", Material: "Лист 10 ГОСТ 19903-2015/09Г2С"},
+			{Id: "c", Figure: &proto.Figure{Assortment: &proto.Assortment{Material: "Резина ТМКЩ"}}},
+			{Id: "d"},
+		},
+	}
+
+	matches := testCatalog().Normalise(root)
+	require.Len(t, matches, 2)
+	assert.Equal(t, "09Г2С", matches[0].Grade)
+	assert.Equal(t, []string{"a", "b"}, matches[0].NodeIds)
+	assert.Equal(t, "Резина ТМКЩ", matches[1].Text)
+	assert.Zero(t, matches[1].GradeId)
+	assert.Equal(t, []string{"c"}, matches[1].NodeIds)
+}
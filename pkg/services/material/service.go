@@ -0,0 +1,90 @@
+package material
+
+import (
+	"context"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Service normalises recognized materials with the material reference catalog
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+// Catalog loads the material reference catalog
+func (s *Service) Catalog(ctx context.Context) (*Catalog, error) {
+	var grades []*proto.MaterialGradeORM
+	if err := s.db.WithContext(ctx).Find(&grades).Error; err != nil {
+		return nil, err
+	}
+
+	return NewCatalog(grades), nil
+}
+
+// TaskMaterials normalises the materials of a completed task of the client
+func (s *Service) TaskMaterials(ctx context.Context, clientID uint64, taskID string) (*proto.TaskMaterials, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := types.TaskTree(task)
+	if err != nil {
+		return nil, err
+	}
+	catalog, err := s.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &proto.TaskMaterials{TaskId: task.Id, Materials: catalog.Normalise(tree)}
+	for _, match := range result.Materials {
+		if match.GradeId == 0 {
+			result.Unmatched++
+		}
+	}
+	return result, nil
+}
+
+// RecordUnmatched adds the materials of the tree no catalog grade matches to the review list
+func (s *Service) RecordUnmatched(ctx context.Context, taskID string, tree *proto.TreeNode) error {
+	catalog, err := s.Catalog(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, match := range catalog.Normalise(tree) {
+		if match.GradeId != 0 {
+			continue
+		}
+		occurrences := int64(len(match.NodeIds))
+		err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "text"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"occurrences":  gorm.Expr("unmatched_materials.occurrences + ?", occurrences),
+				"last_task_id": taskID,
+				"updated_at":   now,
+			}),
+		}).Create(&proto.UnmatchedMaterialORM{
+			Text:        match.Text,
+			Occurrences: occurrences,
+			LastTaskId:  taskID,
+			CreatedAt:   &now,
+			UpdatedAt:   &now,
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
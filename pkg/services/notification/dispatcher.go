@@ -0,0 +1,322 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// CursorName identifies the dispatcher position in the event logs
+	CursorName = "notifications"
+
+	DispatchInterval = 10 * time.Second
+	dispatchBatch    = 100
+
+	// MaxAttempts is how many times an email is tried before it stays failed
+	MaxAttempts = 5
+)
+
+// Dispatcher turns task status events and quota transactions into emails.
+// Each recipient gets at most one email per source event.
+type Dispatcher struct {
+	db             *gorm.DB
+	sender         Sender
+	templates      *Templates
+	quotaThreshold int64
+}
+
+// NewDispatcher creates a dispatcher warning users when quota drops below quotaThreshold,
+// unless they configured their own threshold
+func NewDispatcher(db *gorm.DB, sender Sender, quotaThreshold int64) (*Dispatcher, error) {
+	templates, err := LoadTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Dispatcher{
+		db:             db,
+		sender:         sender,
+		templates:      templates,
+		quotaThreshold: quotaThreshold,
+	}, nil
+}
+
+// Run dispatches pending notifications every DispatchInterval until ctx is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(DispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.DispatchPending(ctx); err != nil {
+			log.Printf("notifications: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending retries failed emails and sends notifications for events recorded since
+// the previous call. The first call only remembers the current position, so history is never mailed.
+// An email that cannot be sent is recorded as failed and does not hold up the following events.
+func (d *Dispatcher) DispatchPending(ctx context.Context) error {
+	db := d.db.WithContext(ctx)
+
+	var cursor proto.EventCursorORM
+	err := db.First(&cursor, "name = ?", CursorName).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return d.initCursor(db)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load cursor: %w", err)
+	}
+
+	if err := d.retryFailed(ctx); err != nil {
+		return err
+	}
+
+	var events []proto.TaskStatusEventORM
+	if err := db.Where("id > ?", cursor.TaskStatusEventId).Order("id").Limit(dispatchBatch).Find(&events).Error; err != nil {
+		return fmt.Errorf("failed to load task status events: %w", err)
+	}
+	for i := range events {
+		if err := d.handleStatusEvent(ctx, &events[i]); err != nil {
+			return err
+		}
+		cursor.TaskStatusEventId = events[i].Id
+		if err := d.saveCursor(db, &cursor); err != nil {
+			return err
+		}
+	}
+
+	var transactions []proto.QuotaTransactionORM
+	if err := db.Where("id > ?", cursor.QuotaTransactionId).Order("id").Limit(dispatchBatch).Find(&transactions).Error; err != nil {
+		return fmt.Errorf("failed to load quota transactions: %w", err)
+	}
+	for i := range transactions {
+		if err := d.handleQuotaTransaction(ctx, &transactions[i]); err != nil {
+			return err
+		}
+		cursor.QuotaTransactionId = transactions[i].Id
+		if err := d.saveCursor(db, &cursor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// retryFailed makes another attempt to send the failed emails that have attempts left
+func (d *Dispatcher) retryFailed(ctx context.Context) error {
+	db := d.db.WithContext(ctx)
+
+	var failed []proto.NotificationORM
+	if err := db.Where("failed = ? AND attempts < ?", true, MaxAttempts).Order("id").Limit(dispatchBatch).Find(&failed).Error; err != nil {
+		return fmt.Errorf("failed to load failed notifications: %w", err)
+	}
+	for i := range failed {
+		if err := d.deliver(ctx, &failed[i]); err != nil {
+			return err
+		}
+		if err := db.Save(&failed[i]).Error; err != nil {
+			return fmt.Errorf("failed to record notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) initCursor(db *gorm.DB) error {
+	cursor := proto.EventCursorORM{Name: CursorName}
+	if err := db.Model(&proto.TaskStatusEventORM{}).Select("COALESCE(MAX(id), 0)").Scan(&cursor.TaskStatusEventId).Error; err != nil {
+		return fmt.Errorf("failed to init cursor: %w", err)
+	}
+	if err := db.Model(&proto.QuotaTransactionORM{}).Select("COALESCE(MAX(id), 0)").Scan(&cursor.QuotaTransactionId).Error; err != nil {
+		return fmt.Errorf("failed to init cursor: %w", err)
+	}
+
+	return d.saveCursor(db, &cursor)
+}
+
+func (d *Dispatcher) saveCursor(db *gorm.DB, cursor *proto.EventCursorORM) error {
+	now := time.Now()
+	cursor.UpdatedAt = &now
+	if err := db.Save(cursor).Error; err != nil {
+		return fmt.Errorf("failed to save cursor: %w", err)
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) handleStatusEvent(ctx context.Context, event *proto.TaskStatusEventORM) error {
+	status := proto.Status(event.Status)
+	if !types.IsTerminalState(status) {
+		return nil
+	}
+	kind := proto.NotificationKind_NOTIFICATION_KIND_TASK_FAILED
+	if status == proto.Status_STATUS_PROCESSING_COMPLETED {
+		kind = proto.NotificationKind_NOTIFICATION_KIND_TASK_COMPLETED
+	}
+
+	var task proto.DataRecognitionTaskORM
+	err := d.db.WithContext(ctx).Preload("Client").First(&task, "id = ?", event.TaskId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// the task was deleted in the meantime
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load task %s: %w", event.TaskId, err)
+	}
+
+	// Notify the creator of the task, or the whole client team for tasks without one
+	query := d.db.WithContext(ctx).Where("client_id = ?", event.ClientId)
+	if task.CreatedById != nil {
+		query = query.Where("id = ?", *task.CreatedById)
+	}
+	var users []proto.ClientUserORM
+	if err := query.Find(&users).Error; err != nil {
+		return fmt.Errorf("failed to load recipients: %w", err)
+	}
+
+	data := TemplateData{TaskID: task.Id, Error: task.Error}
+	if task.Client != nil {
+		data.ClientName = task.Client.Name
+	}
+	key := fmt.Sprintf("task_status_event:%d", event.Id)
+	for i := range users {
+		prefs, err := LoadPreferences(d.db.WithContext(ctx), users[i].Id)
+		if err != nil {
+			return fmt.Errorf("failed to load preferences of user %d: %w", users[i].Id, err)
+		}
+		if !Enabled(prefs, kind) {
+			continue
+		}
+		if err := d.send(ctx, &users[i], prefs, kind, key, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) handleQuotaTransaction(ctx context.Context, transaction *proto.QuotaTransactionORM) error {
+	if transaction.Amount >= 0 {
+		return nil
+	}
+	previous := transaction.Balance - transaction.Amount
+
+	var client proto.ClientORM
+	if err := d.db.WithContext(ctx).First(&client, transaction.ClientId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to load client %d: %w", transaction.ClientId, err)
+	}
+
+	var users []proto.ClientUserORM
+	if err := d.db.WithContext(ctx).Where("client_id = ?", client.Id).Find(&users).Error; err != nil {
+		return fmt.Errorf("failed to load recipients: %w", err)
+	}
+
+	kind := proto.NotificationKind_NOTIFICATION_KIND_QUOTA_LOW
+	key := fmt.Sprintf("quota_transaction:%d", transaction.Id)
+	for i := range users {
+		prefs, err := LoadPreferences(d.db.WithContext(ctx), users[i].Id)
+		if err != nil {
+			return fmt.Errorf("failed to load preferences of user %d: %w", users[i].Id, err)
+		}
+		if !Enabled(prefs, kind) {
+			continue
+		}
+
+		threshold := prefs.QuotaThreshold
+		if threshold == 0 {
+			threshold = d.quotaThreshold
+		}
+		// Only warn when the balance crosses the threshold
+		if transaction.Balance >= threshold || previous < threshold {
+			continue
+		}
+
+		data := TemplateData{ClientName: client.Name, Quota: transaction.Balance, Threshold: threshold}
+		if err := d.send(ctx, &users[i], prefs, kind, key, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// send delivers a single message unless the user was already notified about the event
+func (d *Dispatcher) send(ctx context.Context, user *proto.ClientUserORM, prefs *proto.NotificationPreferencesORM,
+	kind proto.NotificationKind, key string, data TemplateData) error {
+	if user.Email == "" {
+		return nil
+	}
+
+	db := d.db.WithContext(ctx)
+	var sent int64
+	if err := db.Model(&proto.NotificationORM{}).Where("user_id = ? AND event_key = ?", user.Id, key).Count(&sent).Error; err != nil {
+		return fmt.Errorf("failed to check notification log: %w", err)
+	}
+	if sent > 0 {
+		return nil
+	}
+
+	data.UserName = user.Username
+	subject, body, err := d.templates.Render(prefs.Language, kind, data)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	notification := &proto.NotificationORM{
+		UserId:    user.Id,
+		EventKey:  key,
+		Kind:      int32(kind),
+		Email:     user.Email,
+		Subject:   subject,
+		Body:      body,
+		CreatedAt: &now,
+	}
+	if err := d.deliver(ctx, notification); err != nil {
+		return err
+	}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(notification).Error; err != nil {
+		return fmt.Errorf("failed to record notification: %w", err)
+	}
+
+	return nil
+}
+
+// deliver makes one attempt to send the email and records the outcome on the notification.
+// Only a cancelled ctx is returned as an error, the attempt is not counted then.
+func (d *Dispatcher) deliver(ctx context.Context, notification *proto.NotificationORM) error {
+	err := d.sender.Send(ctx, Message{To: notification.Email, Subject: notification.Subject, Body: notification.Body})
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	notification.Attempts++
+	notification.Failed = err != nil
+	notification.Error = ""
+	if err != nil {
+		notification.Error = err.Error()
+		if notification.Attempts >= MaxAttempts {
+			log.Printf("notifications: giving up on %q to %s after %d attempts: %v", notification.EventKey, notification.Email, notification.Attempts, err)
+		}
+	}
+
+	return nil
+}
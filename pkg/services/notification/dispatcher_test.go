@@ -0,0 +1,181 @@
+package notification_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/notification"
+	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// rejectingSender fails every message to the rejected address
+type rejectingSender struct {
+	*notification.MemorySender
+	rejected string
+}
+
+func (s *rejectingSender) Send(ctx context.Context, msg notification.Message) error {
+	if msg.To == s.rejected {
+		return errors.New("550 mailbox unavailable")
+	}
+
+	return s.MemorySender.Send(ctx, msg)
+}
+
+var _ = Describe("Dispatcher", func() {
+	var (
+		client     *proto.Client
+		creator    *proto.ClientUserORM
+		teammate   *proto.ClientUserORM
+		sender     *notification.MemorySender
+		dispatcher *notification.Dispatcher
+	)
+
+	BeforeEach(func() {
+		testutils.ClearDatabase(DB)
+
+		var err error
+		client, err = testutils.CreateTestClient(DB, "Test Client", 12)
+		Expect(err).NotTo(HaveOccurred())
+		creator, err = testutils.CreateTestUser(DB, "creator@example.com", "password123", client.Id)
+		Expect(err).NotTo(HaveOccurred())
+		teammate, err = testutils.CreateTestUser(DB, "teammate@example.com", "password123", client.Id)
+		Expect(err).NotTo(HaveOccurred())
+
+		sender = notification.NewMemorySender()
+		dispatcher, err = notification.NewDispatcher(DB, sender, 10)
+		Expect(err).NotTo(HaveOccurred())
+
+		// the first run only positions the cursor
+		Expect(dispatcher.DispatchPending(ctx)).To(Succeed())
+	})
+
+	createTask := func(status proto.Status) {
+		now := time.Now()
+		Expect(DB.Create(&proto.DataRecognitionTaskORM{
+			Id:          uuid.New().String(),
+			ClientId:    &client.Id,
+			CreatedById: &creator.Id,
+			Status:      int32(status),
+			Error:       "timeout",
+			CreatedAt:   &now,
+			UpdatedAt:   &now,
+		}).Error).NotTo(HaveOccurred())
+	}
+
+	debit := func(amount, balance int64) {
+		now := time.Now()
+		Expect(DB.Create(&proto.QuotaTransactionORM{
+			ClientId:  client.Id,
+			Reason:    int32(proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_RECOGNITION),
+			Amount:    -amount,
+			Balance:   balance,
+			CreatedAt: &now,
+		}).Error).NotTo(HaveOccurred())
+	}
+
+	setPreferences := func(prefs *proto.NotificationPreferencesORM) {
+		Expect(DB.Create(prefs).Error).NotTo(HaveOccurred())
+	}
+
+	It("should email the task creator once when the task completes", func() {
+		createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+
+		Expect(dispatcher.DispatchPending(ctx)).To(Succeed())
+		Expect(dispatcher.DispatchPending(ctx)).To(Succeed())
+
+		messages := sender.Messages()
+		Expect(messages).To(HaveLen(1))
+		Expect(messages[0].To).To(Equal("creator@example.com"))
+		Expect(messages[0].Subject).To(Equal("Распознавание завершено"))
+	})
+
+	It("should use the language of the recipient", func() {
+		prefs := notification.DefaultPreferences(creator.Id)
+		prefs.Language = notification.LanguageEnglish
+		setPreferences(prefs)
+
+		createTask(proto.Status_STATUS_RECOGNITION_FAILED_PROCESSING)
+		Expect(dispatcher.DispatchPending(ctx)).To(Succeed())
+
+		messages := sender.Messages()
+		Expect(messages).To(HaveLen(1))
+		Expect(messages[0].Subject).To(Equal("Recognition failed"))
+		Expect(messages[0].Body).To(ContainSubstring("Reason: timeout"))
+	})
+
+	It("should respect opt-outs", func() {
+		prefs := notification.DefaultPreferences(creator.Id)
+		prefs.TaskFailed = false
+		setPreferences(prefs)
+
+		createTask(proto.Status_STATUS_IMAGES_FAILED_TIMEOUT)
+		Expect(dispatcher.DispatchPending(ctx)).To(Succeed())
+
+		Expect(sender.Messages()).To(BeEmpty())
+	})
+
+	It("should ignore tasks that are still in progress", func() {
+		createTask(proto.Status_STATUS_CREATED)
+		Expect(dispatcher.DispatchPending(ctx)).To(Succeed())
+
+		Expect(sender.Messages()).To(BeEmpty())
+	})
+
+	It("should warn the team once when quota drops below the threshold", func() {
+		prefs := notification.DefaultPreferences(teammate.Id)
+		prefs.QuotaThreshold = 5
+		setPreferences(prefs)
+
+		debit(1, 11)
+		debit(2, 9)
+		debit(1, 8)
+		Expect(dispatcher.DispatchPending(ctx)).To(Succeed())
+
+		messages := sender.Messages()
+		Expect(messages).To(HaveLen(1))
+		Expect(messages[0].To).To(Equal("creator@example.com"))
+		Expect(messages[0].Body).To(ContainSubstring("9"))
+
+		debit(4, 4)
+		Expect(dispatcher.DispatchPending(ctx)).To(Succeed())
+
+		messages = sender.Messages()
+		Expect(messages).To(HaveLen(2))
+		Expect(messages[1].To).To(Equal("teammate@example.com"))
+	})
+
+	It("should record a rejected email and keep dispatching later events", func() {
+		rejecting := &rejectingSender{MemorySender: notification.NewMemorySender(), rejected: "creator@example.com"}
+		dispatcher, err := notification.NewDispatcher(DB, rejecting, 10)
+		Expect(err).NotTo(HaveOccurred())
+
+		createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+		debit(2, 9)
+		Expect(dispatcher.DispatchPending(ctx)).To(Succeed())
+
+		messages := rejecting.Messages()
+		Expect(messages).To(HaveLen(1))
+		Expect(messages[0].To).To(Equal("teammate@example.com"))
+
+		var failed []proto.NotificationORM
+		Expect(DB.Where("user_id = ? AND failed = ?", creator.Id, true).Find(&failed).Error).NotTo(HaveOccurred())
+		Expect(failed).To(HaveLen(2))
+		Expect(failed[0].Attempts).To(Equal(int32(1)))
+		Expect(failed[0].Error).To(ContainSubstring("mailbox unavailable"))
+
+		// failed emails are retried until they run out of attempts
+		for i := 0; i < notification.MaxAttempts+1; i++ {
+			Expect(dispatcher.DispatchPending(ctx)).To(Succeed())
+		}
+		Expect(DB.Where("user_id = ? AND failed = ?", creator.Id, true).Find(&failed).Error).NotTo(HaveOccurred())
+		Expect(failed).To(HaveLen(2))
+		Expect(failed[0].Attempts).To(Equal(int32(notification.MaxAttempts)))
+		Expect(rejecting.Messages()).To(HaveLen(1))
+	})
+})
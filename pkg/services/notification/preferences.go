@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"gorm.io/gorm"
+)
+
+// DefaultPreferences are used for users who never changed their settings
+func DefaultPreferences(userID uint64) *proto.NotificationPreferencesORM {
+	return &proto.NotificationPreferencesORM{
+		UserId:        userID,
+		Language:      LanguageRussian,
+		TaskCompleted: true,
+		TaskFailed:    true,
+		QuotaLow:      true,
+	}
+}
+
+// LoadPreferences returns the stored preferences of the user or the defaults
+func LoadPreferences(db *gorm.DB, userID uint64) (*proto.NotificationPreferencesORM, error) {
+	var prefs []proto.NotificationPreferencesORM
+	if err := db.Where("user_id = ?", userID).Limit(1).Find(&prefs).Error; err != nil {
+		return nil, err
+	}
+	if len(prefs) == 0 {
+		return DefaultPreferences(userID), nil
+	}
+
+	return &prefs[0], nil
+}
+
+// Enabled reports whether the user wants notifications of the given kind
+func Enabled(prefs *proto.NotificationPreferencesORM, kind proto.NotificationKind) bool {
+	switch kind {
+	case proto.NotificationKind_NOTIFICATION_KIND_TASK_COMPLETED:
+		return prefs.TaskCompleted
+	case proto.NotificationKind_NOTIFICATION_KIND_TASK_FAILED:
+		return prefs.TaskFailed
+	case proto.NotificationKind_NOTIFICATION_KIND_QUOTA_LOW:
+		return prefs.QuotaLow
+	default:
+		return false
+	}
+}
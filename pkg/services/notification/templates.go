@@ -0,0 +1,84 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+// Supported message languages
+const (
+	LanguageRussian = "ru"
+	LanguageEnglish = "en"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+var templateNames = map[proto.NotificationKind]string{
+	proto.NotificationKind_NOTIFICATION_KIND_TASK_COMPLETED: "task_completed",
+	proto.NotificationKind_NOTIFICATION_KIND_TASK_FAILED:    "task_failed",
+	proto.NotificationKind_NOTIFICATION_KIND_QUOTA_LOW:      "quota_low",
+}
+
+// TemplateData is available to every message template
+type TemplateData struct {
+	UserName   string
+	ClientName string
+	TaskID     string
+	Error      string
+	Quota      int64
+	Threshold  int64
+}
+
+// Templates renders localized messages
+type Templates struct {
+	templates map[string]*template.Template
+}
+
+// LoadTemplates parses the embedded templates of all supported languages
+func LoadTemplates() (*Templates, error) {
+	t := &Templates{templates: map[string]*template.Template{}}
+	for _, lang := range []string{LanguageRussian, LanguageEnglish} {
+		for _, name := range templateNames {
+			path := fmt.Sprintf("templates/%s/%s.tmpl", lang, name)
+			tmpl, err := template.ParseFS(templateFS, path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse template %s: %w", path, err)
+			}
+			t.templates[lang+"/"+name] = tmpl
+		}
+	}
+
+	return t, nil
+}
+
+// IsSupportedLanguage reports whether messages can be rendered in lang
+func IsSupportedLanguage(lang string) bool {
+	return lang == LanguageRussian || lang == LanguageEnglish
+}
+
+// Render returns the subject and body of a message; unknown languages fall back to Russian
+func (t *Templates) Render(lang string, kind proto.NotificationKind, data TemplateData) (string, string, error) {
+	if !IsSupportedLanguage(lang) {
+		lang = LanguageRussian
+	}
+	tmpl, ok := t.templates[lang+"/"+templateNames[kind]]
+	if !ok {
+		return "", "", fmt.Errorf("no template for notification kind %s", kind)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", err
+	}
+	if err := tmpl.ExecuteTemplate(&body, "body", data); err != nil {
+		return "", "", err
+	}
+
+	return strings.TrimSpace(subject.String()), strings.TrimSpace(body.String()) + "\n", nil
+}
@@ -0,0 +1,115 @@
+package notification
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplates(t *testing.T) {
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+
+	data := TemplateData{
+		UserName:   "Иван",
+		ClientName: "ООО Ромашка",
+		TaskID:     "task-1",
+		Error:      "timeout",
+		Quota:      3,
+		Threshold:  10,
+	}
+
+	t.Run("All kinds render in both languages", func(t *testing.T) {
+		for _, lang := range []string{LanguageRussian, LanguageEnglish} {
+			for kind := range templateNames {
+				subject, body, err := templates.Render(lang, kind, data)
+				require.NoError(t, err, "%s %s", lang, kind)
+				assert.NotEmpty(t, subject)
+				assert.NotContains(t, subject, "\n")
+				assert.Contains(t, body, "ООО Ромашка")
+			}
+		}
+	})
+
+	t.Run("Russian task failure", func(t *testing.T) {
+		subject, body, err := templates.Render(LanguageRussian, proto.NotificationKind_NOTIFICATION_KIND_TASK_FAILED, data)
+		require.NoError(t, err)
+		assert.Equal(t, "Ошибка распознавания", subject)
+		assert.True(t, strings.HasPrefix(body, "Здравствуйте, Иван!"))
+		assert.Contains(t, body, "task-1")
+		assert.Contains(t, body, "Причина: timeout")
+	})
+
+	t.Run("English low quota", func(t *testing.T) {
+		subject, body, err := templates.Render(LanguageEnglish, proto.NotificationKind_NOTIFICATION_KIND_QUOTA_LOW, data)
+		require.NoError(t, err)
+		assert.Equal(t, "Quota is running low", subject)
+		assert.Contains(t, body, "is 3, below the threshold of 10")
+	})
+
+	t.Run("Unknown language falls back to Russian", func(t *testing.T) {
+		subject, _, err := templates.Render("de", proto.NotificationKind_NOTIFICATION_KIND_TASK_COMPLETED, data)
+		require.NoError(t, err)
+		assert.Equal(t, "Распознавание завершено", subject)
+	})
+
+	t.Run("Unknown kind", func(t *testing.T) {
+		_, _, err := templates.Render(LanguageEnglish, proto.NotificationKind_NOTIFICATION_KIND_UNKNOWN, data)
+		assert.Error(t, err)
+	})
+}
+
+func TestMemorySender(t *testing.T) {
+	sender := NewMemorySender()
+	require.NoError(t, sender.Send(context.Background(), Message{To: "user@example.com", Subject: "Hi"}))
+
+	messages := sender.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "user@example.com", messages[0].To)
+
+	sender.Reset()
+	assert.Empty(t, sender.Messages())
+}
+
+func TestSMTPSenderEncodesSubject(t *testing.T) {
+	sender := NewSMTPSender("localhost", "25", "", "", "noreply@example.com")
+
+	msg := string(sender.build(Message{To: "user@example.com", Subject: "Квота", Body: "Текст"}))
+	assert.Contains(t, msg, "Subject: =?UTF-8?b?")
+	assert.Contains(t, msg, "Content-Type: text/plain; charset=UTF-8\r\n")
+	assert.True(t, strings.HasSuffix(msg, "\r\n\r\nТекст"))
+}
+
+func TestSMTPSenderGivesUpOnHungServer(t *testing.T) {
+	// the server accepts connections but never greets
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	sender := NewSMTPSender(host, port, "", "", "noreply@example.com")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	err = sender.Send(ctx, Message{To: "user@example.com", Subject: "Test", Body: "Test"})
+	assert.Error(t, err)
+	assert.Less(t, time.Since(started), 5*time.Second)
+}
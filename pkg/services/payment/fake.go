@@ -0,0 +1,87 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+// FakeSignatureHeader carries the hex encoded HMAC-SHA256 of the webhook body
+const FakeSignatureHeader = "X-Fake-Signature"
+
+// FakeProvider is a payment provider for local development and tests.
+// Payments are never charged: the order is confirmed by posting a callback
+// to the webhook endpoint, signed with the configured secret.
+type FakeProvider struct {
+	secret string
+}
+
+// NewFakeProvider creates a fake provider. With an empty secret every webhook is rejected.
+func NewFakeProvider(secret string) *FakeProvider {
+	return &FakeProvider{secret: secret}
+}
+
+// FakeWebhookPayload is the callback body accepted by FakeProvider
+type FakeWebhookPayload struct {
+	ExternalID string      `json:"external_id"`
+	Status     EventStatus `json:"status"`
+	Error      string      `json:"error,omitempty"`
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) CreatePayment(ctx context.Context, order *proto.OrderORM) (*Payment, error) {
+	externalID := "fake_" + order.Id
+	return &Payment{
+		ExternalID:      externalID,
+		ConfirmationURL: fmt.Sprintf("fake://payments/%s?amount=%d&currency=%s", externalID, order.Amount, order.Currency),
+	}, nil
+}
+
+func (p *FakeProvider) ParseWebhook(r *http.Request) (*WebhookEvent, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook body: %w", err)
+	}
+
+	if p.secret == "" || !hmac.Equal([]byte(p.Sign(body)), []byte(r.Header.Get(FakeSignatureHeader))) {
+		return nil, ErrInvalidSignature
+	}
+
+	var payload FakeWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidWebhook, err)
+	}
+
+	switch payload.Status {
+	case EventSucceeded, EventFailed, EventCanceled:
+	default:
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidWebhook, payload.Status)
+	}
+
+	if payload.ExternalID == "" {
+		return nil, fmt.Errorf("%w: external_id is required", ErrInvalidWebhook)
+	}
+
+	return &WebhookEvent{
+		ExternalID: payload.ExternalID,
+		Status:     payload.Status,
+		Error:      payload.Error,
+	}, nil
+}
+
+// Sign returns the signature expected in FakeSignatureHeader for the body
+func (p *FakeProvider) Sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(p.secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,80 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeWebhookRequest(t *testing.T, body []byte, signature string) *http.Request {
+	r, err := http.NewRequest(http.MethodPost, "/api/v1/payments/fake/webhook", bytes.NewReader(body))
+	require.NoError(t, err)
+	if signature != "" {
+		r.Header.Set(FakeSignatureHeader, signature)
+	}
+
+	return r
+}
+
+func TestFakeProvider(t *testing.T) {
+	provider := NewFakeProvider("test-secret")
+
+	t.Run("Create payment", func(t *testing.T) {
+		payment, err := provider.CreatePayment(context.Background(), &proto.OrderORM{
+			Id:       "order-1",
+			Amount:   1000,
+			Currency: "RUB",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "fake_order-1", payment.ExternalID)
+		assert.Contains(t, payment.ConfirmationURL, "amount=1000")
+	})
+
+	t.Run("Signed webhook", func(t *testing.T) {
+		body := []byte(`{"external_id":"fake_order-1","status":"succeeded"}`)
+
+		event, err := provider.ParseWebhook(newFakeWebhookRequest(t, body, provider.Sign(body)))
+		require.NoError(t, err)
+		assert.Equal(t, "fake_order-1", event.ExternalID)
+		assert.Equal(t, EventSucceeded, event.Status)
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		body := []byte(`{"external_id":"fake_order-1","status":"succeeded"}`)
+
+		_, err := provider.ParseWebhook(newFakeWebhookRequest(t, body, "deadbeef"))
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("Unknown status", func(t *testing.T) {
+		body := []byte(`{"external_id":"fake_order-1","status":"refunded"}`)
+
+		_, err := provider.ParseWebhook(newFakeWebhookRequest(t, body, provider.Sign(body)))
+		assert.ErrorIs(t, err, ErrInvalidWebhook)
+	})
+
+	t.Run("Failed payment", func(t *testing.T) {
+		body := []byte(`{"external_id":"fake_order-1","status":"failed","error":"card declined"}`)
+
+		event, err := provider.ParseWebhook(newFakeWebhookRequest(t, body, provider.Sign(body)))
+		require.NoError(t, err)
+		assert.Equal(t, EventFailed, event.Status)
+		assert.Equal(t, "card declined", event.Error)
+	})
+
+	t.Run("Webhook without secret", func(t *testing.T) {
+		unsigned := NewFakeProvider("")
+		body := []byte(`{"external_id":"fake_order-1","status":"succeeded"}`)
+
+		_, err := unsigned.ParseWebhook(newFakeWebhookRequest(t, body, ""))
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+
+		_, err = unsigned.ParseWebhook(newFakeWebhookRequest(t, body, unsigned.Sign(body)))
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+}
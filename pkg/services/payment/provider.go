@@ -0,0 +1,42 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+// EventStatus is the payment outcome reported by a provider callback
+type EventStatus string
+
+const (
+	EventSucceeded EventStatus = "succeeded"
+	EventFailed    EventStatus = "failed"
+	EventCanceled  EventStatus = "canceled"
+)
+
+// Payment describes a payment registered with a provider
+type Payment struct {
+	// ExternalID identifies the payment on the provider side
+	ExternalID string
+	// ConfirmationURL is where the client completes the payment
+	ConfirmationURL string
+}
+
+// WebhookEvent is a provider callback reduced to what the order flow needs
+type WebhookEvent struct {
+	ExternalID string
+	Status     EventStatus
+	Error      string
+}
+
+// Provider is implemented by payment gateways that can take money for quota orders
+type Provider interface {
+	// Name identifies the provider in orders and webhook routes
+	Name() string
+	// CreatePayment registers the order with the gateway
+	CreatePayment(ctx context.Context, order *proto.OrderORM) (*Payment, error)
+	// ParseWebhook authenticates a gateway callback and extracts the payment outcome
+	ParseWebhook(r *http.Request) (*WebhookEvent, error)
+}
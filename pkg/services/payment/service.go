@@ -0,0 +1,172 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrUnknownProvider  = errors.New("unknown payment provider")
+	ErrInvalidQuota     = errors.New("quota must be positive")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidWebhook   = errors.New("invalid webhook payload")
+	ErrOrderNotFound    = errors.New("order not found")
+)
+
+// Service sells prepaid quota to clients through the registered providers
+type Service struct {
+	db        *gorm.DB
+	providers map[string]Provider
+	unitPrice int64
+	currency  string
+}
+
+// NewService creates a payment service charging unitPrice minor currency units per quota unit
+func NewService(db *gorm.DB, unitPrice int64, currency string, providers ...Provider) *Service {
+	s := &Service{
+		db:        db,
+		providers: make(map[string]Provider, len(providers)),
+		unitPrice: unitPrice,
+		currency:  currency,
+	}
+	for _, p := range providers {
+		s.providers[p.Name()] = p
+	}
+
+	return s
+}
+
+// CreateOrder registers a quota purchase with the provider and stores it as pending
+func (s *Service) CreateOrder(ctx context.Context, clientID, userID uint64, quota int64, providerName string) (*proto.OrderORM, error) {
+	if quota <= 0 {
+		return nil, ErrInvalidQuota
+	}
+
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, providerName)
+	}
+
+	now := time.Now()
+	order := &proto.OrderORM{
+		Id:        uuid.New().String(),
+		ClientId:  clientID,
+		UserId:    userID,
+		Status:    int32(proto.OrderStatus_ORDER_STATUS_PENDING),
+		Quota:     quota,
+		Amount:    quota * s.unitPrice,
+		Currency:  s.currency,
+		Provider:  provider.Name(),
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	payment, err := provider.CreatePayment(ctx, order)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment: %w", err)
+	}
+	order.ExternalId = payment.ExternalID
+	order.ConfirmationUrl = payment.ConfirmationURL
+
+	if err := s.db.WithContext(ctx).Create(order).Error; err != nil {
+		return nil, fmt.Errorf("failed to save order: %w", err)
+	}
+
+	return order, nil
+}
+
+// HandleWebhook applies a provider callback to its order. Quota is credited
+// only on the first successful callback; repeated callbacks for an order that
+// is no longer pending are acknowledged without changes.
+func (s *Service) HandleWebhook(ctx context.Context, providerName string, r *http.Request) (*proto.OrderORM, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, providerName)
+	}
+
+	event, err := provider.ParseWebhook(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var order proto.OrderORM
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&order, "provider = ? AND external_id = ?", provider.Name(), event.ExternalID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrOrderNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		if proto.OrderStatus(order.Status) != proto.OrderStatus_ORDER_STATUS_PENDING {
+			return nil
+		}
+
+		now := time.Now()
+		order.UpdatedAt = &now
+		switch event.Status {
+		case EventSucceeded:
+			order.Status = int32(proto.OrderStatus_ORDER_STATUS_PAID)
+			order.PaidAt = &now
+			if err := creditQuota(tx, &order); err != nil {
+				return err
+			}
+		case EventFailed:
+			order.Status = int32(proto.OrderStatus_ORDER_STATUS_FAILED)
+			order.Error = event.Error
+		case EventCanceled:
+			order.Status = int32(proto.OrderStatus_ORDER_STATUS_CANCELED)
+			order.Error = event.Error
+		}
+
+		return tx.Save(&order).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func creditQuota(tx *gorm.DB, order *proto.OrderORM) error {
+	result := tx.Model(&proto.ClientORM{}).Where("id = ?", order.ClientId).UpdateColumns(map[string]interface{}{
+		"quota":       gorm.Expr("quota + ?", order.Quota),
+		"total_quota": gorm.Expr("total_quota + ?", order.Quota),
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to credit quota: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to credit quota: client %d not found", order.ClientId)
+	}
+
+	var client proto.ClientORM
+	if err := tx.Select("id", "quota").First(&client, order.ClientId).Error; err != nil {
+		return fmt.Errorf("failed to credit quota: %w", err)
+	}
+
+	now := time.Now()
+	err := tx.Create(&proto.QuotaTransactionORM{
+		ClientId:  order.ClientId,
+		Reason:    int32(proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_PURCHASE),
+		Amount:    order.Quota,
+		Balance:   client.Quota,
+		OrderId:   order.Id,
+		CreatedAt: &now,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to record quota transaction: %w", err)
+	}
+
+	return nil
+}
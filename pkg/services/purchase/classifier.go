@@ -0,0 +1,217 @@
+package purchase
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// designationPattern matches a standard designation such as ГОСТ 7798-70, ГОСТ Р 52644-2006 or DIN 933
+var designationPattern = regexp.MustCompile(`(?i)(?:^|[^\p{L}])((?:ГОСТ(?:\s*Р)?|ОСТ|ТУ|DIN|ISO)\s*\d[\d.\-–]*\d?)`)
+
+// drawingNumberPattern matches drawing designations of manufactured parts such as АБВГ.301.012.005
+var drawingNumberPattern = regexp.MustCompile(`\d+\.\d+.*\.\d+`)
+
+// standardNames are the names of items made to a standard
+var standardNames = []string{
+	"болт", "винт", "гайка", "шайба", "шпилька", "шплинт", "штифт", "заклепка", "заклёпка",
+	"шуруп", "шпонка", "рым-болт", "кольцо стопорное", "кольцо уплотнительное", "масленка",
+}
+
+// purchasedNames are the names of other bought items
+var purchasedNames = []string{
+	"подшипник", "электродвигатель", "двигатель", "мотор-редуктор", "редуктор", "манжета",
+	"гидроцилиндр", "пневмоцилиндр", "датчик", "концевой выключатель", "ремень", "звездочка приводная",
+}
+
+// Classifier tells standard and purchased items from manufactured parts
+type Classifier struct {
+	// dictionary entries with a designation, longest first
+	designations []*proto.StandardPartORM
+	// dictionary entries with a name, longest first
+	names []*proto.StandardPartORM
+}
+
+// NewClassifier builds a classifier from the enabled entries of the standards dictionary
+func NewClassifier(dictionary []*proto.StandardPartORM) *Classifier {
+	c := &Classifier{}
+	for _, entry := range dictionary {
+		if !entry.Enabled {
+			continue
+		}
+		if entry.Designation != "" {
+			c.designations = append(c.designations, entry)
+		}
+		if entry.Designation == "" && entry.Name != "" {
+			c.names = append(c.names, entry)
+		}
+	}
+	sort.SliceStable(c.designations, func(i, j int) bool {
+		return len(c.designations[i].Designation) > len(c.designations[j].Designation)
+	})
+	sort.SliceStable(c.names, func(i, j int) bool {
+		return len(c.names[i].Name) > len(c.names[j].Name)
+	})
+
+	return c
+}
+
+// Classification is the kind of a node with the name and designation of the item
+type Classification struct {
+	Kind        proto.PartKind
+	Name        string
+	Designation string
+}
+
+// Bought reports whether the item is standard or purchased
+func (c Classification) Bought() bool {
+	return c.Kind == proto.PartKind_PART_KIND_STANDARD || c.Kind == proto.PartKind_PART_KIND_PURCHASED
+}
+
+// Classify returns the kind of the node. A kind set on the specification row is kept,
+// otherwise the dictionary designations, standard references in the name, drawing numbers
+// and finally the dictionary and built-in item names are checked in this order.
+func (c *Classifier) Classify(node *proto.TreeNode) Classification {
+	name, number := nodeName(node), nodeNumber(node)
+	result := Classification{Kind: proto.PartKind_PART_KIND_MANUFACTURED, Name: name}
+
+	designation := ""
+	if match := designationPattern.FindStringSubmatch(name + " " + number); match != nil {
+		designation = normalizeDesignation(match[1])
+	}
+	if designation != "" {
+		result.Designation = designation
+		result.Name = strings.TrimSpace(cutDesignation(name))
+	}
+
+	if node.Spec != nil && node.Spec.PartKind != proto.PartKind_PART_KIND_UNSPECIFIED {
+		result.Kind = node.Spec.PartKind
+		return result
+	}
+
+	text := normalize(name + " " + number)
+	for _, entry := range c.designations {
+		if strings.Contains(text, normalize(entry.Designation)) {
+			result.Kind = proto.PartKind(entry.Kind)
+			result.Designation = entry.Designation
+			return result
+		}
+	}
+	if designation != "" {
+		result.Kind = proto.PartKind_PART_KIND_STANDARD
+		if kind, ok := c.nameKind(name); ok {
+			result.Kind = kind
+		}
+		return result
+	}
+	if drawingNumberPattern.MatchString(number) {
+		return result
+	}
+	if kind, ok := c.nameKind(name); ok {
+		result.Kind = kind
+	}
+
+	return result
+}
+
+// nameKind matches the beginning of the name against the dictionary and built-in names
+func (c *Classifier) nameKind(name string) (proto.PartKind, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, entry := range c.names {
+		if hasWordPrefix(name, strings.ToLower(entry.Name)) {
+			return proto.PartKind(entry.Kind), true
+		}
+	}
+	for _, prefix := range purchasedNames {
+		if hasWordPrefix(name, prefix) {
+			return proto.PartKind_PART_KIND_PURCHASED, true
+		}
+	}
+	for _, prefix := range standardNames {
+		if hasWordPrefix(name, prefix) {
+			return proto.PartKind_PART_KIND_STANDARD, true
+		}
+	}
+
+	return proto.PartKind_PART_KIND_UNSPECIFIED, false
+}
+
+// Mark sets the kind of the specification rows of the tree that have none yet
+func (c *Classifier) Mark(root *proto.TreeNode) {
+	for _, leaf := range root.Leaves {
+		c.mark(leaf)
+	}
+}
+
+func (c *Classifier) mark(node *proto.TreeNode) {
+	if node.Spec != nil && node.Spec.PartKind == proto.PartKind_PART_KIND_UNSPECIFIED {
+		node.Spec.PartKind = c.Classify(node).Kind
+	}
+	for _, leaf := range node.Leaves {
+		c.mark(leaf)
+	}
+}
+
+// nodeName returns the first known name of the node: its own, of the specification row or of the drawing
+func nodeName(node *proto.TreeNode) string {
+	if node.Name != "" {
+		return node.Name
+	}
+	if node.Spec != nil && node.Spec.Name != "" {
+		return node.Spec.Name
+	}
+	if node.Figure != nil {
+		if node.Figure.Name != "" {
+			return node.Figure.Name
+		}
+		// fasteners are recognized as a figure type such as "Болт$ М16Х40"
+		if node.Figure.Assortment != nil {
+			return strings.TrimSpace(strings.ReplaceAll(node.Figure.Assortment.FigureType, "$", ""))
+		}
+	}
+
+	return ""
+}
+
+func nodeNumber(node *proto.TreeNode) string {
+	if node.Number != "" {
+		return node.Number
+	}
+	if node.Spec != nil {
+		return node.Spec.Number
+	}
+
+	return ""
+}
+
+func hasWordPrefix(name, prefix string) bool {
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+	rest := name[len(prefix):]
+
+	return rest == "" || strings.IndexAny(rest[:1], " ,.-") == 0
+}
+
+// cutDesignation removes the standard designation from the item name
+func cutDesignation(name string) string {
+	loc := designationPattern.FindStringSubmatchIndex(name)
+	if loc == nil {
+		return name
+	}
+
+	return name[:loc[2]] + name[loc[3]:]
+}
+
+func normalizeDesignation(designation string) string {
+	designation = strings.TrimRight(strings.ReplaceAll(designation, "–", "-"), ".-")
+
+	return strings.Join(strings.Fields(designation), " ")
+}
+
+func normalize(value string) string {
+	return types.NormalizeMaterial(strings.ReplaceAll(value, "–", "-"))
+}
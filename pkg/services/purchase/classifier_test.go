@@ -0,0 +1,141 @@
+package purchase
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassify(t *testing.T) {
+	classifier := NewClassifier([]*proto.StandardPartORM{
+		{Designation: "ГОСТ 18855-94", Kind: int32(proto.PartKind_PART_KIND_PURCHASED), Enabled: true},
+		{Name: "Ручка", Kind: int32(proto.PartKind_PART_KIND_PURCHASED), Enabled: true},
+		{Name: "Пластина", Kind: int32(proto.PartKind_PART_KIND_PURCHASED)},
+	})
+
+	tests := []struct {
+		name        string
+		node        *proto.TreeNode
+		kind        proto.PartKind
+		itemName    string
+		designation string
+	}{
+		{
+			name:        "bolt with standard",
+			node:        &proto.TreeNode{Name: "Болт М16х40.58 ГОСТ 7798-70"},
+			kind:        proto.PartKind_PART_KIND_STANDARD,
+			itemName:    "Болт М16х40.58",
+			designation: "ГОСТ 7798-70",
+		},
+		{
+			name:        "standard in the number of the row",
+			node:        &proto.TreeNode{Spec: &proto.SpecificationRow{Name: "Шайба 16 65Г", Number: "ГОСТ 6402-70"}},
+			kind:        proto.PartKind_PART_KIND_STANDARD,
+			itemName:    "Шайба 16 65Г",
+			designation: "ГОСТ 6402-70",
+		},
+		{
+			name:        "dictionary designation",
+			node:        &proto.TreeNode{Name: "Подшипник 180205 ГОСТ 18855–94"},
+			kind:        proto.PartKind_PART_KIND_PURCHASED,
+			itemName:    "Подшипник 180205",
+			designation: "ГОСТ 18855-94",
+		},
+		{
+			name:     "fastener recognized from the drawing",
+			node:     &proto.TreeNode{Figure: &proto.Figure{Assortment: &proto.Assortment{FigureType: "Гайка$ М20 "}}},
+			kind:     proto.PartKind_PART_KIND_STANDARD,
+			itemName: "Гайка М20",
+		},
+		{
+			name:     "dictionary name",
+			node:     &proto.TreeNode{Name: "Ручка-скоба"},
+			kind:     proto.PartKind_PART_KIND_PURCHASED,
+			itemName: "Ручка-скоба",
+		},
+		{
+			name:     "washer with a drawing",
+			node:     &proto.TreeNode{Name: "Шайба", Number: "23.00.27-ТХ.02.01.05.00.03"},
+			kind:     proto.PartKind_PART_KIND_MANUFACTURED,
+			itemName: "Шайба",
+		},
+		{
+			name:     "disabled dictionary entry",
+			node:     &proto.TreeNode{Name: "Пластина", Material: "Лист 10 Ст3 ГОСТ 19903"},
+			kind:     proto.PartKind_PART_KIND_MANUFACTURED,
+			itemName: "Пластина",
+		},
+		{
+			name:     "kind set on the row",
+			node:     &proto.TreeNode{Name: "Болт специальный", Spec: &proto.SpecificationRow{PartKind: proto.PartKind_PART_KIND_MANUFACTURED}},
+			kind:     proto.PartKind_PART_KIND_MANUFACTURED,
+			itemName: "Болт специальный",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifier.Classify(tt.node)
+			assert.Equal(t, tt.kind, got.Kind)
+			assert.Equal(t, tt.itemName, got.Name)
+			assert.Equal(t, tt.designation, got.Designation)
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	root := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{
+				Id:               "frame",
+				Number:           "АБВГ.301.012.000",
+				AccumulatedCount: 2,
+				Leaves: []*proto.TreeNode{
+					{Id: "plate", Number: "АБВГ.301.012.001", Name: "Пластина", AccumulatedCount: 2},
+					{Id: "bolt", Name: "Болт М16х40 ГОСТ 7798-70", AccumulatedCount: 8},
+					{Id: "nut", Name: "Гайка М16 ГОСТ 5915-70", AccumulatedCount: 8},
+				},
+			},
+			{Id: "bolt-2", Name: "Болт  М16х40 ГОСТ 7798-70", Count: 4},
+			{Id: "motor", Name: "Мотор-редуктор", AccumulatedCount: 1, Leaves: []*proto.TreeNode{{Id: "shaft"}}},
+		},
+	}
+
+	manufactured, items := NewClassifier(nil).Split(root)
+
+	require.Len(t, manufactured.Leaves, 1)
+	assert.Equal(t, "frame", manufactured.Leaves[0].Id)
+	require.Len(t, manufactured.Leaves[0].Leaves, 1)
+	assert.Equal(t, "plate", manufactured.Leaves[0].Leaves[0].Id)
+	// the original tree is kept
+	assert.Len(t, root.Leaves, 3)
+	assert.Len(t, root.Leaves[0].Leaves, 3)
+
+	require.Len(t, items, 3)
+	assert.Equal(t, "Болт М16х40", items[0].Name)
+	assert.Equal(t, "ГОСТ 7798-70", items[0].Designation)
+	assert.Equal(t, int64(12), items[0].Quantity)
+	assert.Equal(t, []string{"bolt", "bolt-2"}, items[0].NodeIds)
+	assert.Equal(t, "Гайка М16", items[1].Name)
+	assert.Equal(t, int32(proto.PartKind_PART_KIND_PURCHASED), items[2].Kind)
+	assert.Equal(t, "Мотор-редуктор", items[2].Name)
+	assert.Equal(t, int64(1), items[2].Quantity)
+}
+
+func TestMark(t *testing.T) {
+	root := &proto.TreeNode{
+		Leaves: []*proto.TreeNode{
+			{Spec: &proto.SpecificationRow{Name: "Шплинт 4х63 ГОСТ 397-79"}},
+			{Spec: &proto.SpecificationRow{Name: "Кронштейн", Number: "АБВГ.301.012.002"}},
+			{Spec: &proto.SpecificationRow{Name: "Винт М6", PartKind: proto.PartKind_PART_KIND_MANUFACTURED}},
+		},
+	}
+
+	NewClassifier(nil).Mark(root)
+
+	assert.Equal(t, proto.PartKind_PART_KIND_STANDARD, root.Leaves[0].Spec.PartKind)
+	assert.Equal(t, proto.PartKind_PART_KIND_MANUFACTURED, root.Leaves[1].Spec.PartKind)
+	assert.Equal(t, proto.PartKind_PART_KIND_MANUFACTURED, root.Leaves[2].Spec.PartKind)
+}
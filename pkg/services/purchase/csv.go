@@ -0,0 +1,36 @@
+package purchase
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+// CSVHeader is the header of purchase list CSV files
+var CSVHeader = []string{"kind", "name", "designation", "quantity"}
+
+// KindCodes are the kinds of bought items as written to files and forms
+var KindCodes = map[proto.PartKind]string{
+	proto.PartKind_PART_KIND_STANDARD:  "standard",
+	proto.PartKind_PART_KIND_PURCHASED: "purchased",
+}
+
+// WriteCSV writes the items of the list
+func WriteCSV(w io.Writer, list *proto.PurchaseList) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return err
+	}
+	for _, item := range list.Items {
+		if err := writer.Write([]string{
+			KindCodes[proto.PartKind(item.Kind)], item.Name, item.Designation, strconv.FormatInt(item.Quantity, 10),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,95 @@
+package purchase
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// Split separates the bought items from the tree. It returns a copy of the tree with
+// the manufactured nodes only and the consolidated items with total quantities.
+// A bought assembly is a single item, its children are not listed.
+func (c *Classifier) Split(root *proto.TreeNode) (*proto.TreeNode, []*proto.PurchaseItem) {
+	list := newItemList()
+	manufactured := *root
+	manufactured.Leaves = c.split(root.Leaves, list)
+
+	return &manufactured, list.items()
+}
+
+// Manufactured returns a copy of the tree without the bought items
+func (c *Classifier) Manufactured(root *proto.TreeNode) *proto.TreeNode {
+	tree, _ := c.Split(root)
+	return tree
+}
+
+func (c *Classifier) split(leaves []*proto.TreeNode, list *itemList) []*proto.TreeNode {
+	var result []*proto.TreeNode
+	for _, leaf := range leaves {
+		classification := c.Classify(leaf)
+		if classification.Bought() {
+			list.add(leaf, classification)
+			continue
+		}
+		node := *leaf
+		node.Leaves = c.split(leaf.Leaves, list)
+		result = append(result, &node)
+	}
+
+	return result
+}
+
+type itemKey struct {
+	kind        proto.PartKind
+	name        string
+	designation string
+}
+
+type itemList struct {
+	byKey map[itemKey]*proto.PurchaseItem
+}
+
+func newItemList() *itemList {
+	return &itemList{byKey: make(map[itemKey]*proto.PurchaseItem)}
+}
+
+func (l *itemList) add(node *proto.TreeNode, classification Classification) {
+	key := itemKey{
+		kind:        classification.Kind,
+		name:        strings.ToLower(strings.Join(strings.Fields(classification.Name), " ")),
+		designation: normalize(classification.Designation),
+	}
+	item, ok := l.byKey[key]
+	if !ok {
+		item = &proto.PurchaseItem{
+			Kind:        int32(classification.Kind),
+			Name:        classification.Name,
+			Designation: classification.Designation,
+		}
+		l.byKey[key] = item
+	}
+	item.Quantity += int64(types.NodeQuantity(node))
+	item.NodeIds = append(item.NodeIds, node.Id)
+}
+
+// items returns standard items first, then purchased ones, each sorted by name and designation
+func (l *itemList) items() []*proto.PurchaseItem {
+	items := make([]*proto.PurchaseItem, 0, len(l.byKey))
+	for _, item := range l.byKey {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if an, bn := strings.ToLower(a.Name), strings.ToLower(b.Name); an != bn {
+			return an < bn
+		}
+		return a.Designation < b.Designation
+	})
+
+	return items
+}
@@ -0,0 +1,58 @@
+package purchase
+
+import (
+	"context"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/gorm"
+)
+
+// Service classifies bought items with the standards dictionary
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+// Classifier loads the standards dictionary
+func (s *Service) Classifier(ctx context.Context) (*Classifier, error) {
+	var dictionary []*proto.StandardPartORM
+	if err := s.db.WithContext(ctx).Where("enabled = ?", true).Find(&dictionary).Error; err != nil {
+		return nil, err
+	}
+
+	return NewClassifier(dictionary), nil
+}
+
+// Manufactured returns a copy of the tree without the standard and purchased items
+func (s *Service) Manufactured(ctx context.Context, tree *proto.TreeNode) (*proto.TreeNode, error) {
+	classifier, err := s.Classifier(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return classifier.Manufactured(tree), nil
+}
+
+// TaskPurchaseList returns the consolidated bought items of a completed task of the client
+func (s *Service) TaskPurchaseList(ctx context.Context, clientID uint64, taskID string) (*proto.PurchaseList, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := types.TaskTree(task)
+	if err != nil {
+		return nil, err
+	}
+	classifier, err := s.Classifier(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, items := classifier.Split(tree)
+	return &proto.PurchaseList{TaskId: task.Id, Items: items}, nil
+}
@@ -0,0 +1,95 @@
+package quote
+
+import (
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+// Item changes of a diff
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// Diff compares the terms, totals and lines of two revisions. Lines are matched by node id.
+func Diff(quoteID uint64, from, to *proto.QuoteRevisionORM) *proto.QuoteDiff {
+	diff := &proto.QuoteDiff{
+		QuoteId:      quoteID,
+		FromRevision: from.Revision,
+		ToRevision:   to.Revision,
+		TotalDelta:   roundMoney(to.TotalAmount - from.TotalAmount),
+	}
+
+	for _, field := range []struct {
+		name     string
+		from, to float64
+	}{
+		{"margin_percent", from.MarginPercent, to.MarginPercent},
+		{"discount_percent", from.DiscountPercent, to.DiscountPercent},
+		{"vat_percent", from.VatPercent, to.VatPercent},
+		{"valid_days", float64(from.ValidDays), float64(to.ValidDays)},
+		{"cost", from.Cost, to.Cost},
+		{"gross_amount", from.GrossAmount, to.GrossAmount},
+		{"discount_amount", from.DiscountAmount, to.DiscountAmount},
+		{"net_amount", from.NetAmount, to.NetAmount},
+		{"vat_amount", from.VatAmount, to.VatAmount},
+		{"total_amount", from.TotalAmount, to.TotalAmount},
+	} {
+		if field.from != field.to {
+			diff.Fields = append(diff.Fields, &proto.QuoteFieldChange{Field: field.name, From: field.from, To: field.to})
+		}
+	}
+
+	previous := make(map[string]*proto.QuoteItemORM, len(from.Items))
+	for _, item := range from.Items {
+		previous[item.NodeId] = item
+	}
+	for _, item := range to.Items {
+		old, ok := previous[item.NodeId]
+		if !ok {
+			diff.Items = append(diff.Items, &proto.QuoteItemChange{
+				NodeId:      item.NodeId,
+				Number:      item.Number,
+				Name:        item.Name,
+				Change:      ChangeAdded,
+				ToQuantity:  item.Quantity,
+				ToUnitPrice: item.UnitPrice,
+				ToAmount:    item.Amount,
+			})
+			continue
+		}
+		delete(previous, item.NodeId)
+		if old.Quantity == item.Quantity && old.UnitPrice == item.UnitPrice && old.Amount == item.Amount {
+			continue
+		}
+		diff.Items = append(diff.Items, &proto.QuoteItemChange{
+			NodeId:        item.NodeId,
+			Number:        item.Number,
+			Name:          item.Name,
+			Change:        ChangeChanged,
+			FromQuantity:  old.Quantity,
+			ToQuantity:    item.Quantity,
+			FromUnitPrice: old.UnitPrice,
+			ToUnitPrice:   item.UnitPrice,
+			FromAmount:    old.Amount,
+			ToAmount:      item.Amount,
+		})
+	}
+	// removed lines in the order of the old revision
+	for _, item := range from.Items {
+		if _, ok := previous[item.NodeId]; !ok {
+			continue
+		}
+		diff.Items = append(diff.Items, &proto.QuoteItemChange{
+			NodeId:        item.NodeId,
+			Number:        item.Number,
+			Name:          item.Name,
+			Change:        ChangeRemoved,
+			FromQuantity:  item.Quantity,
+			FromUnitPrice: item.UnitPrice,
+			FromAmount:    item.Amount,
+		})
+	}
+
+	return diff
+}
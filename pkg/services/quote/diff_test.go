@@ -0,0 +1,58 @@
+package quote
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	from := BuildRevision(testBreakdown(), &Terms{MarginPercent: 25, VatPercent: 20})
+	from.Revision = 1
+
+	breakdown := testBreakdown()
+	breakdown.Children[0].Quantity = 4
+	breakdown.Children[0].TotalCost = 400
+	breakdown.Children = append(breakdown.Children[:1],
+		&proto.CostNode{NodeId: "bolt", Name: "Болт", Quantity: 8, TotalCost: 80})
+	to := BuildRevision(breakdown, &Terms{MarginPercent: 25, VatPercent: 20})
+	to.Revision = 2
+
+	diff := Diff(7, from, to)
+
+	assert.Equal(t, uint64(7), diff.QuoteId)
+	assert.Equal(t, int32(1), diff.FromRevision)
+	assert.Equal(t, int32(2), diff.ToRevision)
+	assert.Equal(t, 269.99, diff.TotalDelta)
+
+	require.Len(t, diff.Items, 3)
+	assert.Equal(t, "beam", diff.Items[0].NodeId)
+	assert.Equal(t, ChangeChanged, diff.Items[0].Change)
+	assert.Equal(t, int32(2), diff.Items[0].FromQuantity)
+	assert.Equal(t, int32(4), diff.Items[0].ToQuantity)
+	assert.Equal(t, "bolt", diff.Items[1].NodeId)
+	assert.Equal(t, ChangeAdded, diff.Items[1].Change)
+	assert.Equal(t, "plate", diff.Items[2].NodeId)
+	assert.Equal(t, ChangeRemoved, diff.Items[2].Change)
+
+	fields := make(map[string]*proto.QuoteFieldChange)
+	for _, field := range diff.Fields {
+		fields[field.Field] = field
+	}
+	assert.NotContains(t, fields, "margin_percent")
+	require.Contains(t, fields, "cost")
+	assert.Equal(t, 300.0, fields["cost"].From)
+	assert.Equal(t, 480.0, fields["cost"].To)
+}
+
+func TestDiffSameRevision(t *testing.T) {
+	revision := BuildRevision(testBreakdown(), &Terms{VatPercent: 20})
+
+	diff := Diff(1, revision, revision)
+
+	assert.Empty(t, diff.Fields)
+	assert.Empty(t, diff.Items)
+	assert.Zero(t, diff.TotalDelta)
+}
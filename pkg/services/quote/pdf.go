@@ -0,0 +1,225 @@
+package quote
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/go-pdf/fpdf"
+)
+
+//go:embed fonts/DejaVuSans.ttf
+var regularFont []byte
+
+//go:embed fonts/DejaVuSans-Bold.ttf
+var boldFont []byte
+
+const (
+	pdfFont       = "DejaVu"
+	pdfLineHeight = 5.0
+	pdfMargin     = 15.0
+)
+
+// pdfColumns are the table columns of the quote lines with their widths in mm
+var pdfColumns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"№", 10, "C"},
+	{"Обозначение", 35, "L"},
+	{"Наименование", 60, "L"},
+	{"Кол-во", 17, "R"},
+	{"Цена", 29, "R"},
+	{"Сумма", 29, "R"},
+}
+
+// RenderPDF writes the revision of the quote as a PDF document with the requisites of the client
+func RenderPDF(w io.Writer, client *proto.ClientORM, quote *proto.QuoteORM, revision *proto.QuoteRevisionORM) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(false, pdfMargin)
+	pdf.AddUTF8FontFromBytes(pdfFont, "", regularFont)
+	pdf.AddUTF8FontFromBytes(pdfFont, "B", boldFont)
+	pdf.SetTitle(fmt.Sprintf("Коммерческое предложение № %d", quote.Id), true)
+	pdf.AddPage()
+
+	pageWidth, pageHeight := pdf.GetPageSize()
+	contentWidth := pageWidth - 2*pdfMargin
+
+	// requisites of the supplier
+	pdf.SetFont(pdfFont, "B", 12)
+	pdf.MultiCell(contentWidth, 6, client.Name, "", "L", false)
+	pdf.SetFont(pdfFont, "", 9)
+	var requisites []string
+	if client.Inn != "" {
+		requisites = append(requisites, "ИНН "+client.Inn)
+	}
+	if client.Ogrn != "" {
+		requisites = append(requisites, "ОГРН "+client.Ogrn)
+	}
+	if len(requisites) > 0 {
+		pdf.MultiCell(contentWidth, pdfLineHeight, strings.Join(requisites, ", "), "", "L", false)
+	}
+	pdf.Ln(6)
+
+	date := time.Now()
+	if revision.CreatedAt != nil {
+		date = *revision.CreatedAt
+	}
+	pdf.SetFont(pdfFont, "B", 14)
+	pdf.MultiCell(contentWidth, 7, fmt.Sprintf("Коммерческое предложение № %d от %s", quote.Id, date.Format("02.01.2006")), "", "C", false)
+	pdf.SetFont(pdfFont, "", 9)
+	pdf.MultiCell(contentWidth, pdfLineHeight, fmt.Sprintf("Редакция %d", revision.Revision), "", "C", false)
+	pdf.Ln(4)
+
+	pdf.SetFont(pdfFont, "", 10)
+	if quote.CustomerName != "" {
+		pdf.MultiCell(contentWidth, pdfLineHeight, "Получатель: "+quote.CustomerName, "", "L", false)
+	}
+	if quote.CustomerDetails != "" {
+		pdf.SetFont(pdfFont, "", 9)
+		pdf.MultiCell(contentWidth, pdfLineHeight, quote.CustomerDetails, "", "L", false)
+		pdf.SetFont(pdfFont, "", 10)
+	}
+	if quote.Title != "" {
+		pdf.MultiCell(contentWidth, pdfLineHeight, "Предмет: "+quote.Title, "", "L", false)
+	}
+	pdf.Ln(4)
+
+	tableHeader := func() {
+		pdf.SetFont(pdfFont, "B", 9)
+		pdf.SetFillColor(230, 230, 230)
+		for _, column := range pdfColumns {
+			title := column.title
+			if column.title == "Цена" || column.title == "Сумма" {
+				title += ", " + revision.Currency
+			}
+			pdf.CellFormat(column.width, 7, title, "1", 0, "C", true, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont(pdfFont, "", 9)
+	}
+	tableHeader()
+
+	for _, item := range revision.Items {
+		cells := []string{
+			strconv.Itoa(int(item.Position)),
+			item.Number,
+			item.Name,
+			strconv.Itoa(int(item.Quantity)),
+			formatMoney(item.UnitPrice),
+			formatMoney(item.Amount),
+		}
+		lines := make([][]string, len(cells))
+		rows := 1
+		for i, cell := range cells {
+			lines[i] = splitCell(pdf, cell, pdfColumns[i].width-2)
+			if len(lines[i]) > rows {
+				rows = len(lines[i])
+			}
+		}
+		height := float64(rows) * pdfLineHeight
+		if pdf.GetY()+height > pageHeight-pdfMargin {
+			pdf.AddPage()
+			tableHeader()
+		}
+
+		x, y := pdf.GetX(), pdf.GetY()
+		for i, column := range pdfColumns {
+			pdf.Rect(x, y, column.width, height, "D")
+			for j, line := range lines[i] {
+				pdf.SetXY(x, y+float64(j)*pdfLineHeight)
+				pdf.CellFormat(column.width, pdfLineHeight, line, "", 0, column.align, false, 0, "")
+			}
+			x += column.width
+		}
+		pdf.SetXY(pdfMargin, y+height)
+	}
+
+	// totals aligned with the amount column
+	labelWidth := contentWidth - pdfColumns[len(pdfColumns)-1].width
+	totals := [][2]string{}
+	if revision.DiscountAmount != 0 {
+		totals = append(totals,
+			[2]string{"Сумма без скидки", formatMoney(revision.GrossAmount)},
+			[2]string{fmt.Sprintf("Скидка %s%%", formatPercent(revision.DiscountPercent)), formatMoney(revision.DiscountAmount)},
+		)
+	}
+	totals = append(totals,
+		[2]string{"Итого без НДС", formatMoney(revision.NetAmount)},
+		[2]string{fmt.Sprintf("НДС %s%%", formatPercent(revision.VatPercent)), formatMoney(revision.VatAmount)},
+		[2]string{"Всего к оплате, " + revision.Currency, formatMoney(revision.TotalAmount)},
+	)
+	if pdf.GetY()+float64(len(totals)+1)*6 > pageHeight-pdfMargin {
+		pdf.AddPage()
+	}
+	pdf.Ln(2)
+	for i, total := range totals {
+		if i == len(totals)-1 {
+			pdf.SetFont(pdfFont, "B", 10)
+		}
+		pdf.CellFormat(labelWidth, 6, total[0], "", 0, "R", false, 0, "")
+		pdf.CellFormat(pdfColumns[len(pdfColumns)-1].width, 6, total[1], "", 1, "R", false, 0, "")
+	}
+	pdf.SetFont(pdfFont, "", 9)
+	pdf.Ln(4)
+
+	if revision.ValidDays > 0 {
+		pdf.MultiCell(contentWidth, pdfLineHeight,
+			"Предложение действительно до "+date.AddDate(0, 0, int(revision.ValidDays)).Format("02.01.2006"), "", "L", false)
+	}
+	if revision.Notes != "" {
+		pdf.Ln(2)
+		pdf.MultiCell(contentWidth, pdfLineHeight, revision.Notes, "", "L", false)
+	}
+	if client.OwnerFio != "" {
+		pdf.Ln(10)
+		pdf.MultiCell(contentWidth, pdfLineHeight, "Руководитель ____________________ "+client.OwnerFio, "", "L", false)
+	}
+
+	if err := pdf.Error(); err != nil {
+		return err
+	}
+
+	return pdf.Output(w)
+}
+
+// splitCell wraps the text to the width of a table cell
+func splitCell(pdf *fpdf.Fpdf, text string, width float64) []string {
+	if text == "" {
+		return []string{""}
+	}
+
+	return pdf.SplitText(text, width)
+}
+
+// formatMoney formats an amount with a space between thousands and a decimal comma: 12 345,60
+func formatMoney(value float64) string {
+	sign := ""
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+	cents := int64(math.Round(value * 100))
+	whole := strconv.FormatInt(cents/100, 10)
+
+	var b strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteRune(' ')
+		}
+		b.WriteRune(digit)
+	}
+
+	return fmt.Sprintf("%s%s,%02d", sign, b.String(), cents%100)
+}
+
+func formatPercent(value float64) string {
+	return strings.Replace(strconv.FormatFloat(value, 'f', -1, 64), ".", ",", 1)
+}
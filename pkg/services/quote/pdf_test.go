@@ -0,0 +1,34 @@
+package quote
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPDF(t *testing.T) {
+	revision := BuildRevision(testBreakdown(), &Terms{MarginPercent: 25, DiscountPercent: 10, VatPercent: 20, ValidDays: 14, Notes: "Срок изготовления 20 дней"})
+	now := time.Now()
+	revision.Revision = 2
+	revision.Currency = "RUB"
+	revision.CreatedAt = &now
+	client := &proto.ClientORM{Name: "ООО «Сфера»", Inn: "7700000000", Ogrn: "1027700000000", OwnerFio: "Иванов И. И."}
+	quote := &proto.QuoteORM{Id: 12, CustomerName: "АО «Завод»", Title: "Рама сварная"}
+
+	var document bytes.Buffer
+	require.NoError(t, RenderPDF(&document, client, quote, revision))
+	assert.True(t, bytes.HasPrefix(document.Bytes(), []byte("%PDF")))
+}
+
+func TestFormatMoney(t *testing.T) {
+	assert.Equal(t, "0,00", formatMoney(0))
+	assert.Equal(t, "999,50", formatMoney(999.5))
+	assert.Equal(t, "12 345,60", formatMoney(12345.6))
+	assert.Equal(t, "1 000 000,01", formatMoney(1000000.005))
+	assert.Equal(t, "-1 500,00", formatMoney(-1500))
+	assert.Equal(t, "12,5", formatPercent(12.5))
+}
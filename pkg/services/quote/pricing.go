@@ -0,0 +1,139 @@
+package quote
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+// DefaultVatPercent is the VAT rate of new quotes unless specified
+const DefaultVatPercent = 20
+
+var ErrInvalidTerms = errors.New("invalid quote terms")
+
+// Terms are the commercial settings of a revision
+type Terms struct {
+	MarginPercent   float64
+	DiscountPercent float64
+	VatPercent      float64
+	ValidDays       int32
+	Notes           string
+}
+
+// TermsUpdate changes the terms of the previous revision, nil fields are kept
+type TermsUpdate struct {
+	MarginPercent   *float64
+	DiscountPercent *float64
+	VatPercent      *float64
+	ValidDays       *int32
+	Notes           *string
+}
+
+// Validate checks the percentages are within their ranges
+func (t *Terms) Validate() error {
+	switch {
+	case t.MarginPercent < 0 || t.MarginPercent > 1000:
+		return fmt.Errorf("%w: margin_percent must be between 0 and 1000", ErrInvalidTerms)
+	case t.DiscountPercent < 0 || t.DiscountPercent > 100:
+		return fmt.Errorf("%w: discount_percent must be between 0 and 100", ErrInvalidTerms)
+	case t.VatPercent < 0 || t.VatPercent > 100:
+		return fmt.Errorf("%w: vat_percent must be between 0 and 100", ErrInvalidTerms)
+	case t.ValidDays < 0:
+		return fmt.Errorf("%w: valid_days must not be negative", ErrInvalidTerms)
+	}
+
+	return nil
+}
+
+// Apply returns the terms with the updated fields replaced
+func (u *TermsUpdate) Apply(terms Terms) Terms {
+	if u.MarginPercent != nil {
+		terms.MarginPercent = *u.MarginPercent
+	}
+	if u.DiscountPercent != nil {
+		terms.DiscountPercent = *u.DiscountPercent
+	}
+	if u.VatPercent != nil {
+		terms.VatPercent = *u.VatPercent
+	}
+	if u.ValidDays != nil {
+		terms.ValidDays = *u.ValidDays
+	}
+	if u.Notes != nil {
+		terms.Notes = *u.Notes
+	}
+
+	return terms
+}
+
+// RevisionTerms returns the terms of a stored revision
+func RevisionTerms(revision *proto.QuoteRevisionORM) Terms {
+	return Terms{
+		MarginPercent:   revision.MarginPercent,
+		DiscountPercent: revision.DiscountPercent,
+		VatPercent:      revision.VatPercent,
+		ValidDays:       revision.ValidDays,
+		Notes:           revision.Notes,
+	}
+}
+
+// BuildRevision prices the top-level nodes of the cost breakdown with the terms.
+// Unit prices are rounded first so that amounts of the lines add up to the totals.
+func BuildRevision(breakdown *proto.CostNode, terms *Terms) *proto.QuoteRevisionORM {
+	revision := &proto.QuoteRevisionORM{
+		MarginPercent:   terms.MarginPercent,
+		DiscountPercent: terms.DiscountPercent,
+		VatPercent:      terms.VatPercent,
+		ValidDays:       terms.ValidDays,
+		Notes:           terms.Notes,
+	}
+
+	nodes := breakdown.Children
+	if len(nodes) == 0 {
+		nodes = []*proto.CostNode{breakdown}
+	}
+
+	margin := 1 + terms.MarginPercent/100
+	discount := 1 - terms.DiscountPercent/100
+	for i, node := range nodes {
+		quantity := node.Quantity
+		if quantity <= 0 {
+			quantity = 1
+		}
+		unitCost := node.TotalCost / float64(quantity)
+		unitGross := roundMoney(unitCost * margin)
+		item := &proto.QuoteItemORM{
+			Position:  int32(i + 1),
+			NodeId:    node.NodeId,
+			Number:    node.Number,
+			Name:      node.Name,
+			Material:  node.Material,
+			Quantity:  quantity,
+			UnitCost:  roundMoney(unitCost),
+			Cost:      node.TotalCost,
+			UnitPrice: roundMoney(unitCost * margin * discount),
+			Mass:      node.TotalMass,
+		}
+		item.Amount = roundMoney(item.UnitPrice * float64(quantity))
+		revision.Items = append(revision.Items, item)
+
+		revision.Cost += item.Cost
+		revision.GrossAmount += unitGross * float64(quantity)
+		revision.NetAmount += item.Amount
+	}
+
+	revision.Cost = roundMoney(revision.Cost)
+	revision.GrossAmount = roundMoney(revision.GrossAmount)
+	revision.NetAmount = roundMoney(revision.NetAmount)
+	revision.DiscountAmount = roundMoney(revision.GrossAmount - revision.NetAmount)
+	revision.VatAmount = roundMoney(revision.NetAmount * terms.VatPercent / 100)
+	revision.TotalAmount = roundMoney(revision.NetAmount + revision.VatAmount)
+
+	return revision
+}
+
+func roundMoney(v float64) float64 {
+	return math.Round(v*100) / 100
+}
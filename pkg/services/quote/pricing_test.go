@@ -0,0 +1,79 @@
+package quote
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBreakdown() *proto.CostNode {
+	return &proto.CostNode{
+		NodeId:    "root",
+		Name:      "Рама",
+		TotalCost: 300,
+		Children: []*proto.CostNode{
+			{NodeId: "beam", Number: "АБВГ.001", Name: "Балка", Material: "Ст3", Quantity: 2, TotalCost: 200, TotalMass: 10},
+			{NodeId: "plate", Number: "АБВГ.002", Name: "Пластина", Material: "Ст3", Quantity: 3, TotalCost: 100, TotalMass: 3},
+		},
+	}
+}
+
+func TestBuildRevision(t *testing.T) {
+	revision := BuildRevision(testBreakdown(), &Terms{MarginPercent: 25, DiscountPercent: 10, VatPercent: 20, ValidDays: 30})
+
+	require.Len(t, revision.Items, 2)
+	beam, plate := revision.Items[0], revision.Items[1]
+	assert.Equal(t, int32(1), beam.Position)
+	assert.Equal(t, "beam", beam.NodeId)
+	assert.Equal(t, 100.0, beam.UnitCost)
+	assert.Equal(t, 112.5, beam.UnitPrice)
+	assert.Equal(t, 225.0, beam.Amount)
+	assert.Equal(t, int32(2), plate.Position)
+	assert.Equal(t, 33.33, plate.UnitCost)
+	assert.Equal(t, 37.5, plate.UnitPrice)
+	assert.Equal(t, 112.5, plate.Amount)
+
+	assert.Equal(t, 300.0, revision.Cost)
+	assert.Equal(t, 375.01, revision.GrossAmount)
+	assert.Equal(t, 337.5, revision.NetAmount)
+	assert.Equal(t, 37.51, revision.DiscountAmount)
+	assert.Equal(t, 67.5, revision.VatAmount)
+	assert.Equal(t, 405.0, revision.TotalAmount)
+	assert.Equal(t, int32(30), revision.ValidDays)
+}
+
+func TestBuildRevisionSinglePart(t *testing.T) {
+	revision := BuildRevision(&proto.CostNode{NodeId: "part", Name: "Деталь", TotalCost: 50}, &Terms{VatPercent: 20})
+
+	require.Len(t, revision.Items, 1)
+	assert.Equal(t, int32(1), revision.Items[0].Quantity)
+	assert.Equal(t, 50.0, revision.NetAmount)
+	assert.Equal(t, 0.0, revision.DiscountAmount)
+	assert.Equal(t, 60.0, revision.TotalAmount)
+}
+
+func TestTermsValidate(t *testing.T) {
+	assert.NoError(t, (&Terms{MarginPercent: 30, DiscountPercent: 5, VatPercent: 20}).Validate())
+
+	for _, terms := range []Terms{
+		{MarginPercent: -1},
+		{MarginPercent: 1001},
+		{DiscountPercent: 101},
+		{VatPercent: -5},
+		{ValidDays: -1},
+	} {
+		assert.ErrorIs(t, terms.Validate(), ErrInvalidTerms)
+	}
+}
+
+func TestTermsUpdateApply(t *testing.T) {
+	discount := 15.0
+	notes := "Доставка за счёт покупателя"
+	update := TermsUpdate{DiscountPercent: &discount, Notes: &notes}
+
+	terms := update.Apply(Terms{MarginPercent: 20, DiscountPercent: 5, VatPercent: 20, ValidDays: 10})
+
+	assert.Equal(t, Terms{MarginPercent: 20, DiscountPercent: 15, VatPercent: 20, ValidDays: 10, Notes: notes}, terms)
+}
@@ -0,0 +1,246 @@
+package quote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/costing"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrQuoteNotFound    = errors.New("quote not found")
+	ErrRevisionNotFound = errors.New("quote revision not found")
+)
+
+// Service manages commercial quotes built from cost estimates
+type Service struct {
+	db      *gorm.DB
+	costing *costing.Service
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db, costing: costing.NewService(db)}
+}
+
+// Details are the descriptive fields of a quote
+type Details struct {
+	Title           string
+	CustomerName    string
+	CustomerDetails string
+}
+
+// Create makes a quote of the task with the first revision priced from its cost estimate.
+// The estimate is computed when the task has none yet.
+func (s *Service) Create(ctx context.Context, clientID, userID uint64, taskID string, details *Details, terms *Terms) (*proto.QuoteORM, error) {
+	if err := terms.Validate(); err != nil {
+		return nil, err
+	}
+	estimate, err := s.estimate(ctx, clientID, taskID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	revision, err := buildRevision(estimate, terms, userID, 1, now)
+	if err != nil {
+		return nil, err
+	}
+	quote := &proto.QuoteORM{
+		TaskId:          estimate.TaskId,
+		ClientId:        clientID,
+		Title:           details.Title,
+		CustomerName:    details.CustomerName,
+		CustomerDetails: details.CustomerDetails,
+		CurrentRevision: 1,
+		Revisions:       []*proto.QuoteRevisionORM{revision},
+		CreatedAt:       &now,
+		UpdatedAt:       &now,
+	}
+	if err := s.db.WithContext(ctx).Create(quote).Error; err != nil {
+		return nil, fmt.Errorf("failed to save quote: %w", err)
+	}
+
+	return s.Get(ctx, clientID, quote.Id)
+}
+
+// AddRevision creates the next revision with the updated terms of the latest one.
+// With refresh the costs are estimated anew, otherwise the stored estimate is used.
+func (s *Service) AddRevision(ctx context.Context, clientID, userID, quoteID uint64, update *TermsUpdate, refresh bool) (*proto.QuoteORM, error) {
+	current, err := s.Get(ctx, clientID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := Revision(current, current.CurrentRevision)
+	if err != nil {
+		return nil, err
+	}
+	terms := update.Apply(RevisionTerms(latest))
+	if err := terms.Validate(); err != nil {
+		return nil, err
+	}
+	estimate, err := s.estimate(ctx, clientID, current.TaskId, refresh)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var quote proto.QuoteORM
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&quote, "id = ?", quoteID).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		revision, err := buildRevision(estimate, &terms, userID, quote.CurrentRevision+1, now)
+		if err != nil {
+			return err
+		}
+		revision.QuoteId = &quote.Id
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&quote).Updates(map[string]interface{}{
+			"current_revision": revision.Revision,
+			"updated_at":       now,
+		}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save quote revision: %w", err)
+	}
+
+	return s.Get(ctx, clientID, quoteID)
+}
+
+// estimate returns the stored cost estimate of the task, computing it when missing or on refresh
+func (s *Service) estimate(ctx context.Context, clientID uint64, taskID string, refresh bool) (*proto.CostEstimateORM, error) {
+	if !refresh {
+		estimate, err := s.costing.GetEstimate(ctx, clientID, taskID)
+		if !errors.Is(err, costing.ErrEstimateNotFound) {
+			return estimate, err
+		}
+	}
+
+	return s.costing.EstimateTask(ctx, clientID, taskID)
+}
+
+func buildRevision(estimate *proto.CostEstimateORM, terms *Terms, userID uint64, number int32, now time.Time) (*proto.QuoteRevisionORM, error) {
+	if estimate.Breakdown == nil {
+		return nil, fmt.Errorf("cost estimate of task %s has no breakdown", estimate.TaskId)
+	}
+	breakdown := estimate.Breakdown.Data()
+
+	revision := BuildRevision(&breakdown, terms)
+	revision.Revision = number
+	revision.CreatedBy = userID
+	revision.Currency = estimate.Currency
+	revision.PriceListId = estimate.PriceListId
+	revision.PriceListVersion = estimate.PriceListVersion
+	revision.CreatedAt = &now
+
+	return revision, nil
+}
+
+// Get returns a quote of the client with all revisions and their lines
+func (s *Service) Get(ctx context.Context, clientID, quoteID uint64) (*proto.QuoteORM, error) {
+	var quote proto.QuoteORM
+	err := s.db.WithContext(ctx).
+		Preload("Revisions", func(db *gorm.DB) *gorm.DB { return db.Order("revision") }).
+		Preload("Revisions.Items", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		First(&quote, "id = ? AND client_id = ?", quoteID, clientID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrQuoteNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &quote, nil
+}
+
+// List returns quotes of the client without revisions, newest first, optionally of a single task
+func (s *Service) List(ctx context.Context, clientID uint64, taskID string) ([]*proto.QuoteORM, error) {
+	query := s.db.WithContext(ctx).Where("client_id = ?", clientID).Order("id DESC")
+	if taskID != "" {
+		query = query.Where("task_id = ?", taskID)
+	}
+
+	var quotes []*proto.QuoteORM
+	if err := query.Find(&quotes).Error; err != nil {
+		return nil, err
+	}
+
+	return quotes, nil
+}
+
+// Delete removes a quote of the client with all revisions
+func (s *Service) Delete(ctx context.Context, clientID, quoteID uint64) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var quote proto.QuoteORM
+		err := tx.First(&quote, "id = ? AND client_id = ?", quoteID, clientID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrQuoteNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		return deleteQuotes(tx, []uint64{quote.Id})
+	})
+}
+
+// DeleteTaskQuotes removes the quotes of a task within the transaction
+func DeleteTaskQuotes(tx *gorm.DB, taskID string) error {
+	var ids []uint64
+	if err := tx.Model(&proto.QuoteORM{}).Where("task_id = ?", taskID).Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+
+	return deleteQuotes(tx, ids)
+}
+
+func deleteQuotes(tx *gorm.DB, ids []uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	var revisions []uint64
+	if err := tx.Model(&proto.QuoteRevisionORM{}).Where("quote_id IN ?", ids).Pluck("id", &revisions).Error; err != nil {
+		return err
+	}
+	if len(revisions) > 0 {
+		if err := tx.Where("quote_revision_id IN ?", revisions).Delete(&proto.QuoteItemORM{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id IN ?", revisions).Delete(&proto.QuoteRevisionORM{}).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Where("id IN ?", ids).Delete(&proto.QuoteORM{}).Error
+}
+
+// WritePDF renders the revision of the quote with the requisites of its client
+func (s *Service) WritePDF(ctx context.Context, w io.Writer, quote *proto.QuoteORM, revision *proto.QuoteRevisionORM) error {
+	var client proto.ClientORM
+	if err := s.db.WithContext(ctx).First(&client, quote.ClientId).Error; err != nil {
+		return err
+	}
+
+	return RenderPDF(w, &client, quote, revision)
+}
+
+// Revision returns the revision of a loaded quote by its number
+func Revision(quote *proto.QuoteORM, number int32) (*proto.QuoteRevisionORM, error) {
+	for _, revision := range quote.Revisions {
+		if revision.Revision == number {
+			return revision, nil
+		}
+	}
+
+	return nil, ErrRevisionNotFound
+}
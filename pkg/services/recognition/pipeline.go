@@ -0,0 +1,75 @@
+package recognition
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/assortment"
+	"github.com/bazilio91/sferra-cloud/pkg/services/counts"
+	"github.com/bazilio91/sferra-cloud/pkg/services/mass"
+	"github.com/bazilio91/sferra-cloud/pkg/services/material"
+	"github.com/bazilio91/sferra-cloud/pkg/services/purchase"
+	"github.com/bazilio91/sferra-cloud/pkg/services/validation"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Pipeline prepares a recognized tree for storage. Results of the workers and of the
+// sandbox worker go through the same steps so both are stored in the same shape.
+type Pipeline struct {
+	purchase    *purchase.Service
+	mass        *mass.Service
+	materials   *material.Service
+	assortments *assortment.Service
+	validation  *validation.Service
+}
+
+func NewPipeline(db *gorm.DB) *Pipeline {
+	return &Pipeline{
+		purchase:    purchase.NewService(db),
+		mass:        mass.NewService(db),
+		materials:   material.NewService(db),
+		assortments: assortment.NewService(db),
+		validation:  validation.NewService(db),
+	}
+}
+
+// Apply accumulates the counts of the result, types its sizes, marks standard and purchased parts,
+// annotates assortments and masses, records unmatched materials for review, validates it and sets it
+// as the recognition result of the task
+func (p *Pipeline) Apply(ctx context.Context, task *proto.DataRecognitionTaskORM, result *proto.TreeNode) error {
+	classifier, err := p.purchase.Classifier(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load standards dictionary: %w", err)
+	}
+	if err := counts.Accumulate(result); err != nil {
+		return err
+	}
+	types.AnnotateSizes(result)
+	classifier.Mark(result)
+	if err := p.assortments.Annotate(ctx, result); err != nil {
+		return fmt.Errorf("failed to load assortment standards: %w", err)
+	}
+	var clientID uint64
+	if task.ClientId != nil {
+		clientID = *task.ClientId
+	}
+	if err := p.mass.Annotate(ctx, clientID, result); err != nil {
+		return fmt.Errorf("failed to load material densities: %w", err)
+	}
+	// the review list is best effort and does not hold up the task
+	if err := p.materials.RecordUnmatched(ctx, task.Id, result); err != nil {
+		log.Printf("failed to record unmatched materials of task %s: %v", task.Id, err)
+	}
+	if err := p.validation.Apply(ctx, clientID, task.Id, result); err != nil {
+		return fmt.Errorf("failed to validate recognition result: %w", err)
+	}
+
+	stored := datatypes.NewJSONType[proto.TreeNode](*result)
+	task.RecognitionResult = &stored
+
+	return nil
+}
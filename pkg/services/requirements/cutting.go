@@ -0,0 +1,292 @@
+package requirements
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// Stock bar length and saw cut width in mm used when no stock length matches a profile
+const (
+	DefaultStockLength = 6000
+	DefaultKerf        = 3
+)
+
+// MaxGroupPieces bounds the pieces planned per cutting or nesting group; the plans take time in
+// pieces times bars or sheets and the accumulated counts are unbounded. Pieces over the limit
+// are reported as unplanned.
+const MaxGroupPieces = 10000
+
+// epsilon absorbs rounding of the piece lengths when fitting them into a bar
+const epsilon = 1e-6
+
+// StockLengths selects the stock bar lengths a profile is cut from
+type StockLengths struct {
+	// client entries first, then by descending specificity
+	entries []*proto.StockLengthORM
+}
+
+func NewStockLengths(entries []*proto.StockLengthORM) *StockLengths {
+	sorted := make([]*proto.StockLengthORM, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Length > 0 {
+			sorted = append(sorted, entry)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if (sorted[i].ClientId != nil) != (sorted[j].ClientId != nil) {
+			return sorted[i].ClientId != nil
+		}
+		return stockSpecificity(sorted[i]) > stockSpecificity(sorted[j])
+	})
+
+	return &StockLengths{entries: sorted}
+}
+
+func stockSpecificity(entry *proto.StockLengthORM) int {
+	score := len(types.NormalizeMaterial(entry.Material))
+	if entry.AssortmentType != "" {
+		score += 1000
+	}
+	if entry.Size != "" {
+		score += 2000
+	}
+
+	return score
+}
+
+// Stock returns the available lengths in ascending order and the saw cut width for the part.
+// All lengths of the first matching entry's scope are available; without a match the defaults apply.
+func (s *StockLengths) Stock(part Part) ([]float64, float64) {
+	if s != nil {
+		for _, entry := range s.entries {
+			if !stockMatches(entry, part) {
+				continue
+			}
+			var (
+				lengths []float64
+				kerf    float64
+			)
+			for _, other := range s.entries {
+				if sameStockScope(entry, other) {
+					lengths = append(lengths, other.Length)
+					kerf = math.Max(kerf, other.Kerf)
+				}
+			}
+			sort.Float64s(lengths)
+			return lengths, kerf
+		}
+	}
+
+	return []float64{DefaultStockLength}, DefaultKerf
+}
+
+func stockMatches(entry *proto.StockLengthORM, part Part) bool {
+	if entry.AssortmentType != "" && !strings.EqualFold(entry.AssortmentType, part.AssortmentType) {
+		return false
+	}
+	if entry.Material != "" && !strings.Contains(types.NormalizeMaterial(part.Grade), types.NormalizeMaterial(entry.Material)) {
+		return false
+	}
+
+	return entry.Size == "" || normalizeSize(entry.Size) == part.Size
+}
+
+func sameStockScope(a, b *proto.StockLengthORM) bool {
+	return (a.ClientId == nil) == (b.ClientId == nil) &&
+		(a.ClientId == nil || *a.ClientId == *b.ClientId) &&
+		strings.EqualFold(a.AssortmentType, b.AssortmentType) &&
+		types.NormalizeMaterial(a.Material) == types.NormalizeMaterial(b.Material) &&
+		normalizeSize(a.Size) == normalizeSize(b.Size)
+}
+
+// Optimize cuts the pieces from the stock lengths with the first fit decreasing heuristic.
+// Bars are filled at the longest stock length and then shortened to the shortest length
+// their cuts fit in. Every cut takes the kerf, except when a piece ends at the end of the bar.
+// Pieces longer than the longest stock length are returned as oversize. Lengths are in mm.
+func Optimize(pieces, stock []float64, kerf float64) ([]*proto.CutPattern, []float64) {
+	if len(stock) == 0 {
+		return nil, append([]float64(nil), pieces...)
+	}
+	longest := stock[len(stock)-1]
+
+	sorted := append([]float64(nil), pieces...)
+	sort.Sort(sort.Reverse(sort.Float64Slice(sorted)))
+
+	type bar struct {
+		cuts []float64
+		used float64
+	}
+	var (
+		bars     []*bar
+		oversize []float64
+	)
+	for _, piece := range sorted {
+		if piece > longest+epsilon {
+			oversize = append(oversize, piece)
+			continue
+		}
+		var target *bar
+		for _, b := range bars {
+			if b.used+piece+kerf <= longest+kerf+epsilon {
+				target = b
+				break
+			}
+		}
+		if target == nil {
+			target = &bar{}
+			bars = append(bars, target)
+		}
+		target.cuts = append(target.cuts, piece)
+		target.used += piece + kerf
+	}
+
+	var (
+		patterns []*proto.CutPattern
+		byKey    = make(map[string]*proto.CutPattern)
+	)
+	for _, b := range bars {
+		length := longest
+		for _, l := range stock {
+			if b.used <= l+kerf+epsilon {
+				length = l
+				break
+			}
+		}
+		key := fmt.Sprint(length, b.cuts)
+		if pattern, ok := byKey[key]; ok {
+			pattern.Count++
+			continue
+		}
+		var cut float64
+		for _, c := range b.cuts {
+			cut += c
+		}
+		pattern := &proto.CutPattern{StockLength: length, Cuts: b.cuts, Count: 1, Waste: round(length - cut)}
+		byKey[key] = pattern
+		patterns = append(patterns, pattern)
+	}
+	sort.SliceStable(patterns, func(i, j int) bool {
+		return patterns[i].Count > patterns[j].Count
+	})
+
+	return patterns, oversize
+}
+
+// piece is a part length in mm with the number of pieces of that length
+type piece struct {
+	length float64
+	count  int64
+}
+
+// profileRow collects the pieces of a profile row for the cutting plan
+type profileRow struct {
+	part       Part
+	pieces     []piece
+	unmeasured int64
+}
+
+func (r *profileRow) add(length float64, count int64) {
+	if length <= 0 {
+		r.unmeasured += count
+		return
+	}
+	r.pieces = append(r.pieces, piece{length: length, count: count})
+}
+
+// Cutting plans the cutting of the accumulated profile parts, one group per grade, profile and size.
+// Sheets and materials without an assortment type are not cut from bars and are left out.
+func (a *Aggregator) Cutting(stock *StockLengths) *proto.CuttingPlan {
+	plan := &proto.CuttingPlan{}
+	for _, key := range a.keys() {
+		profile, ok := a.profiles[key]
+		if !ok {
+			continue
+		}
+		plan.Groups = append(plan.Groups, planGroup(profile, stock))
+	}
+
+	return plan
+}
+
+func planGroup(profile *profileRow, stock *StockLengths) *proto.CuttingGroup {
+	lengths, kerf := stock.Stock(profile.part)
+	group := &proto.CuttingGroup{
+		Grade:          profile.part.Grade,
+		AssortmentType: profile.part.AssortmentType,
+		Size:           profile.part.Size,
+		Kerf:           kerf,
+		Unmeasured:     profile.unmeasured,
+	}
+
+	var pieces []float64
+	for _, p := range profile.pieces {
+		planned := min(p.count, MaxGroupPieces-int64(len(pieces)))
+		for i := int64(0); i < planned; i++ {
+			pieces = append(pieces, p.length)
+		}
+		group.Unplanned += p.count - planned
+	}
+	group.Patterns, group.Oversize = Optimize(pieces, lengths, kerf)
+
+	var partsLength, stockLength float64
+	for _, pattern := range group.Patterns {
+		group.Bars += pattern.Count
+		stockLength += pattern.StockLength * float64(pattern.Count)
+		for _, cut := range pattern.Cuts {
+			partsLength += cut * float64(pattern.Count)
+		}
+		group.Pieces += int64(len(pattern.Cuts)) * int64(pattern.Count)
+	}
+	group.PartsLength = round(partsLength / 1000)
+	group.StockLength = round(stockLength / 1000)
+	if stockLength > 0 {
+		group.WastePercent = math.Round((stockLength-partsLength)/stockLength*10000) / 100
+	}
+
+	return group
+}
+
+// StockUsage tells how much stock a part consumes per unit of its own length or area
+type StockUsage struct {
+	factors map[rowKey]float64
+}
+
+// Usage derives the stock consumption of the profiles and sheets from the cutting and nesting plans
+// of the aggregated parts; either plan may be nil
+func Usage(cutting *proto.CuttingPlan, nesting *proto.NestingPlan) *StockUsage {
+	usage := &StockUsage{factors: make(map[rowKey]float64)}
+	for _, group := range cutting.GetGroups() {
+		if group.PartsLength <= 0 {
+			continue
+		}
+		part := Part{Grade: group.Grade, AssortmentType: group.AssortmentType, Size: group.Size}
+		usage.factors[part.key()] = math.Round(group.StockLength/group.PartsLength*10000) / 10000
+	}
+	for _, group := range nesting.GetGroups() {
+		if group.PartsArea <= 0 {
+			continue
+		}
+		part := Part{Grade: group.Grade, AssortmentType: group.AssortmentType, Size: group.Size}
+		usage.factors[part.key()] = math.Round(group.SheetArea/group.PartsArea*10000) / 10000
+	}
+
+	return usage
+}
+
+// Factor returns the stock consumed per unit of the part length or area, 0 when the part is not planned
+func (u *StockUsage) Factor(node *proto.TreeNode) float64 {
+	if u == nil {
+		return 0
+	}
+	part, ok := Classify(node)
+	if !ok {
+		return 0
+	}
+
+	return u.factors[part.key()]
+}
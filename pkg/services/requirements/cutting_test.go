@@ -0,0 +1,131 @@
+package requirements
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimize(t *testing.T) {
+	pieces := []float64{2000, 2000, 2000, 2000, 2000, 2000, 1000, 7000}
+	patterns, oversize := Optimize(pieces, []float64{4000, 6000}, 5)
+
+	assert.Equal(t, []float64{7000}, oversize)
+	require.Len(t, patterns, 2)
+	// two cuts of 2000 and the kerf between them do not fit into 4000, three do not fit into 6000 either
+	assert.Equal(t, &proto.CutPattern{StockLength: 6000, Cuts: []float64{2000, 2000}, Count: 2, Waste: 2000}, patterns[0])
+	assert.Equal(t, &proto.CutPattern{StockLength: 6000, Cuts: []float64{2000, 2000, 1000}, Count: 1, Waste: 1000}, patterns[1])
+}
+
+func TestOptimizeShortensBars(t *testing.T) {
+	// the bar is filled at 6000 and then shortened to the shortest length the cuts fit in
+	patterns, oversize := Optimize([]float64{1500, 1500, 1500}, []float64{4600, 6000}, 3)
+	assert.Empty(t, oversize)
+	require.Len(t, patterns, 1)
+	assert.Equal(t, 4600.0, patterns[0].StockLength)
+	assert.Equal(t, 100.0, patterns[0].Waste)
+}
+
+func TestStockLengths(t *testing.T) {
+	clientID := uint64(1)
+	stock := NewStockLengths([]*proto.StockLengthORM{
+		{Length: 12000, Kerf: 2},
+		{AssortmentType: "Труба", Length: 9000, Kerf: 4},
+		{AssortmentType: "Труба", Length: 6000, Kerf: 3},
+		{AssortmentType: "Труба", Size: "57х3,5", Length: 10500, Kerf: 3},
+		{ClientId: &clientID, AssortmentType: "Уголок", Length: 11700},
+	})
+
+	lengths, kerf := stock.Stock(Part{Grade: "20", AssortmentType: "труба", Size: "57×3.5"})
+	assert.Equal(t, []float64{10500}, lengths)
+	assert.Equal(t, 3.0, kerf)
+
+	lengths, kerf = stock.Stock(Part{Grade: "20", AssortmentType: "Труба", Size: "89×4"})
+	assert.Equal(t, []float64{6000, 9000}, lengths)
+	assert.Equal(t, 4.0, kerf)
+
+	lengths, _ = stock.Stock(Part{Grade: "Ст3", AssortmentType: "Уголок", Size: "50×5"})
+	assert.Equal(t, []float64{11700}, lengths)
+
+	lengths, kerf = (*StockLengths)(nil).Stock(Part{AssortmentType: "Круг"})
+	assert.Equal(t, []float64{DefaultStockLength}, lengths)
+	assert.Equal(t, float64(DefaultKerf), kerf)
+}
+
+func TestAggregatorCutting(t *testing.T) {
+	pipe := "Труба 57х3,5 ГОСТ 8732-78/20 ГОСТ 8731-74"
+	root := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			part("plate", "Лист 10 09Г2С", 500, 1000, 39.25, 2),
+			part("long", pipe, 57, 1500, 6.9, 3),
+			part("short", pipe, 57, 1000, 4.6, 2),
+			{Id: "unmeasured", Material: pipe, AccumulatedCount: 4},
+		},
+	}
+	aggregator := NewAggregator()
+	aggregator.Add(root)
+	stock := NewStockLengths([]*proto.StockLengthORM{
+		{AssortmentType: "Труба", Length: 6000, Kerf: 3},
+		{AssortmentType: "Труба", Length: 3000, Kerf: 3},
+	})
+
+	plan := aggregator.Cutting(stock)
+	require.Len(t, plan.Groups, 1)
+	group := plan.Groups[0]
+	assert.Equal(t, "20", group.Grade)
+	assert.Equal(t, "57×3.5", group.Size)
+	assert.Equal(t, int64(5), group.Pieces)
+	assert.Equal(t, int64(4), group.Unmeasured)
+	assert.Equal(t, 6.5, group.PartsLength)
+	assert.Equal(t, int32(2), group.Bars)
+	assert.Equal(t, 9.0, group.StockLength)
+	assert.Equal(t, 27.78, group.WastePercent)
+	require.Len(t, group.Patterns, 2)
+	assert.Equal(t, []float64{1500, 1500, 1500, 1000}, group.Patterns[0].Cuts)
+	assert.Equal(t, 6000.0, group.Patterns[0].StockLength)
+	assert.Equal(t, []float64{1000}, group.Patterns[1].Cuts)
+	assert.Equal(t, 3000.0, group.Patterns[1].StockLength)
+
+	report := aggregator.Report(NewWasteFactors([]*proto.WasteFactorORM{{AssortmentType: "Лист", Percent: 5}}), stock, nil)
+	require.Len(t, report.Rows, 2)
+	sheet, tube := report.Rows[0], report.Rows[1]
+	assert.Equal(t, 5.0, sheet.WastePercent)
+	assert.Equal(t, int32(0), sheet.Bars)
+	assert.Equal(t, int32(2), tube.Bars)
+	assert.Equal(t, 9.0, tube.StockLength)
+	assert.Equal(t, 38.46, tube.WastePercent)
+	assert.Equal(t, 9.0, tube.GrossLength)
+
+	// an explicit waste factor wins over the plan
+	report = aggregator.Report(NewWasteFactors([]*proto.WasteFactorORM{{AssortmentType: "Труба", Percent: 10}}), stock, nil)
+	tube = report.Rows[1]
+	assert.Equal(t, int32(2), tube.Bars)
+	assert.Equal(t, 10.0, tube.WastePercent)
+	assert.Equal(t, 7.15, tube.GrossLength)
+
+	usage := Usage(plan, nil)
+	assert.Equal(t, 1.3846, usage.Factor(root.Leaves[1]))
+	assert.Equal(t, 0.0, usage.Factor(root.Leaves[0]))
+	assert.Equal(t, 0.0, (*StockUsage)(nil).Factor(root.Leaves[1]))
+}
+
+func TestAggregatorCuttingLimitsPieces(t *testing.T) {
+	pipe := "Труба 57х3,5 ГОСТ 8732-78/20 ГОСТ 8731-74"
+	aggregator := NewAggregator()
+	aggregator.Add(&proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			part("long", pipe, 57, 1500, 6.9, MaxGroupPieces-1),
+			part("short", pipe, 57, 1000, 4.6, 1_000_000_000),
+		},
+	})
+
+	plan := aggregator.Cutting(nil)
+	require.Len(t, plan.Groups, 1)
+	group := plan.Groups[0]
+	assert.Equal(t, int64(MaxGroupPieces), group.Pieces)
+	assert.Equal(t, int64(1_000_000_000-1), group.Unplanned)
+}
@@ -0,0 +1,330 @@
+package requirements
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// sizePattern matches a profile size such as 10, 57х3,5 or 100x100x8
+var sizePattern = regexp.MustCompile(`\d+(?:[.,]\d+)?(?:\s*[xXхХ×*]\s*\d+(?:[.,]\d+)?)*`)
+
+var sizeSeparator = regexp.MustCompile(`\s*[xXхХ×*]\s*`)
+
+// Part is the material of a part as used for grouping
+type Part struct {
+	Grade          string
+	AssortmentType string
+	Size           string
+	// sheets are measured by area, other profiles by length
+	Sheet bool
+}
+
+// Classify derives the grade, assortment type and size of a part from its recognized assortment
+// and material. Parts without a material are not classified.
+func Classify(node *proto.TreeNode) (Part, bool) {
+	material := strings.TrimSpace(types.NodeMaterial(node))
+	if material == "" {
+		return Part{}, false
+	}
+
+	var assortment *proto.Assortment
+	if node.Figure != nil && node.Figure.Assortment != nil {
+		assortment = node.Figure.Assortment
+	} else if node.Spec != nil && node.Spec.Assortment != nil {
+		assortment = node.Spec.Assortment
+	}
+
+	// the profile is written before the grade: "Лист 10 Ст3", "Лист Б-ПУ-14 ГОСТ 19903/Ст3сп ГОСТ 14637-89"
+	profile, grade, _ := strings.Cut(material, "/")
+	profile = cutStandard(profile)
+	words := strings.Fields(profile)
+
+	var part Part
+	if assortment != nil {
+		part.AssortmentType = strings.TrimSpace(assortment.Name)
+		if part.AssortmentType == "" {
+			part.AssortmentType = strings.TrimSpace(assortment.FigureType)
+		}
+		part.Grade = strings.TrimSpace(assortment.ChemicalComposition)
+	}
+	if part.AssortmentType == "" && len(words) > 1 && !strings.ContainsAny(words[0], "0123456789") {
+		part.AssortmentType = words[0]
+	}
+
+	rest := profile
+	if part.AssortmentType != "" && len(words) > 0 && strings.EqualFold(words[0], part.AssortmentType) {
+		rest = strings.TrimSpace(strings.TrimPrefix(profile, words[0]))
+	}
+	if loc := sizePattern.FindStringIndex(rest); loc != nil && part.AssortmentType != "" {
+		part.Size = normalizeSize(rest[loc[0]:loc[1]])
+		rest = rest[loc[1]:]
+	}
+	if part.Size == "" && assortment != nil {
+		part.Size = types.FormatProfileSize(assortment.Size_)
+	}
+
+	if part.Grade == "" {
+		part.Grade = strings.TrimSpace(cutStandard(grade))
+	}
+	if part.Grade == "" && part.AssortmentType != "" {
+		part.Grade = strings.TrimSpace(rest)
+	}
+	if part.Grade == "" {
+		part.Grade = material
+	}
+
+	kind := strings.ToLower(part.AssortmentType)
+	part.Sheet = strings.Contains(kind, "лист") || strings.Contains(kind, "плит") || strings.Contains(kind, "sheet")
+
+	return part, true
+}
+
+// cutStandard drops the standard reference from a material part
+func cutStandard(value string) string {
+	if i := strings.Index(strings.ToUpper(value), "ГОСТ"); i >= 0 {
+		value = value[:i]
+	}
+
+	return strings.TrimSpace(value)
+}
+
+func normalizeSize(size string) string {
+	return strings.ReplaceAll(sizeSeparator.ReplaceAllString(size, "×"), ",", ".")
+}
+
+type rowKey struct {
+	grade, assortmentType, size string
+}
+
+func (p Part) key() rowKey {
+	return rowKey{
+		grade:          types.NormalizeMaterial(p.Grade),
+		assortmentType: strings.ToLower(p.AssortmentType),
+		size:           p.Size,
+	}
+}
+
+// Aggregator accumulates the material requirements of one or more trees
+type Aggregator struct {
+	rows       map[rowKey]*proto.MaterialRequirement
+	profiles   map[rowKey]*profileRow
+	sheets     map[rowKey]*sheetRow
+	unresolved []string
+}
+
+func NewAggregator() *Aggregator {
+	return &Aggregator{
+		rows:     make(map[rowKey]*proto.MaterialRequirement),
+		profiles: make(map[rowKey]*profileRow),
+		sheets:   make(map[rowKey]*sheetRow),
+	}
+}
+
+// Add accumulates the parts of the tree. Quantities come from accumulated_count,
+// so the amounts cover the whole product.
+func (a *Aggregator) Add(root *proto.TreeNode) {
+	if len(root.Leaves) == 0 {
+		a.addPart(root)
+		return
+	}
+	for _, leaf := range root.Leaves {
+		a.Add(leaf)
+	}
+}
+
+func (a *Aggregator) addPart(node *proto.TreeNode) {
+	part, ok := Classify(node)
+	if !ok {
+		a.unresolved = append(a.unresolved, node.Id)
+		return
+	}
+
+	row, ok := a.rows[part.key()]
+	if !ok {
+		row = &proto.MaterialRequirement{
+			Grade:          part.Grade,
+			AssortmentType: part.AssortmentType,
+			Size:           part.Size,
+		}
+		a.rows[part.key()] = row
+	}
+
+	quantity := float64(types.NodeQuantity(node))
+	row.Parts++
+	row.Quantity += int64(quantity)
+
+	var (
+		profile *profileRow
+		sheet   *sheetRow
+	)
+	switch {
+	case part.Sheet:
+		if sheet, ok = a.sheets[part.key()]; !ok {
+			sheet = &sheetRow{part: part}
+			a.sheets[part.key()] = sheet
+		}
+	case part.AssortmentType != "":
+		if profile, ok = a.profiles[part.key()]; !ok {
+			profile = &profileRow{part: part}
+			a.profiles[part.key()] = profile
+		}
+	}
+	if node.Figure == nil {
+		if profile != nil {
+			profile.add(0, int64(quantity))
+		}
+		if sheet != nil {
+			sheet.add(node.Id, 0, 0, int64(quantity))
+		}
+		return
+	}
+	row.Mass += float64(node.Figure.Mass) * quantity
+	vertical, horizontal := float64(node.Figure.SizeVertical), float64(node.Figure.SizeHorizontal)
+	if part.Sheet {
+		row.Area += vertical * horizontal / 1e6 * quantity
+	} else {
+		row.Length += math.Max(vertical, horizontal) / 1000 * quantity
+	}
+	if profile != nil {
+		profile.add(math.Max(vertical, horizontal), int64(quantity))
+	}
+	if sheet != nil {
+		sheet.add(node.Id, horizontal, vertical, int64(quantity))
+	}
+}
+
+// keys returns the row keys sorted by grade, assortment type and size
+func (a *Aggregator) keys() []rowKey {
+	keys := make([]rowKey, 0, len(a.rows))
+	for key := range a.rows {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].grade != keys[j].grade {
+			return keys[i].grade < keys[j].grade
+		}
+		if keys[i].assortmentType != keys[j].assortmentType {
+			return keys[i].assortmentType < keys[j].assortmentType
+		}
+		return keys[i].size < keys[j].size
+	})
+
+	return keys
+}
+
+// Report returns the accumulated rows sorted by grade, assortment type and size with the waste allowances applied.
+// With stock lengths the profiles are planned for cutting and with sheet formats the sheets are planned
+// for nesting. A matching waste factor is an explicit allowance and wins, other planned rows take
+// the allowance from the stock consumed by the plan.
+func (a *Aggregator) Report(factors *WasteFactors, stock *StockLengths, formats *SheetFormats) *proto.MaterialRequirements {
+	report := &proto.MaterialRequirements{Unresolved: a.unresolved}
+
+	groups := make(map[rowKey]*proto.CuttingGroup)
+	if stock != nil {
+		for _, group := range a.Cutting(stock).Groups {
+			part := Part{Grade: group.Grade, AssortmentType: group.AssortmentType, Size: group.Size}
+			groups[part.key()] = group
+		}
+	}
+	nested := make(map[rowKey]*proto.NestingGroup)
+	if formats != nil {
+		for _, group := range a.Nesting(formats).Groups {
+			part := Part{Grade: group.Grade, AssortmentType: group.AssortmentType, Size: group.Size}
+			nested[part.key()] = group
+		}
+	}
+
+	for _, key := range a.keys() {
+		row := a.rows[key]
+		percent, explicit := factors.Match(row.Grade, row.AssortmentType)
+		row.WastePercent = percent
+		if group, ok := groups[key]; ok && group.PartsLength > 0 {
+			row.Bars = group.Bars
+			row.StockLength = group.StockLength
+			if !explicit {
+				row.WastePercent = math.Round((group.StockLength/group.PartsLength-1)*10000) / 100
+			}
+		}
+		if group, ok := nested[key]; ok && group.PartsArea > 0 {
+			row.Sheets = group.Sheets
+			row.SheetArea = group.SheetArea
+			if !explicit {
+				row.WastePercent = math.Round((group.SheetArea/group.PartsArea-1)*10000) / 100
+			}
+		}
+		allowance := 1 + row.WastePercent/100
+		row.Length = round(row.Length)
+		row.Area = round(row.Area)
+		row.Mass = round(row.Mass)
+		row.GrossLength = round(row.Length * allowance)
+		row.GrossArea = round(row.Area * allowance)
+		row.GrossMass = round(row.Mass * allowance)
+
+		report.Rows = append(report.Rows, row)
+		report.TotalMass += row.Mass
+		report.TotalGrossMass += row.GrossMass
+	}
+	report.TotalMass = round(report.TotalMass)
+	report.TotalGrossMass = round(report.TotalGrossMass)
+
+	return report
+}
+
+// WasteFactors selects the waste allowance of a material
+type WasteFactors struct {
+	// client factors first, then by descending specificity
+	factors []*proto.WasteFactorORM
+}
+
+func NewWasteFactors(factors []*proto.WasteFactorORM) *WasteFactors {
+	sorted := append([]*proto.WasteFactorORM(nil), factors...)
+	specificity := func(f *proto.WasteFactorORM) int {
+		score := len(types.NormalizeMaterial(f.Material))
+		if f.AssortmentType != "" {
+			score += 1000
+		}
+		return score
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if (sorted[i].ClientId != nil) != (sorted[j].ClientId != nil) {
+			return sorted[i].ClientId != nil
+		}
+		return specificity(sorted[i]) > specificity(sorted[j])
+	})
+
+	return &WasteFactors{factors: sorted}
+}
+
+// Percent returns the allowance of the first matching factor, 0 when none matches
+func (w *WasteFactors) Percent(grade, assortmentType string) float64 {
+	percent, _ := w.Match(grade, assortmentType)
+	return percent
+}
+
+// Match returns the allowance of the first matching factor and whether any factor matches
+func (w *WasteFactors) Match(grade, assortmentType string) (float64, bool) {
+	if w == nil {
+		return 0, false
+	}
+	normalized := types.NormalizeMaterial(grade)
+	for _, factor := range w.factors {
+		if factor.AssortmentType != "" && !strings.EqualFold(factor.AssortmentType, assortmentType) {
+			continue
+		}
+		if factor.Material != "" && !strings.Contains(normalized, types.NormalizeMaterial(factor.Material)) {
+			continue
+		}
+		return factor.Percent, true
+	}
+
+	return 0, false
+}
+
+func round(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
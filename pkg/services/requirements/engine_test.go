@@ -0,0 +1,128 @@
+package requirements
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func part(id, material string, vertical, horizontal, mass float32, accumulated int32) *proto.TreeNode {
+	return &proto.TreeNode{
+		Id:               id,
+		Material:         material,
+		Count:            1,
+		AccumulatedCount: accumulated,
+		Figure:           &proto.Figure{SizeVertical: vertical, SizeHorizontal: horizontal, Mass: mass},
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		node *proto.TreeNode
+		want Part
+	}{
+		{
+			name: "sheet from material",
+			node: part("1", "Лист 10 09Г2С", 100, 200, 1, 1),
+			want: Part{Grade: "09Г2С", AssortmentType: "Лист", Size: "10", Sheet: true},
+		},
+		{
+			name: "pipe with standards",
+			node: part("2", "Труба 57х3,5 ГОСТ 8732-78/20 ГОСТ 8731-74", 0, 1000, 1, 1),
+			want: Part{Grade: "20", AssortmentType: "Труба", Size: "57×3.5"},
+		},
+		{
+			name: "recognized assortment",
+			node: &proto.TreeNode{
+				Material: "Лист Б-ПУ-14 ГОСТ 19903/СТ3СП ГОСТ 14637-89",
+				Figure: &proto.Figure{Assortment: &proto.Assortment{
+					Name: "Лист", FigureType: "Лист", ChemicalComposition: "СТ3СП",
+				}},
+			},
+			want: Part{Grade: "СТ3СП", AssortmentType: "Лист", Size: "14", Sheet: true},
+		},
+		{
+			name: "grade only",
+			node: part("3", "Ст3", 10, 10, 1, 1),
+			want: Part{Grade: "Ст3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Classify(tt.node)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, ok := Classify(&proto.TreeNode{Id: "empty"})
+	assert.False(t, ok)
+}
+
+func TestAggregatorReport(t *testing.T) {
+	root := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			part("plate", "Лист 10 09Г2С", 500, 1000, 39.25, 2),
+			{
+				Id:               "frame",
+				AccumulatedCount: 3,
+				Leaves: []*proto.TreeNode{
+					part("rib", "Лист 10 09г2с", 200, 500, 7.85, 6),
+					part("pipe", "Труба 57х3,5 ГОСТ 8732-78/20 ГОСТ 8731-74", 57, 1500, 6.9, 3),
+					{Id: "unknown", AccumulatedCount: 3},
+				},
+			},
+		},
+	}
+
+	aggregator := NewAggregator()
+	aggregator.Add(root)
+	report := aggregator.Report(NewWasteFactors([]*proto.WasteFactorORM{
+		{AssortmentType: "лист", Percent: 15},
+		{Percent: 5},
+	}), nil, nil)
+
+	require.Len(t, report.Rows, 2)
+	sheet := report.Rows[0]
+	assert.Equal(t, "09Г2С", sheet.Grade)
+	assert.Equal(t, "Лист", sheet.AssortmentType)
+	assert.Equal(t, "10", sheet.Size)
+	assert.Equal(t, int32(2), sheet.Parts)
+	assert.Equal(t, int64(8), sheet.Quantity)
+	assert.Equal(t, 1.6, sheet.Area)
+	assert.Equal(t, 0.0, sheet.Length)
+	assert.Equal(t, 125.6, sheet.Mass)
+	assert.Equal(t, 15.0, sheet.WastePercent)
+	assert.Equal(t, 1.84, sheet.GrossArea)
+	assert.Equal(t, 144.44, sheet.GrossMass)
+
+	pipe := report.Rows[1]
+	assert.Equal(t, "20", pipe.Grade)
+	assert.Equal(t, "57×3.5", pipe.Size)
+	assert.Equal(t, int64(3), pipe.Quantity)
+	assert.Equal(t, 4.5, pipe.Length)
+	assert.Equal(t, 5.0, pipe.WastePercent)
+	assert.Equal(t, 4.725, pipe.GrossLength)
+
+	assert.Equal(t, []string{"unknown"}, report.Unresolved)
+	assert.Equal(t, 146.3, report.TotalMass)
+}
+
+func TestWasteFactorsPrecedence(t *testing.T) {
+	clientID := uint64(1)
+	factors := NewWasteFactors([]*proto.WasteFactorORM{
+		{Material: "09Г2С", Percent: 10},
+		{AssortmentType: "Лист", Material: "09Г2С", Percent: 12},
+		{ClientId: &clientID, AssortmentType: "Труба", Percent: 3},
+	})
+
+	assert.Equal(t, 12.0, factors.Percent("09г2с", "Лист"))
+	assert.Equal(t, 10.0, factors.Percent("09Г2С", "Круг"))
+	assert.Equal(t, 3.0, factors.Percent("09Г2С", "Труба"))
+	assert.Equal(t, 0.0, factors.Percent("Ст3", "Круг"))
+	assert.Equal(t, 0.0, (*WasteFactors)(nil).Percent("Ст3", "Лист"))
+}
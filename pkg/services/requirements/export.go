@@ -0,0 +1,140 @@
+package requirements
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/xuri/excelize/v2"
+)
+
+// CSVHeader is the header of material requirement CSV files; lengths are in metres,
+// areas in square metres and masses in kg
+var CSVHeader = []string{
+	"grade", "assortment_type", "size", "parts", "quantity", "length", "area", "mass",
+	"waste_percent", "gross_length", "gross_area", "gross_mass", "bars", "stock_length",
+	"sheets", "sheet_area",
+}
+
+// xlsxHeader are the column titles of the spreadsheet in the order of CSVHeader
+var xlsxHeader = []string{
+	"Марка", "Сортамент", "Размер", "Деталей", "Кол-во, шт", "Длина, м", "Площадь, м²", "Масса, кг",
+	"Отход, %", "Длина с отходом, м", "Площадь с отходом, м²", "Масса с отходом, кг", "Хлыстов, шт", "Длина хлыстов, м",
+	"Листов, шт", "Площадь листов, м²",
+}
+
+const xlsxSheet = "Материалы"
+
+func rowValues(row *proto.MaterialRequirement) []interface{} {
+	return []interface{}{
+		row.Grade, row.AssortmentType, row.Size, row.Parts, row.Quantity, row.Length, row.Area, row.Mass,
+		row.WastePercent, row.GrossLength, row.GrossArea, row.GrossMass, row.Bars, row.StockLength,
+		row.Sheets, row.SheetArea,
+	}
+}
+
+// WriteCSV writes the rows of the report
+func WriteCSV(w io.Writer, report *proto.MaterialRequirements) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return err
+	}
+	for _, row := range report.Rows {
+		record := make([]string, 0, len(CSVHeader))
+		for _, value := range rowValues(row) {
+			switch v := value.(type) {
+			case string:
+				record = append(record, v)
+			case int32:
+				record = append(record, strconv.FormatInt(int64(v), 10))
+			case int64:
+				record = append(record, strconv.FormatInt(v, 10))
+			case float64:
+				record = append(record, strconv.FormatFloat(v, 'f', -1, 64))
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// WriteXLSX writes the report as a spreadsheet with a totals row and the tasks it covers
+func WriteXLSX(w io.Writer, report *proto.MaterialRequirements) error {
+	file := excelize.NewFile()
+	defer file.Close()
+
+	if err := file.SetSheetName("Sheet1", xlsxSheet); err != nil {
+		return err
+	}
+	bold, err := file.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return err
+	}
+
+	rows := [][]interface{}{toInterfaces(xlsxHeader)}
+	for _, row := range report.Rows {
+		rows = append(rows, rowValues(row))
+	}
+	rows = append(rows, []interface{}{"Итого", "", "", "", "", "", "", report.TotalMass, "", "", "", report.TotalGrossMass, "", "", "", ""})
+	for i, values := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return err
+		}
+		if err := file.SetSheetRow(xlsxSheet, cell, &values); err != nil {
+			return err
+		}
+	}
+
+	// the filter covers the header and the material rows without the totals
+	last, err := excelize.CoordinatesToCellName(len(xlsxHeader), len(rows)-1)
+	if err != nil {
+		return err
+	}
+	if err := file.SetRowStyle(xlsxSheet, 1, 1, bold); err != nil {
+		return err
+	}
+	if err := file.SetRowStyle(xlsxSheet, len(rows), len(rows), bold); err != nil {
+		return err
+	}
+	if err := file.AutoFilter(xlsxSheet, "A1:"+last, nil); err != nil {
+		return err
+	}
+	if err := file.SetColWidth(xlsxSheet, "A", "C", 18); err != nil {
+		return err
+	}
+	if err := file.SetColWidth(xlsxSheet, "D", "P", 14); err != nil {
+		return err
+	}
+
+	notes := []string{"Задачи: " + strings.Join(report.TaskIds, ", ")}
+	if len(report.Unresolved) > 0 {
+		notes = append(notes, "Детали без материала: "+strings.Join(report.Unresolved, ", "))
+	}
+	for i, note := range notes {
+		cell, err := excelize.CoordinatesToCellName(1, len(rows)+2+i)
+		if err != nil {
+			return err
+		}
+		if err := file.SetCellStr(xlsxSheet, cell, note); err != nil {
+			return err
+		}
+	}
+
+	return file.Write(w)
+}
+
+func toInterfaces(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+
+	return result
+}
@@ -0,0 +1,51 @@
+package requirements
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func testReport() *proto.MaterialRequirements {
+	return &proto.MaterialRequirements{
+		TaskIds: []string{"task"},
+		Rows: []*proto.MaterialRequirement{
+			{Grade: "09Г2С", AssortmentType: "Лист", Size: "10", Parts: 2, Quantity: 8, Area: 1.6, Mass: 125.6, WastePercent: 15, GrossArea: 1.84, GrossMass: 144.44},
+		},
+		TotalMass:      125.6,
+		TotalGrossMass: 144.44,
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, testReport()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, CSVHeader, records[0])
+	assert.Equal(t, []string{"09Г2С", "Лист", "10", "2", "8", "0", "1.6", "125.6", "15", "0", "1.84", "144.44", "0", "0", "0", "0"}, records[1])
+}
+
+func TestWriteXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteXLSX(&buf, testReport()))
+
+	file, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer file.Close()
+
+	rows, err := file.GetRows(xlsxSheet)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(rows), 4)
+	assert.Equal(t, "Марка", rows[0][0])
+	assert.Equal(t, "09Г2С", rows[1][0])
+	assert.Equal(t, "Итого", rows[2][0])
+	assert.Equal(t, "144.44", rows[2][11])
+}
@@ -0,0 +1,317 @@
+package requirements
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// Sheet size and distance between parts in mm used when no sheet format matches a sheet part
+const (
+	DefaultSheetWidth  = 1500
+	DefaultSheetLength = 6000
+	DefaultSheetGap    = 5
+)
+
+// SheetFormats selects the sheet formats a sheet part is nested on
+type SheetFormats struct {
+	// client entries first, then by descending specificity
+	entries []*proto.SheetFormatORM
+}
+
+func NewSheetFormats(entries []*proto.SheetFormatORM) *SheetFormats {
+	sorted := make([]*proto.SheetFormatORM, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Width > 0 && entry.Length > 0 {
+			sorted = append(sorted, entry)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if (sorted[i].ClientId != nil) != (sorted[j].ClientId != nil) {
+			return sorted[i].ClientId != nil
+		}
+		return formatSpecificity(sorted[i]) > formatSpecificity(sorted[j])
+	})
+
+	return &SheetFormats{entries: sorted}
+}
+
+func formatSpecificity(entry *proto.SheetFormatORM) int {
+	score := len(types.NormalizeMaterial(entry.Material))
+	if entry.Thickness > 0 {
+		score += 1000
+	}
+
+	return score
+}
+
+// Formats returns the formats available for the grade and thickness. All formats of the first
+// matching entry's scope are available; without a match the default format applies.
+func (f *SheetFormats) Formats(grade string, thickness float64) []*proto.SheetFormatORM {
+	if f != nil {
+		for _, entry := range f.entries {
+			if !formatMatches(entry, grade, thickness) {
+				continue
+			}
+			var formats []*proto.SheetFormatORM
+			for _, other := range f.entries {
+				if sameFormatScope(entry, other) {
+					formats = append(formats, other)
+				}
+			}
+			return formats
+		}
+	}
+
+	return []*proto.SheetFormatORM{{Width: DefaultSheetWidth, Length: DefaultSheetLength, Gap: DefaultSheetGap}}
+}
+
+func formatMatches(entry *proto.SheetFormatORM, grade string, thickness float64) bool {
+	if entry.Thickness > 0 && math.Abs(entry.Thickness-thickness) > 0.01 {
+		return false
+	}
+
+	return entry.Material == "" || strings.Contains(types.NormalizeMaterial(grade), types.NormalizeMaterial(entry.Material))
+}
+
+func sameFormatScope(a, b *proto.SheetFormatORM) bool {
+	return (a.ClientId == nil) == (b.ClientId == nil) &&
+		(a.ClientId == nil || *a.ClientId == *b.ClientId) &&
+		types.NormalizeMaterial(a.Material) == types.NormalizeMaterial(b.Material) &&
+		math.Abs(a.Thickness-b.Thickness) <= 0.01
+}
+
+// Thickness returns the sheet thickness in mm from the size of a sheet part, 0 when unknown
+func Thickness(part Part) float64 {
+	size, _, _ := strings.Cut(part.Size, "×")
+	thickness, err := strconv.ParseFloat(size, 64)
+	if err != nil {
+		return 0
+	}
+
+	return thickness
+}
+
+// Rect is the bounding box of a part in mm; width is along the drawing's horizontal axis
+type Rect struct {
+	NodeID        string
+	Width, Height float64
+}
+
+// Nest places the bounding boxes on sheets of the given size with the first fit decreasing
+// height shelf heuristic. Parts are laid with the long side along the sheet length and may be
+// turned to fill a shelf. The gap is kept between parts but not at the sheet edges.
+// Rects larger than the sheet are returned as oversize.
+func Nest(rects []Rect, width, length, gap float64) ([]*proto.SheetLayout, []Rect) {
+	if width > length {
+		width, length = length, width
+	}
+
+	sorted := append([]Rect(nil), rects...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		hi, hj := math.Min(sorted[i].Width, sorted[i].Height), math.Min(sorted[j].Width, sorted[j].Height)
+		if hi != hj {
+			return hi > hj
+		}
+		return math.Max(sorted[i].Width, sorted[i].Height) > math.Max(sorted[j].Width, sorted[j].Height)
+	})
+
+	type shelf struct {
+		y, height, used float64
+	}
+	type sheet struct {
+		shelves    []*shelf
+		height     float64
+		placements []*proto.SheetPlacement
+	}
+	place := func(s *sheet, sh *shelf, rect Rect, w, h float64) {
+		s.placements = append(s.placements, &proto.SheetPlacement{
+			NodeId: rect.NodeID, X: sh.used, Y: sh.y, Width: w, Height: h, Rotated: w != rect.Width,
+		})
+		sh.used += w + gap
+	}
+
+	var (
+		sheets   []*sheet
+		oversize []Rect
+	)
+	for _, rect := range sorted {
+		// lay the long side along the sheet
+		w, h := math.Max(rect.Width, rect.Height), math.Min(rect.Width, rect.Height)
+		if w > length+epsilon || h > width+epsilon {
+			oversize = append(oversize, rect)
+			continue
+		}
+
+		placed := false
+		for _, s := range sheets {
+			for _, sh := range s.shelves {
+				switch {
+				case sh.used+w <= length+epsilon && h <= sh.height+epsilon:
+					place(s, sh, rect, w, h)
+				case sh.used+h <= length+epsilon && w <= sh.height+epsilon:
+					place(s, sh, rect, h, w)
+				default:
+					continue
+				}
+				placed = true
+				break
+			}
+			if placed {
+				break
+			}
+			if s.height+h <= width+epsilon {
+				sh := &shelf{y: s.height, height: h}
+				s.shelves = append(s.shelves, sh)
+				s.height += h + gap
+				place(s, sh, rect, w, h)
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			s := &sheet{}
+			sh := &shelf{height: h}
+			s.shelves = append(s.shelves, sh)
+			s.height = h + gap
+			place(s, sh, rect, w, h)
+			sheets = append(sheets, s)
+		}
+	}
+
+	var (
+		layouts []*proto.SheetLayout
+		byKey   = make(map[string]*proto.SheetLayout)
+	)
+	for _, s := range sheets {
+		var key strings.Builder
+		var area float64
+		for _, p := range s.placements {
+			fmt.Fprintf(&key, "%s:%g:%g:%g:%g;", p.NodeId, p.X, p.Y, p.Width, p.Height)
+			area += p.Width * p.Height
+		}
+		if layout, ok := byKey[key.String()]; ok {
+			layout.Count++
+			continue
+		}
+		layout := &proto.SheetLayout{
+			Width:              width,
+			Length:             length,
+			Placements:         s.placements,
+			Count:              1,
+			UtilisationPercent: math.Round(area/(width*length)*10000) / 100,
+		}
+		byKey[key.String()] = layout
+		layouts = append(layouts, layout)
+	}
+	sort.SliceStable(layouts, func(i, j int) bool {
+		return layouts[i].Count > layouts[j].Count
+	})
+
+	return layouts, oversize
+}
+
+// sheetPiece is a part bounding box in mm with the number of pieces
+type sheetPiece struct {
+	rect  Rect
+	count int64
+}
+
+// sheetRow collects the pieces of a sheet row for the nesting plan
+type sheetRow struct {
+	part       Part
+	pieces     []sheetPiece
+	unmeasured int64
+}
+
+func (r *sheetRow) add(nodeID string, width, height float64, count int64) {
+	if width <= 0 || height <= 0 {
+		r.unmeasured += count
+		return
+	}
+	r.pieces = append(r.pieces, sheetPiece{rect: Rect{NodeID: nodeID, Width: width, Height: height}, count: count})
+}
+
+// Nesting plans the nesting of the accumulated sheet parts, one group per grade and thickness.
+// Each group uses the available format that needs the least sheet area, then the fewest sheets.
+func (a *Aggregator) Nesting(formats *SheetFormats) *proto.NestingPlan {
+	plan := &proto.NestingPlan{}
+	for _, key := range a.keys() {
+		row, ok := a.sheets[key]
+		if !ok {
+			continue
+		}
+		plan.Groups = append(plan.Groups, nestGroup(row, formats))
+	}
+
+	return plan
+}
+
+func nestGroup(row *sheetRow, formats *SheetFormats) *proto.NestingGroup {
+	thickness := Thickness(row.part)
+	group := &proto.NestingGroup{
+		Grade:          row.part.Grade,
+		AssortmentType: row.part.AssortmentType,
+		Size:           row.part.Size,
+		Thickness:      thickness,
+		Unmeasured:     row.unmeasured,
+	}
+
+	var rects []Rect
+	for _, p := range row.pieces {
+		planned := min(p.count, MaxGroupPieces-int64(len(rects)))
+		for i := int64(0); i < planned; i++ {
+			rects = append(rects, p.rect)
+		}
+		group.Unplanned += p.count - planned
+	}
+
+	var (
+		best         []*proto.SheetLayout
+		bestOversize []Rect
+		bestArea     float64
+		bestSheets   int32
+	)
+	for i, format := range formats.Formats(row.part.Grade, thickness) {
+		layouts, oversize := Nest(rects, format.Width, format.Length, format.Gap)
+		var (
+			area   float64
+			sheets int32
+		)
+		for _, layout := range layouts {
+			area += layout.Width * layout.Length * float64(layout.Count)
+			sheets += layout.Count
+		}
+		better := len(oversize) < len(bestOversize) ||
+			len(oversize) == len(bestOversize) && (area < bestArea-epsilon || math.Abs(area-bestArea) <= epsilon && sheets < bestSheets)
+		if i == 0 || better {
+			best, bestOversize, bestArea, bestSheets = layouts, oversize, area, sheets
+			group.Gap = format.Gap
+		}
+	}
+	group.Layouts = best
+	for _, rect := range bestOversize {
+		group.Oversize = append(group.Oversize, rect.NodeID)
+	}
+
+	var partsArea float64
+	for _, layout := range group.Layouts {
+		group.Sheets += layout.Count
+		for _, p := range layout.Placements {
+			partsArea += p.Width * p.Height * float64(layout.Count)
+		}
+		group.Pieces += int64(len(layout.Placements)) * int64(layout.Count)
+	}
+	group.PartsArea = round(partsArea / 1e6)
+	group.SheetArea = round(bestArea / 1e6)
+	if bestArea > 0 {
+		group.UtilisationPercent = math.Round(partsArea/bestArea*10000) / 100
+	}
+
+	return group
+}
@@ -0,0 +1,145 @@
+package requirements
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNest(t *testing.T) {
+	rects := []Rect{
+		{NodeID: "big", Width: 600, Height: 1200},
+		{NodeID: "big", Width: 600, Height: 1200},
+		{NodeID: "strip", Width: 1000, Height: 200},
+		{NodeID: "huge", Width: 3000, Height: 500},
+	}
+	layouts, oversize := Nest(rects, 1250, 2500, 10)
+
+	assert.Equal(t, []Rect{{NodeID: "huge", Width: 3000, Height: 500}}, oversize)
+	require.Len(t, layouts, 1)
+	layout := layouts[0]
+	assert.Equal(t, 1250.0, layout.Width)
+	assert.Equal(t, 2500.0, layout.Length)
+	require.Len(t, layout.Placements, 3)
+	// the boxes are turned to lie along the sheet, the strip starts a second shelf
+	assert.Equal(t, &proto.SheetPlacement{NodeId: "big", X: 0, Y: 0, Width: 1200, Height: 600, Rotated: true}, layout.Placements[0])
+	assert.Equal(t, &proto.SheetPlacement{NodeId: "big", X: 1210, Y: 0, Width: 1200, Height: 600, Rotated: true}, layout.Placements[1])
+	assert.Equal(t, &proto.SheetPlacement{NodeId: "strip", X: 0, Y: 610, Width: 1000, Height: 200}, layout.Placements[2])
+	assert.Equal(t, 52.48, layout.UtilisationPercent)
+}
+
+func TestNestMergesLayouts(t *testing.T) {
+	var rects []Rect
+	for i := 0; i < 5; i++ {
+		rects = append(rects, Rect{NodeID: "plate", Width: 1000, Height: 1000})
+	}
+	layouts, oversize := Nest(rects, 1000, 2000, 0)
+
+	assert.Empty(t, oversize)
+	require.Len(t, layouts, 2)
+	assert.Equal(t, int32(2), layouts[0].Count)
+	assert.Len(t, layouts[0].Placements, 2)
+	assert.Equal(t, 100.0, layouts[0].UtilisationPercent)
+	assert.Equal(t, int32(1), layouts[1].Count)
+	assert.Equal(t, 50.0, layouts[1].UtilisationPercent)
+}
+
+func TestSheetFormats(t *testing.T) {
+	clientID := uint64(1)
+	formats := NewSheetFormats([]*proto.SheetFormatORM{
+		{Width: 1500, Length: 6000, Gap: 5},
+		{Thickness: 10, Width: 1500, Length: 3000, Gap: 8},
+		{Thickness: 10, Width: 2000, Length: 6000, Gap: 8},
+		{ClientId: &clientID, Material: "AISI", Width: 1250, Length: 2500},
+	})
+
+	got := formats.Formats("09Г2С", 10)
+	require.Len(t, got, 2)
+	assert.Equal(t, 3000.0, got[0].Length)
+	assert.Equal(t, 2000.0, got[1].Width)
+
+	got = formats.Formats("09Г2С", 4)
+	require.Len(t, got, 1)
+	assert.Equal(t, 6000.0, got[0].Length)
+
+	got = formats.Formats("AISI 304", 10)
+	require.Len(t, got, 1)
+	assert.Equal(t, 2500.0, got[0].Length)
+
+	got = (*SheetFormats)(nil).Formats("Ст3", 2)
+	require.Len(t, got, 1)
+	assert.Equal(t, float64(DefaultSheetLength), got[0].Length)
+}
+
+func TestAggregatorNesting(t *testing.T) {
+	root := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			part("plate", "Лист 10 09Г2С", 1000, 1400, 110, 3),
+			part("rib", "Лист 10 09Г2С", 100, 300, 2.4, 4),
+			{Id: "blank", Material: "Лист 10 09Г2С", AccumulatedCount: 2},
+			part("pipe", "Труба 57х3,5/20", 57, 1500, 6.9, 1),
+		},
+	}
+	aggregator := NewAggregator()
+	aggregator.Add(root)
+	formats := NewSheetFormats([]*proto.SheetFormatORM{
+		{Thickness: 10, Width: 1500, Length: 3000, Gap: 10},
+		{Thickness: 10, Width: 1500, Length: 6000, Gap: 10},
+	})
+
+	plan := aggregator.Nesting(formats)
+	require.Len(t, plan.Groups, 1)
+	group := plan.Groups[0]
+	assert.Equal(t, 10.0, group.Thickness)
+	assert.Equal(t, 10.0, group.Gap)
+	assert.Equal(t, int64(7), group.Pieces)
+	assert.Equal(t, int64(2), group.Unmeasured)
+	assert.Equal(t, 4.32, group.PartsArea)
+	// the parts need two 3000 sheets or one 6000 sheet of the same area
+	assert.Equal(t, int32(1), group.Sheets)
+	assert.Equal(t, 6000.0, group.Layouts[0].Length)
+	assert.Equal(t, 9.0, group.SheetArea)
+	assert.Equal(t, 48.0, group.UtilisationPercent)
+
+	report := aggregator.Report(nil, nil, formats)
+	require.Len(t, report.Rows, 2)
+	sheet := report.Rows[0]
+	assert.Equal(t, group.Sheets, sheet.Sheets)
+	assert.Equal(t, 9.0, sheet.SheetArea)
+	assert.Equal(t, 108.33, sheet.WastePercent)
+
+	usage := Usage(nil, plan)
+	assert.Equal(t, 2.0833, usage.Factor(root.Leaves[0]))
+	assert.Equal(t, 0.0, usage.Factor(root.Leaves[3]))
+
+	var svg bytes.Buffer
+	require.NoError(t, WriteNestingSVG(&svg, plan))
+	assert.True(t, strings.HasPrefix(svg.String(), "<svg"))
+	placements := 0
+	for _, layout := range group.Layouts {
+		placements += len(layout.Placements)
+	}
+	assert.Equal(t, placements, strings.Count(svg.String(), "<title>"))
+}
+
+func TestAggregatorNestingLimitsPieces(t *testing.T) {
+	aggregator := NewAggregator()
+	aggregator.Add(&proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			part("plate", "Лист 10 09Г2С", 1000, 1400, 110, 10),
+			part("rib", "Лист 10 09Г2С", 100, 300, 2.4, 1_000_000_000),
+		},
+	})
+
+	plan := aggregator.Nesting(nil)
+	require.Len(t, plan.Groups, 1)
+	group := plan.Groups[0]
+	assert.Equal(t, int64(MaxGroupPieces), group.Pieces)
+	assert.Equal(t, int64(1_000_000_000+10-MaxGroupPieces), group.Unplanned)
+}
@@ -0,0 +1,60 @@
+package requirements
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+// previewWidth is the drawing width of a sheet in the preview, in pixels
+const previewWidth = 1000
+
+const (
+	previewMargin  = 20
+	previewCaption = 24
+)
+
+// WriteNestingSVG draws the sheet layouts of the plan one under another with the part boxes
+// and a caption of the group, sheet count and utilisation above each sheet
+func WriteNestingSVG(w io.Writer, plan *proto.NestingPlan) error {
+	type sheet struct {
+		caption string
+		layout  *proto.SheetLayout
+		y       float64
+		scale   float64
+	}
+	var (
+		sheets []sheet
+		height = float64(previewMargin)
+	)
+	for _, group := range plan.Groups {
+		for _, layout := range group.Layouts {
+			scale := previewWidth / layout.Length
+			caption := fmt.Sprintf("%s %s %s — %g×%g мм, листов: %d, заполнение %g%%",
+				group.AssortmentType, group.Size, group.Grade, layout.Width, layout.Length, layout.Count, layout.UtilisationPercent)
+			height += previewCaption
+			sheets = append(sheets, sheet{caption: caption, layout: layout, y: height, scale: scale})
+			height += layout.Width*scale + previewMargin
+		}
+	}
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%g" font-family="sans-serif" font-size="12">`+"\n",
+		previewWidth+2*previewMargin, height)
+	for _, s := range sheets {
+		fmt.Fprintf(out, `<text x="%d" y="%g">%s</text>`+"\n", previewMargin, s.y-8, html.EscapeString(s.caption))
+		fmt.Fprintf(out, `<rect x="%d" y="%g" width="%d" height="%g" fill="#f3f4f6" stroke="#374151"/>`+"\n",
+			previewMargin, s.y, previewWidth, s.layout.Width*s.scale)
+		for _, p := range s.layout.Placements {
+			fmt.Fprintf(out, `<rect x="%g" y="%g" width="%g" height="%g" fill="#93c5fd" stroke="#1d4ed8"><title>%s %g×%g</title></rect>`+"\n",
+				previewMargin+p.X*s.scale, s.y+p.Y*s.scale, p.Width*s.scale, p.Height*s.scale,
+				html.EscapeString(p.NodeId), p.Width, p.Height)
+		}
+	}
+	fmt.Fprintln(out, `</svg>`)
+
+	return out.Flush()
+}
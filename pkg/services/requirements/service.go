@@ -0,0 +1,195 @@
+package requirements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/purchase"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MaxTasks limits the number of tasks in one report
+const MaxTasks = 100
+
+var (
+	ErrNoTasks      = errors.New("no tasks given")
+	ErrTooManyTasks = fmt.Errorf("at most %d tasks are allowed", MaxTasks)
+)
+
+// Service builds material requirement reports of recognized tasks
+type Service struct {
+	db       *gorm.DB
+	purchase *purchase.Service
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db, purchase: purchase.NewService(db)}
+}
+
+// ClientWasteFactors loads the client's waste factors together with the factors shared by all clients
+func (s *Service) ClientWasteFactors(ctx context.Context, clientID uint64) (*WasteFactors, error) {
+	var factors []*proto.WasteFactorORM
+	err := s.db.WithContext(ctx).Where("client_id = ? OR client_id IS NULL", clientID).Find(&factors).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWasteFactors(factors), nil
+}
+
+// ClientStockLengths loads the client's stock lengths together with the lengths shared by all clients
+func (s *Service) ClientStockLengths(ctx context.Context, clientID uint64) (*StockLengths, error) {
+	var entries []*proto.StockLengthORM
+	err := s.db.WithContext(ctx).Where("client_id = ? OR client_id IS NULL", clientID).Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStockLengths(entries), nil
+}
+
+// ClientSheetFormats loads the client's sheet formats together with the formats shared by all clients
+func (s *Service) ClientSheetFormats(ctx context.Context, clientID uint64) (*SheetFormats, error) {
+	var entries []*proto.SheetFormatORM
+	err := s.db.WithContext(ctx).Where("client_id = ? OR client_id IS NULL", clientID).Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSheetFormats(entries), nil
+}
+
+// Report aggregates the materials of completed tasks of the client into one report
+func (s *Service) Report(ctx context.Context, clientID uint64, taskIDs []string) (*proto.MaterialRequirements, error) {
+	aggregator, ids, err := s.aggregate(ctx, clientID, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	factors, err := s.ClientWasteFactors(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	stock, err := s.ClientStockLengths(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	formats, err := s.ClientSheetFormats(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := aggregator.Report(factors, stock, formats)
+	report.TaskIds = ids
+	return report, nil
+}
+
+// CuttingPlan plans the cutting of the profile parts of completed tasks of the client from stock bars
+func (s *Service) CuttingPlan(ctx context.Context, clientID uint64, taskIDs []string) (*proto.CuttingPlan, error) {
+	aggregator, ids, err := s.aggregate(ctx, clientID, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	stock, err := s.ClientStockLengths(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := aggregator.Cutting(stock)
+	plan.TaskIds = ids
+	return plan, nil
+}
+
+// NestingPlan plans the nesting of the sheet parts of completed tasks of the client on sheet formats
+func (s *Service) NestingPlan(ctx context.Context, clientID uint64, taskIDs []string) (*proto.NestingPlan, error) {
+	aggregator, ids, err := s.aggregate(ctx, clientID, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	formats, err := s.ClientSheetFormats(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := aggregator.Nesting(formats)
+	plan.TaskIds = ids
+	return plan, nil
+}
+
+// TreeUsage plans the cutting of the profile parts and the nesting of the sheet parts of a tree
+// and returns their stock consumption. The tree is expected to contain manufactured parts only.
+func (s *Service) TreeUsage(ctx context.Context, clientID uint64, tree *proto.TreeNode) (*StockUsage, error) {
+	stock, err := s.ClientStockLengths(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	formats, err := s.ClientSheetFormats(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregator := NewAggregator()
+	aggregator.Add(tree)
+	return Usage(aggregator.Cutting(stock), aggregator.Nesting(formats)), nil
+}
+
+// aggregate accumulates the manufactured parts of completed tasks of the client.
+// It returns the deduplicated task IDs in the given order.
+func (s *Service) aggregate(ctx context.Context, clientID uint64, taskIDs []string) (*Aggregator, []string, error) {
+	ids := make([]string, 0, len(taskIDs))
+	seen := make(map[string]bool, len(taskIDs))
+	for _, id := range taskIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, nil, types.ErrTaskNotFound
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	switch {
+	case len(ids) == 0:
+		return nil, nil, ErrNoTasks
+	case len(ids) > MaxTasks:
+		return nil, nil, ErrTooManyTasks
+	}
+
+	var tasks []*proto.DataRecognitionTaskORM
+	if err := s.db.WithContext(ctx).Where("id IN ? AND client_id = ?", ids, clientID).Find(&tasks).Error; err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[string]*proto.DataRecognitionTaskORM, len(tasks))
+	for _, task := range tasks {
+		byID[task.Id] = task
+	}
+
+	classifier, err := s.purchase.Classifier(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aggregator := NewAggregator()
+	for _, id := range ids {
+		task, ok := byID[id]
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: %s", types.ErrTaskNotFound, id)
+		}
+		if proto.Status(task.Status) != proto.Status_STATUS_PROCESSING_COMPLETED {
+			return nil, nil, fmt.Errorf("%w: %s", types.ErrTaskNotCompleted, id)
+		}
+		tree, err := types.TaskTree(task)
+		if err != nil {
+			return nil, nil, err
+		}
+		// standard and purchased items are bought as they are, not cut from stock
+		aggregator.Add(classifier.Manufactured(tree))
+	}
+
+	return aggregator, ids, nil
+}
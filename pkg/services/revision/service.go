@@ -0,0 +1,187 @@
+package revision
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/treediff"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// Actions of the edits producing revisions
+const (
+	ActionRecognition = "recognition"
+	ActionReplace     = "replace"
+	ActionAddNode     = "add_node"
+	ActionMoveNode    = "move_node"
+	ActionDeleteNode  = "delete_node"
+	ActionUpdateNode  = "update_node"
+	ActionPatch       = "patch"
+	ActionRevert      = "revert"
+)
+
+// Service reads the revision history of the frontend results of tasks
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+// Record stores the after tree as the next revision of the task with the changes from the before tree,
+// made by the user with the action. Nothing is stored when the trees do not differ.
+func Record(ctx context.Context, tx *gorm.DB, taskID string, userID uint64, action string, before, after *proto.TreeNode, revertedTo *int32) (*proto.TreeRevisionORM, error) {
+	diff := treediff.Diff(before, after)
+	if treediff.Empty(diff) {
+		return nil, nil
+	}
+
+	var latest int32
+	err := tx.WithContext(ctx).Model(&proto.TreeRevisionORM{}).Where("task_id = ?", taskID).
+		Select("COALESCE(MAX(revision), 0)").Scan(&latest).Error
+	if err != nil {
+		return nil, err
+	}
+
+	revision := &proto.TreeRevisionORM{
+		TaskId:     taskID,
+		Revision:   latest + 1,
+		Action:     action,
+		RevertedTo: revertedTo,
+	}
+	if userID != 0 {
+		var user proto.ClientUserORM
+		err := tx.WithContext(ctx).Select("id", "email").First(&user, userID).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		revision.AuthorId, revision.Author = &userID, user.Email
+	}
+	if revision.Tree, err = types.JSONValue(after); err != nil {
+		return nil, err
+	}
+	if revision.Diff, err = types.JSONValue(diff); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	revision.CreatedAt = &now
+
+	if err := tx.WithContext(ctx).Create(revision).Error; err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// recognized returns revision 0 of the task: its recognized tree
+func recognized(task *proto.DataRecognitionTaskORM) (*proto.TreeRevisionORM, error) {
+	if task.RecognitionResult == nil {
+		return nil, ErrRevisionNotFound
+	}
+
+	tree := task.RecognitionResult.Data()
+	result := datatypes.NewJSONType(tree)
+	return &proto.TreeRevisionORM{TaskId: task.Id, Action: ActionRecognition, Tree: &result, CreatedAt: task.CreatedAt}, nil
+}
+
+// TaskRevisions returns the revisions of the task without their trees, newest first, ending with revision 0
+// of the recognized tree. When the field is set only the revisions changing it are listed, e.g. count.
+func TaskRevisions(ctx context.Context, db *gorm.DB, task *proto.DataRecognitionTaskORM, field string) ([]*proto.TreeRevisionORM, error) {
+	var revisions []*proto.TreeRevisionORM
+	err := db.WithContext(ctx).Omit("tree").Where("task_id = ?", task.Id).Order("revision DESC").Find(&revisions).Error
+	if err != nil {
+		return nil, err
+	}
+	if first, err := recognized(task); err == nil {
+		first.Tree = nil
+		revisions = append(revisions, first)
+	}
+	if field == "" {
+		return revisions, nil
+	}
+
+	var result []*proto.TreeRevisionORM
+	for _, revision := range revisions {
+		if revision.Diff == nil {
+			continue
+		}
+		diff := revision.Diff.Data()
+		for _, change := range diff.Changes {
+			if change.Field == field {
+				result = append(result, revision)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// TaskRevision returns the revision of the task with its tree, revision 0 is the recognized tree
+func TaskRevision(ctx context.Context, db *gorm.DB, task *proto.DataRecognitionTaskORM, number int32) (*proto.TreeRevisionORM, error) {
+	if number == 0 {
+		return recognized(task)
+	}
+
+	var revision proto.TreeRevisionORM
+	err := db.WithContext(ctx).First(&revision, "task_id = ? AND revision = ?", task.Id, number).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &revision, nil
+}
+
+// List returns the revision history of a task of the client, see TaskRevisions
+func (s *Service) List(ctx context.Context, clientID uint64, taskID, field string) (*proto.TreeRevisionList, error) {
+	task, err := types.ClientTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := TaskRevisions(ctx, s.db, task, field)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &proto.TreeRevisionList{TaskId: task.Id}
+	err = s.db.WithContext(ctx).Model(&proto.TreeRevisionORM{}).Where("task_id = ?", task.Id).
+		Select("COALESCE(MAX(revision), 0)").Scan(&list.CurrentRevision).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, revision := range revisions {
+		pb, err := revision.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		list.Revisions = append(list.Revisions, &pb)
+	}
+
+	return list, nil
+}
+
+// Get returns a revision of a task of the client with its tree and changes
+func (s *Service) Get(ctx context.Context, clientID uint64, taskID string, number int32) (*proto.TreeRevision, error) {
+	task, err := types.ClientTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := TaskRevision(ctx, s.db, task, number)
+	if err != nil {
+		return nil, err
+	}
+
+	pb, err := revision.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb, nil
+}
@@ -0,0 +1,238 @@
+package routing
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// Operation codes used by the default rules
+const (
+	OperationCutting   = "cutting"
+	OperationBending   = "bending"
+	OperationWelding   = "welding"
+	OperationMachining = "machining"
+	OperationPainting  = "painting"
+	OperationAssembly  = "assembly"
+)
+
+// Operations lists the known operation codes in routing order
+var Operations = []string{
+	OperationCutting,
+	OperationBending,
+	OperationMachining,
+	OperationWelding,
+	OperationAssembly,
+	OperationPainting,
+}
+
+// Rules is an ordered rule set of a client
+type Rules struct {
+	rules []*proto.OperationRuleORM
+}
+
+// NewRules orders enabled rules for evaluation: client rules first, then by priority
+func NewRules(rules []*proto.OperationRuleORM) *Rules {
+	enabled := make([]*proto.OperationRuleORM, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Enabled {
+			enabled = append(enabled, rule)
+		}
+	}
+	sort.SliceStable(enabled, func(i, j int) bool {
+		a, b := enabled[i], enabled[j]
+		if (a.ClientId != nil) != (b.ClientId != nil) {
+			return a.ClientId != nil
+		}
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.Id < b.Id
+	})
+
+	return &Rules{rules: enabled}
+}
+
+// Match returns the first matching rule per operation, in routing order
+func (r *Rules) Match(node *proto.TreeNode, assembly bool) []*proto.OperationRuleORM {
+	facts := nodeFacts(node)
+	matched := map[string]*proto.OperationRuleORM{}
+	for _, rule := range r.rules {
+		if _, ok := matched[rule.Operation]; ok {
+			continue
+		}
+		if ruleMatches(rule, &facts, assembly) {
+			matched[rule.Operation] = rule
+		}
+	}
+
+	result := make([]*proto.OperationRuleORM, 0, len(matched))
+	for _, rule := range matched {
+		result = append(result, rule)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return operationLess(result[i].Operation, result[j].Operation)
+	})
+
+	return result
+}
+
+// operationLess orders known operations by routing order and custom ones last, alphabetically
+func operationLess(a, b string) bool {
+	ia, ib := operationIndex(a), operationIndex(b)
+	if ia != ib {
+		return ia < ib
+	}
+
+	return a < b
+}
+
+func operationIndex(operation string) int {
+	for i, op := range Operations {
+		if op == operation {
+			return i
+		}
+	}
+
+	return len(Operations)
+}
+
+type facts struct {
+	figureType string
+	subType    string
+	material   string
+	mass       float64
+	length     float64
+}
+
+func nodeFacts(node *proto.TreeNode) facts {
+	f := facts{material: types.NormalizeMaterial(types.NodeMaterial(node))}
+	if node.Figure != nil {
+		f.mass = float64(node.Figure.Mass)
+		f.length = math.Max(float64(node.Figure.SizeVertical), float64(node.Figure.SizeHorizontal))
+		if node.Figure.Assortment != nil {
+			f.figureType = node.Figure.Assortment.FigureType
+			f.subType = node.Figure.Assortment.SubType
+		}
+	}
+
+	return f
+}
+
+func ruleMatches(rule *proto.OperationRuleORM, f *facts, assembly bool) bool {
+	target := proto.OperationRuleTarget(rule.Target)
+	if assembly != (target == proto.OperationRuleTarget_OPERATION_RULE_TARGET_ASSEMBLIES) {
+		return false
+	}
+	if rule.FigureType != "" && !strings.EqualFold(rule.FigureType, f.figureType) {
+		return false
+	}
+	if rule.SubType != "" && !strings.EqualFold(rule.SubType, f.subType) {
+		return false
+	}
+	if rule.Material != "" && !strings.Contains(f.material, types.NormalizeMaterial(rule.Material)) {
+		return false
+	}
+
+	return inRange(f.mass, rule.MinMass, rule.MaxMass) && inRange(f.length, rule.MinLength, rule.MaxLength)
+}
+
+func inRange(value, min, max float64) bool {
+	if min > 0 && value < min {
+		return false
+	}
+	if max > 0 && value > max {
+		return false
+	}
+
+	return true
+}
+
+// Route builds the routing of the tree. Hours of an operation are the setup time plus
+// the per-unit, per-kg and per-metre norms for every unit of the node.
+func Route(root *proto.TreeNode, rules *Rules) *proto.RoutingNode {
+	return routeNode(root, rules, true)
+}
+
+func routeNode(node *proto.TreeNode, rules *Rules, isRoot bool) *proto.RoutingNode {
+	result := &proto.RoutingNode{
+		NodeId:   node.Id,
+		Number:   node.Number,
+		Name:     node.Name,
+		Quantity: types.NodeQuantity(node),
+	}
+
+	assembly := len(node.Leaves) > 0
+	for _, leaf := range node.Leaves {
+		child := routeNode(leaf, rules, false)
+		result.Children = append(result.Children, child)
+		result.LabourHours += child.LabourHours
+		result.MachineHours += child.MachineHours
+	}
+
+	// the root is a container of drawings, not a real assembly
+	if !isRoot {
+		f := nodeFacts(node)
+		quantity := float64(result.Quantity)
+		for _, rule := range rules.Match(node, assembly) {
+			op := &proto.RoutingOperation{
+				Operation: rule.Operation,
+				RuleId:    rule.Id,
+				RuleName:  rule.Name,
+				LabourHours: rule.SetupHours + quantity*(rule.LabourHoursPerUnit+
+					rule.LabourHoursPerKg*f.mass+rule.LabourHoursPerMetre*f.length/1000),
+				MachineHours: quantity * (rule.MachineHoursPerUnit + rule.MachineHoursPerKg*f.mass),
+			}
+			op.LabourHours = roundHours(op.LabourHours)
+			op.MachineHours = roundHours(op.MachineHours)
+			result.Operations = append(result.Operations, op)
+			result.LabourHours += op.LabourHours
+			result.MachineHours += op.MachineHours
+		}
+	}
+
+	result.LabourHours = roundHours(result.LabourHours)
+	result.MachineHours = roundHours(result.MachineHours)
+
+	return result
+}
+
+// Summarize totals the hours of the routing per operation
+func Summarize(root *proto.RoutingNode) []*proto.RoutingOperation {
+	totals := map[string]*proto.RoutingOperation{}
+	var walk func(node *proto.RoutingNode)
+	walk = func(node *proto.RoutingNode) {
+		for _, op := range node.Operations {
+			total, ok := totals[op.Operation]
+			if !ok {
+				total = &proto.RoutingOperation{Operation: op.Operation}
+				totals[op.Operation] = total
+			}
+			total.LabourHours += op.LabourHours
+			total.MachineHours += op.MachineHours
+		}
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	result := make([]*proto.RoutingOperation, 0, len(totals))
+	for _, total := range totals {
+		total.LabourHours = roundHours(total.LabourHours)
+		total.MachineHours = roundHours(total.MachineHours)
+		result = append(result, total)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return operationLess(result[i].Operation, result[j].Operation)
+	})
+
+	return result
+}
+
+func roundHours(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
@@ -0,0 +1,118 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRules() *Rules {
+	clientID := uint64(7)
+	return NewRules([]*proto.OperationRuleORM{
+		{Id: 1, Name: "Резка", Operation: OperationCutting, Enabled: true, LabourHoursPerUnit: 0.1, LabourHoursPerMetre: 0.05},
+		{Id: 2, Name: "Гибка листа", Operation: OperationBending, Enabled: true, FigureType: "sheet", SetupHours: 0.5, LabourHoursPerUnit: 0.2},
+		{Id: 3, Name: "Сварка", Operation: OperationWelding, Enabled: true,
+			Target: int32(proto.OperationRuleTarget_OPERATION_RULE_TARGET_ASSEMBLIES), LabourHoursPerKg: 0.01},
+		{Id: 4, Name: "Резка клиента", Operation: OperationCutting, Enabled: true, ClientId: &clientID, Priority: 10,
+			Material: "09Г2С", LabourHoursPerUnit: 0.3, MachineHoursPerUnit: 0.2},
+		{Id: 5, Name: "Отключено", Operation: OperationPainting, Enabled: false, LabourHoursPerUnit: 1},
+		{Id: 6, Name: "Тяжёлая мехобработка", Operation: OperationMachining, Enabled: true, MinMass: 10, MachineHoursPerKg: 0.1},
+	})
+}
+
+func routingPart(id, material, figureType string, mass float32, length float32, count int32) *proto.TreeNode {
+	return &proto.TreeNode{
+		Id:               id,
+		Name:             id,
+		Material:         material,
+		AccumulatedCount: count,
+		Figure: &proto.Figure{
+			Mass:           mass,
+			SizeHorizontal: length,
+			Assortment:     &proto.Assortment{FigureType: figureType},
+		},
+	}
+}
+
+func ruleOperations(rules []*proto.OperationRuleORM) []string {
+	result := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, rule.Operation)
+	}
+	return result
+}
+
+func TestMatch(t *testing.T) {
+	rules := testRules()
+
+	matched := rules.Match(routingPart("p", "Ст3", "SHEET", 2, 1000, 1), false)
+	assert.Equal(t, []string{OperationCutting, OperationBending}, ruleOperations(matched))
+	assert.Equal(t, uint64(1), matched[0].Id)
+
+	matched = rules.Match(routingPart("p", "Лист 10 / 09Г2С", "bar", 12, 1000, 1), false)
+	assert.Equal(t, []string{OperationCutting, OperationMachining}, ruleOperations(matched))
+	assert.Equal(t, uint64(4), matched[0].Id, "client rules take precedence")
+
+	matched = rules.Match(&proto.TreeNode{Id: "a"}, true)
+	assert.Equal(t, []string{OperationWelding}, ruleOperations(matched))
+}
+
+func TestInRange(t *testing.T) {
+	assert.True(t, inRange(5, 0, 0))
+	assert.True(t, inRange(5, 5, 5))
+	assert.False(t, inRange(4, 5, 0))
+	assert.False(t, inRange(6, 0, 5))
+}
+
+func TestRoute(t *testing.T) {
+	root := &proto.TreeNode{
+		Id:   "root",
+		Name: "Root",
+		Leaves: []*proto.TreeNode{
+			{
+				Id:               "frame",
+				Name:             "Frame",
+				AccumulatedCount: 2,
+				Figure:           &proto.Figure{Mass: 50},
+				Leaves: []*proto.TreeNode{
+					routingPart("plate", "Ст3", "sheet", 2, 500, 4),
+				},
+			},
+		},
+	}
+
+	result := Route(root, testRules())
+	require.Len(t, result.Children, 1)
+	assert.Empty(t, result.Operations, "the root has no operations of its own")
+
+	frame := result.Children[0]
+	require.Len(t, frame.Operations, 1)
+	assert.Equal(t, OperationWelding, frame.Operations[0].Operation)
+	assert.Equal(t, 1.0, frame.Operations[0].LabourHours)
+
+	plate := frame.Children[0]
+	require.Len(t, plate.Operations, 2)
+	// cutting: 4 × (0.1 + 0.05 × 0.5 m)
+	assert.Equal(t, 0.5, plate.Operations[0].LabourHours)
+	// bending: 0.5 setup + 4 × 0.2
+	assert.Equal(t, 1.3, plate.Operations[1].LabourHours)
+	assert.Equal(t, 1.8, plate.LabourHours)
+
+	assert.Equal(t, 2.8, frame.LabourHours)
+	assert.Equal(t, 2.8, result.LabourHours)
+	assert.Equal(t, 0.0, result.MachineHours)
+
+	totals := Summarize(result)
+	assert.Equal(t, []string{OperationCutting, OperationBending, OperationWelding}, routingOperations(totals))
+	assert.Equal(t, 1.0, totals[2].LabourHours)
+}
+
+func routingOperations(ops []*proto.RoutingOperation) []string {
+	result := make([]string, 0, len(ops))
+	for _, op := range ops {
+		result = append(result, op.Operation)
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package routing
+
+import (
+	"context"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/purchase"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/gorm"
+)
+
+// Service builds manufacturing routings of recognized tasks
+type Service struct {
+	db       *gorm.DB
+	purchase *purchase.Service
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db, purchase: purchase.NewService(db)}
+}
+
+// ClientRules loads the client's rules together with the rules shared by all clients
+func (s *Service) ClientRules(ctx context.Context, clientID uint64) (*Rules, error) {
+	var rules []*proto.OperationRuleORM
+	err := s.db.WithContext(ctx).Where("client_id = ? OR client_id IS NULL", clientID).Find(&rules).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRules(rules), nil
+}
+
+// RouteTask builds the routing of a completed task of the client
+func (s *Service) RouteTask(ctx context.Context, clientID uint64, taskID string) (*proto.Routing, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := types.TaskTree(task)
+	if err != nil {
+		return nil, err
+	}
+	// standard and purchased items are bought, not made
+	if tree, err = s.purchase.Manufactured(ctx, tree); err != nil {
+		return nil, err
+	}
+	rules, err := s.ClientRules(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	root := Route(tree, rules)
+	return &proto.Routing{
+		TaskId:       task.Id,
+		Operations:   Summarize(root),
+		LabourHours:  root.LabourHours,
+		MachineHours: root.MachineHours,
+		Root:         root,
+	}, nil
+}
@@ -103,6 +103,18 @@ func (s *S3Client) GetObject(ctx context.Context, key string) (io.ReadCloser, st
 	return result.Body, contentType, nil
 }
 
+// DeleteObject removes an object
+func (s *S3Client) DeleteObject(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
 // GetObjectMetadata retrieves metadata for an object
 func (s *S3Client) GetObjectMetadata(ctx context.Context, key string) (map[string]string, error) {
 	input := &s3.HeadObjectInput{
@@ -0,0 +1,276 @@
+package treediff
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// ignoredFields are not compared: the structure is compared by the node ids and parents, the other fields
+// are derived by the server from the tree
+var ignoredFields = map[string]bool{
+	"id":                true,
+	"leaves":            true,
+	"parent_id":         true,
+	"accumulated_count": true,
+	"computed_mass":     true,
+	"total_mass":        true,
+	"mass_status":       true,
+	"field_status":      true,
+}
+
+type entry struct {
+	node   *proto.TreeNode
+	parent string
+}
+
+// index lists the nodes of the tree with an id in pre-order with the ids of their parents
+func index(root *proto.TreeNode) ([]string, map[string]entry) {
+	var order []string
+	entries := make(map[string]entry)
+	var walk func(node *proto.TreeNode, parent string)
+	walk = func(node *proto.TreeNode, parent string) {
+		if node == nil {
+			return
+		}
+		if node.Id != "" {
+			if _, ok := entries[node.Id]; !ok {
+				order = append(order, node.Id)
+				entries[node.Id] = entry{node: node, parent: parent}
+			}
+		}
+		for _, leaf := range node.Leaves {
+			walk(leaf, node.Id)
+		}
+	}
+	walk(root, "")
+
+	return order, entries
+}
+
+// flatten adds the values of the object to the fields by their dotted JSON paths, nested objects are
+// flattened, other values are kept JSON encoded
+func flatten(prefix string, object map[string]interface{}, fields map[string]string) {
+	for name, value := range object {
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			flatten(path, nested, fields)
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		fields[path] = string(data)
+	}
+}
+
+// fields returns the compared fields of the node by their dotted JSON paths
+func fields(node *proto.TreeNode) map[string]string {
+	result := make(map[string]string)
+	data, err := json.Marshal(node)
+	if err != nil {
+		return result
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return result
+	}
+	for name := range ignoredFields {
+		delete(object, name)
+	}
+
+	flatten("", object, result)
+	return result
+}
+
+func encode(value string) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+func change(kind proto.TreeChangeKind, node *proto.TreeNode) *proto.TreeChange {
+	return &proto.TreeChange{Kind: kind, NodeId: node.Id, Number: node.Number, Name: node.Name}
+}
+
+func designation(node *proto.TreeNode) string {
+	return types.NormalizeDesignation(node.Number)
+}
+
+func name(node *proto.TreeNode) string {
+	return strings.ToLower(strings.Join(strings.Fields(node.Name), " "))
+}
+
+// fallbackKeys match the nodes left without a counterpart by id, in order of preference
+var fallbackKeys = []func(node *proto.TreeNode) string{
+	func(node *proto.TreeNode) string {
+		if designation(node) == "" || name(node) == "" {
+			return ""
+		}
+		return designation(node) + "\x00" + name(node)
+	},
+	designation,
+	name,
+}
+
+// match returns the ids of the before nodes matching the after nodes: the node with the same id, the root
+// for the root, otherwise the first unmatched node in pre-order with the same designation and name,
+// designation or name
+func match(roots [2]string, beforeOrder []string, beforeNodes map[string]entry, afterOrder []string, afterNodes map[string]entry) map[string]string {
+	matches := make(map[string]string)
+	matched := make(map[string]bool)
+	for _, id := range afterOrder {
+		if _, ok := beforeNodes[id]; ok {
+			matches[id] = id
+			matched[id] = true
+		}
+	}
+
+	// the roots correspond to each other whatever their ids
+	if before, after := roots[0], roots[1]; before != "" && after != "" {
+		if _, ok := matches[after]; !ok && !matched[before] {
+			matches[after] = before
+			matched[before] = true
+		}
+	}
+
+	for _, key := range fallbackKeys {
+		candidates := make(map[string][]string)
+		for _, id := range beforeOrder {
+			if matched[id] {
+				continue
+			}
+			if value := key(beforeNodes[id].node); value != "" {
+				candidates[value] = append(candidates[value], id)
+			}
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		for _, id := range afterOrder {
+			if _, ok := matches[id]; ok {
+				continue
+			}
+			value := key(afterNodes[id].node)
+			if value == "" || len(candidates[value]) == 0 {
+				continue
+			}
+			previous := candidates[value][0]
+			candidates[value] = candidates[value][1:]
+			matches[id] = previous
+			matched[previous] = true
+		}
+	}
+
+	return matches
+}
+
+// Pair is a node of the before tree with the matching node of the after tree
+type Pair struct {
+	Before *proto.TreeNode
+	After  *proto.TreeNode
+}
+
+// Match returns the matching nodes of the trees in pre-order of the after tree, see Diff
+func Match(before, after *proto.TreeNode) []Pair {
+	beforeOrder, beforeNodes := index(before)
+	afterOrder, afterNodes := index(after)
+	matches := match([2]string{before.GetId(), after.GetId()}, beforeOrder, beforeNodes, afterOrder, afterNodes)
+
+	var pairs []Pair
+	for _, id := range afterOrder {
+		if previous, ok := matches[id]; ok {
+			pairs = append(pairs, Pair{Before: beforeNodes[previous].node, After: afterNodes[id].node})
+		}
+	}
+	return pairs
+}
+
+// Diff returns the changes turning the before tree into the after one. Nodes are matched by their ids,
+// falling back to their designations and names, see match. A node is moved when its parent does not match
+// the previous one and updated once per changed field. Changes follow the nodes of the after tree in
+// pre-order, the removed nodes come last.
+func Diff(before, after *proto.TreeNode) *proto.TreeDiff {
+	beforeOrder, beforeNodes := index(before)
+	afterOrder, afterNodes := index(after)
+	matches := match([2]string{before.GetId(), after.GetId()}, beforeOrder, beforeNodes, afterOrder, afterNodes)
+
+	// counterpart returns the id of the before node matching an after node, a value no before node has
+	// for unmatched nodes
+	counterpart := func(id string) string {
+		if id == "" {
+			return ""
+		}
+		if previous, ok := matches[id]; ok {
+			return previous
+		}
+		return "\x00" + id
+	}
+
+	diff := &proto.TreeDiff{}
+	matched := make(map[string]bool)
+	for _, id := range afterOrder {
+		current := afterNodes[id]
+		previousID, ok := matches[id]
+		if !ok {
+			diff.Changes = append(diff.Changes, change(proto.TreeChangeKind_TREE_CHANGE_KIND_ADDED, current.node))
+			diff.Added++
+			continue
+		}
+		matched[previousID] = true
+		previous := beforeNodes[previousID]
+		changed := func(kind proto.TreeChangeKind, field, before, after string) {
+			item := change(kind, current.node)
+			item.Field, item.Before, item.After = field, before, after
+			if previousID != id {
+				item.PreviousNodeId = previousID
+			}
+			diff.Changes = append(diff.Changes, item)
+		}
+
+		if counterpart(current.parent) != previous.parent {
+			changed(proto.TreeChangeKind_TREE_CHANGE_KIND_MOVED, "parent_id", encode(previous.parent), encode(current.parent))
+			diff.Moved++
+		}
+
+		previousFields, currentFields := fields(previous.node), fields(current.node)
+		paths := make([]string, 0, len(currentFields))
+		for path := range previousFields {
+			paths = append(paths, path)
+		}
+		for path := range currentFields {
+			if _, ok := previousFields[path]; !ok {
+				paths = append(paths, path)
+			}
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			if previousFields[path] == currentFields[path] {
+				continue
+			}
+			changed(proto.TreeChangeKind_TREE_CHANGE_KIND_UPDATED, path, previousFields[path], currentFields[path])
+			diff.Updated++
+		}
+	}
+
+	for _, id := range beforeOrder {
+		if !matched[id] {
+			diff.Changes = append(diff.Changes, change(proto.TreeChangeKind_TREE_CHANGE_KIND_REMOVED, beforeNodes[id].node))
+			diff.Removed++
+		}
+	}
+
+	return diff
+}
+
+// Empty reports whether the diff has no changes
+func Empty(diff *proto.TreeDiff) bool {
+	return diff == nil || len(diff.Changes) == 0
+}
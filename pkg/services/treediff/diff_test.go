@@ -0,0 +1,154 @@
+package treediff
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTree() *proto.TreeNode {
+	return &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{
+				Id: "frame", Number: "СФ-01.00.000", Count: 1,
+				Leaves: []*proto.TreeNode{
+					{Id: "plate", Number: "СФ-01.00.001", Count: 4, Spec: &proto.SpecificationRow{Material: "Лист 10"}},
+					{Id: "rib", Number: "СФ-01.00.002", Count: 2},
+				},
+			},
+			{Id: "bracket", Number: "СФ-02.00.000", Count: 1},
+		},
+	}
+}
+
+func TestDiffEqual(t *testing.T) {
+	diff := Diff(testTree(), testTree())
+	assert.True(t, Empty(diff))
+
+	// derived fields are not compared
+	after := testTree()
+	after.Leaves[0].AccumulatedCount = 4
+	after.Leaves[0].ParentId = "root"
+	after.Leaves[0].FieldStatus = map[string]proto.FieldStatus{"count": proto.FieldStatus_YELLOW}
+	assert.True(t, Empty(Diff(testTree(), after)))
+}
+
+func TestDiff(t *testing.T) {
+	after := testTree()
+	frame, bracket := after.Leaves[0], after.Leaves[1]
+	rib := frame.Leaves[1]
+	rib.Count = 3
+	frame.Spec = &proto.SpecificationRow{Material: "Лист 12"}
+	frame.Leaves = nil
+	bracket.Leaves = []*proto.TreeNode{rib}
+	after.Leaves = append(after.Leaves, &proto.TreeNode{Id: "gusset", Name: "косынка"})
+
+	diff := Diff(testTree(), after)
+	require.Len(t, diff.Changes, 5)
+	assert.Equal(t, int32(1), diff.Added)
+	assert.Equal(t, int32(1), diff.Removed)
+	assert.Equal(t, int32(1), diff.Moved)
+	assert.Equal(t, int32(2), diff.Updated)
+
+	material := diff.Changes[0]
+	assert.Equal(t, proto.TreeChangeKind_TREE_CHANGE_KIND_UPDATED, material.Kind)
+	assert.Equal(t, "frame", material.NodeId)
+	assert.Equal(t, "СФ-01.00.000", material.Number)
+	assert.Equal(t, "spec.material", material.Field)
+	assert.Empty(t, material.Before)
+	assert.Equal(t, `"Лист 12"`, material.After)
+
+	moved := diff.Changes[1]
+	assert.Equal(t, proto.TreeChangeKind_TREE_CHANGE_KIND_MOVED, moved.Kind)
+	assert.Equal(t, "rib", moved.NodeId)
+	assert.Equal(t, "parent_id", moved.Field)
+	assert.Equal(t, `"frame"`, moved.Before)
+	assert.Equal(t, `"bracket"`, moved.After)
+
+	count := diff.Changes[2]
+	assert.Equal(t, proto.TreeChangeKind_TREE_CHANGE_KIND_UPDATED, count.Kind)
+	assert.Equal(t, "count", count.Field)
+	assert.Equal(t, "2", count.Before)
+	assert.Equal(t, "3", count.After)
+
+	assert.Equal(t, proto.TreeChangeKind_TREE_CHANGE_KIND_ADDED, diff.Changes[3].Kind)
+	assert.Equal(t, "gusset", diff.Changes[3].NodeId)
+
+	// removals come last
+	assert.Equal(t, proto.TreeChangeKind_TREE_CHANGE_KIND_REMOVED, diff.Changes[4].Kind)
+	assert.Equal(t, "plate", diff.Changes[4].NodeId)
+}
+
+func TestDiffFallbackMatching(t *testing.T) {
+	recognized := &proto.TreeNode{
+		Id: "r-root",
+		Leaves: []*proto.TreeNode{
+			{
+				Id: "r-frame", Number: "СФ-01.00.000", Name: "Рама", Count: 1,
+				Leaves: []*proto.TreeNode{
+					{Id: "r-plate", Number: "CФ 01.00.001", Name: "Пластина", Count: 4},
+					{Id: "r-rib", Name: "Ребро", Count: 2},
+				},
+			},
+			{Id: "r-bolt", Name: "Болт М12", Count: 8},
+		},
+	}
+	edited := &proto.TreeNode{
+		Id: "e-root",
+		Leaves: []*proto.TreeNode{
+			{
+				Id: "e-frame", Number: "СФ-01.00.000", Name: "рама", Count: 1,
+				Leaves: []*proto.TreeNode{
+					// the designation is normalized for matching, the recognized spelling is an update
+					{Id: "e-plate", Number: "СФ-01.00.001", Name: "Пластина", Count: 6},
+				},
+			},
+			{Id: "e-rib", Name: " ребро ", Count: 2},
+		},
+	}
+
+	diff := Diff(recognized, edited)
+	assert.Equal(t, int32(0), diff.Added)
+	assert.Equal(t, int32(1), diff.Removed)
+	assert.Equal(t, int32(1), diff.Moved)
+
+	byNode := make(map[string][]*proto.TreeChange)
+	for _, change := range diff.Changes {
+		byNode[change.NodeId] = append(byNode[change.NodeId], change)
+	}
+
+	// the roots match by position, their empty designations and names are not compared
+	assert.NotContains(t, byNode, "e-root")
+	require.Len(t, byNode["e-frame"], 1)
+	assert.Equal(t, "name", byNode["e-frame"][0].Field)
+	assert.Equal(t, "r-frame", byNode["e-frame"][0].PreviousNodeId)
+
+	require.Len(t, byNode["e-plate"], 2)
+	assert.Equal(t, "count", byNode["e-plate"][0].Field)
+	assert.Equal(t, "number", byNode["e-plate"][1].Field)
+	assert.Equal(t, "r-plate", byNode["e-plate"][0].PreviousNodeId)
+
+	require.Len(t, byNode["e-rib"], 2)
+	assert.Equal(t, proto.TreeChangeKind_TREE_CHANGE_KIND_MOVED, byNode["e-rib"][0].Kind)
+	assert.Equal(t, `"r-frame"`, byNode["e-rib"][0].Before)
+	assert.Equal(t, `"e-root"`, byNode["e-rib"][0].After)
+
+	require.Len(t, byNode["r-bolt"], 1)
+	assert.Equal(t, proto.TreeChangeKind_TREE_CHANGE_KIND_REMOVED, byNode["r-bolt"][0].Kind)
+}
+
+func TestMatch(t *testing.T) {
+	after := testTree()
+	after.Leaves[1].Id = "new-bracket"
+	after.Leaves[0].Leaves = after.Leaves[0].Leaves[:1]
+
+	pairs := Match(testTree(), after)
+	require.Len(t, pairs, 4)
+	assert.Equal(t, "root", pairs[0].Before.Id)
+	assert.Equal(t, "plate", pairs[2].After.Id)
+	assert.Equal(t, "bracket", pairs[3].Before.Id)
+	assert.Equal(t, "new-bracket", pairs[3].After.Id)
+}
@@ -0,0 +1,39 @@
+package treediff
+
+import (
+	"context"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/gorm"
+)
+
+// Service compares the recognized trees of tasks with the user-corrected ones
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+// TaskDiff returns the changes of the recognized tree of the task made by its users, empty while the task
+// has no frontend result
+func TaskDiff(task *proto.DataRecognitionTaskORM) *proto.TreeDiff {
+	if task.RecognitionResult == nil || task.FrontendResult == nil {
+		return &proto.TreeDiff{}
+	}
+
+	recognized, edited := task.RecognitionResult.Data(), task.FrontendResult.Data()
+	return Diff(&recognized, &edited)
+}
+
+// RecognitionDiff returns the changes of the recognized tree of a completed task of the client, see TaskDiff
+func (s *Service) RecognitionDiff(ctx context.Context, clientID uint64, taskID string) (*proto.TreeDiff, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return TaskDiff(task), nil
+}
@@ -0,0 +1,186 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"gorm.io/gorm"
+)
+
+// Report aggregates a client's usage over a date range
+type Report struct {
+	From            time.Time        `json:"from"`
+	To              time.Time        `json:"to"`
+	TotalTasks      int64            `json:"total_tasks"`
+	TasksByStatus   map[string]int64 `json:"tasks_by_status"`
+	ImagesProcessed int64            `json:"images_processed"`
+	QuotaConsumed   int64            `json:"quota_consumed"`
+	Stages          []StageStats     `json:"stages"`
+	Users           []*UserUsage     `json:"users"`
+}
+
+// StageStats is the average time tasks spent in a status before moving on
+type StageStats struct {
+	Status         string  `json:"status"`
+	Count          int64   `json:"count"`
+	AverageSeconds float64 `json:"average_seconds"`
+}
+
+// UserUsage is the usage attributed to the user who created the tasks.
+// Tasks created before creators were tracked are reported with UserID 0.
+type UserUsage struct {
+	UserID          uint64           `json:"user_id"`
+	Email           string           `json:"email"`
+	Username        string           `json:"username"`
+	TotalTasks      int64            `json:"total_tasks"`
+	TasksByStatus   map[string]int64 `json:"tasks_by_status"`
+	ImagesProcessed int64            `json:"images_processed"`
+	QuotaConsumed   int64            `json:"quota_consumed"`
+}
+
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+type taskRow struct {
+	CreatedById *uint64
+	Status      int32
+	Tasks       int64
+	Images      int64
+}
+
+type quotaRow struct {
+	CreatedById *uint64
+	Consumed    int64
+}
+
+type stageRow struct {
+	Status         int32
+	Count          int64
+	AverageSeconds float64
+}
+
+// Report builds the usage report of the client for tasks and quota spent in [from, to)
+func (s *Service) Report(ctx context.Context, clientID uint64, from, to time.Time) (*Report, error) {
+	db := s.db.WithContext(ctx)
+
+	var users []proto.ClientUserORM
+	if err := db.Where("client_id = ?", clientID).Order("id").Find(&users).Error; err != nil {
+		return nil, fmt.Errorf("failed to load users: %w", err)
+	}
+
+	var tasks []taskRow
+	err := db.Raw(`
+		SELECT created_by_id, status, COUNT(*) AS tasks,
+			COALESCE(SUM(COALESCE(array_length(processed_images, 1), 0)), 0) AS images
+		FROM data_recognition_tasks
+		WHERE client_id = ? AND created_at >= ? AND created_at < ?
+		GROUP BY created_by_id, status`, clientID, from, to).Scan(&tasks).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate tasks: %w", err)
+	}
+
+	var quota []quotaRow
+	err = db.Raw(`
+		SELECT t.created_by_id, -SUM(q.amount) AS consumed
+		FROM quota_transactions q
+		LEFT JOIN data_recognition_tasks t ON t.id::text = q.task_id
+		WHERE q.client_id = ? AND q.reason IN ? AND q.created_at >= ? AND q.created_at < ?
+		GROUP BY t.created_by_id`, clientID, []int32{
+		int32(proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_IMAGE_PROCESSING),
+		int32(proto.QuotaTransactionReason_QUOTA_TRANSACTION_REASON_RECOGNITION),
+	}, from, to).Scan(&quota).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate quota: %w", err)
+	}
+
+	// Time in a status is measured up to the next recorded status of the same task
+	var stages []stageRow
+	err = db.Raw(`
+		SELECT status, COUNT(*) AS count,
+			AVG(EXTRACT(EPOCH FROM (next_at - created_at))) AS average_seconds
+		FROM (
+			SELECT status, created_at,
+				LEAD(created_at) OVER (PARTITION BY task_id ORDER BY created_at, id) AS next_at
+			FROM task_status_events
+			WHERE client_id = ?
+		) e
+		WHERE next_at IS NOT NULL AND created_at >= ? AND created_at < ?
+		GROUP BY status
+		ORDER BY status`, clientID, from, to).Scan(&stages).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate stages: %w", err)
+	}
+
+	report := &Report{
+		From:          from,
+		To:            to,
+		TasksByStatus: map[string]int64{},
+		Stages:        make([]StageStats, 0, len(stages)),
+		Users:         make([]*UserUsage, 0, len(users)),
+	}
+
+	byUser := make(map[uint64]*UserUsage, len(users))
+	for _, user := range users {
+		u := &UserUsage{
+			UserID:        user.Id,
+			Email:         user.Email,
+			Username:      user.Username,
+			TasksByStatus: map[string]int64{},
+		}
+		byUser[user.Id] = u
+		report.Users = append(report.Users, u)
+	}
+	userUsage := func(id *uint64) *UserUsage {
+		var userID uint64
+		if id != nil {
+			userID = *id
+		}
+		if u, ok := byUser[userID]; ok {
+			return u
+		}
+
+		u := &UserUsage{UserID: userID, TasksByStatus: map[string]int64{}}
+		byUser[userID] = u
+		report.Users = append(report.Users, u)
+		return u
+	}
+
+	for _, row := range tasks {
+		status := proto.Status(row.Status).String()
+		report.TotalTasks += row.Tasks
+		report.TasksByStatus[status] += row.Tasks
+		report.ImagesProcessed += row.Images
+
+		u := userUsage(row.CreatedById)
+		u.TotalTasks += row.Tasks
+		u.TasksByStatus[status] += row.Tasks
+		u.ImagesProcessed += row.Images
+	}
+
+	for _, row := range quota {
+		report.QuotaConsumed += row.Consumed
+		userUsage(row.CreatedById).QuotaConsumed += row.Consumed
+	}
+
+	for _, row := range stages {
+		report.Stages = append(report.Stages, StageStats{
+			Status:         proto.Status(row.Status).String(),
+			Count:          row.Count,
+			AverageSeconds: row.AverageSeconds,
+		})
+	}
+
+	sort.SliceStable(report.Users, func(i, j int) bool {
+		return report.Users[i].UserID < report.Users[j].UserID
+	})
+
+	return report, nil
+}
@@ -0,0 +1,300 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// Check codes of the validation engine
+const (
+	CheckCountPositive     = "count_positive"
+	CheckMaterialRequired  = "material_required"
+	CheckDesignationFormat = "designation_format"
+	CheckParentResolves    = "parent_resolves"
+	CheckAccumulatedCount  = "accumulated_count"
+	// CheckDesignationHierarchy requires the designation of a part to belong to the designation of its assembly
+	CheckDesignationHierarchy = "designation_hierarchy"
+)
+
+// Checks lists the check codes in evaluation order
+var Checks = []string{
+	CheckCountPositive,
+	CheckMaterialRequired,
+	CheckDesignationFormat,
+	CheckParentResolves,
+	CheckAccumulatedCount,
+	CheckDesignationHierarchy,
+}
+
+// Field status keys of the node fields the checks validate
+const (
+	FieldCount            = "count"
+	FieldMaterial         = "material"
+	FieldNumber           = "number"
+	FieldParentID         = "parent_id"
+	FieldAccumulatedCount = "accumulated_count"
+)
+
+// checkFields maps the check codes to the field they validate
+var checkFields = map[string]string{
+	CheckCountPositive:        FieldCount,
+	CheckMaterialRequired:     FieldMaterial,
+	CheckDesignationFormat:    FieldNumber,
+	CheckParentResolves:       FieldParentID,
+	CheckAccumulatedCount:     FieldAccumulatedCount,
+	CheckDesignationHierarchy: FieldNumber,
+}
+
+// DefaultDesignationPattern accepts designations of letters and digits joined by dots, dashes,
+// slashes or spaces, e.g. СФ-01.02.003 or 12
+const DefaultDesignationPattern = `^[\p{L}\d]+(?:[.\-/ ][\p{L}\d]+)*$`
+
+var defaultDesignation = regexp.MustCompile(DefaultDesignationPattern)
+
+// CheckField returns the field status key of the field the check validates
+func CheckField(check string) string {
+	return checkFields[check]
+}
+
+// Rules is the check configuration of a client
+type Rules struct {
+	disabled    map[string]bool
+	designation *regexp.Regexp
+}
+
+// NewRules resolves the rule of every check: a client rule takes precedence over a shared one, and
+// checks without a rule are enabled with their defaults. An invalid pattern falls back to the default.
+func NewRules(rules []*proto.ValidationRuleORM) *Rules {
+	effective := make(map[string]*proto.ValidationRuleORM)
+	for _, rule := range rules {
+		if current, ok := effective[rule.Code]; ok && (current.ClientId != nil || rule.ClientId == nil) {
+			continue
+		}
+		effective[rule.Code] = rule
+	}
+
+	r := &Rules{disabled: make(map[string]bool), designation: defaultDesignation}
+	for code, rule := range effective {
+		r.disabled[code] = !rule.Enabled
+		if code == CheckDesignationFormat && rule.Pattern != "" {
+			if pattern, err := regexp.Compile(rule.Pattern); err == nil {
+				r.designation = pattern
+			}
+		}
+	}
+
+	return r
+}
+
+// Enabled tells whether the check runs
+func (r *Rules) Enabled(check string) bool {
+	return !r.disabled[check]
+}
+
+// Validate runs the enabled checks on the tree. Every checked field gets an OK or YELLOW status in the
+// field status of its node, the statuses of disabled checks are cleared. The root is a container of
+// drawings and is not checked itself. It returns the problems found, in tree order.
+func Validate(root *proto.TreeNode, rules *Rules) []*proto.ValidationIssueORM {
+	ids := make(map[string]bool)
+	var collect func(node *proto.TreeNode)
+	collect = func(node *proto.TreeNode) {
+		if node.Id != "" {
+			ids[node.Id] = true
+		}
+		for _, leaf := range node.Leaves {
+			collect(leaf)
+		}
+	}
+	collect(root)
+
+	v := &validator{rules: rules, ids: ids}
+	for _, leaf := range root.Leaves {
+		v.walk(leaf, root)
+	}
+
+	return v.issues
+}
+
+type validator struct {
+	rules  *Rules
+	ids    map[string]bool
+	issues []*proto.ValidationIssueORM
+}
+
+func (v *validator) walk(node *proto.TreeNode, parent *proto.TreeNode) {
+	// a field checked by several checks gets the worst status of them
+	statuses := make(map[string]proto.FieldStatus)
+	for _, check := range Checks {
+		field := checkFields[check]
+		if !v.rules.Enabled(check) {
+			continue
+		}
+
+		message, applies := v.check(check, node, parent)
+		if !applies {
+			continue
+		}
+		if message == "" {
+			if _, ok := statuses[field]; !ok {
+				statuses[field] = proto.FieldStatus_OK
+			}
+			continue
+		}
+		statuses[field] = proto.FieldStatus_YELLOW
+		v.issues = append(v.issues, &proto.ValidationIssueORM{
+			NodeId:  node.Id,
+			Number:  node.Number,
+			Name:    node.Name,
+			Field:   field,
+			Code:    check,
+			Message: message,
+		})
+	}
+
+	for _, field := range checkFields {
+		status, ok := statuses[field]
+		if !ok {
+			delete(node.FieldStatus, field)
+			continue
+		}
+		if node.FieldStatus == nil {
+			node.FieldStatus = make(map[string]proto.FieldStatus)
+		}
+		node.FieldStatus[field] = status
+	}
+
+	for _, leaf := range node.Leaves {
+		v.walk(leaf, node)
+	}
+}
+
+// check runs a check on a node. It returns the problem, empty when the field is valid,
+// and whether the check applies to the node at all.
+func (v *validator) check(check string, node *proto.TreeNode, parent *proto.TreeNode) (string, bool) {
+	switch check {
+	case CheckCountPositive:
+		return checkCount(node)
+	case CheckMaterialRequired:
+		return checkMaterial(node)
+	case CheckDesignationFormat:
+		number := strings.TrimSpace(node.Number)
+		if number == "" {
+			return "", false
+		}
+		if !v.rules.designation.MatchString(number) {
+			return fmt.Sprintf("designation %q has an invalid format", node.Number), true
+		}
+		return "", true
+	case CheckParentResolves:
+		if node.ParentId == "" {
+			return "", false
+		}
+		if !v.ids[node.ParentId] {
+			return fmt.Sprintf("parent %q is not in the tree", node.ParentId), true
+		}
+		return "", true
+	case CheckAccumulatedCount:
+		return checkAccumulatedCount(node, types.NodeQuantity(parent))
+	case CheckDesignationHierarchy:
+		return checkDesignationHierarchy(node, parent)
+	}
+
+	return "", false
+}
+
+// checkCount requires a positive count of the nodes attached to a specification row;
+// other nodes have no count and only must not have a negative one
+func checkCount(node *proto.TreeNode) (string, bool) {
+	switch {
+	case node.Count < 0:
+		return fmt.Sprintf("count %d is negative", node.Count), true
+	case node.Spec == nil:
+		return "", node.Count > 0
+	case node.Count <= 0 && node.Spec.Count <= 0:
+		return "count must be positive", true
+	}
+
+	return "", true
+}
+
+// checkMaterial requires a material of manufactured parts; assemblies, standard and purchased items have none
+func checkMaterial(node *proto.TreeNode) (string, bool) {
+	if len(node.Leaves) > 0 {
+		return "", false
+	}
+	if node.Spec != nil && (node.Spec.PartKind == proto.PartKind_PART_KIND_STANDARD || node.Spec.PartKind == proto.PartKind_PART_KIND_PURCHASED) {
+		return "", false
+	}
+	if strings.TrimSpace(types.NodeMaterial(node)) == "" {
+		return "material is required for a manufactured part", true
+	}
+
+	return "", true
+}
+
+// checkAccumulatedCount requires the accumulated count of a node to be its count times the
+// number of units of its parent in the whole product
+func checkAccumulatedCount(node *proto.TreeNode, parentQuantity int32) (string, bool) {
+	switch {
+	case node.AccumulatedCount < 0:
+		return fmt.Sprintf("accumulated count %d is negative", node.AccumulatedCount), true
+	case node.AccumulatedCount == 0 || node.Count <= 0:
+		return "", false
+	}
+	if expected := node.Count * parentQuantity; node.AccumulatedCount != expected {
+		return fmt.Sprintf("accumulated count %d differs from %d, the count times the parent units", node.AccumulatedCount, expected), true
+	}
+
+	return "", true
+}
+
+// checkDesignationHierarchy requires the designation of a node to belong to the designation of its assembly:
+// the number of the parent node, or the assembly of its specification row when the parent has none.
+// It also requires the two to name the same assembly. Nodes without a readable designation are not checked.
+func checkDesignationHierarchy(node *proto.TreeNode, parent *proto.TreeNode) (string, bool) {
+	designation, err := types.ParseDesignation(node.Number)
+	if err != nil {
+		return "", false
+	}
+
+	assembly, err := types.ParseDesignation(parent.Number)
+	if node.Spec != nil {
+		if sb, sbErr := types.ParseDesignation(node.Spec.SbNumber); sbErr == nil {
+			if err == nil && sb.Base() != assembly.Base() {
+				return fmt.Sprintf("specification assembly %s differs from parent %s", sb, assembly), true
+			}
+			assembly, err = sb, nil
+		}
+	}
+	if err != nil {
+		return "", false
+	}
+
+	if !assembly.Contains(designation) {
+		return fmt.Sprintf("designation %s is not part of assembly %s", designation, assembly), true
+	}
+
+	return "", true
+}
+
+// Summarise counts the problems of a task by check and by node
+func Summarise(taskID string, issues []*proto.ValidationIssue) *proto.ValidationSummary {
+	summary := &proto.ValidationSummary{
+		TaskId:   taskID,
+		Problems: int32(len(issues)),
+		Checks:   make(map[string]int32),
+		Issues:   issues,
+	}
+	nodes := make(map[string]bool)
+	for _, issue := range issues {
+		summary.Checks[issue.Code]++
+		nodes[issue.NodeId] = true
+	}
+	summary.Nodes = int32(len(nodes))
+
+	return summary
+}
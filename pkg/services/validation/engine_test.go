@@ -0,0 +1,188 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/counts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTree() *proto.TreeNode {
+	return &proto.TreeNode{
+		Id:    "root",
+		Count: 2,
+		Leaves: []*proto.TreeNode{
+			{
+				Id: "frame", Number: "СФ-01.00.000", Count: 1, AccumulatedCount: 2, ParentId: "root",
+				Spec: &proto.SpecificationRow{Count: 1},
+				Leaves: []*proto.TreeNode{
+					{Id: "plate", Number: "СФ-01.00.001", Count: 4, AccumulatedCount: 8, ParentId: "frame", Material: "Лист 10/Ст3", Spec: &proto.SpecificationRow{Count: 4}},
+					{Id: "rib", Number: "СФ-01..002", Count: 0, AccumulatedCount: 6, ParentId: "frame", Spec: &proto.SpecificationRow{}},
+					{Id: "bolt", Number: "Болт М12", Count: 8, AccumulatedCount: 16, ParentId: "lost", Spec: &proto.SpecificationRow{Count: 8, PartKind: proto.PartKind_PART_KIND_STANDARD}},
+				},
+			},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tree := testTree()
+	issues := Validate(tree, NewRules(nil))
+
+	var found []string
+	for _, issue := range issues {
+		found = append(found, issue.NodeId+":"+issue.Code)
+	}
+	assert.Equal(t, []string{
+		"rib:" + CheckCountPositive,
+		"rib:" + CheckMaterialRequired,
+		"rib:" + CheckDesignationFormat,
+		"bolt:" + CheckParentResolves,
+	}, found)
+
+	frame, plate, rib, bolt := tree.Leaves[0], tree.Leaves[0].Leaves[0], tree.Leaves[0].Leaves[1], tree.Leaves[0].Leaves[2]
+	assert.Nil(t, tree.FieldStatus)
+	assert.Equal(t, proto.FieldStatus_OK, frame.FieldStatus[FieldAccumulatedCount])
+	assert.NotContains(t, frame.FieldStatus, FieldMaterial)
+	assert.Equal(t, proto.FieldStatus_OK, plate.FieldStatus[FieldMaterial])
+	assert.Equal(t, proto.FieldStatus_YELLOW, rib.FieldStatus[FieldCount])
+	assert.NotContains(t, rib.FieldStatus, FieldAccumulatedCount)
+	assert.NotContains(t, bolt.FieldStatus, FieldMaterial)
+	assert.Equal(t, proto.FieldStatus_YELLOW, bolt.FieldStatus[FieldParentID])
+}
+
+func TestValidateAccumulatedCount(t *testing.T) {
+	tree := testTree()
+	tree.Leaves[0].Leaves[0].AccumulatedCount = 4
+
+	issues := Validate(tree, NewRules(nil))
+	require.NotEmpty(t, issues)
+	assert.Equal(t, "plate", issues[0].NodeId)
+	assert.Equal(t, CheckAccumulatedCount, issues[0].Code)
+	assert.Equal(t, FieldAccumulatedCount, issues[0].Field)
+}
+
+func TestValidateAccumulatedCountThroughUncountedNodes(t *testing.T) {
+	tree := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{Id: "assembly", Count: 3, Spec: &proto.SpecificationRow{Count: 3}, Leaves: []*proto.TreeNode{
+				{Id: "drawing", Leaves: []*proto.TreeNode{
+					{Id: "part", Count: 2, Material: "Лист 10/Ст3", Spec: &proto.SpecificationRow{Count: 2}},
+				}},
+			}},
+		},
+	}
+	require.NoError(t, counts.Accumulate(tree))
+
+	part := tree.Leaves[0].Leaves[0].Leaves[0]
+	assert.Equal(t, int32(6), part.AccumulatedCount)
+	assert.Empty(t, Validate(tree, NewRules(nil)))
+	assert.Equal(t, proto.FieldStatus_OK, part.FieldStatus[FieldAccumulatedCount])
+}
+
+func TestRules(t *testing.T) {
+	clientID := uint64(1)
+	rules := NewRules([]*proto.ValidationRuleORM{
+		{ClientId: &clientID, Code: CheckMaterialRequired, Enabled: false},
+		{Code: CheckMaterialRequired, Enabled: true},
+		{Code: CheckDesignationFormat, Enabled: true, Pattern: `^СФ-\d{2}\.\d{2}\.\d{3}$`},
+		{Code: CheckParentResolves, Enabled: false},
+	})
+	assert.False(t, rules.Enabled(CheckMaterialRequired))
+	assert.False(t, rules.Enabled(CheckParentResolves))
+	assert.True(t, rules.Enabled(CheckCountPositive))
+
+	tree := testTree()
+	// statuses of a previous run are cleared for disabled checks
+	tree.Leaves[0].Leaves[2].FieldStatus = map[string]proto.FieldStatus{FieldParentID: proto.FieldStatus_YELLOW}
+	issues := Validate(tree, rules)
+
+	var found []string
+	for _, issue := range issues {
+		found = append(found, issue.NodeId+":"+issue.Code)
+	}
+	assert.Equal(t, []string{
+		"rib:" + CheckCountPositive,
+		"rib:" + CheckDesignationFormat,
+		"bolt:" + CheckDesignationFormat,
+	}, found)
+	assert.NotContains(t, tree.Leaves[0].Leaves[2].FieldStatus, FieldParentID)
+
+	// an invalid pattern falls back to the default
+	rules = NewRules([]*proto.ValidationRuleORM{{Code: CheckDesignationFormat, Enabled: true, Pattern: "("}})
+	assert.Len(t, Validate(testTree(), rules), 4)
+}
+
+func TestSummarise(t *testing.T) {
+	summary := Summarise("task", []*proto.ValidationIssue{
+		{NodeId: "a", Code: CheckCountPositive},
+		{NodeId: "a", Code: CheckMaterialRequired},
+		{NodeId: "b", Code: CheckCountPositive},
+	})
+	assert.Equal(t, int32(3), summary.Problems)
+	assert.Equal(t, int32(2), summary.Nodes)
+	assert.Equal(t, map[string]int32{CheckCountPositive: 2, CheckMaterialRequired: 1}, summary.Checks)
+}
+
+func TestValidateDesignationHierarchy(t *testing.T) {
+	// designations as recognized in pkg/testutils/nodes.json
+	spec := func(sb string) *proto.SpecificationRow { return &proto.SpecificationRow{SbNumber: sb, Count: 1} }
+	tree := &proto.TreeNode{
+		Leaves: []*proto.TreeNode{
+			{
+				Id: "drawing", Number: "23.00.27ТХ.02.01.00,С0.00.",
+				Leaves: []*proto.TreeNode{
+					{
+						Id: "earring", Number: "23.00.27-ТХ. 02.01.03.00.00", Spec: spec("23.00.27ТХ.02.01.00,С0.00."),
+						Leaves: []*proto.TreeNode{
+							{Id: "cheek", Number: "23.00.27-ТХ.02.01.03.00.02", Spec: spec("23.00.27ТХ.02.01.03.00,00,")},
+							{Id: "rib", Number: "23.00.27-тХ.02.01.02.00.01", Spec: spec("23.00.27ТХ.02.01.03.00,00,")},
+						},
+					},
+					{Id: "finger", Number: "23.00 27-ТХ.02.01.00.00.06", Spec: spec("23.00.27ТХ.02.01.00,С0.00.")},
+					{Id: "bracket", Number: "'3.00.27-ТХ.02.01.01.00.00-01", Spec: spec("23.00.27ТХ.02.01.00,С0.00.")},
+					{Id: "bolt", Name: "болт 16 х73° пост 186с-10", Spec: spec("23.00.27ТХ.02.01.00,С0.00.")},
+				},
+			},
+		},
+	}
+	rules := NewRules([]*proto.ValidationRuleORM{{Code: CheckDesignationFormat, Enabled: false}})
+
+	var found []string
+	for _, issue := range Validate(tree, rules) {
+		if issue.Code == CheckDesignationHierarchy {
+			found = append(found, issue.NodeId)
+		}
+	}
+	// the rib is attached to a wrong assembly, the first digit of the bracket is lost
+	assert.Equal(t, []string{"rib", "bracket"}, found)
+
+	drawing := tree.Leaves[0]
+	assert.NotContains(t, drawing.FieldStatus, FieldNumber)
+	assert.Equal(t, proto.FieldStatus_OK, drawing.Leaves[0].FieldStatus[FieldNumber])
+	assert.Equal(t, proto.FieldStatus_OK, drawing.Leaves[0].Leaves[0].FieldStatus[FieldNumber])
+	assert.Equal(t, proto.FieldStatus_OK, drawing.Leaves[1].FieldStatus[FieldNumber])
+	assert.Equal(t, proto.FieldStatus_YELLOW, drawing.Leaves[2].FieldStatus[FieldNumber])
+	assert.NotContains(t, drawing.Leaves[3].FieldStatus, FieldNumber)
+}
+
+func TestValidateDesignationHierarchyMismatch(t *testing.T) {
+	tree := testTree()
+	plate := tree.Leaves[0].Leaves[0]
+	plate.Spec.SbNumber = "СФ-02.00.000"
+
+	issues := Validate(tree, NewRules(nil))
+	require.NotEmpty(t, issues)
+	assert.Equal(t, "plate", issues[0].NodeId)
+	assert.Equal(t, CheckDesignationHierarchy, issues[0].Code)
+	assert.Equal(t, "specification assembly СФ.02.00.000 differs from parent СФ.01.00.000", issues[0].Message)
+
+	// a format problem is not hidden by a valid hierarchy on the same field
+	rib := tree.Leaves[0].Leaves[1]
+	rib.Number = "СФ-01.00.002?"
+	Validate(tree, NewRules(nil))
+	assert.Equal(t, proto.FieldStatus_YELLOW, rib.FieldStatus[FieldNumber])
+}
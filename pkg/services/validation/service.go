@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Service runs the validation engine on the trees of tasks and keeps the problems found
+type Service struct {
+	db *gorm.DB
+}
+
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db}
+}
+
+// ClientRules loads the client's rules together with the rules shared by all clients
+func (s *Service) ClientRules(ctx context.Context, clientID uint64) (*Rules, error) {
+	var rules []*proto.ValidationRuleORM
+	err := s.db.WithContext(ctx).Where("client_id = ? OR client_id IS NULL", clientID).Find(&rules).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRules(rules), nil
+}
+
+// Apply validates the tree of a task with the client's rules, setting the field statuses of the tree,
+// and replaces the stored problems of the task with the ones found
+func (s *Service) Apply(ctx context.Context, clientID uint64, taskID string, tree *proto.TreeNode) error {
+	rules, err := s.ClientRules(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	issues := Validate(tree, rules)
+
+	now := time.Now()
+	for _, issue := range issues {
+		issue.TaskId = taskID
+		issue.CreatedAt = &now
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("task_id = ?", taskID).Delete(&proto.ValidationIssueORM{}).Error; err != nil {
+			return err
+		}
+		if len(issues) == 0 {
+			return nil
+		}
+		return tx.Create(&issues).Error
+	})
+}
+
+// ApplyTask validates the current tree of the task, the user-edited result if present, and stores the
+// annotated tree back in the task. Tasks without a result are left as is.
+func (s *Service) ApplyTask(ctx context.Context, clientID uint64, task *proto.DataRecognitionTaskORM) error {
+	tree, err := types.TaskTree(task)
+	if errors.Is(err, types.ErrNoRecognizedTree) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := s.Apply(ctx, clientID, task.Id, tree); err != nil {
+		return err
+	}
+
+	// types.TaskTree takes the user-edited result only when it has content
+	result := datatypes.NewJSONType(*tree)
+	if edited := task.FrontendResult; edited != nil && (edited.Data().Id != "" || len(edited.Data().Leaves) > 0) {
+		task.FrontendResult = &result
+	} else {
+		task.RecognitionResult = &result
+	}
+	return nil
+}
+
+// TaskSummary returns the problems of the current tree of a completed task of the client
+func (s *Service) TaskSummary(ctx context.Context, clientID uint64, taskID string) (*proto.ValidationSummary, error) {
+	task, err := types.CompletedTask(ctx, s.db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	var stored []*proto.ValidationIssueORM
+	if err := s.db.WithContext(ctx).Where("task_id = ?", task.Id).Order("id").Find(&stored).Error; err != nil {
+		return nil, err
+	}
+	issues := make([]*proto.ValidationIssue, 0, len(stored))
+	for _, issue := range stored {
+		pb, err := issue.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, &pb)
+	}
+
+	return Summarise(task.Id, issues), nil
+}
@@ -0,0 +1,356 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+	"github.com/google/uuid"
+	gormtypes "github.com/infobloxopen/protoc-gen-gorm/types"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// CursorName identifies the dispatcher position in the task status event log
+	CursorName = "webhooks"
+
+	DispatchInterval = 5 * time.Second
+	dispatchBatch    = 100
+
+	// DisableAfterFailures is the number of failed attempts in a row after which an endpoint is disabled
+	DisableAfterFailures = 15
+
+	// claimTimeout is how long a claimed delivery is hidden from other dispatchers
+	// before it is attempted again, should the claiming dispatcher stop mid-attempt
+	claimTimeout = 2 * DeliveryTimeout
+)
+
+// RetrySchedule holds the delays between attempts of a failing delivery.
+// A delivery is marked failed once the schedule is exhausted.
+var RetrySchedule = []time.Duration{
+	30 * time.Second,
+	2 * time.Minute,
+	10 * time.Minute,
+	time.Hour,
+	6 * time.Hour,
+}
+
+// Run queues and delivers webhooks every DispatchInterval until ctx is done
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(DispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.EnqueuePending(ctx); err != nil {
+			log.Printf("webhooks: %v", err)
+		}
+		if err := s.DeliverDue(ctx); err != nil {
+			log.Printf("webhooks: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// EnqueuePending creates deliveries for task status events recorded since the previous call.
+// The first call only remembers the current position, so history is never delivered.
+func (s *Service) EnqueuePending(ctx context.Context) error {
+	db := s.db.WithContext(ctx)
+
+	var cursor proto.EventCursorORM
+	err := db.First(&cursor, "name = ?", CursorName).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		cursor.Name = CursorName
+		if err := db.Model(&proto.TaskStatusEventORM{}).Select("COALESCE(MAX(id), 0)").Scan(&cursor.TaskStatusEventId).Error; err != nil {
+			return fmt.Errorf("failed to init cursor: %w", err)
+		}
+		return saveCursor(db, &cursor)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load cursor: %w", err)
+	}
+
+	var events []proto.TaskStatusEventORM
+	if err := db.Where("id > ?", cursor.TaskStatusEventId).Order("id").Limit(dispatchBatch).Find(&events).Error; err != nil {
+		return fmt.Errorf("failed to load task status events: %w", err)
+	}
+	for i := range events {
+		if err := s.enqueueStatusEvent(ctx, &events[i]); err != nil {
+			return err
+		}
+		cursor.TaskStatusEventId = events[i].Id
+		if err := saveCursor(db, &cursor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func saveCursor(db *gorm.DB, cursor *proto.EventCursorORM) error {
+	now := time.Now()
+	cursor.UpdatedAt = &now
+	if err := db.Save(cursor).Error; err != nil {
+		return fmt.Errorf("failed to save cursor: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) enqueueStatusEvent(ctx context.Context, event *proto.TaskStatusEventORM) error {
+	db := s.db.WithContext(ctx)
+	names := EventsForStatus(proto.Status(event.Status))
+
+	var endpoints []proto.WebhookEndpointORM
+	err := db.Where("client_id = ? AND enabled = ? AND events && ?", event.ClientId, true, pq.StringArray(names)).
+		Find(&endpoints).Error
+	if err != nil {
+		return fmt.Errorf("failed to load webhook endpoints: %w", err)
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	var task proto.DataRecognitionTaskORM
+	err = db.Select("id", "error", "sandbox").First(&task, "id = ?", event.TaskId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// the task was deleted in the meantime
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load task %s: %w", event.TaskId, err)
+	}
+
+	createdAt := time.Now()
+	if event.CreatedAt != nil {
+		createdAt = *event.CreatedAt
+	}
+	data := PayloadData{
+		TaskID:  task.Id,
+		Status:  proto.Status(event.Status).String(),
+		Sandbox: task.Sandbox,
+	}
+	// the initial event of a task has no previous status
+	if event.FromStatus != event.Status {
+		data.PreviousStatus = proto.Status(event.FromStatus).String()
+	}
+	if types.IsTerminalState(proto.Status(event.Status)) {
+		data.Error = task.Error
+	}
+
+	for i := range endpoints {
+		for _, name := range names {
+			if !subscribed(&endpoints[i], name) {
+				continue
+			}
+
+			body, err := json.Marshal(Payload{
+				ID:        fmt.Sprintf("evt_%d_%s", event.Id, name),
+				Event:     name,
+				CreatedAt: createdAt.UTC().Format(time.RFC3339),
+				Data:      data,
+			})
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			delivery := &proto.WebhookDeliveryORM{
+				Id:            uuid.New().String(),
+				EndpointId:    endpoints[i].Id,
+				ClientId:      event.ClientId,
+				EventKey:      fmt.Sprintf("task_status_event:%d:%s", event.Id, name),
+				Event:         name,
+				TaskId:        event.TaskId,
+				Payload:       &gormtypes.Jsonb{RawMessage: body},
+				Status:        int32(proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING),
+				NextAttemptAt: &now,
+				CreatedAt:     &now,
+				UpdatedAt:     &now,
+			}
+			if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(delivery).Error; err != nil {
+				return fmt.Errorf("failed to queue webhook delivery: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func subscribed(endpoint *proto.WebhookEndpointORM, event string) bool {
+	for _, e := range endpoint.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DeliverDue attempts all pending deliveries whose next attempt is due
+func (s *Service) DeliverDue(ctx context.Context) error {
+	var ids []string
+	err := s.db.WithContext(ctx).Model(&proto.WebhookDeliveryORM{}).
+		Joins("JOIN webhook_endpoints ON webhook_endpoints.id = webhook_deliveries.endpoint_id").
+		Where("webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= ? AND webhook_endpoints.enabled = ?",
+			int32(proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING), time.Now(), true).
+		Order("webhook_deliveries.next_attempt_at").
+		Limit(dispatchBatch).
+		Pluck("webhook_deliveries.id", &ids).Error
+	if err != nil {
+		return fmt.Errorf("failed to load due deliveries: %w", err)
+	}
+
+	for _, id := range ids {
+		if err := s.deliver(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver performs a single attempt. The delivery is claimed and the claim committed before
+// the endpoint is called, so no row stays locked while waiting for the endpoint.
+func (s *Service) deliver(ctx context.Context, id string) error {
+	delivery, endpoint, err := s.claim(ctx, id)
+	if err != nil || delivery == nil {
+		return err
+	}
+
+	code, sendErr := s.send(ctx, endpoint, delivery)
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(delivery, "id = ?", id).Error; err != nil {
+			return fmt.Errorf("failed to lock delivery %s: %w", id, err)
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(endpoint, "id = ?", delivery.EndpointId).Error; err != nil {
+			return fmt.Errorf("failed to load endpoint of delivery %s: %w", id, err)
+		}
+
+		now := time.Now()
+		delivery.Attempts++
+		delivery.ResponseCode = int32(code)
+		delivery.UpdatedAt = &now
+		endpoint.UpdatedAt = &now
+
+		if sendErr == nil {
+			delivery.Status = int32(proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED)
+			delivery.Error = ""
+			delivery.DeliveredAt = &now
+			delivery.NextAttemptAt = nil
+			endpoint.ConsecutiveFailures = 0
+		} else {
+			delivery.Error = sendErr.Error()
+			if int(delivery.Attempts) > len(RetrySchedule) {
+				delivery.Status = int32(proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED)
+				delivery.NextAttemptAt = nil
+			} else {
+				next := now.Add(RetrySchedule[delivery.Attempts-1])
+				delivery.NextAttemptAt = &next
+			}
+
+			endpoint.ConsecutiveFailures++
+			if endpoint.Enabled && endpoint.ConsecutiveFailures >= DisableAfterFailures {
+				endpoint.Enabled = false
+				endpoint.DisabledReason = fmt.Sprintf("disabled after %d failed deliveries in a row: %s",
+					endpoint.ConsecutiveFailures, sendErr.Error())
+			}
+		}
+
+		if err := tx.Save(delivery).Error; err != nil {
+			return fmt.Errorf("failed to save delivery %s: %w", id, err)
+		}
+		if err := tx.Save(endpoint).Error; err != nil {
+			return fmt.Errorf("failed to save endpoint %s: %w", endpoint.Id, err)
+		}
+
+		return nil
+	})
+}
+
+// claim hides a due delivery from other dispatchers for claimTimeout. It returns nil when the
+// delivery is locked by another dispatcher, no longer due or its endpoint is disabled.
+func (s *Service) claim(ctx context.Context, id string) (*proto.WebhookDeliveryORM, *proto.WebhookEndpointORM, error) {
+	var delivery *proto.WebhookDeliveryORM
+	var endpoint proto.WebhookEndpointORM
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		var deliveries []proto.WebhookDeliveryORM
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("id = ? AND status = ? AND next_attempt_at <= ?", id,
+				int32(proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING), now).
+			Limit(1).Find(&deliveries).Error
+		if err != nil {
+			return fmt.Errorf("failed to lock delivery %s: %w", id, err)
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		if err := tx.First(&endpoint, "id = ?", deliveries[0].EndpointId).Error; err != nil {
+			return fmt.Errorf("failed to load endpoint of delivery %s: %w", id, err)
+		}
+		if !endpoint.Enabled {
+			return nil
+		}
+
+		claimedUntil := now.Add(claimTimeout)
+		err = tx.Model(&deliveries[0]).Updates(map[string]interface{}{"next_attempt_at": claimedUntil, "updated_at": now}).Error
+		if err != nil {
+			return fmt.Errorf("failed to claim delivery %s: %w", id, err)
+		}
+		delivery = &deliveries[0]
+
+		return nil
+	})
+	if err != nil || delivery == nil {
+		return nil, nil, err
+	}
+
+	return delivery, &endpoint, nil
+}
+
+// send posts the signed payload; any non-2xx response is an error.
+// The response body is discarded, only the status code is kept.
+func (s *Service) send(ctx context.Context, endpoint *proto.WebhookEndpointORM, delivery *proto.WebhookDeliveryORM) (int, error) {
+	var payload []byte
+	if delivery.Payload != nil {
+		payload = delivery.Payload.RawMessage
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "sferra-cloud-webhooks/1.0")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.Id)
+	req.Header.Set(SignatureHeader, Sign(endpoint.Secret, time.Now(), payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,249 @@
+package webhook_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/services/webhook"
+	"github.com/bazilio91/sferra-cloud/pkg/testutils"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+var _ = Describe("Dispatcher", func() {
+	var (
+		client   *proto.Client
+		service  *webhook.Service
+		server   *httptest.Server
+		mu       sync.Mutex
+		received []receivedRequest
+		status   int
+	)
+
+	BeforeEach(func() {
+		testutils.ClearDatabase(DB)
+
+		var err error
+		client, err = testutils.CreateTestClient(DB, "Test Client", 12)
+		Expect(err).NotTo(HaveOccurred())
+
+		received = nil
+		status = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			received = append(received, receivedRequest{header: r.Header.Clone(), body: body})
+			code := status
+			mu.Unlock()
+			w.WriteHeader(code)
+			_, _ = w.Write([]byte("response"))
+		}))
+
+		service = webhook.NewService(DB, server.Client())
+
+		// the first run only positions the cursor
+		Expect(service.EnqueuePending(ctx)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	requests := func() []receivedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]receivedRequest(nil), received...)
+	}
+
+	setStatus := func(code int) {
+		mu.Lock()
+		defer mu.Unlock()
+		status = code
+	}
+
+	createTask := func(taskStatus proto.Status) string {
+		now := time.Now()
+		id := uuid.New().String()
+		Expect(DB.Create(&proto.DataRecognitionTaskORM{
+			Id:        id,
+			ClientId:  &client.Id,
+			Status:    int32(taskStatus),
+			Error:     "timeout",
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		}).Error).NotTo(HaveOccurred())
+		return id
+	}
+
+	dispatch := func() {
+		Expect(service.EnqueuePending(ctx)).To(Succeed())
+		Expect(service.DeliverDue(ctx)).To(Succeed())
+	}
+
+	makeDue := func() {
+		Expect(DB.Model(&proto.WebhookDeliveryORM{}).Where("status = ?",
+			int32(proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING)).
+			Update("next_attempt_at", time.Now().Add(-time.Second)).Error).NotTo(HaveOccurred())
+	}
+
+	It("should deliver signed payloads for subscribed events only", func() {
+		endpoint, err := service.CreateEndpoint(ctx, client.Id, server.URL, []string{webhook.EventTaskFailed})
+		Expect(err).NotTo(HaveOccurred())
+
+		createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+		taskID := createTask(proto.Status_STATUS_RECOGNITION_FAILED_TIMEOUT)
+		dispatch()
+		dispatch()
+
+		reqs := requests()
+		Expect(reqs).To(HaveLen(1))
+		Expect(reqs[0].header.Get(webhook.EventHeader)).To(Equal(webhook.EventTaskFailed))
+		Expect(webhook.Verify(endpoint.Secret, reqs[0].header.Get(webhook.SignatureHeader), reqs[0].body,
+			time.Minute, time.Now())).To(BeTrue())
+
+		var payload webhook.Payload
+		Expect(json.Unmarshal(reqs[0].body, &payload)).To(Succeed())
+		Expect(payload.Event).To(Equal(webhook.EventTaskFailed))
+		Expect(payload.Data.TaskID).To(Equal(taskID))
+		Expect(payload.Data.Status).To(Equal(proto.Status_STATUS_RECOGNITION_FAILED_TIMEOUT.String()))
+		Expect(payload.Data.Error).To(Equal("timeout"))
+
+		var delivery proto.WebhookDeliveryORM
+		Expect(DB.First(&delivery, "id = ?", reqs[0].header.Get(webhook.DeliveryHeader)).Error).NotTo(HaveOccurred())
+		Expect(delivery.Status).To(Equal(int32(proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED)))
+		Expect(delivery.Attempts).To(Equal(int32(1)))
+		Expect(delivery.ResponseCode).To(Equal(int32(http.StatusOK)))
+		Expect(delivery.DeliveredAt).NotTo(BeNil())
+	})
+
+	It("should not deliver events of other clients", func() {
+		other, err := testutils.CreateTestClient(DB, "Other Client", 12)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = service.CreateEndpoint(ctx, other.Id, server.URL, webhook.Events)
+		Expect(err).NotTo(HaveOccurred())
+
+		createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+		dispatch()
+
+		Expect(requests()).To(BeEmpty())
+	})
+
+	It("should retry failed deliveries with backoff and give up after the schedule", func() {
+		_, err := service.CreateEndpoint(ctx, client.Id, server.URL, []string{webhook.EventTaskCompleted})
+		Expect(err).NotTo(HaveOccurred())
+		setStatus(http.StatusInternalServerError)
+
+		createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+		dispatch()
+
+		var delivery proto.WebhookDeliveryORM
+		Expect(DB.First(&delivery).Error).NotTo(HaveOccurred())
+		Expect(delivery.Status).To(Equal(int32(proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING)))
+		Expect(delivery.Attempts).To(Equal(int32(1)))
+		Expect(delivery.ResponseCode).To(Equal(int32(http.StatusInternalServerError)))
+		Expect(*delivery.NextAttemptAt).To(BeTemporally("~", time.Now().Add(webhook.RetrySchedule[0]), 5*time.Second))
+
+		// not due yet
+		dispatch()
+		Expect(requests()).To(HaveLen(1))
+
+		for range webhook.RetrySchedule {
+			makeDue()
+			dispatch()
+		}
+
+		Expect(requests()).To(HaveLen(len(webhook.RetrySchedule) + 1))
+		Expect(DB.First(&delivery).Error).NotTo(HaveOccurred())
+		Expect(delivery.Status).To(Equal(int32(proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED)))
+		Expect(delivery.NextAttemptAt).To(BeNil())
+	})
+
+	It("should disable endpoints that keep failing", func() {
+		endpoint, err := service.CreateEndpoint(ctx, client.Id, server.URL, []string{webhook.EventTaskCompleted})
+		Expect(err).NotTo(HaveOccurred())
+		setStatus(http.StatusBadGateway)
+
+		for i := 0; i < webhook.DisableAfterFailures; i++ {
+			createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+		}
+		dispatch()
+
+		Expect(requests()).To(HaveLen(webhook.DisableAfterFailures))
+		endpoint, err = service.GetEndpoint(ctx, client.Id, endpoint.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoint.Enabled).To(BeFalse())
+		Expect(endpoint.DisabledReason).NotTo(BeEmpty())
+
+		// nothing is delivered to a disabled endpoint
+		createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+		makeDue()
+		dispatch()
+		Expect(requests()).To(HaveLen(webhook.DisableAfterFailures))
+
+		endpoint, err = service.UpdateEndpoint(ctx, client.Id, endpoint.Id, endpoint.Url, endpoint.Events, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoint.ConsecutiveFailures).To(BeZero())
+		Expect(endpoint.DisabledReason).To(BeEmpty())
+	})
+
+	It("should replay a logged delivery", func() {
+		endpoint, err := service.CreateEndpoint(ctx, client.Id, server.URL, []string{webhook.EventTaskCompleted})
+		Expect(err).NotTo(HaveOccurred())
+
+		createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+		dispatch()
+		Expect(requests()).To(HaveLen(1))
+
+		deliveries, total, err := service.ListDeliveries(ctx, client.Id, endpoint.Id, 1, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(total).To(Equal(int64(1)))
+
+		replay, err := service.Replay(ctx, client.Id, endpoint.Id, deliveries[0].Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replay.ReplayOf).To(Equal(deliveries[0].Id))
+		dispatch()
+
+		reqs := requests()
+		Expect(reqs).To(HaveLen(2))
+		Expect(reqs[1].body).To(MatchJSON(reqs[0].body))
+		Expect(reqs[1].header.Get(webhook.DeliveryHeader)).To(Equal(replay.Id))
+	})
+
+	It("should refuse to deliver to private addresses with the default client", func() {
+		guarded := webhook.NewService(DB, nil)
+		_, err := guarded.CreateEndpoint(ctx, client.Id, server.URL, []string{webhook.EventTaskCompleted})
+		Expect(err).NotTo(HaveOccurred())
+
+		createTask(proto.Status_STATUS_PROCESSING_COMPLETED)
+		Expect(guarded.EnqueuePending(ctx)).To(Succeed())
+		Expect(guarded.DeliverDue(ctx)).To(Succeed())
+
+		Expect(requests()).To(BeEmpty())
+		var delivery proto.WebhookDeliveryORM
+		Expect(DB.First(&delivery).Error).NotTo(HaveOccurred())
+		Expect(delivery.Attempts).To(Equal(int32(1)))
+		Expect(delivery.Error).To(ContainSubstring(webhook.ErrForbiddenAddress.Error()))
+	})
+
+	It("should validate endpoints", func() {
+		_, err := service.CreateEndpoint(ctx, client.Id, "ftp://example.com", webhook.Events)
+		Expect(err).To(MatchError(webhook.ErrInvalidURL))
+
+		_, err = service.CreateEndpoint(ctx, client.Id, server.URL, []string{"task.deleted"})
+		Expect(err).To(MatchError(webhook.ErrInvalidEvent))
+
+		_, err = service.CreateEndpoint(ctx, client.Id, server.URL, nil)
+		Expect(err).To(MatchError(webhook.ErrNoEventsSpecified))
+	})
+})
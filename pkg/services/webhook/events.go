@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/bazilio91/sferra-cloud/pkg/types"
+)
+
+// Events endpoints can subscribe to
+const (
+	EventTaskStatusChanged = "task.status_changed"
+	EventTaskCompleted     = "task.completed"
+	EventTaskFailed        = "task.failed"
+)
+
+// Events lists all supported event names
+var Events = []string{EventTaskStatusChanged, EventTaskCompleted, EventTaskFailed}
+
+// IsValidEvent reports whether name is a supported event
+func IsValidEvent(name string) bool {
+	for _, event := range Events {
+		if event == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EventsForStatus returns the events raised when a task reaches status
+func EventsForStatus(status proto.Status) []string {
+	events := []string{EventTaskStatusChanged}
+	switch {
+	case status == proto.Status_STATUS_PROCESSING_COMPLETED:
+		events = append(events, EventTaskCompleted)
+	case types.IsTerminalState(status):
+		events = append(events, EventTaskFailed)
+	}
+
+	return events
+}
+
+// Payload is the JSON body delivered to endpoints
+type Payload struct {
+	ID        string      `json:"id"`
+	Event     string      `json:"event"`
+	CreatedAt string      `json:"created_at"`
+	Data      PayloadData `json:"data"`
+}
+
+// PayloadData describes the task the event is about
+type PayloadData struct {
+	TaskID         string `json:"task_id"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+	Error          string `json:"error,omitempty"`
+	Sandbox        bool   `json:"sandbox"`
+}
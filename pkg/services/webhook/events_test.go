@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidEvent(t *testing.T) {
+	for _, event := range Events {
+		assert.True(t, IsValidEvent(event), event)
+	}
+	assert.False(t, IsValidEvent("task.deleted"))
+	assert.False(t, IsValidEvent(""))
+}
+
+func TestEventsForStatus(t *testing.T) {
+	assert.Equal(t, []string{EventTaskStatusChanged},
+		EventsForStatus(proto.Status_STATUS_IMAGES_PROCESSING))
+	assert.Equal(t, []string{EventTaskStatusChanged, EventTaskCompleted},
+		EventsForStatus(proto.Status_STATUS_PROCESSING_COMPLETED))
+	assert.Equal(t, []string{EventTaskStatusChanged, EventTaskFailed},
+		EventsForStatus(proto.Status_STATUS_RECOGNITION_FAILED_TIMEOUT))
+}
@@ -0,0 +1,230 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidURL        = errors.New("webhook url must be an absolute http(s) url")
+	ErrInvalidEvent      = errors.New("unknown webhook event")
+	ErrEndpointNotFound  = errors.New("webhook endpoint not found")
+	ErrDeliveryNotFound  = errors.New("webhook delivery not found")
+	ErrEndpointDisabled  = errors.New("webhook endpoint is disabled")
+	ErrNoEventsSpecified = errors.New("at least one event is required")
+)
+
+// DeliveryTimeout limits a single delivery attempt
+const DeliveryTimeout = 10 * time.Second
+
+// Service manages webhook endpoints of clients and delivers task events to them
+type Service struct {
+	db     *gorm.DB
+	client *http.Client
+}
+
+// NewService creates a webhook service; a nil client uses NewDeliveryClient
+func NewService(db *gorm.DB, client *http.Client) *Service {
+	if client == nil {
+		client = NewDeliveryClient()
+	}
+
+	return &Service{
+		db:     db,
+		client: client,
+	}
+}
+
+func validateEndpoint(rawURL string, events []string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidURL
+	}
+	if len(events) == 0 {
+		return ErrNoEventsSpecified
+	}
+	for _, event := range events {
+		if !IsValidEvent(event) {
+			return fmt.Errorf("%w: %s", ErrInvalidEvent, event)
+		}
+	}
+
+	return nil
+}
+
+// CreateEndpoint registers an enabled endpoint with a freshly generated secret
+func (s *Service) CreateEndpoint(ctx context.Context, clientID uint64, rawURL string, events []string) (*proto.WebhookEndpointORM, error) {
+	if err := validateEndpoint(rawURL, events); err != nil {
+		return nil, err
+	}
+
+	secret, err := GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	now := time.Now()
+	endpoint := &proto.WebhookEndpointORM{
+		Id:        uuid.New().String(),
+		ClientId:  clientID,
+		Url:       rawURL,
+		Secret:    secret,
+		Events:    pq.StringArray(events),
+		Enabled:   true,
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+	if err := s.db.WithContext(ctx).Create(endpoint).Error; err != nil {
+		return nil, fmt.Errorf("failed to save webhook endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+// GetEndpoint returns an endpoint of the client
+func (s *Service) GetEndpoint(ctx context.Context, clientID uint64, id string) (*proto.WebhookEndpointORM, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrEndpointNotFound
+	}
+
+	var endpoint proto.WebhookEndpointORM
+	err := s.db.WithContext(ctx).First(&endpoint, "id = ? AND client_id = ?", id, clientID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrEndpointNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpoint, nil
+}
+
+// ListEndpoints returns all endpoints of the client
+func (s *Service) ListEndpoints(ctx context.Context, clientID uint64) ([]proto.WebhookEndpointORM, error) {
+	var endpoints []proto.WebhookEndpointORM
+	if err := s.db.WithContext(ctx).Where("client_id = ?", clientID).Order("created_at").Find(&endpoints).Error; err != nil {
+		return nil, err
+	}
+
+	return endpoints, nil
+}
+
+// UpdateEndpoint changes the url, events and state of an endpoint. Re-enabling an
+// endpoint resets its failure counter.
+func (s *Service) UpdateEndpoint(ctx context.Context, clientID uint64, id, rawURL string, events []string, enabled bool) (*proto.WebhookEndpointORM, error) {
+	if err := validateEndpoint(rawURL, events); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.GetEndpoint(ctx, clientID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if enabled && !endpoint.Enabled {
+		endpoint.ConsecutiveFailures = 0
+		endpoint.DisabledReason = ""
+	}
+	now := time.Now()
+	endpoint.Url = rawURL
+	endpoint.Events = pq.StringArray(events)
+	endpoint.Enabled = enabled
+	endpoint.UpdatedAt = &now
+
+	if err := s.db.WithContext(ctx).Save(endpoint).Error; err != nil {
+		return nil, fmt.Errorf("failed to save webhook endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+// DeleteEndpoint removes an endpoint together with its delivery log
+func (s *Service) DeleteEndpoint(ctx context.Context, clientID uint64, id string) error {
+	endpoint, err := s.GetEndpoint(ctx, clientID, id)
+	if err != nil {
+		return err
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("endpoint_id = ?", endpoint.Id).Delete(&proto.WebhookDeliveryORM{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(endpoint).Error
+	})
+}
+
+// ListDeliveries returns a page of the endpoint's delivery log, newest first
+func (s *Service) ListDeliveries(ctx context.Context, clientID uint64, endpointID string, page, pageSize int) ([]proto.WebhookDeliveryORM, int64, error) {
+	endpoint, err := s.GetEndpoint(ctx, clientID, endpointID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := s.db.WithContext(ctx).Model(&proto.WebhookDeliveryORM{}).Where("endpoint_id = ?", endpoint.Id)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var deliveries []proto.WebhookDeliveryORM
+	if err := query.Order("created_at DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&deliveries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
+
+// Replay queues a new delivery with the payload of a logged one
+func (s *Service) Replay(ctx context.Context, clientID uint64, endpointID, deliveryID string) (*proto.WebhookDeliveryORM, error) {
+	endpoint, err := s.GetEndpoint(ctx, clientID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	if !endpoint.Enabled {
+		return nil, ErrEndpointDisabled
+	}
+	if _, err := uuid.Parse(deliveryID); err != nil {
+		return nil, ErrDeliveryNotFound
+	}
+
+	var original proto.WebhookDeliveryORM
+	err = s.db.WithContext(ctx).First(&original, "id = ? AND endpoint_id = ?", deliveryID, endpoint.Id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New().String()
+	now := time.Now()
+	replay := &proto.WebhookDeliveryORM{
+		Id:            id,
+		EndpointId:    endpoint.Id,
+		ClientId:      endpoint.ClientId,
+		EventKey:      "replay:" + id,
+		Event:         original.Event,
+		TaskId:        original.TaskId,
+		Payload:       original.Payload,
+		Status:        int32(proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING),
+		NextAttemptAt: &now,
+		ReplayOf:      original.Id,
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	}
+	if err := s.db.WithContext(ctx).Create(replay).Error; err != nil {
+		return nil, fmt.Errorf("failed to queue replay: %w", err)
+	}
+
+	return replay, nil
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	require.NoError(t, err)
+	b, err := GenerateSecret()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(a, "whsec_"))
+	assert.Len(t, a, len("whsec_")+64)
+	assert.NotEqual(t, a, b)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	secret := "whsec_test"
+	body := []byte(`{"event":"task.completed"}`)
+	now := time.Unix(1700000000, 0)
+
+	header := Sign(secret, now, body)
+	assert.True(t, strings.HasPrefix(header, "t=1700000000,v1="))
+
+	t.Run("Valid signature", func(t *testing.T) {
+		assert.True(t, Verify(secret, header, body, 5*time.Minute, now.Add(time.Minute)))
+	})
+
+	t.Run("Wrong secret", func(t *testing.T) {
+		assert.False(t, Verify("whsec_other", header, body, 5*time.Minute, now))
+	})
+
+	t.Run("Tampered body", func(t *testing.T) {
+		assert.False(t, Verify(secret, header, []byte(`{"event":"task.failed"}`), 5*time.Minute, now))
+	})
+
+	t.Run("Expired timestamp", func(t *testing.T) {
+		assert.False(t, Verify(secret, header, body, 5*time.Minute, now.Add(10*time.Minute)))
+	})
+
+	t.Run("Malformed header", func(t *testing.T) {
+		assert.False(t, Verify(secret, "garbage", body, 0, now))
+		assert.False(t, Verify(secret, "t=abc,v1=00", body, 0, now))
+	})
+}
@@ -56,6 +56,13 @@ func StartTestDBContainer(ctx context.Context) (*TestDBContainer, error) {
 		JWTSecret:      "testsecret",
 		APIServerPort:  "8080",
 		GRPCServerPort: "50051",
+
+		PaymentProvider:      "fake",
+		PaymentWebhookSecret: "testsecret",
+		PaymentFakeEnabled:   true,
+		QuotaUnitPrice:       100,
+		QuotaCurrency:        "RUB",
+		QuotaLowThreshold:    10,
 	}
 
 	// Initialize the database using db.InitDB(cfg)
@@ -98,6 +105,36 @@ func ClearDatabase(DB *gorm.DB) {
 	if err != nil {
 		panic(err)
 	}
+	DB.Exec("DELETE FROM quote_items")
+	DB.Exec("DELETE FROM quote_revisions")
+	DB.Exec("DELETE FROM quotes")
+	DB.Exec("DELETE FROM cost_estimates")
+	DB.Exec("DELETE FROM operation_rules")
+	DB.Exec("DELETE FROM waste_factors")
+	DB.Exec("DELETE FROM stock_lengths")
+	DB.Exec("DELETE FROM sheet_formats")
+	DB.Exec("DELETE FROM material_densities")
+	DB.Exec("DELETE FROM material_grades")
+	DB.Exec("DELETE FROM unmatched_materials")
+	DB.Exec("DELETE FROM assortment_standard_sizes")
+	DB.Exec("DELETE FROM assortment_standards")
+	DB.Exec("DELETE FROM validation_rules")
+	DB.Exec("DELETE FROM validation_issues")
+	DB.Exec("DELETE FROM standard_parts")
+	DB.Exec("DELETE FROM tree_revisions")
+	DB.Exec("DELETE FROM recognition_metrics")
+	DB.Exec("DELETE FROM dataset_exports")
+	DB.Exec("DELETE FROM material_prices")
+	DB.Exec("DELETE FROM operation_rates")
+	DB.Exec("DELETE FROM price_lists")
+	DB.Exec("DELETE FROM webhook_deliveries")
+	DB.Exec("DELETE FROM webhook_endpoints")
+	DB.Exec("DELETE FROM notifications")
+	DB.Exec("DELETE FROM notification_preferences")
+	DB.Exec("DELETE FROM event_cursors")
+	DB.Exec("DELETE FROM task_status_events")
+	DB.Exec("DELETE FROM quota_transactions")
+	DB.Exec("DELETE FROM orders")
 	DB.Exec("DELETE FROM clients")
 	DB.Exec("DELETE FROM admins")
 }
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidDesignation = errors.New("invalid designation")
+
+// Designation is a parsed drawing designation such as "23.00.27-ТХ.02.01.03.00.00-01.СБ":
+// the project prefix, the hierarchy levels, the variant and the document code
+type Designation struct {
+	Prefix   string
+	Levels   []string
+	Variant  string
+	Document string
+}
+
+// designationLookAlikes maps the Latin letters recognition reads for their Cyrillic twins and the dashes to one spelling
+var designationLookAlikes = strings.NewReplacer(
+	"A", "А", "B", "В", "C", "С", "E", "Е", "H", "Н", "K", "К", "M", "М",
+	"O", "О", "P", "Р", "T", "Т", "X", "Х", "Y", "У",
+	"–", "-", "—", "-", "‐", "-", "−", "-",
+	",", ".",
+)
+
+// digitLookAlikes maps the letters recognition reads for digits of the levels. С is a letter of its own,
+// e.g. of the СБ document code, and is only read as 0 next to digits, see levelDigits.
+var (
+	digitLookAlikes      = strings.NewReplacer("О", "0", "З", "3")
+	gluedDigitLookAlikes = strings.NewReplacer("О", "0", "С", "0", "З", "3")
+)
+
+var (
+	spacedDigitsPattern = regexp.MustCompile(`(\d)\s+(\d)`)
+	// codeDigitsPattern matches a project code glued to the number before it, e.g. 27ТХ
+	codeDigitsPattern   = regexp.MustCompile(`(\d)(\p{Lu}{2,})`)
+	repeatedSepPattern  = regexp.MustCompile(`([.\-])[.\-]+`)
+	designationGroup    = regexp.MustCompile(`[\p{Lu}\d]+|[.\-]`)
+	documentCodePattern = regexp.MustCompile(`^\p{Lu}{1,3}\d?$`)
+	designationDigits   = regexp.MustCompile(`^\d+$`)
+	designationLetters  = regexp.MustCompile(`^\p{Lu}+$`)
+)
+
+// minDesignationLevels is the least number of levels of a designation, telling it from other numbered text
+const minDesignationLevels = 2
+
+// NormalizeDesignation unifies the spelling of a recognized designation: upper case Cyrillic letters,
+// dots for commas and stray spaces between numbers, one kind of dash and no surrounding punctuation
+func NormalizeDesignation(text string) string {
+	s := designationLookAlikes.Replace(strings.ToUpper(strings.TrimSpace(text)))
+	s = spacedDigitsPattern.ReplaceAllString(s, "$1.$2")
+	s = strings.Join(strings.Fields(s), "")
+	s = codeDigitsPattern.ReplaceAllString(s, "$1-$2")
+	s = repeatedSepPattern.ReplaceAllString(s, "$1")
+
+	return strings.TrimFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// ParseDesignation reads a recognized designation. The prefix ends with the last letter group
+// before the levels, designations without letters have no prefix. A designation has at least two levels.
+func ParseDesignation(text string) (*Designation, error) {
+	groups := designationGroup.FindAllString(NormalizeDesignation(text), -1)
+	if len(groups) == 0 {
+		return nil, ErrInvalidDesignation
+	}
+
+	d := &Designation{}
+	if last := groups[len(groups)-1]; len(groups) > 1 && documentCodePattern.MatchString(last) && !designationDigits.MatchString(levelDigits(last)) {
+		d.Document = last
+		groups = groups[:len(groups)-1]
+		if sep := groups[len(groups)-1]; sep == "." || sep == "-" {
+			groups = groups[:len(groups)-1]
+		}
+	}
+
+	levels := 0
+	for i, group := range groups {
+		if designationLetters.MatchString(group) && !designationDigits.MatchString(levelDigits(group)) {
+			levels = i + 1
+		}
+	}
+	if levels > 0 {
+		d.Prefix = strings.Join(groups[:levels], "")
+		if levels < len(groups) {
+			// skip the separator after the prefix
+			levels++
+		}
+	}
+
+	for i := levels; i < len(groups); i++ {
+		group := groups[i]
+		if group == "." {
+			continue
+		}
+		if group == "-" {
+			if d.Variant != "" || len(d.Levels) == 0 || i+1 >= len(groups) {
+				return nil, ErrInvalidDesignation
+			}
+			continue
+		}
+		digits := levelDigits(group)
+		if !designationDigits.MatchString(digits) {
+			return nil, ErrInvalidDesignation
+		}
+		if d.Variant != "" {
+			return nil, ErrInvalidDesignation
+		}
+		if i > levels && groups[i-1] == "-" {
+			d.Variant = digits
+			continue
+		}
+		d.Levels = append(d.Levels, digits)
+	}
+	if len(d.Levels) < minDesignationLevels {
+		return nil, ErrInvalidDesignation
+	}
+
+	return d, nil
+}
+
+// levelDigits reads the look-alike letters of a group as digits. С counts as 0 only in groups with digits.
+func levelDigits(group string) string {
+	if strings.ContainsAny(group, "0123456789") {
+		return gluedDigitLookAlikes.Replace(group)
+	}
+
+	return digitLookAlikes.Replace(group)
+}
+
+// Base returns the designation of the item without the variant and the document code
+func (d *Designation) Base() string {
+	base := strings.Join(d.Levels, ".")
+	if d.Prefix != "" {
+		base = d.Prefix + "." + base
+	}
+
+	return base
+}
+
+// String returns the designation in the normalized spelling
+func (d *Designation) String() string {
+	s := d.Base()
+	if d.Variant != "" {
+		s += "-" + d.Variant
+	}
+	if d.Document != "" {
+		s += "." + d.Document
+	}
+
+	return s
+}
+
+// Significant returns the levels without the trailing zero levels, the position of an assembly in the hierarchy
+func (d *Designation) Significant() []string {
+	levels := d.Levels
+	for len(levels) > 0 && strings.Trim(levels[len(levels)-1], "0") == "" {
+		levels = levels[:len(levels)-1]
+	}
+
+	return levels
+}
+
+// Contains tells whether the designation of child places it inside the assembly: it has the same prefix and
+// its significant levels extend the significant levels of the assembly
+func (d *Designation) Contains(child *Designation) bool {
+	if d.Prefix != child.Prefix {
+		return false
+	}
+	parent, levels := d.Significant(), child.Significant()
+	if len(levels) <= len(parent) {
+		return false
+	}
+	for i, level := range parent {
+		if levels[i] != level {
+			return false
+		}
+	}
+
+	return true
+}
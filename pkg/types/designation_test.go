@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDesignation(t *testing.T) {
+	tests := []struct {
+		text string
+		want Designation
+	}{
+		{"23.00.27-ТХ.02.01.03.00.02", Designation{Prefix: "23.00.27-ТХ", Levels: []string{"02", "01", "03", "00", "02"}}},
+		{"23.00.27-ТХ. 02.01.03.00.00", Designation{Prefix: "23.00.27-ТХ", Levels: []string{"02", "01", "03", "00", "00"}}},
+		{"23.00.27ТХ.02.01.00,С0.00.", Designation{Prefix: "23.00.27-ТХ", Levels: []string{"02", "01", "00", "00", "00"}}},
+		{"23.00.27-тХ.02.01.02.00.01", Designation{Prefix: "23.00.27-ТХ", Levels: []string{"02", "01", "02", "00", "01"}}},
+		{"23.00 27-TX.02.01.00.00.06", Designation{Prefix: "23.00.27-ТХ", Levels: []string{"02", "01", "00", "00", "06"}}},
+		{"23.00.27–ТХ.02.01.01.01.02-01", Designation{Prefix: "23.00.27-ТХ", Levels: []string{"02", "01", "01", "01", "02"}, Variant: "01"}},
+		{"23.00.27-ТХ.02.01.05.00.00.СБ", Designation{Prefix: "23.00.27-ТХ", Levels: []string{"02", "01", "05", "00", "00"}, Document: "СБ"}},
+		{"СФ-01..002", Designation{Prefix: "СФ", Levels: []string{"01", "002"}}},
+		{"С-01.002", Designation{Prefix: "С", Levels: []string{"01", "002"}}},
+		{"23.00.27-ТХ.02.01.05.00.00.С", Designation{Prefix: "23.00.27-ТХ", Levels: []string{"02", "01", "05", "00", "00"}, Document: "С"}},
+		{"СФ-01.О2.З4", Designation{Prefix: "СФ", Levels: []string{"01", "02", "34"}}},
+		{"12.34.56", Designation{Levels: []string{"12", "34", "56"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseDesignation(tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, &tt.want, got)
+		})
+	}
+
+	for _, text := range []string{"", "12", "Болт М12", "ГОСТ 7798-70", "СФ-01.АБ.002"} {
+		_, err := ParseDesignation(text)
+		assert.ErrorIs(t, err, ErrInvalidDesignation, text)
+	}
+}
+
+func TestDesignationString(t *testing.T) {
+	d, err := ParseDesignation("23.00.27ТХ.02.01.01.00.00-01.сб")
+	require.NoError(t, err)
+	assert.Equal(t, "23.00.27-ТХ.02.01.01.00.00-01.СБ", d.String())
+	assert.Equal(t, "23.00.27-ТХ.02.01.01.00.00", d.Base())
+	assert.Equal(t, []string{"02", "01", "01"}, d.Significant())
+}
+
+func TestDesignationContains(t *testing.T) {
+	tests := []struct {
+		parent, child string
+		want          bool
+	}{
+		{"23.00.27ТХ.02.01.00,С0.00.", "23.00.27-ТХ. 02.01.03.00.00", true},
+		{"23.00.27ТХ.02.01.00,С0.00.", "23.00.27-ТХ.02.01.00.00.04", true},
+		{"23.00.27ТХ.02.01.00,С0.00.", "23.00.27-ТХ.02.01.05.00.00.СБ", true},
+		{"23.00.27-ТХ.02.01.01.00.00", "23.00.27-ТХ. 02.01.01.01.00-01", true},
+		{"23.00.27-ТХ.02.01.01.01.00", "23.00.27-ТХ.02.01.01.01.02-01", true},
+		{"23.00.27ТХ.02.01.00,С0.00.", "'3.00.27-ТХ.02.01.01.00.00-01", false},
+		{"23.00.27-ТХ.02.01.03.00.00", "23.00.27-ТХ.02.01.02.00.01", false},
+		{"23.00.27-ТХ.02.01.03.00.00", "23.00.27-ТХ.02.01.03.00.00-01", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.child, func(t *testing.T) {
+			parent, err := ParseDesignation(tt.parent)
+			require.NoError(t, err)
+			child, err := ParseDesignation(tt.child)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, parent.Contains(child))
+		})
+	}
+}
@@ -0,0 +1,407 @@
+package types
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+// SizeStatusField is the assortment field status key of the size
+const SizeStatusField = "size"
+
+// lookAlikes maps the separators and symbols recognition confuses to one spelling
+var lookAlikes = strings.NewReplacer(
+	"х", "x", "Х", "x", "X", "x", "×", "x", "*", "x",
+	",", ".",
+	"⌀", "Ø", "ø", "Ø", "∅", "Ø",
+	"∟", "L",
+)
+
+var (
+	// standardPattern matches standard references that carry numbers unrelated to the size
+	standardPattern = regexp.MustCompile(`(?i)(?:ГОСТ|ОСТ|ТУ|DIN|ISO|EN)\s*(?:Р\s*)?[\d.\-–]+`)
+	// diameterPattern matches Ф written for the diameter sign before a number
+	diameterPattern = regexp.MustCompile(`[Фф]\s*(\d)`)
+	unitPattern     = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*(мм|mm|см|cm|м|m)([^\p{L}]|$)`)
+	// seriesPattern matches Latin letters written for the Cyrillic series of channels and beams, e.g. 10P or 20B1
+	seriesPattern    = regexp.MustCompile(`(\d)\s*([PYEBKMD])(\d?)([^\p{L}]|$)`)
+	thicknessPattern = regexp.MustCompile(`(?i)(?:^|[^\p{L}])(?:s|δ|t|толщ\.?|толщина)\s*=?\s*(\d+(?:\.\d+)?)`)
+	numberPattern    = regexp.MustCompile(`\d+(?:\.\d+)?`)
+	channelPattern   = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*([ПУЭЛС]?)`)
+	beamPattern      = regexp.MustCompile(`(\d+)\s*([БКШДМ]\d?)?`)
+	anglePattern     = regexp.MustCompile(`(?:^|[^\p{L}])l\s*\d`)
+	beamSignPattern  = regexp.MustCompile(`(?:^|[^\p{L}])i\s*\d`)
+	// sizeTokenPattern matches a size in a cleaned material, e.g. 57x3.5, L50x5 or 20Б1
+	sizeTokenPattern = regexp.MustCompile(`[ØL\[□]?\s*\d+(?:\.\d+)?(?:\s*x\s*\d+(?:\.\d+)?)*(?:[ПУЭЛСБКШДМ]\d?)?`)
+)
+
+var seriesLetters = map[string]string{"P": "П", "Y": "У", "E": "Э", "B": "Б", "K": "К", "M": "М", "D": "Д"}
+
+var unitScale = map[string]float64{"мм": 1, "mm": 1, "см": 10, "cm": 10, "м": 1000, "m": 1000}
+
+// familyWords name the profile families; square tubes go before pipes
+var familyWords = []struct {
+	family proto.ProfileFamily
+	words  []string
+}{
+	{proto.ProfileFamily_PROFILE_FAMILY_SQUARE_TUBE, []string{"труба проф", "профильная труба", "труба квадрат", "квадратная труба", "труба прямоуг", "прямоугольная труба", "□"}},
+	{proto.ProfileFamily_PROFILE_FAMILY_PIPE, []string{"труба", "pipe", "tube"}},
+	{proto.ProfileFamily_PROFILE_FAMILY_SHEET, []string{"лист", "плита", "sheet", "plate"}},
+	{proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR, []string{"круг", "пруток", "round"}},
+	{proto.ProfileFamily_PROFILE_FAMILY_ANGLE, []string{"уголок", "angle"}},
+	{proto.ProfileFamily_PROFILE_FAMILY_CHANNEL, []string{"швеллер", "channel", "["}},
+	{proto.ProfileFamily_PROFILE_FAMILY_BEAM, []string{"двутавр", "балка", "beam"}},
+}
+
+// ProfileFamilyWord returns a word naming the family, a hint to read sizes of a known family.
+// It returns an empty string for an unspecified family.
+func ProfileFamilyWord(family proto.ProfileFamily) string {
+	for _, entry := range familyWords {
+		if entry.family == family {
+			return entry.words[0]
+		}
+	}
+
+	return ""
+}
+
+// ParseProfileSize reads a recognized size such as "Ø57x3,5", "L50x5", "Лист 10" or "Швеллер 10П"
+// into a typed size. The family is taken from the text, otherwise from the hint, which may be any
+// text naming the profile such as the assortment name or the material.
+func ParseProfileSize(text, hint string) (*proto.ProfileSize, bool) {
+	raw := strings.TrimSpace(text)
+	clean := cleanSize(raw)
+	if clean == "" {
+		return nil, false
+	}
+
+	family := profileFamily(clean)
+	if family == proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED {
+		family = profileFamily(cleanSize(hint))
+	}
+	numbers := parseNumbers(clean)
+	if family == proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED && strings.Contains(clean, "Ø") {
+		switch len(numbers) {
+		case 1:
+			family = proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR
+		case 2:
+			family = proto.ProfileFamily_PROFILE_FAMILY_PIPE
+		}
+	}
+
+	size := &proto.ProfileSize{Family: family, Raw: raw}
+	ok := false
+	switch family {
+	case proto.ProfileFamily_PROFILE_FAMILY_SHEET:
+		size.Sheet, ok = parseSheet(clean, numbers)
+	case proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR:
+		if len(numbers) == 1 {
+			size.RoundBar, ok = &proto.RoundBarSize{Diameter: numbers[0]}, true
+		}
+	case proto.ProfileFamily_PROFILE_FAMILY_PIPE:
+		if len(numbers) == 2 && numbers[1]*2 < numbers[0] {
+			size.Pipe, ok = &proto.PipeSize{Diameter: numbers[0], Wall: numbers[1]}, true
+		}
+	case proto.ProfileFamily_PROFILE_FAMILY_ANGLE:
+		size.Angle, ok = parseAngle(numbers)
+	case proto.ProfileFamily_PROFILE_FAMILY_CHANNEL:
+		size.Channel, ok = parseChannel(clean, numbers)
+	case proto.ProfileFamily_PROFILE_FAMILY_BEAM:
+		size.Beam, ok = parseBeam(clean, numbers)
+	case proto.ProfileFamily_PROFILE_FAMILY_SQUARE_TUBE:
+		size.SquareTube, ok = parseSquareTube(numbers)
+	}
+	if !ok {
+		return nil, false
+	}
+
+	return size, true
+}
+
+// cleanSize drops the grade and standard references and unifies look-alike characters and units to mm
+func cleanSize(text string) string {
+	text, _, _ = strings.Cut(text, "/")
+	text = standardPattern.ReplaceAllString(text, " ")
+	text = diameterPattern.ReplaceAllString(text, "Ø$1")
+	text = lookAlikes.Replace(text)
+	text = unitPattern.ReplaceAllStringFunc(text, func(match string) string {
+		parts := unitPattern.FindStringSubmatch(match)
+		value, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return match
+		}
+		return strconv.FormatFloat(value*unitScale[parts[2]], 'f', -1, 64) + parts[3]
+	})
+	text = seriesPattern.ReplaceAllStringFunc(text, func(match string) string {
+		parts := seriesPattern.FindStringSubmatch(match)
+		return parts[1] + seriesLetters[parts[2]] + parts[3] + parts[4]
+	})
+
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func profileFamily(text string) proto.ProfileFamily {
+	lower := strings.ToLower(text)
+	for _, entry := range familyWords {
+		for _, word := range entry.words {
+			if strings.Contains(lower, word) {
+				return entry.family
+			}
+		}
+	}
+	switch {
+	case anglePattern.MatchString(lower):
+		return proto.ProfileFamily_PROFILE_FAMILY_ANGLE
+	case beamSignPattern.MatchString(lower):
+		return proto.ProfileFamily_PROFILE_FAMILY_BEAM
+	}
+
+	return proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED
+}
+
+func parseNumbers(text string) []float64 {
+	var numbers []float64
+	for _, match := range numberPattern.FindAllString(text, -1) {
+		if value, err := strconv.ParseFloat(match, 64); err == nil {
+			numbers = append(numbers, value)
+		}
+	}
+
+	return numbers
+}
+
+// parseSheet takes the marked thickness such as s10 or δ=10, otherwise the smallest number;
+// the other numbers are the width and length
+func parseSheet(text string, numbers []float64) (*proto.SheetSize, bool) {
+	if match := thicknessPattern.FindStringSubmatch(text); match != nil {
+		thickness, err := strconv.ParseFloat(match[1], 64)
+		if err != nil || thickness <= 0 {
+			return nil, false
+		}
+		return &proto.SheetSize{Thickness: thickness}, true
+	}
+	if len(numbers) == 0 || len(numbers) > 3 {
+		return nil, false
+	}
+
+	sorted := append([]float64(nil), numbers...)
+	sort.Float64s(sorted)
+	if sorted[0] <= 0 {
+		return nil, false
+	}
+	sheet := &proto.SheetSize{Thickness: sorted[0]}
+	if len(sorted) > 1 {
+		sheet.Width = sorted[1]
+	}
+	if len(sorted) > 2 {
+		sheet.Length = sorted[2]
+	}
+
+	return sheet, true
+}
+
+// parseAngle reads an equal angle as width × thickness and an unequal one as width × height × thickness
+func parseAngle(numbers []float64) (*proto.AngleSize, bool) {
+	var angle *proto.AngleSize
+	switch len(numbers) {
+	case 2:
+		angle = &proto.AngleSize{Width: numbers[0], Height: numbers[0], Thickness: numbers[1]}
+	case 3:
+		angle = &proto.AngleSize{Width: numbers[0], Height: numbers[1], Thickness: numbers[2]}
+	default:
+		return nil, false
+	}
+	if angle.Thickness <= 0 || angle.Thickness >= angle.Width || angle.Thickness >= angle.Height {
+		return nil, false
+	}
+
+	return angle, true
+}
+
+// parseChannel reads a profile number such as 10П, which is the height in cm,
+// or the height × width × thickness of a bent channel
+func parseChannel(text string, numbers []float64) (*proto.ChannelSize, bool) {
+	switch {
+	case len(numbers) == 1:
+		match := channelPattern.FindStringSubmatch(text)
+		return &proto.ChannelSize{Number: match[1] + match[2], Height: numbers[0] * 10}, numbers[0] > 0
+	case len(numbers) == 3:
+		return &proto.ChannelSize{Height: numbers[0]}, numbers[0] > 0
+	}
+
+	return nil, false
+}
+
+// parseBeam reads a profile number such as 20Б1, which starts with the height in cm
+func parseBeam(text string, numbers []float64) (*proto.BeamSize, bool) {
+	if len(numbers) == 0 || len(numbers) > 2 {
+		return nil, false
+	}
+	match := beamPattern.FindStringSubmatch(text)
+	if match == nil {
+		return nil, false
+	}
+	height, err := strconv.ParseFloat(match[1], 64)
+	if err != nil || height <= 0 {
+		return nil, false
+	}
+
+	return &proto.BeamSize{Number: match[1] + match[2], Height: height * 10}, true
+}
+
+// parseSquareTube reads a square tube as width × wall and a rectangular one as width × height × wall
+func parseSquareTube(numbers []float64) (*proto.SquareTubeSize, bool) {
+	var tube *proto.SquareTubeSize
+	switch len(numbers) {
+	case 2:
+		tube = &proto.SquareTubeSize{Width: numbers[0], Height: numbers[0], Wall: numbers[1]}
+	case 3:
+		tube = &proto.SquareTubeSize{Width: numbers[0], Height: numbers[1], Wall: numbers[2]}
+	default:
+		return nil, false
+	}
+	if tube.Wall <= 0 || tube.Wall*2 >= tube.Width || tube.Wall*2 >= tube.Height {
+		return nil, false
+	}
+
+	return tube, true
+}
+
+// FormatProfileSize writes a typed size the way sizes are written in materials, e.g. 57×3.5 or 10П.
+// It returns an empty string for a size without a family.
+func FormatProfileSize(size *proto.ProfileSize) string {
+	if size == nil {
+		return ""
+	}
+	join := func(values ...float64) string {
+		parts := make([]string, 0, len(values))
+		for _, v := range values {
+			if v > 0 {
+				parts = append(parts, strconv.FormatFloat(v, 'f', -1, 64))
+			}
+		}
+		return strings.Join(parts, "×")
+	}
+
+	switch {
+	case size.Sheet != nil:
+		return join(size.Sheet.Thickness)
+	case size.RoundBar != nil:
+		return join(size.RoundBar.Diameter)
+	case size.Pipe != nil:
+		return join(size.Pipe.Diameter, size.Pipe.Wall)
+	case size.Angle != nil:
+		if size.Angle.Width == size.Angle.Height {
+			return join(size.Angle.Width, size.Angle.Thickness)
+		}
+		return join(size.Angle.Width, size.Angle.Height, size.Angle.Thickness)
+	case size.Channel != nil:
+		if size.Channel.Number != "" {
+			return size.Channel.Number
+		}
+		return join(size.Channel.Height)
+	case size.Beam != nil:
+		return size.Beam.Number
+	case size.SquareTube != nil:
+		if size.SquareTube.Width == size.SquareTube.Height {
+			return join(size.SquareTube.Width, size.SquareTube.Wall)
+		}
+		return join(size.SquareTube.Width, size.SquareTube.Height, size.SquareTube.Wall)
+	}
+
+	return ""
+}
+
+// AnnotateSizes reads the recognized sizes of the tree into typed assortment sizes: the size
+// column of specification rows, the main size of drawings, or else the size written in the material.
+// Typed sizes and standard or purchased rows are kept as is. A size that cannot be read is flagged YELLOW in the field status of the assortment.
+func AnnotateSizes(root *proto.TreeNode) {
+	if spec := root.Spec; spec != nil && spec.PartKind != proto.PartKind_PART_KIND_STANDARD && spec.PartKind != proto.PartKind_PART_KIND_PURCHASED {
+		text := strings.TrimSpace(spec.Size_)
+		if text == "" {
+			text = materialSize(spec.Material)
+		}
+		hint := spec.Name + " " + spec.Material
+		switch {
+		case spec.Assortment != nil:
+			annotateAssortment(spec.Assortment, text, spec.Assortment.Name+" "+spec.Assortment.FigureType+" "+hint)
+		case text != "":
+			// a row without an assortment only gets one for a readable size
+			if size, ok := ParseProfileSize(text, hint); ok {
+				spec.Assortment = &proto.Assortment{Size_: size}
+			}
+		}
+	}
+	if figure := root.Figure; figure != nil && figure.Assortment != nil {
+		assortment := figure.Assortment
+		text := strings.TrimSpace(figure.GetMainSizeStr())
+		if text == "" {
+			text = materialSize(assortment.Material)
+		}
+		annotateAssortment(assortment, text, assortment.Name+" "+assortment.FigureType+" "+assortment.Material)
+	}
+	for _, leaf := range root.Leaves {
+		AnnotateSizes(leaf)
+	}
+}
+
+func annotateAssortment(assortment *proto.Assortment, text, hint string) {
+	if assortment == nil || assortment.Size_ != nil || text == "" {
+		return
+	}
+	if size, ok := ParseProfileSize(text, hint); ok {
+		assortment.Size_ = size
+		return
+	}
+	if assortment.FieldStatus == nil {
+		assortment.FieldStatus = make(map[string]proto.FieldStatus)
+	}
+	assortment.FieldStatus[SizeStatusField] = proto.FieldStatus_YELLOW
+}
+
+// materialSize returns the size written after the profile name in a material such as
+// "Труба 57х3,5 ГОСТ 8732-78/20", or an empty string when the material names no profile
+func materialSize(material string) string {
+	clean := cleanSize(material)
+	if profileFamily(clean) == proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED {
+		return ""
+	}
+
+	return sizeTokenPattern.FindString(clean)
+}
+
+// NodeProfileSize returns the typed size of the node's assortment. Nodes of trees recognized
+// before sizes were typed have their size read from the recognized text. It returns nil when unknown.
+func NodeProfileSize(node *proto.TreeNode) *proto.ProfileSize {
+	var assortment *proto.Assortment
+	if node.Figure != nil && node.Figure.Assortment != nil {
+		assortment = node.Figure.Assortment
+	} else if node.Spec != nil && node.Spec.Assortment != nil {
+		assortment = node.Spec.Assortment
+	}
+	if assortment != nil && assortment.Size_ != nil {
+		return assortment.Size_
+	}
+
+	material := NodeMaterial(node)
+	hint := material
+	if assortment != nil {
+		hint = assortment.Name + " " + assortment.FigureType + " " + hint
+	}
+	var text string
+	if node.Figure != nil {
+		text = strings.TrimSpace(node.Figure.GetMainSizeStr())
+	}
+	if text == "" && node.Spec != nil {
+		text = strings.TrimSpace(node.Spec.Size_)
+	}
+	if text == "" {
+		text = materialSize(material)
+	}
+	size, _ := ParseProfileSize(text, hint)
+
+	return size
+}
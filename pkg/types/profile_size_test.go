@@ -0,0 +1,117 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProfileSize(t *testing.T) {
+	tests := []struct {
+		text, hint string
+		want       *proto.ProfileSize
+	}{
+		{"Ø57x3,5", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_PIPE, Pipe: &proto.PipeSize{Diameter: 57, Wall: 3.5}}},
+		{"ф57х3.5", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_PIPE, Pipe: &proto.PipeSize{Diameter: 57, Wall: 3.5}}},
+		{"57×3,5", "Труба ГОСТ 8732-78", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_PIPE, Pipe: &proto.PipeSize{Diameter: 57, Wall: 3.5}}},
+		{"Ø20", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR, RoundBar: &proto.RoundBarSize{Diameter: 20}}},
+		{"Круг 2 см", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR, RoundBar: &proto.RoundBarSize{Diameter: 20}}},
+		{"L50x5", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_ANGLE, Angle: &proto.AngleSize{Width: 50, Height: 50, Thickness: 5}}},
+		{"Уголок 63х40х6", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_ANGLE, Angle: &proto.AngleSize{Width: 63, Height: 40, Thickness: 6}}},
+		{"Лист 10", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_SHEET, Sheet: &proto.SheetSize{Thickness: 10}}},
+		{"s10", "Лист", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_SHEET, Sheet: &proto.SheetSize{Thickness: 10}}},
+		{"1500х6000х8", "лист", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_SHEET, Sheet: &proto.SheetSize{Thickness: 8, Width: 1500, Length: 6000}}},
+		{"Швеллер 10П", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_CHANNEL, Channel: &proto.ChannelSize{Number: "10П", Height: 100}}},
+		{"10P", "Швеллер", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_CHANNEL, Channel: &proto.ChannelSize{Number: "10П", Height: 100}}},
+		{"Двутавр 20Б1", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_BEAM, Beam: &proto.BeamSize{Number: "20Б1", Height: 200}}},
+		{"I20B1", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_BEAM, Beam: &proto.BeamSize{Number: "20Б1", Height: 200}}},
+		{"Труба проф. 40х40х3", "", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_SQUARE_TUBE, SquareTube: &proto.SquareTubeSize{Width: 40, Height: 40, Wall: 3}}},
+		{"60х40х4", "Труба профильная", &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_SQUARE_TUBE, SquareTube: &proto.SquareTubeSize{Width: 60, Height: 40, Wall: 4}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, ok := ParseProfileSize(tt.text, tt.hint)
+			require.True(t, ok)
+			tt.want.Raw = tt.text
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, text := range []string{"", "57х3,5", "Ø57x30", "L50", "Лист", "Уголок 5х50"} {
+		_, ok := ParseProfileSize(text, "")
+		assert.False(t, ok, text)
+	}
+}
+
+func TestFormatProfileSize(t *testing.T) {
+	for _, text := range []string{"Ø57x3,5", "L50x5", "Швеллер 10P", "Двутавр 20Б1", "Лист 12", "Труба проф. 60x40x4"} {
+		size, ok := ParseProfileSize(text, "")
+		require.True(t, ok, text)
+		assert.NotEmpty(t, FormatProfileSize(size), text)
+	}
+	size, _ := ParseProfileSize("Ø57x3,5", "")
+	assert.Equal(t, "57×3.5", FormatProfileSize(size))
+	size, _ = ParseProfileSize("10P", "Швеллер")
+	assert.Equal(t, "10П", FormatProfileSize(size))
+	assert.Empty(t, FormatProfileSize(nil))
+}
+
+func TestAnnotateSizes(t *testing.T) {
+	typed := &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR, RoundBar: &proto.RoundBarSize{Diameter: 30}}
+	root := &proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{Id: "pipe", Spec: &proto.SpecificationRow{Size_: "Ø57x3,5", Material: "Труба ГОСТ 8732-78/20"}},
+			{Id: "sheet", Spec: &proto.SpecificationRow{Material: "Лист 10 ГОСТ 19903-2015/09Г2С"}},
+			{Id: "bolt", Spec: &proto.SpecificationRow{Size_: "M12x40", PartKind: proto.PartKind_PART_KIND_STANDARD}},
+			{Id: "angle", Figure: &proto.Figure{
+				MainSize:   &proto.Figure_MainSizeStr{MainSizeStr: "L50х5"},
+				Assortment: &proto.Assortment{Material: "Ст3"},
+			}},
+			{Id: "unreadable", Figure: &proto.Figure{
+				MainSize:   &proto.Figure_MainSizeStr{MainSizeStr: "50"},
+				Assortment: &proto.Assortment{Name: "Уголок"},
+			}},
+			{Id: "typed", Figure: &proto.Figure{
+				MainSize:   &proto.Figure_MainSizeStr{MainSizeStr: "Ø20"},
+				Assortment: &proto.Assortment{Size_: typed},
+			}},
+		},
+	}
+
+	AnnotateSizes(root)
+
+	assert.Equal(t, proto.ProfileFamily_PROFILE_FAMILY_PIPE, root.Leaves[0].Spec.Assortment.Size_.Family)
+	assert.Equal(t, 10.0, root.Leaves[1].Spec.Assortment.Size_.Sheet.Thickness)
+	assert.Nil(t, root.Leaves[2].Spec.Assortment)
+	assert.Equal(t, 50.0, root.Leaves[3].Figure.Assortment.Size_.Angle.Width)
+	unreadable := root.Leaves[4].Figure.Assortment
+	assert.Nil(t, unreadable.Size_)
+	assert.Equal(t, proto.FieldStatus_YELLOW, unreadable.FieldStatus[SizeStatusField])
+	assert.Same(t, typed, root.Leaves[5].Figure.Assortment.Size_)
+}
+
+func TestNodeProfileSize(t *testing.T) {
+	typed := &proto.ProfileSize{Family: proto.ProfileFamily_PROFILE_FAMILY_ROUND_BAR, RoundBar: &proto.RoundBarSize{Diameter: 30}}
+	assert.Same(t, typed, NodeProfileSize(&proto.TreeNode{
+		Figure: &proto.Figure{Assortment: &proto.Assortment{Size_: typed}},
+	}))
+
+	size := NodeProfileSize(&proto.TreeNode{Material: "Труба 57х3,5 ГОСТ 8732-78/20"})
+	require.NotNil(t, size)
+	assert.Equal(t, &proto.PipeSize{Diameter: 57, Wall: 3.5}, size.Pipe)
+
+	assert.Nil(t, NodeProfileSize(&proto.TreeNode{Material: "Ст3"}))
+}
+
+func TestProfileFamilyWord(t *testing.T) {
+	size, ok := ParseProfileSize("57x3,5", ProfileFamilyWord(proto.ProfileFamily_PROFILE_FAMILY_PIPE))
+	require.True(t, ok)
+	assert.Equal(t, proto.ProfileFamily_PROFILE_FAMILY_PIPE, size.Family)
+	size, ok = ParseProfileSize("60x4", ProfileFamilyWord(proto.ProfileFamily_PROFILE_FAMILY_SQUARE_TUBE))
+	require.True(t, ok)
+	assert.Equal(t, proto.ProfileFamily_PROFILE_FAMILY_SQUARE_TUBE, size.Family)
+	assert.Empty(t, ProfileFamilyWord(proto.ProfileFamily_PROFILE_FAMILY_UNSPECIFIED))
+}
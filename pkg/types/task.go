@@ -0,0 +1,64 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTaskNotFound     = errors.New("task not found")
+	ErrTaskNotCompleted = errors.New("task recognition is not completed")
+)
+
+// ClientTask loads a task of the client. Tasks of other clients and ids that are not UUIDs are not found.
+// Pass a transaction with a locking clause to lock the task.
+func ClientTask(ctx context.Context, db *gorm.DB, clientID uint64, taskID string) (*proto.DataRecognitionTaskORM, error) {
+	if _, err := uuid.Parse(taskID); err != nil {
+		return nil, ErrTaskNotFound
+	}
+
+	var task proto.DataRecognitionTaskORM
+	err := db.WithContext(ctx).First(&task, "id = ? AND client_id = ?", taskID, clientID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// CompletedTask loads a task of the client whose recognition is completed, see ClientTask
+func CompletedTask(ctx context.Context, db *gorm.DB, clientID uint64, taskID string) (*proto.DataRecognitionTaskORM, error) {
+	task, err := ClientTask(ctx, db, clientID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if proto.Status(task.Status) != proto.Status_STATUS_PROCESSING_COMPLETED {
+		return nil, ErrTaskNotCompleted
+	}
+
+	return task, nil
+}
+
+// JSONValue converts a message into the value of a JSON column
+func JSONValue[T any](message *T) (*datatypes.JSONType[T], error) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	var result datatypes.JSONType[T]
+	if err := result.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+)
+
+var ErrNoRecognizedTree = errors.New("task has no recognition result")
+
+// TaskTree returns the tree of a task: the user-edited result if present, otherwise the recognized one
+func TaskTree(task *proto.DataRecognitionTaskORM) (*proto.TreeNode, error) {
+	if task.FrontendResult != nil {
+		node := task.FrontendResult.Data()
+		if node.Id != "" || len(node.Leaves) > 0 {
+			return &node, nil
+		}
+	}
+	if task.RecognitionResult != nil {
+		node := task.RecognitionResult.Data()
+		return &node, nil
+	}
+
+	return nil, ErrNoRecognizedTree
+}
+
+// FlattenTree lists the nodes of the tree children first, each with the id of its parent set, in the
+// format of the flat frontend result
+func FlattenTree(root proto.TreeNode) []proto.TreeNode {
+	return flattenNode(root, nil)
+}
+
+func flattenNode(node proto.TreeNode, nodes []proto.TreeNode) []proto.TreeNode {
+	for _, child := range node.Leaves {
+		child.ParentId = node.Id
+		nodes = flattenNode(*child, nodes)
+	}
+
+	return append(nodes, node)
+}
+
+// NodeMaterial returns the material of the node, falling back to the recognized assortment
+func NodeMaterial(node *proto.TreeNode) string {
+	if node.Material != "" {
+		return node.Material
+	}
+	if node.Figure != nil && node.Figure.Assortment != nil && node.Figure.Assortment.Material != "" {
+		return node.Figure.Assortment.Material
+	}
+	if node.Spec != nil {
+		if node.Spec.Material != "" {
+			return node.Spec.Material
+		}
+		if node.Spec.Assortment != nil {
+			return node.Spec.Assortment.Material
+		}
+	}
+
+	return ""
+}
+
+// NodeQuantity returns the number of units of the node in the whole product: the accumulated count,
+// which counts.Accumulate sets on every node below the root. Trees not accumulated fall back to the
+// count of the node, nodes without counts are treated as a single unit.
+func NodeQuantity(node *proto.TreeNode) int32 {
+	if node.AccumulatedCount > 0 {
+		return node.AccumulatedCount
+	}
+	if node.Count > 0 {
+		return node.Count
+	}
+
+	return 1
+}
+
+// NormalizeMaterial lowercases a material and strips whitespace for substring matching
+func NormalizeMaterial(material string) string {
+	return strings.Join(strings.Fields(strings.ToLower(material)), "")
+}
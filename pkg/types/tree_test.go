@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/bazilio91/sferra-cloud/pkg/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeMaterial(t *testing.T) {
+	assert.Equal(t, "Ст3", NodeMaterial(&proto.TreeNode{Material: "Ст3"}))
+	assert.Equal(t, "09Г2С", NodeMaterial(&proto.TreeNode{
+		Figure: &proto.Figure{Assortment: &proto.Assortment{Material: "09Г2С"}},
+	}))
+	assert.Equal(t, "Ст20", NodeMaterial(&proto.TreeNode{
+		Spec: &proto.SpecificationRow{Material: "Ст20"},
+	}))
+	assert.Empty(t, NodeMaterial(&proto.TreeNode{}))
+}
+
+func TestNodeQuantity(t *testing.T) {
+	assert.Equal(t, int32(6), NodeQuantity(&proto.TreeNode{Count: 2, AccumulatedCount: 6}))
+	assert.Equal(t, int32(2), NodeQuantity(&proto.TreeNode{Count: 2}))
+	assert.Equal(t, int32(1), NodeQuantity(&proto.TreeNode{}))
+}
+
+func TestFlattenTree(t *testing.T) {
+	nodes := FlattenTree(proto.TreeNode{
+		Id: "root",
+		Leaves: []*proto.TreeNode{
+			{Id: "frame", Leaves: []*proto.TreeNode{{Id: "plate"}}},
+			{Id: "bolt"},
+		},
+	})
+
+	var ids, parents []string
+	for _, node := range nodes {
+		ids = append(ids, node.Id)
+		parents = append(parents, node.ParentId)
+	}
+	assert.Equal(t, []string{"plate", "frame", "bolt", "root"}, ids)
+	assert.Equal(t, []string{"frame", "root", "root", ""}, parents)
+}